@@ -35,6 +35,9 @@ func main() {
 		IdleTimeout:  container.Config.Server.IdleTimeout,
 	}
 
+	// End open event streams on shutdown so they don't hold it up
+	server.RegisterOnShutdown(container.RealtimeHub.Close)
+
 	// Start server in a goroutine
 	go func() {
 		log.Printf("Starting server on %s", server.Addr)
@@ -46,12 +49,28 @@ func main() {
 		}
 	}()
 
+	// Run background work until shutdown. Emails and scheduled jobs are
+	// claimed one by one, so every instance polls for them; the scheduler runs
+	// the recurring housekeeping jobs on one instance at a time.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go runPeriodically(jobsCtx, "notification delivery", 30*time.Second, func(ctx context.Context) (int64, error) {
+		sent, err := container.NotificationService.DeliverPendingEmails(ctx)
+		return int64(sent), err
+	})
+	go runPeriodically(jobsCtx, "scheduled jobs", container.Config.Scheduler.PollInterval, func(ctx context.Context) (int64, error) {
+		done, err := container.SchedulerService.RunDueJobs(ctx)
+		return int64(done), err
+	})
+	go container.RealtimeRelay.Run(jobsCtx)
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	// Give the server 30 seconds to finish handling requests
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -69,3 +88,25 @@ func setupRoutes(container *container.Container) http.Handler {
 	// Use the router from the container which has all the new endpoints
 	return container.Router.SetupRoutes()
 }
+
+// runPeriodically calls fn once at startup and then on every tick, until ctx
+// is cancelled, and logs how many items each call handled
+func runPeriodically(ctx context.Context, name string, interval time.Duration, fn func(context.Context) (int64, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		handled, err := fn(ctx)
+		if err != nil {
+			log.Printf("Failed to run %s: %v", name, err)
+		} else if handled > 0 {
+			log.Printf("Ran %s, %d item(s) handled", name, handled)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,6 +3,8 @@ package article
 import (
 	"context"
 	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
@@ -15,10 +17,10 @@ type Repository interface {
 	Create(ctx context.Context, article *Article) error
 	GetByID(ctx context.Context, id string) (*Article, error)
 	GetBySlug(ctx context.Context, slug string) (*Article, error)
-	GetAll(ctx context.Context) ([]*Article, error)
-	GetAllPublished(ctx context.Context) ([]*Article, error)
-	GetByCategory(ctx context.Context, category string) ([]*Article, error)
-	GetPublishedByCategory(ctx context.Context, category string) ([]*Article, error)
+	GetAll(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	GetAllPublished(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	GetByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	GetPublishedByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*Article], error)
 	Update(ctx context.Context, article *Article) error
 	Delete(ctx context.Context, id string) error
 }
@@ -1,15 +1,19 @@
 package article
 
-import "context"
+import (
+	"context"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
 
 type Service interface {
 	CreateArticle(ctx context.Context, id, title, content, author, category, slug string) (*Article, error)
 	GetArticle(ctx context.Context, id string) (*Article, error)
 	GetArticleBySlug(ctx context.Context, slug string) (*Article, error)
-	ListArticles(ctx context.Context) ([]*Article, error)
-	ListPublishedArticles(ctx context.Context) ([]*Article, error)
-	ListArticlesByCategory(ctx context.Context, category string) ([]*Article, error)
-	ListPublishedArticlesByCategory(ctx context.Context, category string) ([]*Article, error)
+	ListArticles(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	ListPublishedArticles(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	ListArticlesByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*Article], error)
+	ListPublishedArticlesByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*Article], error)
 	UpdateArticle(ctx context.Context, article *Article) error
 	DeleteArticle(ctx context.Context, id string) error
 }
@@ -0,0 +1,114 @@
+package assessment
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusAssigned  Status = "assigned"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Assessment is one administration of an instrument: assigned by a therapist,
+// completed once by the client and scored by the server
+type Assessment struct {
+	ID             string
+	InstrumentCode string
+	TherapistID    string
+	ClientID       string
+	Status         Status
+	Responses      []int
+	Score          *Score
+	DueAt          *time.Time
+	AssignedAt     time.Time
+	CompletedAt    *time.Time
+	UpdatedAt      time.Time
+}
+
+func NewAssessment(instrumentCode, therapistID, clientID string, dueAt *time.Time, now time.Time) (*Assessment, error) {
+	instrument, ok := GetInstrument(instrumentCode)
+	if !ok {
+		return nil, ErrUnknownInstrument
+	}
+
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if dueAt != nil && !dueAt.After(now) {
+		return nil, errors.New("due date must be in the future")
+	}
+
+	return &Assessment{
+		ID:             generateID(),
+		InstrumentCode: instrument.Code,
+		TherapistID:    therapistID,
+		ClientID:       clientID,
+		Status:         StatusAssigned,
+		DueAt:          dueAt,
+		AssignedAt:     now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+func (a *Assessment) Instrument() *Instrument {
+	instrument, _ := GetInstrument(a.InstrumentCode)
+	return instrument
+}
+
+// Complete records the client's responses, one per item in order, and scores them
+func (a *Assessment) Complete(responses []int, now time.Time) error {
+	if a.Status != StatusAssigned {
+		return ErrInvalidStatusTransition
+	}
+
+	score, err := a.Instrument().Score(responses)
+	if err != nil {
+		return err
+	}
+
+	a.Responses = append([]int(nil), responses...)
+	a.Score = score
+	a.Status = StatusCompleted
+	a.CompletedAt = &now
+	a.UpdatedAt = now
+	return nil
+}
+
+// Cancel withdraws an assessment the client has not completed yet
+func (a *Assessment) Cancel(now time.Time) error {
+	if a.Status != StatusAssigned {
+		return ErrInvalidStatusTransition
+	}
+
+	a.Status = StatusCancelled
+	a.UpdatedAt = now
+	return nil
+}
+
+func (a *Assessment) AssignedBy(therapistID string) bool {
+	return a.TherapistID == therapistID
+}
+
+func (a *Assessment) AssignedTo(clientID string) bool {
+	return a.ClientID == clientID
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
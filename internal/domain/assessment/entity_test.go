@@ -0,0 +1,75 @@
+package assessment
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewAssessment(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+
+	if _, err := NewAssessment("BDI-II", "therapist-123", "client-456", nil, now); !errors.Is(err, ErrUnknownInstrument) {
+		t.Errorf("Expected ErrUnknownInstrument, got %v", err)
+	}
+
+	if _, err := NewAssessment("PHQ-9", "therapist-123", "client-456", &past, now); err == nil {
+		t.Error("Expected error for a due date in the past")
+	}
+
+	assessment, err := NewAssessment("gad-7", "therapist-123", "client-456", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if assessment.InstrumentCode != "GAD-7" {
+		t.Errorf("Expected instrument code to be normalized to GAD-7, got %q", assessment.InstrumentCode)
+	}
+
+	if assessment.Status != StatusAssigned {
+		t.Errorf("Expected status %q, got %q", StatusAssigned, assessment.Status)
+	}
+}
+
+func TestAssessment_Lifecycle(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	assessment, err := NewAssessment("PHQ-9", "therapist-123", "client-456", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := assessment.Complete(responses(1, 1, 1), now); err == nil {
+		t.Fatal("Expected error for an incomplete set of responses")
+	}
+
+	if assessment.Status != StatusAssigned || assessment.Score != nil {
+		t.Fatal("Failed completion must leave the assessment open")
+	}
+
+	completedAt := now.Add(24 * time.Hour)
+	if err := assessment.Complete(responses(2, 2, 1, 2, 1, 1, 1, 0, 2), completedAt); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if assessment.Score.Total != 12 || assessment.Score.Severity != "moderate" {
+		t.Errorf("Expected a moderate score of 12, got %d (%s)", assessment.Score.Total, assessment.Score.Severity)
+	}
+
+	if !assessment.Score.HasCriticalItems() {
+		t.Error("Expected item 9 to be flagged")
+	}
+
+	if assessment.CompletedAt == nil || !assessment.CompletedAt.Equal(completedAt) {
+		t.Errorf("Expected completion time %v, got %v", completedAt, assessment.CompletedAt)
+	}
+
+	if err := assessment.Complete(responses(0, 0, 0, 0, 0, 0, 0, 0, 0), completedAt); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Errorf("Expected ErrInvalidStatusTransition when completing twice, got %v", err)
+	}
+
+	if err := assessment.Cancel(completedAt); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Errorf("Expected ErrInvalidStatusTransition when cancelling a completed assessment, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package assessment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Instrument is a validated questionnaire with a fixed scoring model: every item
+// is answered on the same option scale, the total is the sum of the item scores
+// and maps to a severity band.
+type Instrument struct {
+	Code          string
+	Name          string
+	Description   string
+	Instructions  string
+	Items         []string
+	Options       []Option
+	Bands         []SeverityBand
+	Subscales     []Subscale
+	CriticalItems []CriticalItem
+}
+
+// Option is one answer on the item scale, e.g. 0 "Not at all"
+type Option struct {
+	Value int
+	Label string
+}
+
+// SeverityBand is an inclusive total score range with its clinical label
+type SeverityBand struct {
+	Code  string
+	Label string
+	Min   int
+	Max   int
+}
+
+// Subscale sums a subset of items, numbered from 1
+type Subscale struct {
+	Code  string
+	Name  string
+	Items []int
+}
+
+// CriticalItem flags an answer that needs the therapist's attention regardless
+// of the total, e.g. any endorsement of PHQ-9 item 9
+type CriticalItem struct {
+	Item        int
+	MinResponse int
+	Reason      string
+}
+
+// Score is the result of scoring one completed instrument
+type Score struct {
+	Total         int            `json:"total"`
+	Severity      string         `json:"severity"`
+	Subscales     map[string]int `json:"subscales,omitempty"`
+	CriticalItems []int          `json:"critical_items,omitempty"`
+}
+
+func (s *Score) HasCriticalItems() bool {
+	return len(s.CriticalItems) > 0
+}
+
+// GetInstrument looks up a built-in instrument by code, ignoring case
+func GetInstrument(code string) (*Instrument, bool) {
+	instrument, ok := instruments[strings.ToUpper(strings.TrimSpace(code))]
+	return instrument, ok
+}
+
+// Instruments lists the built-in instruments ordered by code
+func Instruments() []*Instrument {
+	list := make([]*Instrument, 0, len(instruments))
+	for _, instrument := range instruments {
+		list = append(list, instrument)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+func (i *Instrument) MaxScore() int {
+	return len(i.Items) * i.Options[len(i.Options)-1].Value
+}
+
+// Band looks up one of the instrument's severity bands by code
+func (i *Instrument) Band(code string) (SeverityBand, bool) {
+	for _, band := range i.Bands {
+		if band.Code == code {
+			return band, true
+		}
+	}
+	return SeverityBand{}, false
+}
+
+// Score checks one response per item, in item order, and computes the total,
+// severity band, subscale totals and critical items
+func (i *Instrument) Score(responses []int) (*Score, error) {
+	if len(responses) != len(i.Items) {
+		return nil, fmt.Errorf("%s needs %d responses, got %d", i.Code, len(i.Items), len(responses))
+	}
+
+	score := &Score{}
+	for n, response := range responses {
+		if !i.validResponse(response) {
+			return nil, fmt.Errorf("response to item %d must be between %d and %d", n+1, i.Options[0].Value, i.Options[len(i.Options)-1].Value)
+		}
+		score.Total += response
+	}
+
+	for _, band := range i.Bands {
+		if score.Total >= band.Min && score.Total <= band.Max {
+			score.Severity = band.Code
+			break
+		}
+	}
+
+	if len(i.Subscales) > 0 {
+		score.Subscales = make(map[string]int, len(i.Subscales))
+		for _, subscale := range i.Subscales {
+			for _, item := range subscale.Items {
+				score.Subscales[subscale.Code] += responses[item-1]
+			}
+		}
+	}
+
+	for _, critical := range i.CriticalItems {
+		if responses[critical.Item-1] >= critical.MinResponse {
+			score.CriticalItems = append(score.CriticalItems, critical.Item)
+		}
+	}
+
+	return score, nil
+}
+
+func (i *Instrument) validResponse(response int) bool {
+	for _, option := range i.Options {
+		if option.Value == response {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package assessment
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func responses(values ...int) []int {
+	return values
+}
+
+func TestInstrument_Score(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		responses     []int
+		errString     string
+		wantTotal     int
+		wantSeverity  string
+		wantCritical  []int
+		wantSubscales map[string]int
+	}{
+		{
+			name:         "PHQ-9 minimal",
+			code:         "PHQ-9",
+			responses:    responses(0, 1, 0, 1, 0, 0, 1, 0, 0),
+			wantTotal:    3,
+			wantSeverity: "minimal",
+		},
+		{
+			name:         "PHQ-9 band boundary",
+			code:         "phq-9",
+			responses:    responses(3, 3, 3, 1, 0, 0, 0, 0, 0),
+			wantTotal:    10,
+			wantSeverity: "moderate",
+		},
+		{
+			name:         "PHQ-9 item 9 is flagged at any endorsement",
+			code:         "PHQ-9",
+			responses:    responses(0, 0, 0, 0, 0, 0, 0, 0, 1),
+			wantTotal:    1,
+			wantSeverity: "minimal",
+			wantCritical: []int{9},
+		},
+		{
+			name:         "PHQ-9 maximum",
+			code:         "PHQ-9",
+			responses:    responses(3, 3, 3, 3, 3, 3, 3, 3, 3),
+			wantTotal:    27,
+			wantSeverity: "severe",
+			wantCritical: []int{9},
+		},
+		{
+			name:         "GAD-7 mild",
+			code:         "GAD-7",
+			responses:    responses(1, 1, 1, 1, 1, 1, 0),
+			wantTotal:    6,
+			wantSeverity: "mild",
+		},
+		{
+			name:         "PCL-5 subscales",
+			code:         "PCL-5",
+			responses:    responses(4, 4, 4, 4, 4, 2, 2, 1, 1, 1, 1, 1, 1, 1, 0, 0, 3, 3, 0, 0),
+			wantTotal:    37,
+			wantSeverity: "probable_ptsd",
+			wantSubscales: map[string]int{
+				"intrusion":               20,
+				"avoidance":               4,
+				"negative_cognition_mood": 7,
+				"arousal_reactivity":      6,
+			},
+		},
+		{
+			name:      "too few responses",
+			code:      "GAD-7",
+			responses: responses(1, 1, 1),
+			errString: "GAD-7 needs 7 responses, got 3",
+		},
+		{
+			name:      "response outside the option scale",
+			code:      "PHQ-9",
+			responses: responses(0, 0, 4, 0, 0, 0, 0, 0, 0),
+			errString: "response to item 3 must be between 0 and 3",
+		},
+		{
+			name:      "negative response",
+			code:      "PCL-5",
+			responses: responses(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1),
+			errString: "response to item 20 must be between 0 and 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instrument, ok := GetInstrument(tt.code)
+			if !ok {
+				t.Fatalf("Instrument %q not found", tt.code)
+			}
+
+			score, err := instrument.Score(tt.responses)
+
+			if tt.errString != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, got nil", tt.errString)
+				}
+				if !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("Expected error containing %q, got %q", tt.errString, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if score.Total != tt.wantTotal {
+				t.Errorf("Expected total %d, got %d", tt.wantTotal, score.Total)
+			}
+
+			if score.Severity != tt.wantSeverity {
+				t.Errorf("Expected severity %q, got %q", tt.wantSeverity, score.Severity)
+			}
+
+			if !slices.Equal(score.CriticalItems, tt.wantCritical) {
+				t.Errorf("Expected critical items %v, got %v", tt.wantCritical, score.CriticalItems)
+			}
+
+			if len(score.Subscales) != len(tt.wantSubscales) {
+				t.Fatalf("Expected %d subscales, got %v", len(tt.wantSubscales), score.Subscales)
+			}
+			for code, want := range tt.wantSubscales {
+				if score.Subscales[code] != want {
+					t.Errorf("Expected subscale %s to be %d, got %d", code, want, score.Subscales[code])
+				}
+			}
+		})
+	}
+}
+
+// Every possible total must fall in exactly one band, otherwise a score could
+// be reported without a severity
+func TestInstruments_BandsCoverScoreRange(t *testing.T) {
+	for _, instrument := range Instruments() {
+		for total := 0; total <= instrument.MaxScore(); total++ {
+			matches := 0
+			for _, band := range instrument.Bands {
+				if total >= band.Min && total <= band.Max {
+					matches++
+				}
+			}
+			if matches != 1 {
+				t.Errorf("%s: total %d falls in %d bands", instrument.Code, total, matches)
+			}
+		}
+
+		for _, subscale := range instrument.Subscales {
+			for _, item := range subscale.Items {
+				if item < 1 || item > len(instrument.Items) {
+					t.Errorf("%s: subscale %s refers to item %d", instrument.Code, subscale.Code, item)
+				}
+			}
+		}
+	}
+}
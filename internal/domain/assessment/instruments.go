@@ -0,0 +1,116 @@
+package assessment
+
+// The built-in instruments are in the public domain. Item wording follows the
+// published forms; severity bands use the cut-offs from their validation studies.
+var instruments = map[string]*Instrument{
+	phq9.Code: phq9,
+	gad7.Code: gad7,
+	pcl5.Code: pcl5,
+}
+
+// Frequency over the last two weeks, shared by the PHQ-9 and GAD-7
+var frequencyOptions = []Option{
+	{Value: 0, Label: "Not at all"},
+	{Value: 1, Label: "Several days"},
+	{Value: 2, Label: "More than half the days"},
+	{Value: 3, Label: "Nearly every day"},
+}
+
+var phq9 = &Instrument{
+	Code:         "PHQ-9",
+	Name:         "Patient Health Questionnaire-9",
+	Description:  "Screens for depression and measures its severity.",
+	Instructions: "Over the last 2 weeks, how often have you been bothered by any of the following problems?",
+	Items: []string{
+		"Little interest or pleasure in doing things",
+		"Feeling down, depressed, or hopeless",
+		"Trouble falling or staying asleep, or sleeping too much",
+		"Feeling tired or having little energy",
+		"Poor appetite or overeating",
+		"Feeling bad about yourself - or that you are a failure or have let yourself or your family down",
+		"Trouble concentrating on things, such as reading the newspaper or watching television",
+		"Moving or speaking so slowly that other people could have noticed? Or the opposite - being so fidgety or restless that you have been moving around a lot more than usual",
+		"Thoughts that you would be better off dead or of hurting yourself in some way",
+	},
+	Options: frequencyOptions,
+	Bands: []SeverityBand{
+		{Code: "minimal", Label: "Minimal depression", Min: 0, Max: 4},
+		{Code: "mild", Label: "Mild depression", Min: 5, Max: 9},
+		{Code: "moderate", Label: "Moderate depression", Min: 10, Max: 14},
+		{Code: "moderately_severe", Label: "Moderately severe depression", Min: 15, Max: 19},
+		{Code: "severe", Label: "Severe depression", Min: 20, Max: 27},
+	},
+	CriticalItems: []CriticalItem{
+		{Item: 9, MinResponse: 1, Reason: "Thoughts of death or self-harm"},
+	},
+}
+
+var gad7 = &Instrument{
+	Code:         "GAD-7",
+	Name:         "Generalized Anxiety Disorder-7",
+	Description:  "Screens for generalized anxiety and measures its severity.",
+	Instructions: "Over the last 2 weeks, how often have you been bothered by the following problems?",
+	Items: []string{
+		"Feeling nervous, anxious, or on edge",
+		"Not being able to stop or control worrying",
+		"Worrying too much about different things",
+		"Trouble relaxing",
+		"Being so restless that it is hard to sit still",
+		"Becoming easily annoyed or irritable",
+		"Feeling afraid, as if something awful might happen",
+	},
+	Options: frequencyOptions,
+	Bands: []SeverityBand{
+		{Code: "minimal", Label: "Minimal anxiety", Min: 0, Max: 4},
+		{Code: "mild", Label: "Mild anxiety", Min: 5, Max: 9},
+		{Code: "moderate", Label: "Moderate anxiety", Min: 10, Max: 14},
+		{Code: "severe", Label: "Severe anxiety", Min: 15, Max: 21},
+	},
+}
+
+var pcl5 = &Instrument{
+	Code:         "PCL-5",
+	Name:         "PTSD Checklist for DSM-5",
+	Description:  "Measures the 20 DSM-5 symptoms of PTSD. A total of 33 or more suggests probable PTSD.",
+	Instructions: "Keeping your worst stressful experience in mind, please indicate how much you have been bothered by each problem in the past month.",
+	Items: []string{
+		"Repeated, disturbing, and unwanted memories of the stressful experience",
+		"Repeated, disturbing dreams of the stressful experience",
+		"Suddenly feeling or acting as if the stressful experience were actually happening again (as if you were actually back there reliving it)",
+		"Feeling very upset when something reminded you of the stressful experience",
+		"Having strong physical reactions when something reminded you of the stressful experience (for example, heart pounding, trouble breathing, sweating)",
+		"Avoiding memories, thoughts, or feelings related to the stressful experience",
+		"Avoiding external reminders of the stressful experience (for example, people, places, conversations, activities, objects, or situations)",
+		"Trouble remembering important parts of the stressful experience",
+		"Having strong negative beliefs about yourself, other people, or the world (for example, having thoughts such as: I am bad, there is something seriously wrong with me, no one can be trusted, the world is completely dangerous)",
+		"Blaming yourself or someone else for the stressful experience or what happened after it",
+		"Having strong negative feelings such as fear, horror, anger, guilt, or shame",
+		"Loss of interest in activities that you used to enjoy",
+		"Feeling distant or cut off from other people",
+		"Trouble experiencing positive feelings (for example, being unable to feel happiness or have loving feelings for people close to you)",
+		"Irritable behavior, angry outbursts, or acting aggressively",
+		"Taking too many risks or doing things that could cause you harm",
+		"Being \"superalert\" or watchful or on guard",
+		"Feeling jumpy or easily startled",
+		"Having difficulty concentrating",
+		"Trouble falling or staying asleep",
+	},
+	Options: []Option{
+		{Value: 0, Label: "Not at all"},
+		{Value: 1, Label: "A little bit"},
+		{Value: 2, Label: "Moderately"},
+		{Value: 3, Label: "Quite a bit"},
+		{Value: 4, Label: "Extremely"},
+	},
+	Bands: []SeverityBand{
+		{Code: "below_threshold", Label: "Below the PTSD threshold", Min: 0, Max: 32},
+		{Code: "probable_ptsd", Label: "Probable PTSD", Min: 33, Max: 80},
+	},
+	// DSM-5 symptom clusters
+	Subscales: []Subscale{
+		{Code: "intrusion", Name: "Intrusion (B)", Items: []int{1, 2, 3, 4, 5}},
+		{Code: "avoidance", Name: "Avoidance (C)", Items: []int{6, 7}},
+		{Code: "negative_cognition_mood", Name: "Negative alterations in cognition and mood (D)", Items: []int{8, 9, 10, 11, 12, 13, 14}},
+		{Code: "arousal_reactivity", Name: "Alterations in arousal and reactivity (E)", Items: []int{15, 16, 17, 18, 19, 20}},
+	},
+}
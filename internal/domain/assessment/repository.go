@@ -0,0 +1,24 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrAssessmentNotFound      = errors.New("assessment not found")
+	ErrAlreadyAssigned         = errors.New("client already has this instrument open")
+	ErrInvalidStatusTransition = errors.New("assessment cannot change to the requested status")
+	ErrUnknownInstrument       = errors.New("unknown assessment instrument")
+)
+
+type Repository interface {
+	Create(ctx context.Context, assessment *Assessment) error
+	GetByID(ctx context.Context, id string) (*Assessment, error)
+	Update(ctx context.Context, assessment *Assessment) error
+	// GetByClientID lists a client's assessments, newest first. An empty
+	// therapistID matches assessments from any therapist.
+	GetByClientID(ctx context.Context, clientID, therapistID string) ([]*Assessment, error)
+	// GetScoreHistory lists completed assessments of one instrument, oldest first
+	GetScoreHistory(ctx context.Context, clientID, therapistID, instrumentCode string) ([]*Assessment, error)
+}
@@ -0,0 +1,41 @@
+package assessment
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAssessmentServiceUnavailable = errors.New("assessment service unavailable")
+	ErrUnauthorizedAccess           = errors.New("unauthorized access to assessment data")
+	ErrInvalidAssessmentData        = errors.New("invalid assessment data")
+	ErrClientNotAssigned            = errors.New("client is not assigned to this therapist")
+)
+
+type Service interface {
+	// Public
+	GetInstruments() []*Instrument
+
+	// Therapists
+	AssignAssessment(ctx context.Context, therapistUserID string, req AssignRequest) (*Assessment, error)
+	CancelAssessment(ctx context.Context, therapistUserID, assessmentID string) (*Assessment, error)
+	GetClientAssessments(ctx context.Context, therapistUserID, clientUserID string) ([]*Assessment, error)
+	GetScoreHistory(ctx context.Context, therapistUserID, clientUserID, instrumentCode string) ([]*Assessment, error)
+
+	// Clients
+	GetOwnAssessments(ctx context.Context, clientUserID string) ([]*Assessment, error)
+	CompleteAssessment(ctx context.Context, clientUserID, assessmentID string, responses []int) (*Assessment, error)
+}
+
+type AssignRequest struct {
+	ClientID       string
+	InstrumentCode string
+	DueAt          *time.Time
+}
+
+// RiskScreener alerts the client's therapist when a completed assessment
+// endorses a critical item. It reports whether the assessment was flagged.
+type RiskScreener interface {
+	ScreenAssessment(ctx context.Context, assessment *Assessment) (bool, error)
+}
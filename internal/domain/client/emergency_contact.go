@@ -0,0 +1,178 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxEmergencyContacts = 5
+
+	maxContactNameLength  = 100
+	maxContactEmailLength = 255
+	minPhoneDigits        = 7
+	maxPhoneDigits        = 15
+)
+
+type ContactRelationship string
+
+const (
+	ContactRelationshipParent   ContactRelationship = "parent"
+	ContactRelationshipPartner  ContactRelationship = "partner"
+	ContactRelationshipSibling  ContactRelationship = "sibling"
+	ContactRelationshipChild    ContactRelationship = "child"
+	ContactRelationshipRelative ContactRelationship = "relative"
+	ContactRelationshipFriend   ContactRelationship = "friend"
+	ContactRelationshipOther    ContactRelationship = "other"
+)
+
+// EmergencyContact is someone to reach when a client is in crisis. Priority 1
+// is tried first. ConsentToContact records that the client allows their care
+// team to reach out to this person.
+type EmergencyContact struct {
+	Name             string              `json:"name"`
+	Relationship     ContactRelationship `json:"relationship"`
+	Phone            string              `json:"phone,omitempty"`
+	Email            string              `json:"email,omitempty"`
+	Priority         int                 `json:"priority"`
+	ConsentToContact bool                `json:"consent_to_contact"`
+}
+
+// NeedsCompletion reports a contact that cannot be reached yet. Contacts are
+// only saved with a phone or email, but those migrated from the old free-text
+// field may have neither until the client fills one in.
+func (c EmergencyContact) NeedsCompletion() bool {
+	return c.Phone == "" && c.Email == ""
+}
+
+// SetEmergencyContacts replaces the client's contacts. Phone numbers are
+// stored in E.164 and the list is kept in priority order; an empty list
+// removes them all.
+func (c *ClientProfile) SetEmergencyContacts(contacts []EmergencyContact) error {
+	if len(contacts) > MaxEmergencyContacts {
+		return fmt.Errorf("at most %d emergency contacts", MaxEmergencyContacts)
+	}
+
+	normalized := make([]EmergencyContact, 0, len(contacts))
+	priorities := make(map[int]bool, len(contacts))
+	for _, contact := range contacts {
+		contact, err := normalizeEmergencyContact(contact)
+		if err != nil {
+			return err
+		}
+
+		if priorities[contact.Priority] {
+			return fmt.Errorf("emergency contacts must have different priorities, %d is used twice", contact.Priority)
+		}
+		priorities[contact.Priority] = true
+
+		normalized = append(normalized, contact)
+	}
+
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Priority < normalized[j].Priority })
+
+	c.EmergencyContacts = normalized
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func IsValidContactRelationship(relationship ContactRelationship) bool {
+	switch relationship {
+	case ContactRelationshipParent, ContactRelationshipPartner, ContactRelationshipSibling, ContactRelationshipChild,
+		ContactRelationshipRelative, ContactRelationshipFriend, ContactRelationshipOther:
+		return true
+	}
+	return false
+}
+
+// NormalizePhone converts a number written in international format to E.164,
+// e.g. "+385 (91) 234-5678" or "00385 91 234 5678" to "+385912345678". Numbers
+// without a country code are rejected, since the country cannot be guessed.
+func NormalizePhone(phone string) (string, error) {
+	phone = strings.TrimSpace(phone)
+
+	var digits strings.Builder
+	for i, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+		case strings.ContainsRune(" -.()/", r):
+		default:
+			return "", fmt.Errorf("phone number %q contains invalid characters", phone)
+		}
+	}
+
+	number := digits.String()
+	switch {
+	case strings.HasPrefix(phone, "+"):
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	default:
+		return "", fmt.Errorf("phone number %q must start with + and the country code", phone)
+	}
+
+	if len(number) < minPhoneDigits || len(number) > maxPhoneDigits || number[0] == '0' {
+		return "", fmt.Errorf("phone number %q must have %d to %d digits and start with a valid country code", phone, minPhoneDigits, maxPhoneDigits)
+	}
+
+	return "+" + number, nil
+}
+
+func normalizeEmergencyContact(contact EmergencyContact) (EmergencyContact, error) {
+	contact.Name = strings.TrimSpace(contact.Name)
+	if contact.Name == "" {
+		return contact, errors.New("emergency contact name is required")
+	}
+	if utf8.RuneCountInString(contact.Name) > maxContactNameLength {
+		return contact, fmt.Errorf("emergency contact name must be %d characters or less", maxContactNameLength)
+	}
+
+	contact.Relationship = ContactRelationship(strings.ToLower(strings.TrimSpace(string(contact.Relationship))))
+	if !IsValidContactRelationship(contact.Relationship) {
+		return contact, fmt.Errorf("invalid relationship %q for emergency contact %s", contact.Relationship, contact.Name)
+	}
+
+	if contact.Priority < 1 || contact.Priority > MaxEmergencyContacts {
+		return contact, fmt.Errorf("emergency contact priority must be between 1 and %d", MaxEmergencyContacts)
+	}
+
+	contact.Phone = strings.TrimSpace(contact.Phone)
+	if contact.Phone != "" {
+		phone, err := NormalizePhone(contact.Phone)
+		if err != nil {
+			return contact, err
+		}
+		contact.Phone = phone
+	}
+
+	contact.Email = strings.ToLower(strings.TrimSpace(contact.Email))
+	if contact.Email != "" {
+		if err := validateContactEmail(contact.Email); err != nil {
+			return contact, err
+		}
+	}
+
+	if contact.NeedsCompletion() {
+		return contact, fmt.Errorf("emergency contact %s needs a phone number or an email", contact.Name)
+	}
+
+	return contact, nil
+}
+
+func validateContactEmail(email string) error {
+	if len(email) > maxContactEmailLength {
+		return fmt.Errorf("emergency contact email must be %d characters or less", maxContactEmailLength)
+	}
+
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" || !strings.Contains(domain, ".") || strings.Contains(domain, "@") {
+		return fmt.Errorf("invalid emergency contact email %q", email)
+	}
+
+	return nil
+}
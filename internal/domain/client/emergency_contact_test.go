@@ -0,0 +1,153 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name    string
+		phone   string
+		want    string
+		wantErr bool
+	}{
+		{name: "already E.164", phone: "+385912345678", want: "+385912345678"},
+		{name: "separators are dropped", phone: "+385 (91) 234-5678", want: "+385912345678"},
+		{name: "00 prefix", phone: "00385 91 234 5678", want: "+385912345678"},
+		{name: "no country code", phone: "091 234 5678", wantErr: true},
+		{name: "letters", phone: "+385 91 CALL ME", wantErr: true},
+		{name: "too short", phone: "+38591", wantErr: true},
+		{name: "too long", phone: "+3859123456789012", wantErr: true},
+		{name: "country code cannot start with zero", phone: "+0385912345678", wantErr: true},
+		{name: "plus in the middle", phone: "385+912345678", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhone(tt.phone)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NormalizePhone(%q) expected error, got %q", tt.phone, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizePhone(%q) unexpected error = %v", tt.phone, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientProfile_SetEmergencyContacts(t *testing.T) {
+	profile, err := NewClientProfile("user-123", "John", "Doe")
+	if err != nil {
+		t.Fatalf("Failed to create client profile: %v", err)
+	}
+
+	err = profile.SetEmergencyContacts([]EmergencyContact{
+		{Name: "Mark Doe", Relationship: "Sibling", Email: " Mark@Example.com ", Priority: 2},
+		{Name: " Jane Doe ", Relationship: ContactRelationshipParent, Phone: "+385 91 234 5678", Priority: 1, ConsentToContact: true},
+	})
+	if err != nil {
+		t.Fatalf("SetEmergencyContacts() unexpected error = %v", err)
+	}
+
+	if len(profile.EmergencyContacts) != 2 {
+		t.Fatalf("Expected 2 contacts, got %d", len(profile.EmergencyContacts))
+	}
+	first, second := profile.EmergencyContacts[0], profile.EmergencyContacts[1]
+	if first.Name != "Jane Doe" || first.Phone != "+385912345678" || !first.ConsentToContact {
+		t.Errorf("Expected the priority 1 contact to be normalized and first, got %+v", first)
+	}
+	if second.Relationship != ContactRelationshipSibling || second.Email != "mark@example.com" {
+		t.Errorf("Expected relationship and email to be lowercased, got %+v", second)
+	}
+
+	invalid := []struct {
+		name     string
+		contacts []EmergencyContact
+	}{
+		{
+			name:     "missing name",
+			contacts: []EmergencyContact{{Relationship: ContactRelationshipFriend, Phone: "+385912345678", Priority: 1}},
+		},
+		{
+			name:     "name too long",
+			contacts: []EmergencyContact{{Name: strings.Repeat("a", 101), Relationship: ContactRelationshipFriend, Phone: "+385912345678", Priority: 1}},
+		},
+		{
+			name:     "unknown relationship",
+			contacts: []EmergencyContact{{Name: "Jane", Relationship: "neighbour", Phone: "+385912345678", Priority: 1}},
+		},
+		{
+			name:     "no phone or email",
+			contacts: []EmergencyContact{{Name: "Jane", Relationship: ContactRelationshipFriend, Priority: 1}},
+		},
+		{
+			name:     "local phone number",
+			contacts: []EmergencyContact{{Name: "Jane", Relationship: ContactRelationshipFriend, Phone: "091 234 5678", Priority: 1}},
+		},
+		{
+			name:     "invalid email",
+			contacts: []EmergencyContact{{Name: "Jane", Relationship: ContactRelationshipFriend, Email: "jane.example.com", Priority: 1}},
+		},
+		{
+			name:     "priority out of range",
+			contacts: []EmergencyContact{{Name: "Jane", Relationship: ContactRelationshipFriend, Phone: "+385912345678", Priority: 0}},
+		},
+		{
+			name: "duplicate priority",
+			contacts: []EmergencyContact{
+				{Name: "Jane", Relationship: ContactRelationshipFriend, Phone: "+385912345678", Priority: 1},
+				{Name: "Mark", Relationship: ContactRelationshipFriend, Phone: "+385912345679", Priority: 1},
+			},
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := profile.SetEmergencyContacts(tt.contacts); err == nil {
+				t.Error("SetEmergencyContacts() expected error but got none")
+			}
+			if len(profile.EmergencyContacts) != 2 {
+				t.Error("SetEmergencyContacts() should keep the existing contacts on error")
+			}
+		})
+	}
+
+	if err := profile.SetEmergencyContacts(nil); err != nil || len(profile.EmergencyContacts) != 0 {
+		t.Errorf("Expected an empty list to remove all contacts, got %v, %+v", err, profile.EmergencyContacts)
+	}
+}
+
+func TestEmergencyContact_NameLengthCountsCharacters(t *testing.T) {
+	profile, err := NewClientProfile("user-123", "John", "Doe")
+	if err != nil {
+		t.Fatalf("Failed to create client profile: %v", err)
+	}
+
+	// 100 characters but 200 bytes, the same limit the migration applied to old contacts
+	name := strings.Repeat("č", maxContactNameLength)
+	err = profile.SetEmergencyContacts([]EmergencyContact{
+		{Name: name, Relationship: ContactRelationshipOther, Phone: "+385912345678", Priority: 1},
+	})
+	if err != nil {
+		t.Errorf("SetEmergencyContacts() unexpected error for a %d character name = %v", maxContactNameLength, err)
+	}
+}
+
+func TestEmergencyContact_NeedsCompletion(t *testing.T) {
+	migrated := EmergencyContact{Name: "Jane Doe - sister", Relationship: ContactRelationshipOther, Priority: 1}
+	if !migrated.NeedsCompletion() {
+		t.Error("Expected a contact without phone or email to need completion")
+	}
+
+	migrated.Email = "jane@example.com"
+	if migrated.NeedsCompletion() {
+		t.Error("Expected a contact with an email to be complete")
+	}
+}
@@ -2,21 +2,28 @@ package client
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+
+	"github.com/goran/thappy/internal/domain/language"
 )
 
+// AgeOfMajority is the age from which clients manage their own account and
+// give their own consent. Younger clients are minors and act through a guardian.
+const AgeOfMajority = 18
+
 type ClientProfile struct {
-	UserID           string
-	FirstName        string
-	LastName         string
-	DateOfBirth      *time.Time
-	Phone            string
-	EmergencyContact string
-	TherapistID      *string
-	Notes            string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	UserID            string
+	FirstName         string
+	LastName          string
+	DateOfBirth       *time.Time
+	Phone             string
+	EmergencyContacts []EmergencyContact
+	TherapistID       *string
+	PreferredLanguage *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 func NewClientProfile(userID, firstName, lastName string) (*ClientProfile, error) {
@@ -57,17 +64,12 @@ func (c *ClientProfile) UpdatePersonalInfo(firstName, lastName string) error {
 	return nil
 }
 
-func (c *ClientProfile) UpdateContactInfo(phone, emergencyContact string) error {
+func (c *ClientProfile) UpdateContactInfo(phone string) error {
 	if err := validatePhone(phone); err != nil {
 		return err
 	}
 
-	if err := validateEmergencyContact(emergencyContact); err != nil {
-		return err
-	}
-
 	c.Phone = strings.TrimSpace(phone)
-	c.EmergencyContact = strings.TrimSpace(emergencyContact)
 	c.UpdatedAt = time.Now()
 	return nil
 }
@@ -87,15 +89,74 @@ func (c *ClientProfile) AssignTherapist(therapistID *string) {
 	c.UpdatedAt = time.Now()
 }
 
-func (c *ClientProfile) UpdateNotes(notes string) {
-	c.Notes = strings.TrimSpace(notes)
+// SetPreferredLanguage records the ISO 639-1 language the client would like sessions in; nil clears it
+func (c *ClientProfile) SetPreferredLanguage(code *string) error {
+	if code != nil {
+		normalized := language.Normalize(*code)
+		if !language.IsValid(normalized) {
+			return fmt.Errorf("unsupported language code: %s", *code)
+		}
+		code = &normalized
+	}
+
+	c.PreferredLanguage = code
 	c.UpdatedAt = time.Now()
+	return nil
 }
 
 func (c *ClientProfile) GetFullName() string {
 	return c.FirstName + " " + c.LastName
 }
 
+// AgeAt returns the client's age in whole years on the day of now. ok is false
+// when no date of birth is on file.
+func (c *ClientProfile) AgeAt(now time.Time) (age int, ok bool) {
+	if c.DateOfBirth == nil {
+		return 0, false
+	}
+
+	birthYear, birthMonth, birthDay := c.DateOfBirth.Date()
+	year, month, day := now.UTC().Date()
+
+	age = year - birthYear
+	if month < birthMonth || (month == birthMonth && day < birthDay) {
+		age--
+	}
+	return age, true
+}
+
+// IsMinorAt reports whether the client is under the age of majority on the day
+// of now. Clients without a date of birth are treated as adults.
+func (c *ClientProfile) IsMinorAt(now time.Time) bool {
+	age, ok := c.AgeAt(now)
+	return ok && age < AgeOfMajority
+}
+
+// MajorityDate returns the day the client comes of age, or nil when no date of
+// birth is on file
+func (c *ClientProfile) MajorityDate() *time.Time {
+	if c.DateOfBirth == nil {
+		return nil
+	}
+
+	date := c.DateOfBirth.AddDate(AgeOfMajority, 0, 0)
+	return &date
+}
+
+// MajorityCutoff returns the latest date of birth of someone who is of age on
+// the day of now. Someone born on 29 February comes of age on 1 March in years
+// that are not leap years.
+func MajorityCutoff(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+
+	cutoff := time.Date(year-AgeOfMajority, month, day, 0, 0, 0, 0, time.UTC)
+	if cutoff.Month() != month {
+		// 29 February in a year that has none: the last day of February
+		cutoff = time.Date(year-AgeOfMajority, month+1, 0, 0, 0, 0, 0, time.UTC)
+	}
+	return cutoff
+}
+
 func validateUserID(userID string) error {
 	if userID == "" {
 		return errors.New("user ID is required")
@@ -136,11 +197,3 @@ func validatePhone(phone string) error {
 	}
 	return nil
 }
-
-func validateEmergencyContact(emergencyContact string) error {
-	emergencyContact = strings.TrimSpace(emergencyContact)
-	if len(emergencyContact) > 255 {
-		return errors.New("emergency contact must be 255 characters or less")
-	}
-	return nil
-}
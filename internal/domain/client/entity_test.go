@@ -189,43 +189,32 @@ func TestClientProfile_UpdateContactInfo(t *testing.T) {
 	time.Sleep(10 * time.Millisecond)
 
 	tests := []struct {
-		name             string
-		phone            string
-		emergencyContact string
-		wantErr          bool
-		errString        string
+		name      string
+		phone     string
+		wantErr   bool
+		errString string
 	}{
 		{
-			name:             "valid contact update",
-			phone:            "+1-555-0123",
-			emergencyContact: "Jane Doe - Sister - +1-555-9876",
-			wantErr:          false,
+			name:    "valid contact update",
+			phone:   "+1-555-0123",
+			wantErr: false,
 		},
 		{
-			name:             "empty phone is valid",
-			phone:            "",
-			emergencyContact: "Jane Doe - Sister - +1-555-9876",
-			wantErr:          false,
+			name:    "empty phone is valid",
+			phone:   "",
+			wantErr: false,
 		},
 		{
-			name:             "phone too long",
-			phone:            "ThisIsAReallyLongPhoneNumberThatExceedsTwentyCharacters",
-			emergencyContact: "Jane Doe",
-			wantErr:          true,
-			errString:        "phone must be 20 characters or less",
-		},
-		{
-			name:             "emergency contact too long",
-			phone:            "+1-555-0123",
-			emergencyContact: "This is a really long emergency contact description that exceeds two hundred fifty five characters which should cause a validation error because we want to limit the length of this field to ensure database storage works correctly and efficiently for our application needs",
-			wantErr:          true,
-			errString:        "emergency contact must be 255 characters or less",
+			name:      "phone too long",
+			phone:     "ThisIsAReallyLongPhoneNumberThatExceedsTwentyCharacters",
+			wantErr:   true,
+			errString: "phone must be 20 characters or less",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := profile.UpdateContactInfo(tt.phone, tt.emergencyContact)
+			err := profile.UpdateContactInfo(tt.phone)
 
 			if tt.wantErr {
 				if err == nil {
@@ -247,10 +236,6 @@ func TestClientProfile_UpdateContactInfo(t *testing.T) {
 				t.Errorf("UpdateContactInfo() Phone = %v, want %v", profile.Phone, tt.phone)
 			}
 
-			if profile.EmergencyContact != tt.emergencyContact {
-				t.Errorf("UpdateContactInfo() EmergencyContact = %v, want %v", profile.EmergencyContact, tt.emergencyContact)
-			}
-
 			if !profile.UpdatedAt.After(oldUpdatedAt) {
 				t.Error("UpdateContactInfo() should update UpdatedAt timestamp")
 			}
@@ -385,25 +370,32 @@ func TestClientProfile_AssignTherapist(t *testing.T) {
 	}
 }
 
-func TestClientProfile_UpdateNotes(t *testing.T) {
+func TestClientProfile_SetPreferredLanguage(t *testing.T) {
 	profile, err := NewClientProfile("user-123", "John", "Doe")
 	if err != nil {
 		t.Fatalf("Failed to create client profile: %v", err)
 	}
 
-	oldUpdatedAt := profile.UpdatedAt
-	time.Sleep(10 * time.Millisecond)
+	croatian := " HR "
+	if err := profile.SetPreferredLanguage(&croatian); err != nil {
+		t.Fatalf("SetPreferredLanguage() unexpected error = %v", err)
+	}
 
-	notes := "Initial assessment completed. Client shows good engagement."
+	if profile.PreferredLanguage == nil || *profile.PreferredLanguage != "hr" {
+		t.Errorf("SetPreferredLanguage() PreferredLanguage = %v, want %v", profile.PreferredLanguage, "hr")
+	}
 
-	profile.UpdateNotes(notes)
+	unknown := "xx"
+	if err := profile.SetPreferredLanguage(&unknown); err == nil {
+		t.Error("SetPreferredLanguage() with unknown code expected error but got none")
+	}
 
-	if profile.Notes != notes {
-		t.Errorf("UpdateNotes() Notes = %v, want %v", profile.Notes, notes)
+	if err := profile.SetPreferredLanguage(nil); err != nil {
+		t.Fatalf("SetPreferredLanguage() unexpected error = %v", err)
 	}
 
-	if !profile.UpdatedAt.After(oldUpdatedAt) {
-		t.Error("UpdateNotes() should update UpdatedAt timestamp")
+	if profile.PreferredLanguage != nil {
+		t.Error("SetPreferredLanguage(nil) should clear the preferred language")
 	}
 }
 
@@ -418,3 +410,84 @@ func TestClientProfile_GetFullName(t *testing.T) {
 		t.Errorf("GetFullName() = %v, want %v", fullName, expectedFullName)
 	}
 }
+
+func TestClientProfile_IsMinorAt(t *testing.T) {
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		dateOfBirth *time.Time
+		wantAge     int
+		wantKnown   bool
+		wantMinor   bool
+	}{
+		{name: "no date of birth", wantKnown: false, wantMinor: false},
+		{name: "twelve years old", dateOfBirth: date(2013, 7, 1), wantAge: 12, wantKnown: true, wantMinor: true},
+		{name: "eighteenth birthday tomorrow", dateOfBirth: date(2008, 3, 11), wantAge: 17, wantKnown: true, wantMinor: true},
+		{name: "eighteenth birthday today", dateOfBirth: date(2008, 3, 10), wantAge: 18, wantKnown: true, wantMinor: false},
+		{name: "adult", dateOfBirth: date(1990, 12, 31), wantAge: 35, wantKnown: true, wantMinor: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &ClientProfile{UserID: "user-123", DateOfBirth: tt.dateOfBirth}
+
+			age, known := profile.AgeAt(now)
+			if age != tt.wantAge || known != tt.wantKnown {
+				t.Errorf("AgeAt() = %d, %v, want %d, %v", age, known, tt.wantAge, tt.wantKnown)
+			}
+
+			if minor := profile.IsMinorAt(now); minor != tt.wantMinor {
+				t.Errorf("IsMinorAt() = %v, want %v", minor, tt.wantMinor)
+			}
+		})
+	}
+}
+
+func TestMajorityCutoff(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "ordinary day",
+			now:  time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC),
+			want: time.Date(2008, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day with no leap day eighteen years earlier",
+			now:  time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2010, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MajorityCutoff(tt.now); !got.Equal(tt.want) {
+				t.Errorf("MajorityCutoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Someone born on a leap day comes of age on 1 March, and the cutoff agrees
+	leapDay := time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC)
+	leapling := &ClientProfile{UserID: "user-123", DateOfBirth: &leapDay}
+	for _, now := range []time.Time{
+		time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		minor := leapling.IsMinorAt(now)
+		pastCutoff := leapling.DateOfBirth.After(MajorityCutoff(now))
+		if minor != pastCutoff {
+			t.Errorf("on %s IsMinorAt() = %v but date of birth after cutoff = %v", now.Format("2006-01-02"), minor, pastCutoff)
+		}
+	}
+	if !leapling.IsMinorAt(time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)) || leapling.IsMinorAt(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Error("someone born on 29 February should come of age on 1 March")
+	}
+}
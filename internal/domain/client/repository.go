@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
@@ -16,7 +18,7 @@ type ClientRepository interface {
 	GetByUserID(ctx context.Context, userID string) (*ClientProfile, error)
 	Update(ctx context.Context, profile *ClientProfile) error
 	Delete(ctx context.Context, userID string) error
-	GetByTherapistID(ctx context.Context, therapistID string) ([]*ClientProfile, error)
-	GetActiveClients(ctx context.Context) ([]*ClientProfile, error)
+	GetByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*ClientProfile], error)
+	GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*ClientProfile], error)
 	ExistsByUserID(ctx context.Context, userID string) (bool, error)
 }
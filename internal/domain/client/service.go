@@ -3,11 +3,15 @@ package client
 import (
 	"context"
 	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
 	ErrClientServiceUnavailable = errors.New("client service unavailable")
 	ErrUnauthorizedAccess       = errors.New("unauthorized access to client data")
+	ErrConsentRequired          = errors.New("required consent forms must be signed before treatment can start")
+	ErrManagedByGuardian        = errors.New("this is managed by the client's guardian")
 )
 
 type ClientService interface {
@@ -16,19 +20,31 @@ type ClientService interface {
 	UpdatePersonalInfo(ctx context.Context, userID string, req UpdatePersonalInfoRequest) (*ClientProfile, error)
 	UpdateContactInfo(ctx context.Context, userID string, req UpdateContactInfoRequest) (*ClientProfile, error)
 	SetDateOfBirth(ctx context.Context, userID string, req SetDateOfBirthRequest) (*ClientProfile, error)
+	SetPreferredLanguage(ctx context.Context, userID string, code *string) (*ClientProfile, error)
 	AssignTherapist(ctx context.Context, clientUserID, therapistUserID string) error
 	UnassignTherapist(ctx context.Context, clientUserID string) error
-	UpdateNotes(ctx context.Context, clientUserID string, notes string) error
-	GetClientsByTherapist(ctx context.Context, therapistUserID string) ([]*ClientProfile, error)
-	GetActiveClients(ctx context.Context) ([]*ClientProfile, error)
+	GetClientsByTherapist(ctx context.Context, therapistUserID string, page pagination.PageRequest) (*pagination.Page[*ClientProfile], error)
+	GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*ClientProfile], error)
 	DeleteProfile(ctx context.Context, userID string) error
 }
 
+// ConsentChecker tells whether a client has signed every required consent
+// form, which they must before a therapist is assigned
+type ConsentChecker interface {
+	HasSignedRequiredConsents(ctx context.Context, clientUserID string) (bool, error)
+}
+
+// GuardianChecker tells whether a guardian manages a client's account. The
+// date of birth of such a client can only be changed by the guardian.
+type GuardianChecker interface {
+	HasActiveGuardian(ctx context.Context, clientUserID string) (bool, error)
+}
+
 type CreateProfileRequest struct {
-	FirstName        string
-	LastName         string
-	Phone            string
-	EmergencyContact string
+	FirstName         string
+	LastName          string
+	Phone             string
+	EmergencyContacts []EmergencyContact
 }
 
 type UpdatePersonalInfoRequest struct {
@@ -36,9 +52,11 @@ type UpdatePersonalInfoRequest struct {
 	LastName  string
 }
 
+// UpdateContactInfoRequest replaces the client's phone and, when given, their
+// emergency contacts. Nil leaves the contacts unchanged.
 type UpdateContactInfoRequest struct {
-	Phone            string
-	EmergencyContact string
+	Phone             string
+	EmergencyContacts *[]EmergencyContact
 }
 
 type SetDateOfBirthRequest struct {
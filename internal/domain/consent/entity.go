@@ -0,0 +1,268 @@
+package consent
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kind groups consent documents by purpose
+type Kind string
+
+const (
+	KindInformedConsent Kind = "informed_consent"
+	KindPrivacy         Kind = "privacy"
+	KindOther           Kind = "other"
+)
+
+const (
+	maxTitleLength         = 200
+	maxBodyLength          = 100000
+	maxChangeSummaryLength = 1000
+	maxTypedNameLength     = 200
+	maxUserAgentLength     = 500
+)
+
+// Document is a consent form template. Its text lives in immutable versions;
+// publishing a new version asks every client to sign again.
+type Document struct {
+	ID             string
+	Title          string
+	Kind           Kind
+	Required       bool
+	CurrentVersion *Version
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Version is one published text of a document, with the title it had at the
+// time. Versions are never edited; ContentHash identifies the exact text a
+// client signed.
+type Version struct {
+	ID            string
+	DocumentID    string
+	Number        int
+	Title         string
+	Body          string
+	ChangeSummary string
+	ContentHash   string
+	PublishedBy   string
+	PublishedAt   time.Time
+}
+
+// Signature records a client's agreement to one version of a document. For a
+// minor it is given by a guardian, whose ID is kept in GuardianID.
+type Signature struct {
+	ID            string
+	ClientID      string
+	GuardianID    string
+	DocumentID    string
+	VersionID     string
+	VersionNumber int
+	TypedName     string
+	IPAddress     string
+	UserAgent     string
+	DocumentHash  string
+	SignedAt      time.Time
+}
+
+type DocumentDetails struct {
+	Title    string
+	Kind     Kind
+	Required bool
+}
+
+func NewDocument(details DocumentDetails, now time.Time) (*Document, error) {
+	title, err := validateTitle(details.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsValidKind(details.Kind) {
+		return nil, fmt.Errorf("unknown document kind %q", details.Kind)
+	}
+
+	return &Document{
+		ID:        generateID(),
+		Title:     title,
+		Kind:      details.Kind,
+		Required:  details.Required,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Update changes the document's title, kind and whether it is required. The
+// text can only change by publishing a new version, which also picks up a new title.
+func (d *Document) Update(details DocumentDetails, now time.Time) error {
+	title, err := validateTitle(details.Title)
+	if err != nil {
+		return err
+	}
+
+	if !IsValidKind(details.Kind) {
+		return fmt.Errorf("unknown document kind %q", details.Kind)
+	}
+
+	d.Title = title
+	d.Kind = details.Kind
+	d.Required = details.Required
+	d.UpdatedAt = now
+	return nil
+}
+
+// PublishVersion creates the next version of the document and makes it current
+func (d *Document) PublishVersion(body, changeSummary, publishedBy string, now time.Time) (*Version, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, errors.New("body is required")
+	}
+
+	if len(body) > maxBodyLength {
+		return nil, fmt.Errorf("body must be %d characters or less", maxBodyLength)
+	}
+
+	changeSummary = strings.TrimSpace(changeSummary)
+	if len(changeSummary) > maxChangeSummaryLength {
+		return nil, fmt.Errorf("change summary must be %d characters or less", maxChangeSummaryLength)
+	}
+
+	number := 1
+	if d.CurrentVersion != nil {
+		if body == d.CurrentVersion.Body && d.Title == d.CurrentVersion.Title {
+			return nil, errors.New("title and body are unchanged from the current version")
+		}
+		number = d.CurrentVersion.Number + 1
+	}
+
+	version := &Version{
+		ID:            generateID(),
+		DocumentID:    d.ID,
+		Number:        number,
+		Title:         d.Title,
+		Body:          body,
+		ChangeSummary: changeSummary,
+		ContentHash:   HashContent(d.Title, number, body),
+		PublishedBy:   publishedBy,
+		PublishedAt:   now,
+	}
+
+	d.CurrentVersion = version
+	d.UpdatedAt = now
+	return version, nil
+}
+
+// Sign records the client's agreement to the document's current version. The
+// client must have seen that exact text: versionID names it, and documentHash,
+// when given, must match its content hash.
+func (d *Document) Sign(clientID, versionID, documentHash, typedName, ipAddress, userAgent string, now time.Time) (*Signature, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if d.CurrentVersion == nil {
+		return nil, ErrVersionNotFound
+	}
+
+	if versionID != d.CurrentVersion.ID {
+		return nil, ErrVersionSuperseded
+	}
+
+	if documentHash != "" && !strings.EqualFold(documentHash, d.CurrentVersion.ContentHash) {
+		return nil, ErrDocumentHashMismatch
+	}
+
+	typedName = strings.Join(strings.Fields(typedName), " ")
+	if typedName == "" {
+		return nil, errors.New("typed name is required")
+	}
+
+	if len(typedName) > maxTypedNameLength {
+		return nil, fmt.Errorf("typed name must be %d characters or less", maxTypedNameLength)
+	}
+
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	return &Signature{
+		ID:            generateID(),
+		ClientID:      clientID,
+		DocumentID:    d.ID,
+		VersionID:     d.CurrentVersion.ID,
+		VersionNumber: d.CurrentVersion.Number,
+		TypedName:     typedName,
+		IPAddress:     ipAddress,
+		UserAgent:     userAgent,
+		DocumentHash:  d.CurrentVersion.ContentHash,
+		SignedAt:      now,
+	}, nil
+}
+
+// SignOnBehalf records a guardian's agreement to the current version for the
+// minor client they look after. The typed name is the guardian's own.
+func (d *Document) SignOnBehalf(guardianID, clientID, versionID, documentHash, typedName, ipAddress, userAgent string, now time.Time) (*Signature, error) {
+	if strings.TrimSpace(guardianID) == "" {
+		return nil, errors.New("guardian ID is required")
+	}
+
+	if guardianID == clientID {
+		return nil, errors.New("a client cannot sign on their own behalf as guardian")
+	}
+
+	signature, err := d.Sign(clientID, versionID, documentHash, typedName, ipAddress, userAgent, now)
+	if err != nil {
+		return nil, err
+	}
+
+	signature.GuardianID = guardianID
+	return signature, nil
+}
+
+// SignedByGuardian reports whether a guardian signed on the client's behalf
+func (s *Signature) SignedByGuardian() bool {
+	return s.GuardianID != ""
+}
+
+// HashContent returns the SHA-256 of a version's title, number and body. The
+// fields are length-prefixed so different splits of the same text never collide.
+func HashContent(title string, number int, body string) string {
+	h := sha256.New()
+	for _, field := range []string{title, fmt.Sprint(number), body} {
+		fmt.Fprintf(h, "%d:%s\n", len(field), field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func IsValidKind(kind Kind) bool {
+	switch kind {
+	case KindInformedConsent, KindPrivacy, KindOther:
+		return true
+	}
+	return false
+}
+
+func validateTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", errors.New("title is required")
+	}
+
+	if len(title) > maxTitleLength {
+		return "", fmt.Errorf("title must be %d characters or less", maxTitleLength)
+	}
+
+	return title, nil
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
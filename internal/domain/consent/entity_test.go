@@ -0,0 +1,253 @@
+package consent
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newPublishedDocument(t *testing.T, now time.Time) *Document {
+	t.Helper()
+
+	document, err := NewDocument(DocumentDetails{Title: "Informed consent", Kind: KindInformedConsent, Required: true}, now)
+	if err != nil {
+		t.Fatalf("Failed to create document: %v", err)
+	}
+
+	if _, err := document.PublishVersion("I agree to take part in therapy.", "", "admin-1", now); err != nil {
+		t.Fatalf("Failed to publish version: %v", err)
+	}
+
+	return document
+}
+
+func TestNewDocument(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		details   DocumentDetails
+		errString string
+	}{
+		{name: "valid document", details: DocumentDetails{Title: " Privacy notice ", Kind: KindPrivacy}},
+		{name: "missing title", details: DocumentDetails{Title: "  ", Kind: KindPrivacy}, errString: "title is required"},
+		{name: "unknown kind", details: DocumentDetails{Title: "Waiver", Kind: "waiver"}, errString: "unknown document kind"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := NewDocument(tt.details, now)
+
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errString, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if document.Title != "Privacy notice" || document.CurrentVersion != nil {
+				t.Errorf("Unexpected document %+v", document)
+			}
+		})
+	}
+}
+
+func TestDocument_PublishVersion(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	document := newPublishedDocument(t, now)
+	first := document.CurrentVersion
+
+	if first.Number != 1 || first.Title != "Informed consent" || len(first.ContentHash) != 64 {
+		t.Errorf("Unexpected first version %+v", first)
+	}
+
+	if _, err := document.PublishVersion(" I agree to take part in therapy. ", "", "admin-1", now); err == nil {
+		t.Error("Expected error when publishing unchanged text")
+	}
+
+	if _, err := document.PublishVersion("", "", "admin-1", now); err == nil {
+		t.Error("Expected error without a body")
+	}
+
+	second, err := document.PublishVersion("I agree to take part in therapy, online or in person.", "Adds online sessions", "admin-1", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if second.Number != 2 || document.CurrentVersion != second {
+		t.Errorf("Expected version 2 to be current, got %+v", document.CurrentVersion)
+	}
+	if second.ContentHash == first.ContentHash {
+		t.Error("Expected a new content hash for new text")
+	}
+	if first.Body != "I agree to take part in therapy." {
+		t.Error("Publishing must not change earlier versions")
+	}
+
+	// A new title alone is enough for a new version
+	if err := document.Update(DocumentDetails{Title: "Consent to treatment", Kind: KindInformedConsent, Required: true}, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	third, err := document.PublishVersion(second.Body, "", "admin-1", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if third.Title != "Consent to treatment" || third.ContentHash == second.ContentHash {
+		t.Errorf("Expected the new title in version 3, got %+v", third)
+	}
+}
+
+func TestHashContent(t *testing.T) {
+	hash := HashContent("Consent", 1, "Body")
+
+	if hash != HashContent("Consent", 1, "Body") {
+		t.Error("Expected the same hash for the same content")
+	}
+
+	for _, other := range []string{HashContent("Consent", 2, "Body"), HashContent("ConsentB", 1, "ody"), HashContent("Consent", 1, "Body ")} {
+		if other == hash {
+			t.Error("Expected a different hash for different content")
+		}
+	}
+}
+
+func TestDocument_Sign(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	document := newPublishedDocument(t, now)
+	current := document.CurrentVersion
+
+	tests := []struct {
+		name      string
+		versionID string
+		hash      string
+		typedName string
+		wantErr   error
+		errString string
+	}{
+		{name: "valid signature", versionID: current.ID, typedName: "  Ana   Marić "},
+		{name: "matching hash", versionID: current.ID, hash: strings.ToUpper(current.ContentHash), typedName: "Ana Marić"},
+		{name: "old version", versionID: "version-0", typedName: "Ana Marić", wantErr: ErrVersionSuperseded},
+		{name: "different text", versionID: current.ID, hash: strings.Repeat("0", 64), typedName: "Ana Marić", wantErr: ErrDocumentHashMismatch},
+		{name: "missing name", versionID: current.ID, typedName: "   ", errString: "typed name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature, err := document.Sign("client-1", tt.versionID, tt.hash, tt.typedName, "203.0.113.7", "Mozilla/5.0", now)
+
+			if tt.wantErr != nil || tt.errString != "" {
+				if err == nil {
+					t.Fatal("Expected error, got nil")
+				}
+				if tt.wantErr != nil && err != tt.wantErr {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+				if tt.errString != "" && !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("Expected error containing %q, got %q", tt.errString, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if signature.TypedName != "Ana Marić" || signature.VersionNumber != 1 || signature.DocumentHash != current.ContentHash {
+				t.Errorf("Unexpected signature %+v", signature)
+			}
+		})
+	}
+}
+
+func TestBuildStatuses(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	consent := newPublishedDocument(t, now)
+	oldVersion := consent.CurrentVersion
+	if _, err := consent.PublishVersion("Updated consent text.", "", "admin-1", now); err != nil {
+		t.Fatalf("Failed to publish version: %v", err)
+	}
+
+	privacy, _ := NewDocument(DocumentDetails{Title: "Privacy notice", Kind: KindPrivacy, Required: true}, now)
+	privacy.PublishVersion("We protect your data.", "", "admin-1", now)
+
+	optional, _ := NewDocument(DocumentDetails{Title: "Newsletter", Kind: KindOther}, now)
+	optional.PublishVersion("Send me news.", "", "admin-1", now)
+
+	signedOld := &Signature{DocumentID: consent.ID, VersionID: oldVersion.ID, VersionNumber: 1, SignedAt: now}
+	signedPrivacy := &Signature{DocumentID: privacy.ID, VersionID: privacy.CurrentVersion.ID, VersionNumber: 1, SignedAt: now}
+
+	statuses := BuildStatuses([]*Document{consent, privacy, optional}, []*Signature{signedPrivacy, signedOld})
+
+	want := []State{StateResignRequired, StateSigned, StatePending}
+	for i, status := range statuses {
+		if status.State != want[i] {
+			t.Errorf("%s: state = %s, want %s", status.Document.Title, status.State, want[i])
+		}
+	}
+
+	if statuses[2].Outstanding() {
+		t.Error("Optional documents are never outstanding")
+	}
+	if !HasOutstanding(statuses) {
+		t.Error("Expected the re-consent to be outstanding")
+	}
+
+	signedNew := &Signature{DocumentID: consent.ID, VersionID: consent.CurrentVersion.ID, VersionNumber: 2, SignedAt: now.Add(time.Hour)}
+	statuses = BuildStatuses([]*Document{consent, privacy, optional}, []*Signature{signedOld, signedNew, signedPrivacy})
+	if HasOutstanding(statuses) {
+		t.Error("Expected nothing outstanding after signing the new version")
+	}
+	if statuses[0].LastSignature != signedNew {
+		t.Error("Expected the latest signature to be reported")
+	}
+}
+
+func TestDocument_SignOnBehalf(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	document := newPublishedDocument(t, now)
+
+	signature, err := document.SignOnBehalf("guardian-1", "client-1", document.CurrentVersion.ID, "", "Mira Marić", "203.0.113.7", "Mozilla/5.0", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if signature.ClientID != "client-1" || signature.GuardianID != "guardian-1" || !signature.SignedByGuardian() {
+		t.Errorf("Unexpected signature %+v", signature)
+	}
+
+	if _, err := document.SignOnBehalf("client-1", "client-1", document.CurrentVersion.ID, "", "Ana Marić", "", "", now); err == nil {
+		t.Error("Expected an error when the client signs as their own guardian")
+	}
+
+	if _, err := document.SignOnBehalf("guardian-1", "client-1", "version-0", "", "Mira Marić", "", "", now); err != ErrVersionSuperseded {
+		t.Errorf("Expected %v, got %v", ErrVersionSuperseded, err)
+	}
+}
+
+func TestCountingSignatures(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	document := newPublishedDocument(t, now)
+	versionID := document.CurrentVersion.ID
+
+	byGuardian := &Signature{ID: "s1", ClientID: "client-1", GuardianID: "guardian-1", DocumentID: document.ID, VersionID: versionID, VersionNumber: 1, SignedAt: now}
+	byClient := &Signature{ID: "s2", ClientID: "client-1", DocumentID: document.ID, VersionID: versionID, VersionNumber: 1, SignedAt: now.Add(time.Hour)}
+
+	minor := CountingSignatures([]*Signature{byClient, byGuardian}, true)
+	if len(minor) != 1 || minor[0] != byGuardian {
+		t.Errorf("For a minor only the guardian's signature should count, got %v", minor)
+	}
+
+	adult := CountingSignatures([]*Signature{byClient, byGuardian}, false)
+	if len(adult) != 1 || adult[0] != byClient {
+		t.Errorf("For an adult only their own signature should count, got %v", adult)
+	}
+
+	// Once of age, consent a guardian gave while the client was a minor no longer counts
+	statuses := BuildStatuses([]*Document{document}, CountingSignatures([]*Signature{byGuardian}, false))
+	if statuses[0].State != StatePending || !HasOutstanding(statuses) {
+		t.Errorf("Expected the document to be pending after coming of age, got %s", statuses[0].State)
+	}
+}
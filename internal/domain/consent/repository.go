@@ -0,0 +1,35 @@
+package consent
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrDocumentNotFound     = errors.New("consent document not found")
+	ErrVersionNotFound      = errors.New("consent document version not found")
+	ErrSignatureNotFound    = errors.New("consent signature not found")
+	ErrAlreadySigned        = errors.New("this version of the consent document is already signed")
+	ErrVersionSuperseded    = errors.New("a newer version of the consent document has been published")
+	ErrVersionConflict      = errors.New("another version of the consent document was published at the same time")
+	ErrDocumentHashMismatch = errors.New("document hash does not match the published version")
+)
+
+type Repository interface {
+	// CreateDocument stores a new document together with its first version
+	CreateDocument(ctx context.Context, document *Document) error
+	UpdateDocument(ctx context.Context, document *Document) error
+	// AddVersion stores a newly published version and makes it the document's current one
+	AddVersion(ctx context.Context, document *Document, version *Version) error
+	// GetDocument and ListDocuments return documents with their current version
+	GetDocument(ctx context.Context, id string) (*Document, error)
+	ListDocuments(ctx context.Context) ([]*Document, error)
+	GetVersion(ctx context.Context, id string) (*Version, error)
+	// GetVersions lists every version of a document, newest first
+	GetVersions(ctx context.Context, documentID string) ([]*Version, error)
+
+	CreateSignature(ctx context.Context, signature *Signature) error
+	GetSignature(ctx context.Context, id string) (*Signature, error)
+	// GetSignaturesByClientID lists a client's signatures, newest first
+	GetSignaturesByClientID(ctx context.Context, clientID string) ([]*Signature, error)
+}
@@ -0,0 +1,80 @@
+package consent
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrConsentServiceUnavailable = errors.New("consent service unavailable")
+	ErrUnauthorizedAccess        = errors.New("unauthorized access to consent documents")
+	ErrInvalidConsentData        = errors.New("invalid consent data")
+	ErrClientNotAssigned         = errors.New("client is not assigned to this therapist")
+	ErrGuardianConsentRequired   = errors.New("consent for a minor must be given by their guardian")
+)
+
+type Service interface {
+	// Admins
+	CreateDocument(ctx context.Context, adminUserID string, req CreateDocumentRequest) (*Document, error)
+	UpdateDocument(ctx context.Context, adminUserID, documentID string, details DocumentDetails) (*Document, error)
+	PublishVersion(ctx context.Context, adminUserID, documentID string, req PublishVersionRequest) (*Document, error)
+	ListDocuments(ctx context.Context, adminUserID string) ([]*Document, error)
+	GetVersions(ctx context.Context, adminUserID, documentID string) ([]*Version, error)
+
+	// Clients
+	GetOwnStatus(ctx context.Context, clientUserID string) ([]DocumentStatus, error)
+	Sign(ctx context.Context, clientUserID string, req SignRequest) (*Signature, error)
+	GetOwnSignatures(ctx context.Context, clientUserID string) ([]*Signature, error)
+	GetSignedRecord(ctx context.Context, clientUserID, signatureID string) ([]byte, error)
+
+	// Guardians
+	GetMinorStatus(ctx context.Context, guardianUserID, clientUserID string) ([]DocumentStatus, error)
+	SignForMinor(ctx context.Context, guardianUserID, clientUserID string, req SignRequest) (*Signature, error)
+	GetMinorSignedRecord(ctx context.Context, guardianUserID, clientUserID, signatureID string) ([]byte, error)
+
+	// Therapists
+	GetClientStatus(ctx context.Context, therapistUserID, clientUserID string) ([]DocumentStatus, error)
+
+	// HasSignedRequiredConsents reports whether the client signed the current
+	// version of every required document
+	HasSignedRequiredConsents(ctx context.Context, clientUserID string) (bool, error)
+}
+
+// GuardianAuthorizer confirms that a guardian may give consent for a minor
+// client. It returns an error describing why not otherwise.
+type GuardianAuthorizer interface {
+	AuthorizeConsent(ctx context.Context, guardianUserID, clientUserID string) error
+}
+
+// RecordRenderer turns a signature into a printable record, e.g. a PDF
+type RecordRenderer interface {
+	RenderSignedRecord(record *SignedRecord) ([]byte, error)
+}
+
+// SignedRecord is everything a signed consent record shows: the exact text
+// that was signed and the evidence of who signed it, when and from where
+type SignedRecord struct {
+	Version     *Version
+	Signature   *Signature
+	ClientEmail string
+	// GuardianEmail is set when a guardian signed on the client's behalf
+	GuardianEmail string
+}
+
+type CreateDocumentRequest struct {
+	DocumentDetails
+	Body string
+}
+
+type PublishVersionRequest struct {
+	Body          string
+	ChangeSummary string
+}
+
+type SignRequest struct {
+	VersionID    string
+	DocumentHash string
+	TypedName    string
+	IPAddress    string
+	UserAgent    string
+}
@@ -0,0 +1,82 @@
+package consent
+
+// State is where a client stands with one consent document
+type State string
+
+const (
+	// StateSigned means the client signed the current version
+	StateSigned State = "signed"
+	// StatePending means the client never signed the document
+	StatePending State = "pending"
+	// StateResignRequired means the client signed an earlier version only
+	StateResignRequired State = "resign_required"
+)
+
+// DocumentStatus pairs a document with the client's latest signature of it
+type DocumentStatus struct {
+	Document      *Document
+	State         State
+	LastSignature *Signature
+}
+
+// Outstanding reports whether the client still has to sign this document
+// before treatment can start
+func (s DocumentStatus) Outstanding() bool {
+	return s.Document.Required && s.State != StateSigned
+}
+
+// BuildStatuses works out the client's state for every published document.
+// Signatures may be in any order; the latest per document counts.
+func BuildStatuses(documents []*Document, signatures []*Signature) []DocumentStatus {
+	latest := make(map[string]*Signature, len(signatures))
+	for _, signature := range signatures {
+		current, ok := latest[signature.DocumentID]
+		if !ok || signature.VersionNumber > current.VersionNumber ||
+			(signature.VersionNumber == current.VersionNumber && signature.SignedAt.After(current.SignedAt)) {
+			latest[signature.DocumentID] = signature
+		}
+	}
+
+	statuses := make([]DocumentStatus, 0, len(documents))
+	for _, document := range documents {
+		if document.CurrentVersion == nil {
+			continue
+		}
+
+		status := DocumentStatus{Document: document, State: StatePending}
+		if signature, ok := latest[document.ID]; ok {
+			status.LastSignature = signature
+			status.State = StateResignRequired
+			if signature.VersionID == document.CurrentVersion.ID {
+				status.State = StateSigned
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// CountingSignatures keeps the signatures that give consent for the client as
+// they are now: a guardian's for a minor, the client's own for an adult. A
+// client who comes of age therefore signs again for themselves.
+func CountingSignatures(signatures []*Signature, clientIsMinor bool) []*Signature {
+	counting := make([]*Signature, 0, len(signatures))
+	for _, signature := range signatures {
+		if signature.SignedByGuardian() == clientIsMinor {
+			counting = append(counting, signature)
+		}
+	}
+	return counting
+}
+
+// HasOutstanding reports whether any required document is unsigned or was
+// signed only in an earlier version
+func HasOutstanding(statuses []DocumentStatus) bool {
+	for _, status := range statuses {
+		if status.Outstanding() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownKey       = errors.New("unknown key-encryption key")
+	ErrDecryptionFailed = errors.New("decryption failed")
+)
+
+// DataKeySize is the length of the AES-256 keys that encrypt records
+const DataKeySize = 32
+
+// KeyProvider holds the key-encryption keys. Records are never encrypted with
+// those keys directly: each record gets a fresh data key, and only the wrapped
+// (encrypted) data key is stored next to the ciphertext. Swapping the provider,
+// e.g. for a cloud KMS, leaves stored records readable as long as the new
+// provider can unwrap the old key IDs.
+type KeyProvider interface {
+	// GenerateDataKey returns a new data key in plaintext and wrapped under the
+	// provider's current key-encryption key
+	GenerateDataKey(ctx context.Context) (*DataKey, error)
+	// DecryptDataKey unwraps a data key wrapped under the given key-encryption key
+	DecryptDataKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+type DataKey struct {
+	KeyID      string
+	Plaintext  []byte
+	WrappedKey []byte
+}
+
+// Envelope is an encrypted record as stored: the ciphertext together with the
+// wrapped data key that decrypts it
+type Envelope struct {
+	KeyID      string
+	WrappedKey []byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// Seal encrypts plaintext with a new data key using AES-256-GCM. The associated
+// data is authenticated but not stored, so the same value must be passed to Open;
+// binding it to the record's identity stops ciphertext being moved between rows.
+func Seal(ctx context.Context, provider KeyProvider, plaintext, associatedData []byte) (*Envelope, error) {
+	dataKey, err := provider.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	defer clear(dataKey.Plaintext)
+
+	aead, err := newAEAD(dataKey.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return &Envelope{
+		KeyID:      dataKey.KeyID,
+		WrappedKey: dataKey.WrappedKey,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, associatedData),
+	}, nil
+}
+
+// Open unwraps the envelope's data key and decrypts the ciphertext
+func Open(ctx context.Context, provider KeyProvider, envelope *Envelope, associatedData []byte) ([]byte, error) {
+	key, err := provider.DecryptDataKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.Nonce) != aead.NonceSize() {
+		return nil, ErrDecryptionFailed
+	}
+
+	plaintext, err := aead.Open(nil, envelope.Nonce, envelope.Ciphertext, associatedData)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != DataKeySize {
+		return nil, fmt.Errorf("data key must be %d bytes", DataKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
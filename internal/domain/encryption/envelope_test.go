@@ -0,0 +1,144 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+// fakeProvider "wraps" keys by XOR with a fixed key so tests can tamper with them
+type fakeProvider struct {
+	keyID string
+	kek   []byte
+}
+
+func newFakeProvider(keyID string) *fakeProvider {
+	kek := make([]byte, DataKeySize)
+	rand.Read(kek)
+	return &fakeProvider{keyID: keyID, kek: kek}
+}
+
+func (p *fakeProvider) GenerateDataKey(ctx context.Context) (*DataKey, error) {
+	key := make([]byte, DataKeySize)
+	rand.Read(key)
+	return &DataKey{KeyID: p.keyID, Plaintext: key, WrappedKey: p.xor(key)}, nil
+}
+
+func (p *fakeProvider) DecryptDataKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	if keyID != p.keyID {
+		return nil, ErrUnknownKey
+	}
+	return p.xor(wrappedKey), nil
+}
+
+func (p *fakeProvider) xor(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i := range in {
+		out[i] = in[i] ^ p.kek[i%len(p.kek)]
+	}
+	return out
+}
+
+func TestSealOpen(t *testing.T) {
+	ctx := context.Background()
+	provider := newFakeProvider("k1")
+	plaintext := []byte("Client reports improved sleep since last session.")
+	aad := []byte("note-123")
+
+	envelope, err := Seal(ctx, provider, plaintext, aad)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	if envelope.KeyID != "k1" {
+		t.Errorf("Expected key ID k1, got %q", envelope.KeyID)
+	}
+
+	if bytes.Contains(envelope.Ciphertext, []byte("sleep")) {
+		t.Error("Ciphertext contains plaintext")
+	}
+
+	opened, err := Open(ctx, provider, envelope, aad)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	if !bytes.Equal(opened, plaintext) {
+		t.Errorf("Open() = %q, want %q", opened, plaintext)
+	}
+
+	// Every record gets its own data key
+	other, err := Seal(ctx, provider, plaintext, aad)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if bytes.Equal(other.WrappedKey, envelope.WrappedKey) || bytes.Equal(other.Ciphertext, envelope.Ciphertext) {
+		t.Error("Expected a fresh data key and nonce per record")
+	}
+}
+
+func TestOpen_Rejects(t *testing.T) {
+	ctx := context.Background()
+	provider := newFakeProvider("k1")
+	aad := []byte("note-123")
+
+	seal := func() *Envelope {
+		envelope, err := Seal(ctx, provider, []byte("plan: continue CBT"), aad)
+		if err != nil {
+			t.Fatalf("Seal() error = %v", err)
+		}
+		return envelope
+	}
+
+	tests := []struct {
+		name    string
+		tamper  func(e *Envelope)
+		aad     []byte
+		wantErr error
+	}{
+		{
+			name:    "different associated data",
+			tamper:  func(e *Envelope) {},
+			aad:     []byte("note-456"),
+			wantErr: ErrDecryptionFailed,
+		},
+		{
+			name:    "modified ciphertext",
+			tamper:  func(e *Envelope) { e.Ciphertext[0] ^= 0xFF },
+			aad:     aad,
+			wantErr: ErrDecryptionFailed,
+		},
+		{
+			name:    "modified wrapped key",
+			tamper:  func(e *Envelope) { e.WrappedKey[0] ^= 0xFF },
+			aad:     aad,
+			wantErr: ErrDecryptionFailed,
+		},
+		{
+			name:    "truncated nonce",
+			tamper:  func(e *Envelope) { e.Nonce = e.Nonce[:4] },
+			aad:     aad,
+			wantErr: ErrDecryptionFailed,
+		},
+		{
+			name:    "unknown key",
+			tamper:  func(e *Envelope) { e.KeyID = "k0" },
+			aad:     aad,
+			wantErr: ErrUnknownKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := seal()
+			tt.tamper(envelope)
+
+			_, err := Open(ctx, provider, envelope, tt.aad)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package guardian
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Relationship is how a guardian is related to the minor
+type Relationship string
+
+const (
+	RelationshipParent        Relationship = "parent"
+	RelationshipLegalGuardian Relationship = "legal_guardian"
+)
+
+// Scope is one area of a minor's account a guardian may access
+type Scope string
+
+const (
+	// ScopeProfile lets the guardian view and edit the minor's profile
+	ScopeProfile Scope = "profile"
+	// ScopeConsents lets the guardian sign consent forms on the minor's behalf
+	ScopeConsents Scope = "consents"
+	// ScopeHomework lets the guardian follow the minor's homework, without their answers
+	ScopeHomework Scope = "homework"
+)
+
+// DefaultScopes are granted to every new guardianship. The minor's therapist
+// can narrow them later.
+var DefaultScopes = []Scope{ScopeProfile, ScopeConsents, ScopeHomework}
+
+type Status string
+
+const (
+	StatusActive Status = "active"
+	StatusEnded  Status = "ended"
+)
+
+// EndReason records why a guardianship stopped
+type EndReason string
+
+const (
+	// EndReasonRevoked means the minor's therapist removed the guardian
+	EndReasonRevoked EndReason = "revoked"
+	// EndReasonMajority means the client came of age and took over their account
+	EndReasonMajority EndReason = "majority"
+)
+
+// Guardianship links a parent or legal guardian to a minor client. It gives
+// the guardian access to the parts of the minor's account named by its scopes,
+// and only while the client is under the age of majority.
+type Guardianship struct {
+	ID           string
+	GuardianID   string
+	ClientID     string
+	Relationship Relationship
+	Scopes       []Scope
+	Status       Status
+	EndReason    EndReason
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	EndedAt      *time.Time
+}
+
+// NewGuardianship links a guardian to a client, who must be a minor
+func NewGuardianship(guardianID, clientID string, relationship Relationship, clientIsMinor bool, now time.Time) (*Guardianship, error) {
+	if strings.TrimSpace(guardianID) == "" {
+		return nil, errors.New("guardian ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if guardianID == clientID {
+		return nil, errors.New("a client cannot be their own guardian")
+	}
+
+	if !IsValidRelationship(relationship) {
+		return nil, fmt.Errorf("unknown relationship %q", relationship)
+	}
+
+	if !clientIsMinor {
+		return nil, ErrClientNotMinor
+	}
+
+	return &Guardianship{
+		ID:           generateID(),
+		GuardianID:   guardianID,
+		ClientID:     clientID,
+		Relationship: relationship,
+		Scopes:       append([]Scope(nil), DefaultScopes...),
+		Status:       StatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func (g *Guardianship) IsActive() bool {
+	return g.Status == StatusActive
+}
+
+func (g *Guardianship) Allows(scope Scope) bool {
+	for _, granted := range g.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize checks that the guardian may currently act in the given scope.
+// Access stops the day the client comes of age, even before the guardianship
+// is formally ended.
+func (g *Guardianship) Authorize(scope Scope, clientIsMinor bool) error {
+	if !g.IsActive() {
+		return ErrGuardianshipEnded
+	}
+
+	if !clientIsMinor {
+		return ErrClientNotMinor
+	}
+
+	if !g.Allows(scope) {
+		return ErrScopeNotGranted
+	}
+
+	return nil
+}
+
+// SetScopes replaces the areas the guardian may access. Duplicates are dropped;
+// no scopes at all leaves the guardian with only the link to the minor.
+func (g *Guardianship) SetScopes(scopes []Scope, now time.Time) error {
+	if !g.IsActive() {
+		return ErrGuardianshipEnded
+	}
+
+	unique := make([]Scope, 0, len(scopes))
+	seen := make(map[Scope]bool, len(scopes))
+	for _, scope := range scopes {
+		if !IsValidScope(scope) {
+			return fmt.Errorf("unknown scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+
+	g.Scopes = unique
+	g.UpdatedAt = now
+	return nil
+}
+
+// End stops the guardianship. Ended guardianships are kept as a record.
+func (g *Guardianship) End(reason EndReason, now time.Time) error {
+	if !g.IsActive() {
+		return ErrGuardianshipEnded
+	}
+
+	if reason != EndReasonRevoked && reason != EndReasonMajority {
+		return fmt.Errorf("unknown end reason %q", reason)
+	}
+
+	g.Status = StatusEnded
+	g.EndReason = reason
+	g.EndedAt = &now
+	g.UpdatedAt = now
+	return nil
+}
+
+func IsValidRelationship(relationship Relationship) bool {
+	switch relationship {
+	case RelationshipParent, RelationshipLegalGuardian:
+		return true
+	}
+	return false
+}
+
+func IsValidScope(scope Scope) bool {
+	switch scope {
+	case ScopeProfile, ScopeConsents, ScopeHomework:
+		return true
+	}
+	return false
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,188 @@
+package guardian
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewGuardianship(t *testing.T) {
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		guardianID    string
+		clientID      string
+		relationship  Relationship
+		clientIsMinor bool
+		wantErr       error
+		errString     string
+	}{
+		{
+			name:          "parent of a minor",
+			guardianID:    "guardian-123",
+			clientID:      "client-123",
+			relationship:  RelationshipParent,
+			clientIsMinor: true,
+		},
+		{
+			name:          "legal guardian of a minor",
+			guardianID:    "guardian-123",
+			clientID:      "client-123",
+			relationship:  RelationshipLegalGuardian,
+			clientIsMinor: true,
+		},
+		{
+			name:          "adult client",
+			guardianID:    "guardian-123",
+			clientID:      "client-123",
+			relationship:  RelationshipParent,
+			clientIsMinor: false,
+			wantErr:       ErrClientNotMinor,
+		},
+		{
+			name:          "unknown relationship",
+			guardianID:    "guardian-123",
+			clientID:      "client-123",
+			relationship:  "uncle",
+			clientIsMinor: true,
+			errString:     `unknown relationship "uncle"`,
+		},
+		{
+			name:          "own guardian",
+			guardianID:    "client-123",
+			clientID:      "client-123",
+			relationship:  RelationshipParent,
+			clientIsMinor: true,
+			errString:     "cannot be their own guardian",
+		},
+		{
+			name:          "missing guardian",
+			clientID:      "client-123",
+			relationship:  RelationshipParent,
+			clientIsMinor: true,
+			errString:     "guardian ID is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guardianship, err := NewGuardianship(tt.guardianID, tt.clientID, tt.relationship, tt.clientIsMinor, now)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("NewGuardianship() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewGuardianship() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewGuardianship() unexpected error = %v", err)
+			}
+
+			if !guardianship.IsActive() || guardianship.EndedAt != nil {
+				t.Error("new guardianship should be active")
+			}
+			for _, scope := range DefaultScopes {
+				if !guardianship.Allows(scope) {
+					t.Errorf("new guardianship should allow %q", scope)
+				}
+			}
+		})
+	}
+}
+
+func TestGuardianship_Authorize(t *testing.T) {
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	guardianship, err := NewGuardianship("guardian-123", "client-123", RelationshipParent, true, now)
+	if err != nil {
+		t.Fatalf("NewGuardianship() unexpected error = %v", err)
+	}
+
+	if err := guardianship.Authorize(ScopeConsents, true); err != nil {
+		t.Errorf("Authorize() unexpected error = %v", err)
+	}
+
+	if err := guardianship.Authorize(ScopeConsents, false); !errors.Is(err, ErrClientNotMinor) {
+		t.Errorf("Authorize() for a client who came of age error = %v, want %v", err, ErrClientNotMinor)
+	}
+
+	if err := guardianship.SetScopes([]Scope{ScopeProfile, ScopeProfile}, now); err != nil {
+		t.Fatalf("SetScopes() unexpected error = %v", err)
+	}
+	if len(guardianship.Scopes) != 1 {
+		t.Errorf("SetScopes() kept %v, want duplicates dropped", guardianship.Scopes)
+	}
+	if err := guardianship.Authorize(ScopeConsents, true); !errors.Is(err, ErrScopeNotGranted) {
+		t.Errorf("Authorize() outside the scopes error = %v, want %v", err, ErrScopeNotGranted)
+	}
+
+	if err := guardianship.End(EndReasonRevoked, now); err != nil {
+		t.Fatalf("End() unexpected error = %v", err)
+	}
+	if err := guardianship.Authorize(ScopeProfile, true); !errors.Is(err, ErrGuardianshipEnded) {
+		t.Errorf("Authorize() after End() error = %v, want %v", err, ErrGuardianshipEnded)
+	}
+}
+
+func TestGuardianship_SetScopes(t *testing.T) {
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	guardianship, err := NewGuardianship("guardian-123", "client-123", RelationshipParent, true, now)
+	if err != nil {
+		t.Fatalf("NewGuardianship() unexpected error = %v", err)
+	}
+
+	err = guardianship.SetScopes([]Scope{ScopeProfile, "messages"}, now)
+	if err == nil || !strings.Contains(err.Error(), `unknown scope "messages"`) {
+		t.Errorf("SetScopes() error = %v, want unknown scope", err)
+	}
+	if len(guardianship.Scopes) != len(DefaultScopes) {
+		t.Error("failed SetScopes() should leave the scopes unchanged")
+	}
+
+	if err := guardianship.SetScopes(nil, now); err != nil {
+		t.Fatalf("SetScopes(nil) unexpected error = %v", err)
+	}
+	if guardianship.Allows(ScopeProfile) {
+		t.Error("SetScopes(nil) should remove every scope")
+	}
+}
+
+func TestGuardianship_End(t *testing.T) {
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	guardianship, err := NewGuardianship("guardian-123", "client-123", RelationshipParent, true, now)
+	if err != nil {
+		t.Fatalf("NewGuardianship() unexpected error = %v", err)
+	}
+
+	if err := guardianship.End("moved away", later); err == nil {
+		t.Error("End() with an unknown reason should fail")
+	}
+
+	if err := guardianship.End(EndReasonMajority, later); err != nil {
+		t.Fatalf("End() unexpected error = %v", err)
+	}
+	if guardianship.Status != StatusEnded || guardianship.EndReason != EndReasonMajority {
+		t.Errorf("End() status = %s, reason = %s", guardianship.Status, guardianship.EndReason)
+	}
+	if guardianship.EndedAt == nil || !guardianship.EndedAt.Equal(later) {
+		t.Errorf("End() EndedAt = %v, want %v", guardianship.EndedAt, later)
+	}
+
+	if err := guardianship.End(EndReasonRevoked, later); !errors.Is(err, ErrGuardianshipEnded) {
+		t.Errorf("second End() error = %v, want %v", err, ErrGuardianshipEnded)
+	}
+	if err := guardianship.SetScopes(DefaultScopes, later); !errors.Is(err, ErrGuardianshipEnded) {
+		t.Errorf("SetScopes() after End() error = %v, want %v", err, ErrGuardianshipEnded)
+	}
+}
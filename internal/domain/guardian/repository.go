@@ -0,0 +1,31 @@
+package guardian
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrGuardianshipNotFound = errors.New("guardianship not found")
+	ErrGuardianshipExists   = errors.New("guardian is already linked to this client")
+	ErrGuardianshipEnded    = errors.New("guardianship has ended")
+	ErrClientNotMinor       = errors.New("client is not a minor")
+	ErrScopeNotGranted      = errors.New("guardian has no access to this part of the client's account")
+)
+
+type Repository interface {
+	Create(ctx context.Context, guardianship *Guardianship) error
+	GetByID(ctx context.Context, id string) (*Guardianship, error)
+	Update(ctx context.Context, guardianship *Guardianship) error
+	// GetActive returns the active guardianship between a guardian and a client
+	GetActive(ctx context.Context, guardianID, clientID string) (*Guardianship, error)
+	// GetActiveByGuardianID lists the guardian's active guardianships, oldest first
+	GetActiveByGuardianID(ctx context.Context, guardianID string) ([]*Guardianship, error)
+	// GetByClientID lists every guardianship of a client, including ended ones, newest first
+	GetByClientID(ctx context.Context, clientID string) ([]*Guardianship, error)
+	HasActive(ctx context.Context, clientID string) (bool, error)
+	// EndAtMajority ends the active guardianships of clients born on or before
+	// cutoff and returns how many were ended
+	EndAtMajority(ctx context.Context, cutoff, now time.Time) (int64, error)
+}
@@ -0,0 +1,86 @@
+package guardian
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrGuardianServiceUnavailable = errors.New("guardian service unavailable")
+	ErrUnauthorizedAccess         = errors.New("unauthorized access to guardian data")
+	ErrInvalidGuardianData        = errors.New("invalid guardian data")
+	ErrClientNotAssigned          = errors.New("client is not assigned to this therapist")
+	ErrGuardianNotFound           = errors.New("no active guardian account with this email")
+)
+
+type Service interface {
+	// Guardians
+	RegisterMinor(ctx context.Context, guardianUserID string, req RegisterMinorRequest) (*Minor, error)
+	AddGuardian(ctx context.Context, guardianUserID, clientUserID string, req AddGuardianRequest) (*Guardianship, error)
+	GetMinors(ctx context.Context, guardianUserID string) ([]*Minor, error)
+	GetMinor(ctx context.Context, guardianUserID, clientUserID string) (*Minor, error)
+	UpdateMinor(ctx context.Context, guardianUserID, clientUserID string, req UpdateMinorRequest) (*Minor, error)
+	GetMinorHomework(ctx context.Context, guardianUserID, clientUserID string) ([]*HomeworkItem, error)
+
+	// Therapists
+	GetClientGuardianships(ctx context.Context, therapistUserID, clientUserID string) ([]*Guardianship, error)
+	UpdateScopes(ctx context.Context, therapistUserID, guardianshipID string, scopes []Scope) (*Guardianship, error)
+	RevokeGuardianship(ctx context.Context, therapistUserID, guardianshipID string) (*Guardianship, error)
+
+	// Clients
+	GetOwnGuardianships(ctx context.Context, clientUserID string) ([]*Guardianship, error)
+
+	// AuthorizeConsent checks that the guardian may sign consent forms for the client
+	AuthorizeConsent(ctx context.Context, guardianUserID, clientUserID string) error
+	// HasActiveGuardian reports whether anyone manages the client's account
+	HasActiveGuardian(ctx context.Context, clientUserID string) (bool, error)
+	// EndGuardianshipsAtMajority ends the guardianships of clients who came of
+	// age and returns how many were ended
+	EndGuardianshipsAtMajority(ctx context.Context) (int64, error)
+}
+
+// Minor is a client as their guardian sees them
+type Minor struct {
+	Guardianship *Guardianship
+	FirstName    string
+	LastName     string
+	DateOfBirth  *time.Time
+	// MajorityDate is the day the client comes of age and takes over their account
+	MajorityDate *time.Time
+}
+
+// HomeworkItem is a homework assignment as a guardian sees it: what was set and
+// whether it is done, but not the minor's own answers
+type HomeworkItem struct {
+	ID           string
+	Title        string
+	Instructions string
+	Status       string
+	DueAt        *time.Time
+	AssignedAt   time.Time
+	CompletedAt  *time.Time
+}
+
+// RegisterMinorRequest creates a client account for a minor, managed by the
+// guardian who registers it. The minor signs in with the email and password.
+type RegisterMinorRequest struct {
+	Email        string
+	Password     string
+	FirstName    string
+	LastName     string
+	DateOfBirth  string
+	Relationship Relationship
+}
+
+// AddGuardianRequest links another guardian account, e.g. the second parent
+type AddGuardianRequest struct {
+	Email        string
+	Relationship Relationship
+}
+
+type UpdateMinorRequest struct {
+	FirstName   string
+	LastName    string
+	DateOfBirth string
+}
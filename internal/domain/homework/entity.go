@@ -0,0 +1,219 @@
+package homework
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusAssigned  Status = "assigned"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
+// ResourceType names the library content an assignment can point to
+type ResourceType string
+
+const (
+	ResourceArticle ResourceType = "article"
+	ResourceTherapy ResourceType = "therapy"
+)
+
+const (
+	maxTitleLength        = 200
+	maxInstructionsLength = 5000
+	maxResponseLength     = 10000
+	maxReflectionLength   = 5000
+	minHelpfulness        = 1
+	maxHelpfulness        = 5
+)
+
+// Assignment is an exercise a therapist gives a client between sessions. It
+// may point to an article or therapy from the library, and is completed once
+// by the client with their answers and a reflection.
+type Assignment struct {
+	ID           string
+	TherapistID  string
+	ClientID     string
+	Title        string
+	Instructions string
+	ResourceType ResourceType
+	ResourceID   string
+	DueAt        *time.Time
+	Status       Status
+	Submission   *Submission
+	AssignedAt   time.Time
+	UpdatedAt    time.Time
+}
+
+// Submission is the client's completion of an assignment
+type Submission struct {
+	Response    string
+	Reflection  string
+	Helpfulness *int
+	CompletedAt time.Time
+}
+
+type AssignmentDetails struct {
+	Title        string
+	Instructions string
+	ResourceType ResourceType
+	ResourceID   string
+	DueAt        *time.Time
+}
+
+func NewAssignment(therapistID, clientID string, details AssignmentDetails, now time.Time) (*Assignment, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	title := strings.TrimSpace(details.Title)
+	if title == "" {
+		return nil, errors.New("title is required")
+	}
+
+	if len(title) > maxTitleLength {
+		return nil, fmt.Errorf("title must be %d characters or less", maxTitleLength)
+	}
+
+	instructions := strings.TrimSpace(details.Instructions)
+	if len(instructions) > maxInstructionsLength {
+		return nil, fmt.Errorf("instructions must be %d characters or less", maxInstructionsLength)
+	}
+
+	resourceID := strings.TrimSpace(details.ResourceID)
+	switch details.ResourceType {
+	case "":
+		if resourceID != "" {
+			return nil, errors.New("resource type is required with a resource ID")
+		}
+	case ResourceArticle, ResourceTherapy:
+		if resourceID == "" {
+			return nil, errors.New("resource ID is required with a resource type")
+		}
+	default:
+		return nil, fmt.Errorf("unknown resource type %q - must be article or therapy", details.ResourceType)
+	}
+
+	if details.DueAt != nil && !details.DueAt.After(now) {
+		return nil, errors.New("due date must be in the future")
+	}
+
+	return &Assignment{
+		ID:           generateID(),
+		TherapistID:  therapistID,
+		ClientID:     clientID,
+		Title:        title,
+		Instructions: instructions,
+		ResourceType: details.ResourceType,
+		ResourceID:   resourceID,
+		DueAt:        details.DueAt,
+		Status:       StatusAssigned,
+		AssignedAt:   now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Complete records the client's submission. Overdue assignments can still be
+// completed; CompletedLate tells the therapist afterwards.
+func (a *Assignment) Complete(response, reflection string, helpfulness *int, now time.Time) error {
+	if a.Status != StatusAssigned {
+		return ErrInvalidStatusTransition
+	}
+
+	response = strings.TrimSpace(response)
+	if len(response) > maxResponseLength {
+		return fmt.Errorf("response must be %d characters or less", maxResponseLength)
+	}
+
+	reflection = strings.TrimSpace(reflection)
+	if len(reflection) > maxReflectionLength {
+		return fmt.Errorf("reflection must be %d characters or less", maxReflectionLength)
+	}
+
+	if helpfulness != nil && (*helpfulness < minHelpfulness || *helpfulness > maxHelpfulness) {
+		return fmt.Errorf("helpfulness must be between %d and %d", minHelpfulness, maxHelpfulness)
+	}
+
+	a.Submission = &Submission{
+		Response:    response,
+		Reflection:  reflection,
+		Helpfulness: helpfulness,
+		CompletedAt: now,
+	}
+	a.Status = StatusCompleted
+	a.UpdatedAt = now
+	return nil
+}
+
+func (a *Assignment) Cancel(now time.Time) error {
+	if a.Status != StatusAssigned {
+		return ErrInvalidStatusTransition
+	}
+
+	a.Status = StatusCancelled
+	a.UpdatedAt = now
+	return nil
+}
+
+// IsOverdue reports whether an open assignment is past its due date
+func (a *Assignment) IsOverdue(now time.Time) bool {
+	return a.Status == StatusAssigned && a.DueAt != nil && now.After(*a.DueAt)
+}
+
+// CompletedLate reports whether the assignment was completed after its due date
+func (a *Assignment) CompletedLate() bool {
+	return a.Submission != nil && a.DueAt != nil && a.Submission.CompletedAt.After(*a.DueAt)
+}
+
+// Matches reports whether the assignment is in the given status. The extra
+// filter "overdue" matches open assignments past their due date.
+func (a *Assignment) Matches(filter StatusFilter, now time.Time) bool {
+	switch filter {
+	case "":
+		return true
+	case FilterOverdue:
+		return a.IsOverdue(now)
+	default:
+		return a.Status == Status(filter)
+	}
+}
+
+func (a *Assignment) AssignedBy(therapistID string) bool {
+	return a.TherapistID == therapistID
+}
+
+func (a *Assignment) AssignedTo(clientID string) bool {
+	return a.ClientID == clientID
+}
+
+// StatusFilter narrows assignment listings: a Status or FilterOverdue
+type StatusFilter string
+
+const FilterOverdue StatusFilter = "overdue"
+
+func IsValidStatusFilter(filter StatusFilter) bool {
+	switch filter {
+	case "", FilterOverdue, StatusFilter(StatusAssigned), StatusFilter(StatusCompleted), StatusFilter(StatusCancelled):
+		return true
+	}
+	return false
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
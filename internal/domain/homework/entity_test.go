@@ -0,0 +1,131 @@
+package homework
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAssignment(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	tomorrow := now.AddDate(0, 0, 1)
+	yesterday := now.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name      string
+		details   AssignmentDetails
+		errString string
+	}{
+		{
+			name:    "breathing practice",
+			details: AssignmentDetails{Title: "Box breathing", Instructions: "Twice a day", DueAt: &tomorrow},
+		},
+		{
+			name:    "reading an article",
+			details: AssignmentDetails{Title: "Read about sleep", ResourceType: ResourceArticle, ResourceID: "sleep-hygiene"},
+		},
+		{
+			name:      "missing title",
+			details:   AssignmentDetails{Title: "  "},
+			errString: "title is required",
+		},
+		{
+			name:      "resource ID without type",
+			details:   AssignmentDetails{Title: "Read", ResourceID: "sleep-hygiene"},
+			errString: "resource type is required",
+		},
+		{
+			name:      "resource type without ID",
+			details:   AssignmentDetails{Title: "Read", ResourceType: ResourceTherapy},
+			errString: "resource ID is required",
+		},
+		{
+			name:      "unknown resource type",
+			details:   AssignmentDetails{Title: "Watch", ResourceType: "video", ResourceID: "x"},
+			errString: `unknown resource type "video"`,
+		},
+		{
+			name:      "due date in the past",
+			details:   AssignmentDetails{Title: "Worksheet", DueAt: &yesterday},
+			errString: "due date must be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment, err := NewAssignment("therapist-123", "client-123", tt.details, now)
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewAssignment() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewAssignment() unexpected error = %v", err)
+			}
+
+			if assignment.Status != StatusAssigned || assignment.Submission != nil {
+				t.Errorf("NewAssignment() status = %q, submission = %v", assignment.Status, assignment.Submission)
+			}
+		})
+	}
+}
+
+func TestAssignment_Overdue(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	dueAt := now.AddDate(0, 0, 2)
+
+	assignment, err := NewAssignment("therapist-123", "client-123", AssignmentDetails{Title: "Thought record", DueAt: &dueAt}, now)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	later := dueAt.Add(time.Hour)
+	if assignment.IsOverdue(now) || !assignment.IsOverdue(later) {
+		t.Errorf("IsOverdue() should turn true after %v", dueAt)
+	}
+	if !assignment.Matches(FilterOverdue, later) || assignment.Matches(StatusFilter(StatusCompleted), later) {
+		t.Error("Matches() did not filter an overdue assignment")
+	}
+
+	helpful := 4
+	if err := assignment.Complete(" Filled in ", "Noticed a pattern", &helpful, later); err != nil {
+		t.Fatalf("Complete() unexpected error = %v", err)
+	}
+
+	if assignment.IsOverdue(later) {
+		t.Error("IsOverdue() should be false once completed")
+	}
+	if !assignment.CompletedLate() {
+		t.Error("CompletedLate() should be true after the due date")
+	}
+	if assignment.Submission.Response != "Filled in" {
+		t.Errorf("Complete() did not trim response %q", assignment.Submission.Response)
+	}
+}
+
+func TestAssignment_StatusTransitions(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	assignment, err := NewAssignment("therapist-123", "client-123", AssignmentDetails{Title: "Worksheet"}, now)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	unhelpful := 6
+	if err := assignment.Complete("", "", &unhelpful, now); err == nil || !strings.Contains(err.Error(), "helpfulness must be between 1 and 5") {
+		t.Errorf("Complete() error = %v, want helpfulness error", err)
+	}
+
+	if err := assignment.Cancel(now); err != nil {
+		t.Fatalf("Cancel() unexpected error = %v", err)
+	}
+	if err := assignment.Complete("", "", nil, now); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Errorf("Complete() after cancel error = %v, want ErrInvalidStatusTransition", err)
+	}
+	if err := assignment.Cancel(now); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Errorf("Cancel() twice error = %v, want ErrInvalidStatusTransition", err)
+	}
+}
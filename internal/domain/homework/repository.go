@@ -0,0 +1,23 @@
+package homework
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAssignmentNotFound      = errors.New("homework assignment not found")
+	ErrInvalidStatusTransition = errors.New("homework assignment is no longer open")
+)
+
+type Repository interface {
+	Create(ctx context.Context, assignment *Assignment) error
+	GetByID(ctx context.Context, id string) (*Assignment, error)
+	Update(ctx context.Context, assignment *Assignment) error
+	// GetByClientID lists a client's assignments, newest first. An empty
+	// therapistID matches assignments from any therapist.
+	GetByClientID(ctx context.Context, clientID, therapistID string) ([]*Assignment, error)
+	// GetOverdue lists a therapist's open assignments that were due before the given time
+	GetOverdue(ctx context.Context, therapistID string, now time.Time) ([]*Assignment, error)
+}
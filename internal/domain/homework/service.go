@@ -0,0 +1,37 @@
+package homework
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrHomeworkServiceUnavailable = errors.New("homework service unavailable")
+	ErrUnauthorizedAccess         = errors.New("unauthorized access to homework")
+	ErrInvalidAssignmentData      = errors.New("invalid homework assignment data")
+	ErrClientNotAssigned          = errors.New("client is not assigned to this therapist")
+	ErrResourceNotFound           = errors.New("referenced article or therapy not found")
+)
+
+type Service interface {
+	// Therapists
+	CreateAssignment(ctx context.Context, therapistUserID string, req CreateAssignmentRequest) (*Assignment, error)
+	CancelAssignment(ctx context.Context, therapistUserID, assignmentID string) (*Assignment, error)
+	GetClientAssignments(ctx context.Context, therapistUserID, clientUserID string, filter StatusFilter) ([]*Assignment, error)
+	GetOverdueAssignments(ctx context.Context, therapistUserID string) ([]*Assignment, error)
+
+	// Clients
+	GetOwnAssignments(ctx context.Context, clientUserID string, filter StatusFilter) ([]*Assignment, error)
+	CompleteAssignment(ctx context.Context, clientUserID, assignmentID string, req CompleteAssignmentRequest) (*Assignment, error)
+}
+
+type CreateAssignmentRequest struct {
+	ClientID string
+	AssignmentDetails
+}
+
+type CompleteAssignmentRequest struct {
+	Response    string
+	Reflection  string
+	Helpfulness *int
+}
@@ -0,0 +1,173 @@
+package journal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DateLayout is the format of entry dates, the client's local calendar day
+	DateLayout = "2006-01-02"
+
+	MinMoodScore = 1
+	MaxMoodScore = 10
+
+	maxEmotions         = 10
+	maxEmotionLength    = 30
+	maxTextLength       = 10000
+	maxSleepHours       = 24
+	maxActivityMinutes  = 24 * 60
+	minSleepQuality     = 1
+	maxSleepQuality     = 5
+	futureDateTolerance = 24 * time.Hour
+)
+
+// Entry is a client's mood log for one day. Entries are private to the client
+// unless they share them with their therapist one at a time.
+type Entry struct {
+	ID                  string
+	ClientID            string
+	EntryDate           time.Time
+	MoodScore           int
+	Emotions            []string
+	Text                string
+	SleepHours          *float64
+	SleepQuality        *int
+	ActivityMinutes     *int
+	SharedWithTherapist bool
+	SharedAt            *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+
+	// RiskFlagged is set when the latest write suggested acute risk, so the
+	// client can be shown crisis resources. It is not stored.
+	RiskFlagged bool
+}
+
+// EntryContent is everything a client writes in an entry
+type EntryContent struct {
+	MoodScore       int
+	Emotions        []string
+	Text            string
+	SleepHours      *float64
+	SleepQuality    *int
+	ActivityMinutes *int
+}
+
+func NewEntry(clientID string, entryDate time.Time, content EntryContent, now time.Time) (*Entry, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	// Clients may be a day ahead of the server's clock
+	entryDate = truncateToDate(entryDate)
+	if entryDate.After(now.Add(futureDateTolerance)) {
+		return nil, errors.New("entry date cannot be in the future")
+	}
+
+	entry := &Entry{
+		ID:        generateID(),
+		ClientID:  clientID,
+		EntryDate: entryDate,
+		CreatedAt: now,
+	}
+
+	if err := entry.Update(content, now); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (e *Entry) Update(content EntryContent, now time.Time) error {
+	if content.MoodScore < MinMoodScore || content.MoodScore > MaxMoodScore {
+		return fmt.Errorf("mood score must be between %d and %d", MinMoodScore, MaxMoodScore)
+	}
+
+	emotions, err := normalizeEmotions(content.Emotions)
+	if err != nil {
+		return err
+	}
+
+	text := strings.TrimSpace(content.Text)
+	if len(text) > maxTextLength {
+		return fmt.Errorf("journal text must be %d characters or less", maxTextLength)
+	}
+
+	if content.SleepHours != nil && (*content.SleepHours < 0 || *content.SleepHours > maxSleepHours) {
+		return errors.New("sleep hours must be between 0 and 24")
+	}
+
+	if content.SleepQuality != nil && (*content.SleepQuality < minSleepQuality || *content.SleepQuality > maxSleepQuality) {
+		return fmt.Errorf("sleep quality must be between %d and %d", minSleepQuality, maxSleepQuality)
+	}
+
+	if content.ActivityMinutes != nil && (*content.ActivityMinutes < 0 || *content.ActivityMinutes > maxActivityMinutes) {
+		return fmt.Errorf("activity minutes must be between 0 and %d", maxActivityMinutes)
+	}
+
+	e.MoodScore = content.MoodScore
+	e.Emotions = emotions
+	e.Text = text
+	e.SleepHours = content.SleepHours
+	e.SleepQuality = content.SleepQuality
+	e.ActivityMinutes = content.ActivityMinutes
+	e.UpdatedAt = now
+	return nil
+}
+
+// SetShared controls whether the client's assigned therapist can read the entry
+func (e *Entry) SetShared(shared bool, now time.Time) {
+	if shared && !e.SharedWithTherapist {
+		e.SharedAt = &now
+	}
+	if !shared {
+		e.SharedAt = nil
+	}
+	e.SharedWithTherapist = shared
+	e.UpdatedAt = now
+}
+
+func (e *Entry) BelongsTo(clientID string) bool {
+	return e.ClientID == clientID
+}
+
+// normalizeEmotions lowercases emotion tags and drops blanks and duplicates
+func normalizeEmotions(emotions []string) ([]string, error) {
+	normalized := make([]string, 0, len(emotions))
+	for _, emotion := range emotions {
+		emotion = strings.ToLower(strings.TrimSpace(emotion))
+		if emotion == "" || slices.Contains(normalized, emotion) {
+			continue
+		}
+
+		if len(emotion) > maxEmotionLength {
+			return nil, fmt.Errorf("emotion tags must be %d characters or less", maxEmotionLength)
+		}
+
+		normalized = append(normalized, emotion)
+	}
+
+	if len(normalized) > maxEmotions {
+		return nil, fmt.Errorf("at most %d emotion tags per entry", maxEmotions)
+	}
+
+	return normalized, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
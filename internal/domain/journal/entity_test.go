@@ -0,0 +1,125 @@
+package journal
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewEntry(t *testing.T) {
+	now := time.Date(2025, 3, 10, 22, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	hours := func(v float64) *float64 { return &v }
+	number := func(v int) *int { return &v }
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		content   EntryContent
+		errString string
+	}{
+		{
+			name: "full entry",
+			date: today,
+			content: EntryContent{
+				MoodScore:       7,
+				Emotions:        []string{" Calm ", "hopeful", "calm", ""},
+				Text:            "  Good day at work  ",
+				SleepHours:      hours(7.5),
+				SleepQuality:    number(4),
+				ActivityMinutes: number(45),
+			},
+		},
+		{
+			name:    "client a day ahead of the server",
+			date:    today.AddDate(0, 0, 1),
+			content: EntryContent{MoodScore: 5},
+		},
+		{
+			name:      "date too far in the future",
+			date:      today.AddDate(0, 0, 2),
+			content:   EntryContent{MoodScore: 5},
+			errString: "entry date cannot be in the future",
+		},
+		{
+			name:      "mood out of range",
+			date:      today,
+			content:   EntryContent{MoodScore: 11},
+			errString: "mood score must be between 1 and 10",
+		},
+		{
+			name:      "too much sleep",
+			date:      today,
+			content:   EntryContent{MoodScore: 5, SleepHours: hours(25)},
+			errString: "sleep hours must be between 0 and 24",
+		},
+		{
+			name:      "sleep quality out of range",
+			date:      today,
+			content:   EntryContent{MoodScore: 5, SleepQuality: number(0)},
+			errString: "sleep quality must be between 1 and 5",
+		},
+		{
+			name:      "negative activity",
+			date:      today,
+			content:   EntryContent{MoodScore: 5, ActivityMinutes: number(-1)},
+			errString: "activity minutes must be between 0 and 1440",
+		},
+		{
+			name:      "long emotion tag",
+			date:      today,
+			content:   EntryContent{MoodScore: 5, Emotions: []string{strings.Repeat("a", 31)}},
+			errString: "emotion tags must be 30 characters or less",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewEntry("client-123", tt.date, tt.content, now)
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewEntry() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewEntry() unexpected error = %v", err)
+			}
+
+			if entry.SharedWithTherapist {
+				t.Error("NewEntry() entries should be private by default")
+			}
+			if entry.Text != strings.TrimSpace(entry.Text) {
+				t.Errorf("NewEntry() did not trim text %q", entry.Text)
+			}
+			if len(tt.content.Emotions) > 0 && strings.Join(entry.Emotions, ",") != "calm,hopeful" {
+				t.Errorf("NewEntry() emotions = %v, want [calm hopeful]", entry.Emotions)
+			}
+		})
+	}
+}
+
+func TestEntry_SetShared(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	entry, err := NewEntry("client-123", now, EntryContent{MoodScore: 6}, now)
+	if err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+
+	entry.SetShared(true, now)
+	if !entry.SharedWithTherapist || entry.SharedAt == nil || !entry.SharedAt.Equal(now) {
+		t.Errorf("SetShared(true) = %v at %v", entry.SharedWithTherapist, entry.SharedAt)
+	}
+
+	// Sharing again keeps the original time
+	entry.SetShared(true, now.Add(time.Hour))
+	if !entry.SharedAt.Equal(now) {
+		t.Errorf("SetShared(true) again moved SharedAt to %v", entry.SharedAt)
+	}
+
+	entry.SetShared(false, now)
+	if entry.SharedWithTherapist || entry.SharedAt != nil {
+		t.Errorf("SetShared(false) = %v at %v", entry.SharedWithTherapist, entry.SharedAt)
+	}
+}
@@ -0,0 +1,23 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrEntryNotFound = errors.New("journal entry not found")
+	ErrEntryExists   = errors.New("a journal entry already exists for this date")
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *Entry) error
+	GetByID(ctx context.Context, id string) (*Entry, error)
+	Update(ctx context.Context, entry *Entry) error
+	Delete(ctx context.Context, id string) error
+	// GetByClientID lists a client's entries between two dates (inclusive), newest first
+	GetByClientID(ctx context.Context, clientID string, from, to time.Time, sharedOnly bool) ([]*Entry, error)
+	// GetEntryDates returns the dates of all of a client's entries, for streaks
+	GetEntryDates(ctx context.Context, clientID string, sharedOnly bool) ([]time.Time, error)
+}
@@ -0,0 +1,46 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrJournalServiceUnavailable = errors.New("journal service unavailable")
+	ErrUnauthorizedAccess        = errors.New("unauthorized access to journal")
+	ErrInvalidEntryData          = errors.New("invalid journal entry data")
+	ErrClientNotAssigned         = errors.New("client is not assigned to this therapist")
+)
+
+type Service interface {
+	// Clients
+	CreateEntry(ctx context.Context, clientUserID string, req CreateEntryRequest) (*Entry, error)
+	UpdateEntry(ctx context.Context, clientUserID, entryID string, content EntryContent) (*Entry, error)
+	DeleteEntry(ctx context.Context, clientUserID, entryID string) error
+	SetEntryShared(ctx context.Context, clientUserID, entryID string, shared bool) (*Entry, error)
+	GetOwnEntries(ctx context.Context, clientUserID string, period Period) ([]*Entry, error)
+	GetOwnTrends(ctx context.Context, clientUserID string, weeks int) (*Trends, error)
+
+	// Therapists, limited to entries their assigned clients shared
+	GetClientEntries(ctx context.Context, therapistUserID, clientUserID string, period Period) ([]*Entry, error)
+	GetClientTrends(ctx context.Context, therapistUserID, clientUserID string, weeks int) (*Trends, error)
+}
+
+type CreateEntryRequest struct {
+	EntryDate time.Time
+	EntryContent
+}
+
+// Period limits entry listings to a date range. Zero values default to the
+// last 30 days.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// RiskScreener looks for signs of acute risk in what a client writes and
+// alerts their therapist. It reports whether the entry was flagged.
+type RiskScreener interface {
+	ScreenJournalEntry(ctx context.Context, entry *Entry) (bool, error)
+}
@@ -0,0 +1,173 @@
+package journal
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultTrendWeeks = 8
+	MaxTrendWeeks     = 52
+
+	topEmotionCount = 5
+)
+
+// Trends summarizes a client's journal over a number of calendar weeks
+// (Monday to Sunday), ending with the current week
+type Trends struct {
+	From          time.Time
+	To            time.Time
+	Weeks         []WeekSummary
+	TotalEntries  int
+	AverageMood   *float64
+	TopEmotions   []EmotionCount
+	CurrentStreak int
+	LongestStreak int
+}
+
+// WeekSummary holds the averages for one week; they are nil for weeks without entries
+type WeekSummary struct {
+	WeekStart              time.Time
+	Entries                int
+	AverageMood            *float64
+	AverageSleepHours      *float64
+	AverageActivityMinutes *float64
+}
+
+type EmotionCount struct {
+	Emotion string
+	Count   int
+}
+
+// TrendPeriod returns the first and last day covered by trends over the given
+// number of weeks
+func TrendPeriod(today time.Time, weeks int) (time.Time, time.Time) {
+	today = truncateToDate(today)
+	offset := (int(today.Weekday()) + 6) % 7 // days since Monday
+	from := today.AddDate(0, 0, -offset-(weeks-1)*7)
+	return from, today
+}
+
+// BuildTrends aggregates entries into weekly averages. Streaks count
+// consecutive days with an entry and are computed from entryDates, which may
+// reach further back than the trend period. The current streak is still
+// running if the client wrote an entry today or yesterday.
+func BuildTrends(entries []*Entry, entryDates []time.Time, today time.Time, weeks int) *Trends {
+	from, to := TrendPeriod(today, weeks)
+
+	trends := &Trends{
+		From:  from,
+		To:    to,
+		Weeks: make([]WeekSummary, weeks),
+	}
+
+	type totals struct {
+		mood, sleep, activity                  float64
+		entries, sleepEntries, activityEntries int
+	}
+	weekTotals := make([]totals, weeks)
+	var overall totals
+	emotionCounts := make(map[string]int)
+
+	for _, entry := range entries {
+		date := truncateToDate(entry.EntryDate)
+		if date.Before(from) || date.After(to) {
+			continue
+		}
+
+		week := int(date.Sub(from).Hours()/24) / 7
+		t := &weekTotals[week]
+		t.entries++
+		t.mood += float64(entry.MoodScore)
+		if entry.SleepHours != nil {
+			t.sleepEntries++
+			t.sleep += *entry.SleepHours
+		}
+		if entry.ActivityMinutes != nil {
+			t.activityEntries++
+			t.activity += float64(*entry.ActivityMinutes)
+		}
+
+		overall.entries++
+		overall.mood += float64(entry.MoodScore)
+		for _, emotion := range entry.Emotions {
+			emotionCounts[emotion]++
+		}
+	}
+
+	for i, t := range weekTotals {
+		trends.Weeks[i] = WeekSummary{
+			WeekStart:              from.AddDate(0, 0, 7*i),
+			Entries:                t.entries,
+			AverageMood:            average(t.mood, t.entries),
+			AverageSleepHours:      average(t.sleep, t.sleepEntries),
+			AverageActivityMinutes: average(t.activity, t.activityEntries),
+		}
+	}
+
+	trends.TotalEntries = overall.entries
+	trends.AverageMood = average(overall.mood, overall.entries)
+	trends.TopEmotions = topEmotions(emotionCounts)
+	trends.CurrentStreak, trends.LongestStreak = streaks(entryDates, to)
+
+	return trends
+}
+
+func streaks(entryDates []time.Time, today time.Time) (int, int) {
+	days := make([]time.Time, 0, len(entryDates))
+	for _, date := range entryDates {
+		days = append(days, truncateToDate(date))
+	}
+	slices.SortFunc(days, func(a, b time.Time) int { return a.Compare(b) })
+	days = slices.CompactFunc(days, func(a, b time.Time) bool { return a.Equal(b) })
+
+	if len(days) == 0 {
+		return 0, 0
+	}
+
+	longest, run := 1, 1
+	for i := 1; i < len(days); i++ {
+		if days[i].Equal(days[i-1].AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+	}
+
+	// run is now the length of the streak ending on the latest entry
+	if days[len(days)-1].Before(today.AddDate(0, 0, -1)) {
+		return 0, longest
+	}
+	return run, longest
+}
+
+func topEmotions(counts map[string]int) []EmotionCount {
+	emotions := make([]EmotionCount, 0, len(counts))
+	for emotion, count := range counts {
+		emotions = append(emotions, EmotionCount{Emotion: emotion, Count: count})
+	}
+
+	sort.Slice(emotions, func(i, j int) bool {
+		if emotions[i].Count != emotions[j].Count {
+			return emotions[i].Count > emotions[j].Count
+		}
+		return emotions[i].Emotion < emotions[j].Emotion
+	})
+
+	if len(emotions) > topEmotionCount {
+		emotions = emotions[:topEmotionCount]
+	}
+	return emotions
+}
+
+// average rounds to one decimal place and is nil when there is nothing to average
+func average(total float64, count int) *float64 {
+	if count == 0 {
+		return nil
+	}
+	value := math.Round(total/float64(count)*10) / 10
+	return &value
+}
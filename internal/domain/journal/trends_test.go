@@ -0,0 +1,104 @@
+package journal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildTrends(t *testing.T) {
+	// Wednesday; the current week started on Monday 2025-03-10
+	today := time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return time.Date(2025, 3, 12+offset, 0, 0, 0, 0, time.UTC) }
+	hours := func(v float64) *float64 { return &v }
+
+	entries := []*Entry{
+		{EntryDate: day(0), MoodScore: 8, Emotions: []string{"calm"}, SleepHours: hours(8)},
+		{EntryDate: day(-1), MoodScore: 6, Emotions: []string{"calm", "tired"}},
+		{EntryDate: day(-2), MoodScore: 5, Emotions: []string{"anxious"}, SleepHours: hours(6)},
+		{EntryDate: day(-7), MoodScore: 3, Emotions: []string{"anxious"}},
+		// Outside the two-week period
+		{EntryDate: day(-30), MoodScore: 1, Emotions: []string{"sad"}},
+	}
+
+	var dates []time.Time
+	for _, entry := range entries {
+		dates = append(dates, entry.EntryDate)
+	}
+
+	trends := BuildTrends(entries, dates, today, 2)
+
+	if !trends.From.Equal(day(-9)) || !trends.To.Equal(day(0)) {
+		t.Errorf("BuildTrends() period = %v to %v, want 2025-03-03 to 2025-03-12", trends.From, trends.To)
+	}
+
+	if len(trends.Weeks) != 2 {
+		t.Fatalf("BuildTrends() weeks = %d, want 2", len(trends.Weeks))
+	}
+
+	previous, current := trends.Weeks[0], trends.Weeks[1]
+	if previous.Entries != 1 || *previous.AverageMood != 3 || previous.AverageSleepHours != nil {
+		t.Errorf("BuildTrends() previous week = %+v", previous)
+	}
+	if current.Entries != 3 || *current.AverageMood != 6.3 || *current.AverageSleepHours != 7 {
+		t.Errorf("BuildTrends() current week = %d entries, mood %v, sleep %v", current.Entries, *current.AverageMood, *current.AverageSleepHours)
+	}
+	if current.AverageActivityMinutes != nil {
+		t.Errorf("BuildTrends() activity average = %v, want nil", *current.AverageActivityMinutes)
+	}
+
+	if trends.TotalEntries != 4 || *trends.AverageMood != 5.5 {
+		t.Errorf("BuildTrends() total = %d, average = %v", trends.TotalEntries, *trends.AverageMood)
+	}
+
+	if len(trends.TopEmotions) != 3 || trends.TopEmotions[0].Emotion != "anxious" || trends.TopEmotions[0].Count != 2 {
+		t.Errorf("BuildTrends() top emotions = %+v", trends.TopEmotions)
+	}
+
+	if trends.CurrentStreak != 3 || trends.LongestStreak != 3 {
+		t.Errorf("BuildTrends() streaks = %d current, %d longest, want 3 and 3", trends.CurrentStreak, trends.LongestStreak)
+	}
+}
+
+func TestBuildTrends_Streaks(t *testing.T) {
+	today := time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return time.Date(2025, 3, 12+offset, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name        string
+		dates       []time.Time
+		wantCurrent int
+		wantLongest int
+	}{
+		{
+			name: "no entries",
+		},
+		{
+			name:        "streak still running from yesterday",
+			dates:       []time.Time{day(-1), day(-2), day(-4)},
+			wantCurrent: 2,
+			wantLongest: 2,
+		},
+		{
+			name:        "broken streak",
+			dates:       []time.Time{day(-2), day(-3), day(-4), day(-5)},
+			wantCurrent: 0,
+			wantLongest: 4,
+		},
+		{
+			name:        "entry for tomorrow from a client ahead of the server",
+			dates:       []time.Time{day(1), day(0)},
+			wantCurrent: 2,
+			wantLongest: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trends := BuildTrends(nil, tt.dates, today, 1)
+			if trends.CurrentStreak != tt.wantCurrent || trends.LongestStreak != tt.wantLongest {
+				t.Errorf("BuildTrends() streaks = %d current, %d longest, want %d and %d",
+					trends.CurrentStreak, trends.LongestStreak, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
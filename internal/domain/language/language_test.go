@@ -0,0 +1,49 @@
+package language
+
+import "testing"
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{code: "hr", want: true},
+		{code: "en", want: true},
+		{code: "de", want: true},
+		{code: "HR", want: false},
+		{code: "xx", want: false},
+		{code: "hrv", want: false},
+		{code: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := IsValid(tt.code); got != tt.want {
+				t.Errorf("IsValid(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := Normalize(" DE "); got != "de" {
+		t.Errorf("Normalize() = %q, want %q", got, "de")
+	}
+
+	if got := Name("hr"); got != "Croatian" {
+		t.Errorf("Name() = %q, want %q", got, "Croatian")
+	}
+}
+
+func TestAll(t *testing.T) {
+	languages := All()
+	if len(languages) == 0 {
+		t.Fatal("All() returned no languages")
+	}
+
+	for i := 1; i < len(languages); i++ {
+		if languages[i-1].Name > languages[i].Name {
+			t.Fatalf("All() not sorted by name: %q before %q", languages[i-1].Name, languages[i].Name)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package media
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"time"
+)
+
+var (
+	ErrUnsupportedImageType = errors.New("image must be a JPEG or PNG")
+	ErrImageTooLarge        = errors.New("image exceeds the maximum size")
+	ErrInvalidImage         = errors.New("image could not be read")
+)
+
+const (
+	// MaxImageSize caps a single uploaded image file
+	MaxImageSize = 8 << 20
+	// MaxImagePixels guards against small files that decode into huge bitmaps
+	MaxImagePixels = 40_000_000
+
+	jpegQuality = 85
+)
+
+// Variant is one stored rendition of an uploaded image. The image is scaled to
+// fit within MaxSide without upscaling; Square variants are centre-cropped first.
+type Variant struct {
+	Name    string
+	MaxSide int
+	Square  bool
+}
+
+const (
+	VariantLarge     = "large"
+	VariantThumbnail = "thumbnail"
+)
+
+// ProfilePhotoVariants are the renditions kept for every profile photo
+var ProfilePhotoVariants = []Variant{
+	{Name: VariantLarge, MaxSide: 800},
+	{Name: VariantThumbnail, MaxSide: 256, Square: true},
+}
+
+// Rendition is an encoded variant, always a JPEG
+type Rendition struct {
+	Variant string
+	Width   int
+	Height  int
+	Content []byte
+}
+
+// SniffImageType reports the content type from the file's magic bytes,
+// or "" when the content is not a supported image
+func SniffImageType(content []byte) string {
+	switch {
+	case bytes.HasPrefix(content, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(content, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	default:
+		return ""
+	}
+}
+
+// ProcessImage decodes an uploaded JPEG or PNG, applies its EXIF orientation and
+// re-encodes it into each variant. Re-encoding drops every metadata segment, so
+// camera details and GPS coordinates never reach storage.
+func ProcessImage(content []byte, variants []Variant) ([]Rendition, error) {
+	if len(content) == 0 {
+		return nil, ErrInvalidImage
+	}
+
+	if len(content) > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	contentType := SniffImageType(content)
+	if contentType == "" {
+		return nil, ErrUnsupportedImageType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	var decoded image.Image
+	if contentType == "image/jpeg" {
+		decoded, err = jpeg.Decode(bytes.NewReader(content))
+	} else {
+		decoded, err = png.Decode(bytes.NewReader(content))
+	}
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	// Flatten onto white: JPEG has no transparency
+	bounds := decoded.Bounds()
+	flat := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(flat, flat.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), decoded, bounds.Min, draw.Over)
+
+	if contentType == "image/jpeg" {
+		flat = orient(flat, exifOrientation(content))
+	}
+
+	renditions := make([]Rendition, 0, len(variants))
+	for _, variant := range variants {
+		src := flat
+		if variant.Square {
+			src = cropSquare(src)
+		}
+
+		scaled := fit(src, variant.MaxSide)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+
+		renditions = append(renditions, Rendition{
+			Variant: variant.Name,
+			Width:   scaled.Bounds().Dx(),
+			Height:  scaled.Bounds().Dy(),
+			Content: buf.Bytes(),
+		})
+	}
+
+	return renditions, nil
+}
+
+// NewKey returns a fresh key under prefix, so every upload gets its own cacheable URLs
+func NewKey(prefix string) string {
+	return prefix + "/" + generateID()
+}
+
+// VariantKey is where a variant of the image stored under key lives
+func VariantKey(key, variant string) string {
+	return key + "/" + variant + ".jpg"
+}
+
+func cropSquare(src *image.RGBA) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w == h {
+		return src
+	}
+
+	side := min(w, h)
+	x0 := (w - side) / 2
+	y0 := (h - side) / 2
+
+	dst := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(dst, dst.Bounds(), src, image.Point{X: x0, Y: y0}, draw.Src)
+	return dst
+}
+
+// fit scales src down to fit within maxSide using box filtering, which keeps
+// downscaled photos smooth. Images already small enough are returned as is.
+func fit(src *image.RGBA, maxSide int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w <= maxSide && h <= maxSide {
+		return src
+	}
+
+	dw, dh := maxSide, maxSide
+	if w > h {
+		dh = max(1, h*maxSide/w)
+	} else {
+		dw = max(1, w*maxSide/h)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0 := y * h / dh
+		sy1 := max(sy0+1, (y+1)*h/dh)
+		for x := 0; x < dw; x++ {
+			sx0 := x * w / dw
+			sx1 := max(sx0+1, (x+1)*w/dw)
+
+			var r, g, b, a, n uint32
+			for sy := sy0; sy < sy1; sy++ {
+				offset := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint32(src.Pix[offset])
+					g += uint32(src.Pix[offset+1])
+					b += uint32(src.Pix[offset+2])
+					a += uint32(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+// orient rotates or mirrors src so it displays upright for the given EXIF orientation (1-8)
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // mirrored
+				sx, sy = w-1-x, y
+			case 3: // rotated 180
+				sx, sy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // rotated 90 clockwise
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // rotated 90 counter-clockwise
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+
+	return dst
+}
+
+// exifOrientation reads the orientation tag from a JPEG's EXIF segment, defaulting to 1 (upright)
+func exifOrientation(content []byte) int {
+	const orientationTag = 0x0112
+
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return 1
+		}
+		marker := content[i+1]
+		// Start of scan or end of image: no more metadata segments
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(content[i+2 : i+4]))
+		if size < 2 || i+2+size > len(content) {
+			return 1
+		}
+		segment := content[i+4 : i+2+size]
+		i += 2 + size
+
+		if marker != 0xE1 || !bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			continue
+		}
+
+		tiff := segment[6:]
+		if len(tiff) < 8 {
+			return 1
+		}
+
+		var order binary.ByteOrder
+		switch string(tiff[:2]) {
+		case "II":
+			order = binary.LittleEndian
+		case "MM":
+			order = binary.BigEndian
+		default:
+			return 1
+		}
+
+		ifd := int(order.Uint32(tiff[4:8]))
+		if ifd < 8 || ifd+2 > len(tiff) {
+			return 1
+		}
+
+		entries := int(order.Uint16(tiff[ifd : ifd+2]))
+		for e := 0; e < entries; e++ {
+			entry := ifd + 2 + e*12
+			if entry+12 > len(tiff) {
+				return 1
+			}
+			if order.Uint16(tiff[entry:entry+2]) == orientationTag {
+				return int(order.Uint16(tiff[entry+8 : entry+10]))
+			}
+		}
+		return 1
+	}
+
+	return 1
+}
+
+func generateID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,162 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("Failed to encode JPEG: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withExif inserts an APP1 segment carrying the orientation tag and a fake GPS marker after SOI
+func withExif(content []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	tiff = append(tiff, []byte("GPS-SECRET")...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, content[:2]...)
+	result = append(result, segment...)
+	return append(result, content[2:]...)
+}
+
+func TestSniffImageType(t *testing.T) {
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, image.NewRGBA(image.Rect(0, 0, 2, 2))); err != nil {
+		t.Fatalf("Failed to encode PNG: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		content []byte
+		want    string
+	}{
+		{name: "jpeg", content: encodeJPEG(t, 2, 2), want: "image/jpeg"},
+		{name: "png", content: pngBuf.Bytes(), want: "image/png"},
+		{name: "gif", content: []byte("GIF89a..."), want: ""},
+		{name: "html pretending to be an image", content: []byte("<html><script>"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SniffImageType(tt.content); got != tt.want {
+				t.Errorf("SniffImageType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessImage(t *testing.T) {
+	renditions, err := ProcessImage(encodeJPEG(t, 1200, 900), ProfilePhotoVariants)
+	if err != nil {
+		t.Fatalf("ProcessImage() unexpected error = %v", err)
+	}
+
+	if len(renditions) != 2 {
+		t.Fatalf("ProcessImage() returned %d renditions, want 2", len(renditions))
+	}
+
+	large, thumbnail := renditions[0], renditions[1]
+	if large.Width != 800 || large.Height != 600 {
+		t.Errorf("large rendition = %dx%d, want 800x600", large.Width, large.Height)
+	}
+	if thumbnail.Width != 256 || thumbnail.Height != 256 {
+		t.Errorf("thumbnail rendition = %dx%d, want 256x256", thumbnail.Width, thumbnail.Height)
+	}
+
+	for _, rendition := range renditions {
+		decoded, err := jpeg.Decode(bytes.NewReader(rendition.Content))
+		if err != nil {
+			t.Fatalf("%s rendition is not a valid JPEG: %v", rendition.Variant, err)
+		}
+		if decoded.Bounds().Dx() != rendition.Width {
+			t.Errorf("%s rendition width = %d, want %d", rendition.Variant, decoded.Bounds().Dx(), rendition.Width)
+		}
+	}
+}
+
+func TestProcessImage_DoesNotUpscale(t *testing.T) {
+	renditions, err := ProcessImage(encodeJPEG(t, 100, 50), ProfilePhotoVariants)
+	if err != nil {
+		t.Fatalf("ProcessImage() unexpected error = %v", err)
+	}
+
+	if renditions[0].Width != 100 || renditions[0].Height != 50 {
+		t.Errorf("large rendition = %dx%d, want 100x50", renditions[0].Width, renditions[0].Height)
+	}
+	if renditions[1].Width != 50 || renditions[1].Height != 50 {
+		t.Errorf("thumbnail rendition = %dx%d, want 50x50", renditions[1].Width, renditions[1].Height)
+	}
+}
+
+func TestProcessImage_StripsExifAndAppliesOrientation(t *testing.T) {
+	content := withExif(encodeJPEG(t, 400, 200), 6)
+
+	if got := exifOrientation(content); got != 6 {
+		t.Fatalf("exifOrientation() = %d, want 6", got)
+	}
+
+	renditions, err := ProcessImage(content, ProfilePhotoVariants)
+	if err != nil {
+		t.Fatalf("ProcessImage() unexpected error = %v", err)
+	}
+
+	large := renditions[0]
+	if large.Width != 200 || large.Height != 400 {
+		t.Errorf("rotated rendition = %dx%d, want 200x400", large.Width, large.Height)
+	}
+
+	for _, rendition := range renditions {
+		if bytes.Contains(rendition.Content, []byte("Exif")) || bytes.Contains(rendition.Content, []byte("GPS-SECRET")) {
+			t.Errorf("%s rendition still carries EXIF metadata", rendition.Variant)
+		}
+	}
+}
+
+func TestProcessImage_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+		wantErr error
+	}{
+		{name: "empty", content: nil, wantErr: ErrInvalidImage},
+		{name: "too large", content: make([]byte, MaxImageSize+1), wantErr: ErrImageTooLarge},
+		{name: "not an image", content: []byte("%PDF-1.7"), wantErr: ErrUnsupportedImageType},
+		{name: "truncated jpeg", content: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}, wantErr: ErrInvalidImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProcessImage(tt.content, ProfilePhotoVariants)
+			if err != tt.wantErr {
+				t.Errorf("ProcessImage() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrObjectNotFound = errors.New("stored object not found")
+	ErrInvalidKey     = errors.New("invalid storage key")
+)
+
+// Storage keeps uploaded files under slash-separated keys such as
+// "therapists/<user-id>/<photo-id>/thumbnail.jpg". The contract mirrors an
+// S3-compatible object store: Put overwrites, Delete of a missing key is not
+// an error, and Get reports ErrObjectNotFound.
+type Storage interface {
+	Put(ctx context.Context, key string, object *Object) error
+	Get(ctx context.Context, key string) (*Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Object struct {
+	Content     []byte
+	ContentType string
+	ModifiedAt  time.Time
+}
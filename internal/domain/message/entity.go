@@ -0,0 +1,248 @@
+package message
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	MaxBodyLength = 5000
+
+	// MaxAttachments and MaxAttachmentSize cap what a single message can carry
+	MaxAttachments    = 3
+	MaxAttachmentSize = 10 << 20
+
+	// Messages are deleted once they are older than the conversation's
+	// retention period. Therapists can choose a period between the bounds.
+	DefaultRetentionDays = 365
+	MinRetentionDays     = 30
+	MaxRetentionDays     = 3650
+
+	maxFileNameLength = 255
+)
+
+var allowedAttachmentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+// Conversation is the private channel between a client and a therapist. There
+// is one per pair; it stays readable after the relationship ends, but only an
+// active relationship can add to it.
+type Conversation struct {
+	ID            string
+	ClientID      string
+	TherapistID   string
+	RetentionDays int
+	LastMessageAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ConversationSummary is a conversation as listed for one of its participants
+type ConversationSummary struct {
+	Conversation *Conversation
+	UnreadCount  int
+	// Active is set while the client is still assigned to the therapist
+	Active bool
+}
+
+// Message is one post in a conversation. Replies form threads: ThreadID is the
+// first message of the thread, and replies to replies join the same thread.
+type Message struct {
+	ID             string
+	ConversationID string
+	SenderID       string
+	ThreadID       string
+	Body           string
+	Attachments    []*Attachment
+	// ReplyCount is filled in when listing the first messages of threads
+	ReplyCount int
+	// ReadAt is the read receipt: when the other participant first read it
+	ReadAt    *time.Time
+	CreatedAt time.Time
+
+	// RiskFlagged is set when a client's message suggested acute risk, so
+	// they can be shown crisis resources. It is not stored.
+	RiskFlagged bool
+}
+
+// Attachment is a file sent with a message. Content is only loaded for download.
+type Attachment struct {
+	ID          string
+	MessageID   string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Content     []byte
+	CreatedAt   time.Time
+}
+
+// AttachmentUpload is a file as received from the sender
+type AttachmentUpload struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+func NewConversation(clientID, therapistID string, now time.Time) (*Conversation, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if clientID == therapistID {
+		return nil, errors.New("a conversation needs two different participants")
+	}
+
+	return &Conversation{
+		ID:            generateID(),
+		ClientID:      clientID,
+		TherapistID:   therapistID,
+		RetentionDays: DefaultRetentionDays,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (c *Conversation) HasParticipant(userID string) bool {
+	return userID != "" && (c.ClientID == userID || c.TherapistID == userID)
+}
+
+// OtherParticipant is the participant userID is talking to
+func (c *Conversation) OtherParticipant(userID string) string {
+	if userID == c.ClientID {
+		return c.TherapistID
+	}
+	return c.ClientID
+}
+
+// SetRetention changes how many days messages are kept. It applies to
+// messages already sent as well as new ones.
+func (c *Conversation) SetRetention(days int, now time.Time) error {
+	if days < MinRetentionDays || days > MaxRetentionDays {
+		return fmt.Errorf("retention must be between %d and %d days", MinRetentionDays, MaxRetentionDays)
+	}
+
+	c.RetentionDays = days
+	c.UpdatedAt = now
+	return nil
+}
+
+// LastActivityAt orders conversation listings: the last message, or when the
+// conversation was started if nothing was sent yet
+func (c *Conversation) LastActivityAt() time.Time {
+	if c.LastMessageAt != nil {
+		return *c.LastMessageAt
+	}
+	return c.CreatedAt
+}
+
+// NewMessage writes a message from one of the conversation's participants.
+// replyTo is the message being answered, or nil to start a new thread. A
+// message needs text, attachments or both.
+func NewMessage(conversation *Conversation, senderID string, replyTo *Message, body string, uploads []AttachmentUpload, now time.Time) (*Message, error) {
+	if !conversation.HasParticipant(senderID) {
+		return nil, errors.New("sender is not part of the conversation")
+	}
+
+	body = strings.TrimSpace(body)
+	if len(body) > MaxBodyLength {
+		return nil, fmt.Errorf("message must be %d characters or less", MaxBodyLength)
+	}
+
+	if len(uploads) > MaxAttachments {
+		return nil, fmt.Errorf("at most %d attachments per message", MaxAttachments)
+	}
+
+	if body == "" && len(uploads) == 0 {
+		return nil, errors.New("message needs text or an attachment")
+	}
+
+	message := &Message{
+		ID:             generateID(),
+		ConversationID: conversation.ID,
+		SenderID:       senderID,
+		Body:           body,
+		CreatedAt:      now,
+	}
+
+	if replyTo != nil {
+		if replyTo.ConversationID != conversation.ID {
+			return nil, errors.New("replies must stay in the same conversation")
+		}
+		message.ThreadID = replyTo.ThreadRootID()
+	}
+
+	for _, upload := range uploads {
+		attachment, err := newAttachment(message.ID, upload, now)
+		if err != nil {
+			return nil, err
+		}
+		message.Attachments = append(message.Attachments, attachment)
+	}
+
+	return message, nil
+}
+
+// ThreadRootID is the ID of the first message of the thread this message is in
+func (m *Message) ThreadRootID() string {
+	if m.ThreadID != "" {
+		return m.ThreadID
+	}
+	return m.ID
+}
+
+func (m *Message) IsRead() bool {
+	return m.ReadAt != nil
+}
+
+func newAttachment(messageID string, upload AttachmentUpload, now time.Time) (*Attachment, error) {
+	fileName := filepath.Base(strings.TrimSpace(upload.FileName))
+	if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+		return nil, errors.New("attachment file name is required")
+	}
+
+	if len(fileName) > maxFileNameLength {
+		return nil, fmt.Errorf("attachment file name must be %d characters or less", maxFileNameLength)
+	}
+
+	if len(upload.Content) == 0 {
+		return nil, fmt.Errorf("attachment %s is empty", fileName)
+	}
+
+	if len(upload.Content) > MaxAttachmentSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	if !allowedAttachmentContentTypes[upload.ContentType] {
+		return nil, ErrUnsupportedAttachmentType
+	}
+
+	return &Attachment{
+		ID:          generateID(),
+		MessageID:   messageID,
+		FileName:    fileName,
+		ContentType: upload.ContentType,
+		SizeBytes:   int64(len(upload.Content)),
+		Content:     upload.Content,
+		CreatedAt:   now,
+	}, nil
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
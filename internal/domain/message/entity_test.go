@@ -0,0 +1,160 @@
+package message
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewConversation(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	conversation, err := NewConversation("client-123", "therapist-123", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if conversation.RetentionDays != DefaultRetentionDays {
+		t.Errorf("Expected the default retention, got %d", conversation.RetentionDays)
+	}
+	if !conversation.HasParticipant("client-123") || !conversation.HasParticipant("therapist-123") || conversation.HasParticipant("other-123") {
+		t.Error("Expected only the client and therapist to be participants")
+	}
+	if conversation.OtherParticipant("client-123") != "therapist-123" || conversation.OtherParticipant("therapist-123") != "client-123" {
+		t.Error("Expected each participant to be talking to the other")
+	}
+	if !conversation.LastActivityAt().Equal(now) {
+		t.Errorf("Expected a new conversation to be active from its start, got %v", conversation.LastActivityAt())
+	}
+
+	if _, err := NewConversation("", "therapist-123", now); err == nil {
+		t.Error("Expected an error without a client")
+	}
+	if _, err := NewConversation("user-123", "user-123", now); err == nil {
+		t.Error("Expected an error for a conversation with oneself")
+	}
+}
+
+func TestConversation_SetRetention(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	conversation, err := NewConversation("client-123", "therapist-123", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, days := range []int{MinRetentionDays - 1, MaxRetentionDays + 1} {
+		if err := conversation.SetRetention(days, now); err == nil {
+			t.Errorf("Expected an error for %d days", days)
+		}
+	}
+
+	later := now.Add(time.Hour)
+	if err := conversation.SetRetention(90, later); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if conversation.RetentionDays != 90 || !conversation.UpdatedAt.Equal(later) {
+		t.Errorf("Expected the retention to be updated, got %+v", conversation)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	conversation, err := NewConversation("client-123", "therapist-123", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pdf := AttachmentUpload{FileName: "../worksheet.pdf", ContentType: "application/pdf", Content: []byte("%PDF-1.4")}
+
+	tests := []struct {
+		name     string
+		senderID string
+		body     string
+		uploads  []AttachmentUpload
+		wantErr  error
+	}{
+		{name: "text", senderID: "client-123", body: "  See you on Tuesday  "},
+		{name: "attachment only", senderID: "therapist-123", uploads: []AttachmentUpload{pdf}},
+		{name: "outsider", senderID: "other-123", body: "Hello"},
+		{name: "empty", senderID: "client-123", body: "   "},
+		{name: "too long", senderID: "client-123", body: strings.Repeat("a", MaxBodyLength+1)},
+		{name: "too many attachments", senderID: "client-123", uploads: []AttachmentUpload{pdf, pdf, pdf, pdf}},
+		{
+			name:     "unsupported type",
+			senderID: "client-123",
+			uploads:  []AttachmentUpload{{FileName: "notes.txt", ContentType: "text/plain", Content: []byte("notes")}},
+			wantErr:  ErrUnsupportedAttachmentType,
+		},
+		{
+			name:     "oversized attachment",
+			senderID: "client-123",
+			uploads:  []AttachmentUpload{{FileName: "scan.png", ContentType: "image/png", Content: make([]byte, MaxAttachmentSize+1)}},
+			wantErr:  ErrAttachmentTooLarge,
+		},
+	}
+
+	valid := map[string]bool{"text": true, "attachment only": true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := NewMessage(conversation, tt.senderID, nil, tt.body, tt.uploads, now)
+
+			if !valid[tt.name] {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if message.Body != strings.TrimSpace(tt.body) || message.ThreadID != "" || message.IsRead() {
+				t.Errorf("Unexpected message %+v", message)
+			}
+			for _, attachment := range message.Attachments {
+				if attachment.FileName != "worksheet.pdf" || attachment.MessageID != message.ID || attachment.SizeBytes != int64(len(pdf.Content)) {
+					t.Errorf("Unexpected attachment %+v", attachment)
+				}
+			}
+		})
+	}
+}
+
+func TestNewMessage_Threads(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	conversation, err := NewConversation("client-123", "therapist-123", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	first, err := NewMessage(conversation, "client-123", nil, "Can we move Tuesday?", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	reply, err := NewMessage(conversation, "therapist-123", first, "Wednesday works", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reply.ThreadID != first.ID {
+		t.Errorf("Expected the reply to join the thread %s, got %q", first.ID, reply.ThreadID)
+	}
+
+	nested, err := NewMessage(conversation, "client-123", reply, "Thanks", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if nested.ThreadID != first.ID {
+		t.Errorf("Expected a reply to a reply to stay in thread %s, got %q", first.ID, nested.ThreadID)
+	}
+
+	other, err := NewConversation("client-456", "therapist-123", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := NewMessage(other, "therapist-123", first, "Wrong place", nil, now); err == nil {
+		t.Error("Expected an error for a reply in another conversation")
+	}
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrConversationExists   = errors.New("conversation already exists")
+	ErrMessageNotFound      = errors.New("message not found")
+	ErrAttachmentNotFound   = errors.New("attachment not found")
+)
+
+type Repository interface {
+	CreateConversation(ctx context.Context, conversation *Conversation) error
+	GetConversationByID(ctx context.Context, id string) (*Conversation, error)
+	GetConversationByParticipants(ctx context.Context, clientID, therapistID string) (*Conversation, error)
+	UpdateConversation(ctx context.Context, conversation *Conversation) error
+	// ListConversations pages through a user's conversations, most recently active first
+	ListConversations(ctx context.Context, userID string, page pagination.PageRequest) (*pagination.Page[*ConversationSummary], error)
+
+	// CreateMessage stores the message with its attachments and moves the
+	// conversation's last activity forward
+	CreateMessage(ctx context.Context, message *Message) error
+	// GetMessageByID loads a message with its attachments' details but not their content
+	GetMessageByID(ctx context.Context, id string) (*Message, error)
+	// ListMessages pages through a conversation, newest first. An empty threadID
+	// lists the first message of each thread with its reply count; otherwise
+	// the replies in that thread.
+	ListMessages(ctx context.Context, conversationID, threadID string, page pagination.PageRequest) (*pagination.Page[*Message], error)
+	// GetAttachment loads an attachment including its content
+	GetAttachment(ctx context.Context, id string) (*Attachment, error)
+	// MarkRead sets the read receipt on every unread message the other
+	// participant sent, and returns how many were marked
+	MarkRead(ctx context.Context, conversationID, readerID string, now time.Time) (int64, error)
+	// DeleteExpired removes messages older than their conversation's retention
+	// period. The first message of a thread is kept until its last reply expires.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
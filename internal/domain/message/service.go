@@ -0,0 +1,57 @@
+package message
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrMessageServiceUnavailable = errors.New("message service unavailable")
+	ErrUnauthorizedAccess        = errors.New("unauthorized access to messages")
+	ErrInvalidMessageData        = errors.New("invalid message data")
+	ErrNoActiveRelationship      = errors.New("messaging requires an active client-therapist relationship")
+	ErrAttachmentTooLarge        = errors.New("attachment exceeds the maximum size")
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment type")
+)
+
+// Service lets a client and their assigned therapist message each other.
+// Either participant can read a conversation; sending needs the relationship
+// to still be active.
+type Service interface {
+	// StartConversation opens the conversation with the other participant, or
+	// returns the one the two already have
+	StartConversation(ctx context.Context, userID, participantID string) (*Conversation, error)
+	ListConversations(ctx context.Context, userID string, page pagination.PageRequest) (*pagination.Page[*ConversationSummary], error)
+	SendMessage(ctx context.Context, senderID string, req SendMessageRequest) (*Message, error)
+	GetMessages(ctx context.Context, userID string, query MessagesQuery) (*pagination.Page[*Message], error)
+	// MarkConversationRead sets read receipts on everything the other participant sent
+	MarkConversationRead(ctx context.Context, userID, conversationID string) (int64, error)
+	GetAttachment(ctx context.Context, userID, attachmentID string) (*Attachment, error)
+
+	// Therapists choose how long a conversation's messages are kept
+	SetRetention(ctx context.Context, therapistUserID, conversationID string, days int) (*Conversation, error)
+	// PurgeExpiredMessages deletes messages past their retention period
+	PurgeExpiredMessages(ctx context.Context) (int64, error)
+}
+
+type SendMessageRequest struct {
+	ConversationID string
+	// ReplyToID answers a message in the conversation; empty starts a new thread
+	ReplyToID   string
+	Body        string
+	Attachments []AttachmentUpload
+}
+
+type MessagesQuery struct {
+	ConversationID string
+	ThreadID       string
+	Page           pagination.PageRequest
+}
+
+// RiskScreener looks for signs of acute risk in what a client sends and
+// alerts their therapist. It reports whether the message was flagged.
+type RiskScreener interface {
+	ScreenMessage(ctx context.Context, clientUserID string, message *Message) (bool, error)
+}
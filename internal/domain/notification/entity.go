@@ -0,0 +1,294 @@
+package notification
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	// Quiet hours are kept in the user's time zone, which must load on
+	// images without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/goran/thappy/internal/domain/language"
+)
+
+// DefaultLanguage is used when the user has no language or there are no
+// templates in it
+const DefaultLanguage = "en"
+
+// MaxEmailAttempts is how often sending an email is tried before giving up
+const MaxEmailAttempts = 5
+
+// Content is a notification rendered in the recipient's language. Subject and
+// Summary make up the in-app notification; emails use Subject, Text and HTML.
+type Content struct {
+	Subject string
+	Summary string
+	Text    string
+	HTML    string
+}
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	ID        string
+	UserID    string
+	Type      string
+	Title     string
+	Body      string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+type EmailStatus string
+
+const (
+	EmailPending EmailStatus = "pending"
+	EmailSending EmailStatus = "sending"
+	EmailSent    EmailStatus = "sent"
+	EmailFailed  EmailStatus = "failed"
+)
+
+// Email is a rendered email waiting in the outbox. It is sent once SendAfter
+// has passed, which quiet hours push back, and retried with backoff on failure.
+type Email struct {
+	ID        string
+	UserID    string
+	Type      string
+	To        string
+	Subject   string
+	TextBody  string
+	HTMLBody  string
+	Status    EmailStatus
+	Attempts  int
+	SendAfter time.Time
+	LastError string
+	SentAt    *time.Time
+	// ClaimedUntil is when the claim of the instance sending the email expires
+	ClaimedUntil *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Preferences are a user's choices about how they are notified. Users who
+// never saved any get DefaultPreferences.
+type Preferences struct {
+	UserID       string
+	EmailEnabled bool
+	InAppEnabled bool
+	// Language overrides the language notifications are written in
+	Language   *string
+	QuietHours *QuietHours
+	UpdatedAt  time.Time
+}
+
+// QuietHours is a daily period in the user's time zone during which no email
+// is sent. Start and End are minutes after midnight; a period that ends
+// earlier than it starts runs past midnight.
+type QuietHours struct {
+	Start    int
+	End      int
+	TimeZone string
+}
+
+func NewNotification(userID, eventType string, content *Content, now time.Time) (*Notification, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	if strings.TrimSpace(content.Subject) == "" {
+		return nil, errors.New("notification title is required")
+	}
+
+	return &Notification{
+		ID:        generateID(),
+		UserID:    userID,
+		Type:      eventType,
+		Title:     content.Subject,
+		Body:      content.Summary,
+		CreatedAt: now,
+	}, nil
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func NewEmail(userID, eventType, to string, content *Content, sendAfter, now time.Time) (*Email, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	if strings.TrimSpace(to) == "" {
+		return nil, errors.New("recipient address is required")
+	}
+
+	if strings.TrimSpace(content.Subject) == "" || strings.TrimSpace(content.Text) == "" {
+		return nil, errors.New("email needs a subject and a text body")
+	}
+
+	return &Email{
+		ID:        generateID(),
+		UserID:    userID,
+		Type:      eventType,
+		To:        to,
+		Subject:   content.Subject,
+		TextBody:  content.Text,
+		HTMLBody:  content.HTML,
+		Status:    EmailPending,
+		SendAfter: sendAfter,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (e *Email) MarkSent(now time.Time) {
+	e.Status = EmailSent
+	e.Attempts++
+	e.LastError = ""
+	e.SentAt = &now
+	e.UpdatedAt = now
+}
+
+// MarkFailed records a failed attempt. The email is retried after a growing
+// delay until MaxEmailAttempts is reached.
+func (e *Email) MarkFailed(cause error, now time.Time) {
+	e.Attempts++
+	e.LastError = cause.Error()
+	e.UpdatedAt = now
+
+	if e.Attempts >= MaxEmailAttempts {
+		e.Status = EmailFailed
+		return
+	}
+
+	e.Status = EmailPending
+	e.SendAfter = now.Add(time.Duration(e.Attempts*e.Attempts) * time.Minute)
+}
+
+func DefaultPreferences(userID string) *Preferences {
+	return &Preferences{
+		UserID:       userID,
+		EmailEnabled: true,
+		InAppEnabled: true,
+	}
+}
+
+// Update replaces the preferences. language may be nil to follow the profile,
+// quietHours nil to allow email at any time.
+func (p *Preferences) Update(emailEnabled, inAppEnabled bool, lang *string, quietHours *QuietHours, now time.Time) error {
+	if lang != nil {
+		code := language.Normalize(*lang)
+		if !language.IsValid(code) {
+			return fmt.Errorf("unsupported language: %s", *lang)
+		}
+		lang = &code
+	}
+
+	p.EmailEnabled = emailEnabled
+	p.InAppEnabled = inAppEnabled
+	p.Language = lang
+	p.QuietHours = quietHours
+	p.UpdatedAt = now
+	return nil
+}
+
+// EmailSendTime is when an email created at now may be sent: straight away,
+// or once the user's quiet hours are over
+func (p *Preferences) EmailSendTime(now time.Time) time.Time {
+	if p.QuietHours == nil || !p.QuietHours.Contains(now) {
+		return now
+	}
+	return p.QuietHours.EndAfter(now)
+}
+
+// ParseQuietHours reads a period given as "HH:MM" times in an IANA time zone
+func ParseQuietHours(start, end, timeZone string) (*QuietHours, error) {
+	startMinute, err := parseClock(start)
+	if err != nil {
+		return nil, err
+	}
+
+	endMinute, err := parseClock(end)
+	if err != nil {
+		return nil, err
+	}
+
+	if startMinute == endMinute {
+		return nil, errors.New("quiet hours must start and end at different times")
+	}
+
+	if strings.TrimSpace(timeZone) == "" {
+		return nil, errors.New("quiet hours time zone is required")
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, fmt.Errorf("unknown time zone: %s", timeZone)
+	}
+
+	return &QuietHours{
+		Start:    startMinute,
+		End:      endMinute,
+		TimeZone: timeZone,
+	}, nil
+}
+
+// Contains reports whether t falls within the quiet hours
+func (q *QuietHours) Contains(t time.Time) bool {
+	local := t.In(q.location())
+	minute := local.Hour()*60 + local.Minute()
+
+	if q.Start < q.End {
+		return minute >= q.Start && minute < q.End
+	}
+	return minute >= q.Start || minute < q.End
+}
+
+// EndAfter is the first end of the quiet hours after t
+func (q *QuietHours) EndAfter(t time.Time) time.Time {
+	local := t.In(q.location())
+	end := time.Date(local.Year(), local.Month(), local.Day(), q.End/60, q.End%60, 0, 0, local.Location())
+	if !end.After(local) {
+		end = time.Date(local.Year(), local.Month(), local.Day()+1, q.End/60, q.End%60, 0, 0, local.Location())
+	}
+	return end
+}
+
+func (q *QuietHours) StartClock() string {
+	return formatClock(q.Start)
+}
+
+func (q *QuietHours) EndClock() string {
+	return formatClock(q.End)
+}
+
+// location falls back to UTC for a zone that is no longer known; zones are
+// checked when the quiet hours are set
+func (q *QuietHours) location() *time.Location {
+	location, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func parseClock(value string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
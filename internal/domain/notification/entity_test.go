@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewNotification(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	content := &Content{Subject: "New message", Summary: "You have a new message."}
+
+	notification, err := NewNotification("user-123", "message.created", content, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if notification.Title != "New message" || notification.Body != "You have a new message." || notification.IsRead() {
+		t.Errorf("Unexpected notification %+v", notification)
+	}
+
+	if _, err := NewNotification("", "message.created", content, now); err == nil {
+		t.Error("Expected an error without a user")
+	}
+	if _, err := NewNotification("user-123", "message.created", &Content{}, now); err == nil {
+		t.Error("Expected an error without a title")
+	}
+}
+
+func TestEmail_Delivery(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	content := &Content{Subject: "New message", Text: "You have a new message.", HTML: "<p>You have a new message.</p>"}
+
+	if _, err := NewEmail("user-123", "message.created", "", content, now, now); err == nil {
+		t.Error("Expected an error without a recipient")
+	}
+	if _, err := NewEmail("user-123", "message.created", "user@example.com", &Content{Subject: "New message"}, now, now); err == nil {
+		t.Error("Expected an error without a text body")
+	}
+
+	email, err := NewEmail("user-123", "message.created", "user@example.com", content, now, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if email.Status != EmailPending || !email.SendAfter.Equal(now) {
+		t.Errorf("Expected a pending email due now, got %+v", email)
+	}
+
+	email.MarkFailed(errors.New("connection refused"), now)
+	if email.Status != EmailPending || email.Attempts != 1 || !email.SendAfter.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected a retry after a minute, got %+v", email)
+	}
+
+	email.MarkFailed(errors.New("connection refused"), now)
+	if !email.SendAfter.Equal(now.Add(4 * time.Minute)) {
+		t.Errorf("Expected the retry delay to grow, got %v", email.SendAfter)
+	}
+
+	for email.Attempts < MaxEmailAttempts {
+		email.MarkFailed(errors.New("connection refused"), now)
+	}
+	if email.Status != EmailFailed || email.LastError != "connection refused" {
+		t.Errorf("Expected the email to fail after %d attempts, got %+v", MaxEmailAttempts, email)
+	}
+
+	retried, err := NewEmail("user-123", "message.created", "user@example.com", content, now, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	retried.MarkFailed(errors.New("timeout"), now)
+	retried.MarkSent(now.Add(time.Minute))
+	if retried.Status != EmailSent || retried.SentAt == nil || retried.LastError != "" || retried.Attempts != 2 {
+		t.Errorf("Expected the email to be sent, got %+v", retried)
+	}
+}
+
+func TestParseQuietHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		timeZone string
+		wantErr  bool
+	}{
+		{name: "overnight", start: "22:00", end: "07:30", timeZone: "Europe/Zagreb"},
+		{name: "daytime", start: "09:00", end: "17:00", timeZone: "UTC"},
+		{name: "invalid time", start: "25:00", end: "07:00", timeZone: "UTC", wantErr: true},
+		{name: "empty period", start: "22:00", end: "22:00", timeZone: "UTC", wantErr: true},
+		{name: "missing time zone", start: "22:00", end: "07:00", wantErr: true},
+		{name: "unknown time zone", start: "22:00", end: "07:00", timeZone: "Mars/Olympus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quietHours, err := ParseQuietHours(tt.start, tt.end, tt.timeZone)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if quietHours.StartClock() != tt.start || quietHours.EndClock() != tt.end {
+				t.Errorf("Expected %s-%s, got %s-%s", tt.start, tt.end, quietHours.StartClock(), quietHours.EndClock())
+			}
+		})
+	}
+}
+
+func TestPreferences_EmailSendTime(t *testing.T) {
+	zagreb, err := time.LoadLocation("Europe/Zagreb")
+	if err != nil {
+		t.Skipf("Time zone data unavailable: %v", err)
+	}
+
+	quietHours, err := ParseQuietHours("22:00", "07:00", "Europe/Zagreb")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	preferences := DefaultPreferences("user-123")
+	if err := preferences.Update(true, true, nil, quietHours, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "daytime",
+			now:  time.Date(2026, 4, 1, 12, 0, 0, 0, zagreb),
+			want: time.Date(2026, 4, 1, 12, 0, 0, 0, zagreb),
+		},
+		{
+			name: "before midnight",
+			now:  time.Date(2026, 4, 1, 23, 15, 0, 0, zagreb),
+			want: time.Date(2026, 4, 2, 7, 0, 0, 0, zagreb),
+		},
+		{
+			name: "after midnight",
+			now:  time.Date(2026, 4, 2, 3, 0, 0, 0, zagreb),
+			want: time.Date(2026, 4, 2, 7, 0, 0, 0, zagreb),
+		},
+		{
+			name: "quiet hours end",
+			now:  time.Date(2026, 4, 2, 7, 0, 0, 0, zagreb),
+			want: time.Date(2026, 4, 2, 7, 0, 0, 0, zagreb),
+		},
+		{
+			name: "checked in another zone",
+			now:  time.Date(2026, 4, 1, 21, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 4, 2, 7, 0, 0, 0, zagreb),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := preferences.EmailSendTime(tt.now); !got.Equal(tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	preferences.QuietHours = nil
+	now := time.Date(2026, 4, 1, 23, 15, 0, 0, zagreb)
+	if got := preferences.EmailSendTime(now); !got.Equal(now) {
+		t.Errorf("Expected email straight away without quiet hours, got %v", got)
+	}
+}
+
+func TestPreferences_Update(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	preferences := DefaultPreferences("user-123")
+	if !preferences.EmailEnabled || !preferences.InAppEnabled {
+		t.Fatal("Expected every channel to be on by default")
+	}
+
+	unknown := "xx"
+	if err := preferences.Update(false, true, &unknown, nil, now); err == nil {
+		t.Error("Expected an error for an unknown language")
+	}
+	if !preferences.EmailEnabled {
+		t.Error("Expected a rejected update to leave the preferences unchanged")
+	}
+
+	croatian := " HR "
+	if err := preferences.Update(false, true, &croatian, nil, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if preferences.EmailEnabled || preferences.Language == nil || *preferences.Language != "hr" || !preferences.UpdatedAt.Equal(now) {
+		t.Errorf("Unexpected preferences %+v", preferences)
+	}
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrPreferencesNotFound  = errors.New("notification preferences not found")
+	ErrEmailClaimLost       = errors.New("email is no longer claimed by this sender")
+)
+
+type Repository interface {
+	CreateNotification(ctx context.Context, notification *Notification) error
+	// ListNotifications pages through a user's inbox, newest first
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, page pagination.PageRequest) (*pagination.Page[*Notification], error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	// MarkRead marks one of the user's notifications read. Reading it again
+	// keeps the first read time.
+	MarkRead(ctx context.Context, userID, notificationID string, now time.Time) error
+	// MarkAllRead marks the user's unread notifications read and returns how many there were
+	MarkAllRead(ctx context.Context, userID string, now time.Time) (int64, error)
+
+	GetPreferences(ctx context.Context, userID string) (*Preferences, error)
+	// SavePreferences creates or replaces the user's preferences
+	SavePreferences(ctx context.Context, preferences *Preferences) error
+
+	CreateEmail(ctx context.Context, email *Email) error
+	// ClaimDueEmails marks up to limit emails that are due as sending and
+	// returns them. Claims expire after lease, so emails held by an instance
+	// that stopped are picked up again. Instances never claim the same email.
+	ClaimDueEmails(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*Email, error)
+	// UpdateEmail records the outcome of a claimed send. It returns
+	// ErrEmailClaimLost without writing when the claim expired and another
+	// instance took the email over.
+	UpdateEmail(ctx context.Context, email *Email) error
+}
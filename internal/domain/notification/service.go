@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrNotificationServiceUnavailable = errors.New("notification service unavailable")
+	ErrUnauthorizedAccess             = errors.New("unauthorized access to notifications")
+	ErrInvalidPreferences             = errors.New("invalid notification preferences")
+	ErrNoTemplate                     = errors.New("no notification template for event")
+)
+
+// Service turns events into in-app notifications and emails, following each
+// user's preferences
+type Service interface {
+	// Notify notifies the user of an event. Events without a template are ignored.
+	Notify(ctx context.Context, userID, eventType string, data map[string]interface{}) error
+
+	GetNotifications(ctx context.Context, userID string, query InboxQuery) (*pagination.Page[*Notification], error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	MarkRead(ctx context.Context, userID, notificationID string) error
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+
+	GetPreferences(ctx context.Context, userID string) (*Preferences, error)
+	UpdatePreferences(ctx context.Context, userID string, req UpdatePreferencesRequest) (*Preferences, error)
+
+	// DeliverPendingEmails sends the emails that are due and returns how many were sent
+	DeliverPendingEmails(ctx context.Context) (int, error)
+}
+
+// Renderer writes the notification for an event in a language, falling back
+// to DefaultLanguage. It returns ErrNoTemplate for events nobody is notified of.
+type Renderer interface {
+	Render(eventType, language string, data map[string]interface{}) (*Content, error)
+}
+
+// Sender delivers an email
+type Sender interface {
+	Send(ctx context.Context, email *Email) error
+}
+
+type InboxQuery struct {
+	UnreadOnly bool
+	Page       pagination.PageRequest
+}
+
+type UpdatePreferencesRequest struct {
+	EmailEnabled bool
+	InAppEnabled bool
+	Language     *string
+	// QuietHours nil turns quiet hours off
+	QuietHours *QuietHoursRequest
+}
+
+// QuietHoursRequest gives quiet hours as "HH:MM" times in an IANA time zone
+type QuietHoursRequest struct {
+	Start    string
+	End      string
+	TimeZone string
+}
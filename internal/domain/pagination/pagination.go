@@ -0,0 +1,34 @@
+package pagination
+
+import "errors"
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// PageRequest asks for up to Limit items following the position encoded in Cursor.
+// An empty Cursor requests the first page.
+type PageRequest struct {
+	Cursor string
+	Limit  int
+}
+
+// Normalize applies the default page size and caps oversized requests
+func (p PageRequest) Normalize() PageRequest {
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+	return p
+}
+
+// Page is one slice of a list. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+}
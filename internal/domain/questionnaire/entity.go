@@ -0,0 +1,301 @@
+package questionnaire
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type QuestionType string
+
+const (
+	TypeText         QuestionType = "text"
+	TypeSingleChoice QuestionType = "single_choice"
+	TypeMultiChoice  QuestionType = "multi_choice"
+	TypeScale        QuestionType = "scale"
+	TypeDate         QuestionType = "date"
+)
+
+const (
+	// DateLayout is the format of date answers
+	DateLayout = "2006-01-02"
+
+	maxTitleLength       = 200
+	maxDescriptionLength = 2000
+	maxQuestions         = 100
+	maxQuestionIDLength  = 50
+	maxPromptLength      = 500
+	maxHelpTextLength    = 1000
+	maxOptions           = 50
+	maxOptionLength      = 200
+	maxScaleLabelLength  = 100
+	maxScaleSteps        = 100
+)
+
+// Questionnaire is an intake form a therapist asks their clients to fill in.
+// Changing the questions creates a new version; responses keep pointing at the
+// version they answered so earlier submissions stay readable.
+type Questionnaire struct {
+	ID          string
+	TherapistID string
+	Title       string
+	Description string
+	Version     int
+	Questions   []Question
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Question is one field of a questionnaire. IDs are chosen by the therapist
+// (or generated) and stay stable across versions so conditions can refer to them.
+type Question struct {
+	ID       string       `json:"id"`
+	Type     QuestionType `json:"type"`
+	Prompt   string       `json:"prompt"`
+	HelpText string       `json:"help_text,omitempty"`
+	Required bool         `json:"required"`
+	Options  []string     `json:"options,omitempty"`
+	Scale    *ScaleRange  `json:"scale,omitempty"`
+	ShowIf   *Condition   `json:"show_if,omitempty"`
+}
+
+// ScaleRange bounds a scale question, e.g. 0-10 with labels for both ends
+type ScaleRange struct {
+	Min      int    `json:"min"`
+	Max      int    `json:"max"`
+	MinLabel string `json:"min_label,omitempty"`
+	MaxLabel string `json:"max_label,omitempty"`
+}
+
+// Condition shows a question only when an earlier choice question was answered
+// with at least one of the listed options
+type Condition struct {
+	QuestionID string   `json:"question_id"`
+	AnyOf      []string `json:"any_of"`
+}
+
+func NewQuestionnaire(therapistID, title, description string, questions []Question) (*Questionnaire, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	q := &Questionnaire{
+		ID:          generateID(),
+		TherapistID: therapistID,
+		IsActive:    true,
+	}
+
+	now := time.Now()
+	if err := q.Revise(title, description, questions, now); err != nil {
+		return nil, err
+	}
+
+	q.CreatedAt = now
+	return q, nil
+}
+
+// Revise replaces the form's content and starts a new version
+func (q *Questionnaire) Revise(title, description string, questions []Question, now time.Time) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.New("title is required")
+	}
+
+	if len(title) > maxTitleLength {
+		return errors.New("title must be 200 characters or less")
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > maxDescriptionLength {
+		return errors.New("description must be 2000 characters or less")
+	}
+
+	normalized, err := normalizeQuestions(questions)
+	if err != nil {
+		return err
+	}
+
+	q.Title = title
+	q.Description = description
+	q.Questions = normalized
+	q.Version++
+	q.UpdatedAt = now
+	return nil
+}
+
+// SetActive controls whether clients are offered the questionnaire. Inactive
+// forms keep their responses.
+func (q *Questionnaire) SetActive(active bool, now time.Time) {
+	q.IsActive = active
+	q.UpdatedAt = now
+}
+
+func (q *Questionnaire) OwnedBy(therapistID string) bool {
+	return q.TherapistID == therapistID
+}
+
+func (q *Questionnaire) question(id string) (Question, bool) {
+	for _, question := range q.Questions {
+		if question.ID == id {
+			return question, true
+		}
+	}
+	return Question{}, false
+}
+
+func normalizeQuestions(questions []Question) ([]Question, error) {
+	if len(questions) == 0 {
+		return nil, errors.New("at least one question is required")
+	}
+
+	if len(questions) > maxQuestions {
+		return nil, fmt.Errorf("a questionnaire can have at most %d questions", maxQuestions)
+	}
+
+	normalized := make([]Question, 0, len(questions))
+	seen := make(map[string]Question, len(questions))
+	for i, question := range questions {
+		question, err := normalizeQuestion(question, i+1)
+		if err != nil {
+			return nil, fmt.Errorf("question %d: %w", i+1, err)
+		}
+
+		if _, exists := seen[question.ID]; exists {
+			return nil, fmt.Errorf("question %d: duplicate question ID %q", i+1, question.ID)
+		}
+
+		// Conditions may only look back, which also rules out cycles
+		if question.ShowIf != nil {
+			source, ok := seen[question.ShowIf.QuestionID]
+			if !ok {
+				return nil, fmt.Errorf("question %d: condition must refer to an earlier question", i+1)
+			}
+
+			if source.Type != TypeSingleChoice && source.Type != TypeMultiChoice {
+				return nil, fmt.Errorf("question %d: condition must refer to a choice question", i+1)
+			}
+
+			for _, value := range question.ShowIf.AnyOf {
+				if !slices.Contains(source.Options, value) {
+					return nil, fmt.Errorf("question %d: condition value %q is not an option of %q", i+1, value, source.ID)
+				}
+			}
+		}
+
+		seen[question.ID] = question
+		normalized = append(normalized, question)
+	}
+
+	return normalized, nil
+}
+
+func normalizeQuestion(question Question, position int) (Question, error) {
+	question.ID = strings.TrimSpace(question.ID)
+	if question.ID == "" {
+		question.ID = fmt.Sprintf("q%d", position)
+	}
+
+	if len(question.ID) > maxQuestionIDLength {
+		return question, errors.New("question ID must be 50 characters or less")
+	}
+
+	question.Prompt = strings.TrimSpace(question.Prompt)
+	if question.Prompt == "" {
+		return question, errors.New("prompt is required")
+	}
+
+	if len(question.Prompt) > maxPromptLength {
+		return question, errors.New("prompt must be 500 characters or less")
+	}
+
+	question.HelpText = strings.TrimSpace(question.HelpText)
+	if len(question.HelpText) > maxHelpTextLength {
+		return question, errors.New("help text must be 1000 characters or less")
+	}
+
+	switch question.Type {
+	case TypeSingleChoice, TypeMultiChoice:
+		options, err := normalizeOptions(question.Options)
+		if err != nil {
+			return question, err
+		}
+		question.Options = options
+		question.Scale = nil
+	case TypeScale:
+		if question.Scale == nil {
+			return question, errors.New("scale questions need a range")
+		}
+		scale := *question.Scale
+		if scale.Min >= scale.Max {
+			return question, errors.New("scale minimum must be below the maximum")
+		}
+		if scale.Max-scale.Min > maxScaleSteps {
+			return question, fmt.Errorf("scale can span at most %d steps", maxScaleSteps)
+		}
+		scale.MinLabel = strings.TrimSpace(scale.MinLabel)
+		scale.MaxLabel = strings.TrimSpace(scale.MaxLabel)
+		if len(scale.MinLabel) > maxScaleLabelLength || len(scale.MaxLabel) > maxScaleLabelLength {
+			return question, errors.New("scale labels must be 100 characters or less")
+		}
+		question.Scale = &scale
+		question.Options = nil
+	case TypeText, TypeDate:
+		question.Options = nil
+		question.Scale = nil
+	default:
+		return question, fmt.Errorf("unknown question type %q", question.Type)
+	}
+
+	if question.ShowIf != nil {
+		condition := Condition{QuestionID: strings.TrimSpace(question.ShowIf.QuestionID)}
+		for _, value := range question.ShowIf.AnyOf {
+			condition.AnyOf = append(condition.AnyOf, strings.TrimSpace(value))
+		}
+		if condition.QuestionID == "" || len(condition.AnyOf) == 0 {
+			return question, errors.New("condition needs a question ID and at least one value")
+		}
+		question.ShowIf = &condition
+	}
+
+	return question, nil
+}
+
+func normalizeOptions(options []string) ([]string, error) {
+	if len(options) < 2 {
+		return nil, errors.New("choice questions need at least two options")
+	}
+
+	if len(options) > maxOptions {
+		return nil, fmt.Errorf("choice questions can have at most %d options", maxOptions)
+	}
+
+	normalized := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return nil, errors.New("options cannot be empty")
+		}
+		if len(option) > maxOptionLength {
+			return nil, errors.New("options must be 200 characters or less")
+		}
+		if slices.Contains(normalized, option) {
+			return nil, fmt.Errorf("duplicate option %q", option)
+		}
+		normalized = append(normalized, option)
+	}
+
+	return normalized, nil
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,148 @@
+package questionnaire
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func intakeQuestions() []Question {
+	return []Question{
+		{ID: "reason", Type: TypeText, Prompt: "What brings you to therapy?", Required: true},
+		{ID: "prior", Type: TypeSingleChoice, Prompt: "Have you been in therapy before?", Required: true, Options: []string{"Yes", "No"}},
+		{ID: "prior_details", Type: TypeText, Prompt: "What worked or didn't?", Required: true,
+			ShowIf: &Condition{QuestionID: "prior", AnyOf: []string{"Yes"}}},
+		{ID: "concerns", Type: TypeMultiChoice, Prompt: "Current concerns", Options: []string{"Sleep", "Anxiety", "Mood"}},
+		{ID: "stress", Type: TypeScale, Prompt: "Stress level this week", Scale: &ScaleRange{Min: 0, Max: 10}},
+		{Type: TypeDate, Prompt: "Date of last check-up"},
+	}
+}
+
+func TestNewQuestionnaire(t *testing.T) {
+	tests := []struct {
+		name      string
+		title     string
+		questions []Question
+		errString string
+	}{
+		{
+			name:      "valid questionnaire",
+			title:     "  Adult intake  ",
+			questions: intakeQuestions(),
+		},
+		{
+			name:      "missing title",
+			title:     " ",
+			questions: intakeQuestions(),
+			errString: "title is required",
+		},
+		{
+			name:      "no questions",
+			title:     "Adult intake",
+			errString: "at least one question is required",
+		},
+		{
+			name:  "unknown type",
+			title: "Adult intake",
+			questions: []Question{
+				{Type: "slider", Prompt: "How are you?"},
+			},
+			errString: `unknown question type "slider"`,
+		},
+		{
+			name:  "choice with one option",
+			title: "Adult intake",
+			questions: []Question{
+				{Type: TypeSingleChoice, Prompt: "Pick", Options: []string{"Only"}},
+			},
+			errString: "at least two options",
+		},
+		{
+			name:  "inverted scale",
+			title: "Adult intake",
+			questions: []Question{
+				{Type: TypeScale, Prompt: "Rate", Scale: &ScaleRange{Min: 5, Max: 1}},
+			},
+			errString: "scale minimum must be below the maximum",
+		},
+		{
+			name:  "duplicate IDs",
+			title: "Adult intake",
+			questions: []Question{
+				{ID: "a", Type: TypeText, Prompt: "One"},
+				{ID: "a", Type: TypeText, Prompt: "Two"},
+			},
+			errString: `duplicate question ID "a"`,
+		},
+		{
+			name:  "condition on a later question",
+			title: "Adult intake",
+			questions: []Question{
+				{ID: "a", Type: TypeText, Prompt: "One", ShowIf: &Condition{QuestionID: "b", AnyOf: []string{"Yes"}}},
+				{ID: "b", Type: TypeSingleChoice, Prompt: "Two", Options: []string{"Yes", "No"}},
+			},
+			errString: "condition must refer to an earlier question",
+		},
+		{
+			name:  "condition on a text question",
+			title: "Adult intake",
+			questions: []Question{
+				{ID: "a", Type: TypeText, Prompt: "One"},
+				{ID: "b", Type: TypeText, Prompt: "Two", ShowIf: &Condition{QuestionID: "a", AnyOf: []string{"x"}}},
+			},
+			errString: "condition must refer to a choice question",
+		},
+		{
+			name:  "condition value that is not an option",
+			title: "Adult intake",
+			questions: []Question{
+				{ID: "a", Type: TypeSingleChoice, Prompt: "One", Options: []string{"Yes", "No"}},
+				{ID: "b", Type: TypeText, Prompt: "Two", ShowIf: &Condition{QuestionID: "a", AnyOf: []string{"Maybe"}}},
+			},
+			errString: `condition value "Maybe" is not an option`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQuestionnaire("therapist-123", tt.title, "", tt.questions)
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewQuestionnaire() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewQuestionnaire() unexpected error = %v", err)
+			}
+			if q.Title != "Adult intake" || q.Version != 1 || !q.IsActive {
+				t.Errorf("NewQuestionnaire() = %q v%d active=%v, want trimmed title, version 1, active", q.Title, q.Version, q.IsActive)
+			}
+			if q.Questions[5].ID != "q6" {
+				t.Errorf("NewQuestionnaire() generated question ID = %q, want %q", q.Questions[5].ID, "q6")
+			}
+		})
+	}
+}
+
+func TestQuestionnaire_Revise(t *testing.T) {
+	q, err := NewQuestionnaire("therapist-123", "Adult intake", "", intakeQuestions())
+	if err != nil {
+		t.Fatalf("Failed to create questionnaire: %v", err)
+	}
+
+	if err := q.Revise("Adult intake", "", nil, time.Now()); err == nil {
+		t.Error("Revise() expected error without questions")
+	}
+	if q.Version != 1 || len(q.Questions) != 6 {
+		t.Errorf("failed Revise() changed the questionnaire to v%d with %d questions", q.Version, len(q.Questions))
+	}
+
+	if err := q.Revise("Adult intake (short)", "", intakeQuestions()[:2], time.Now()); err != nil {
+		t.Fatalf("Revise() unexpected error = %v", err)
+	}
+	if q.Version != 2 || len(q.Questions) != 2 {
+		t.Errorf("Revise() = v%d with %d questions, want v2 with 2", q.Version, len(q.Questions))
+	}
+}
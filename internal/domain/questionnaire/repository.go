@@ -0,0 +1,29 @@
+package questionnaire
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrQuestionnaireNotFound = errors.New("questionnaire not found")
+	ErrResponseNotFound      = errors.New("questionnaire response not found")
+)
+
+// Repository stores questionnaires with every version of their questions, and
+// the responses submitted against those versions
+type Repository interface {
+	// Create stores the questionnaire and its first version
+	Create(ctx context.Context, questionnaire *Questionnaire) error
+	// GetByID returns the current version
+	GetByID(ctx context.Context, id string) (*Questionnaire, error)
+	GetVersion(ctx context.Context, id string, version int) (*Questionnaire, error)
+	// Update saves the questionnaire, adding its version if it is new
+	Update(ctx context.Context, questionnaire *Questionnaire) error
+	GetByTherapistID(ctx context.Context, therapistID string, activeOnly bool) ([]*Questionnaire, error)
+
+	CreateResponse(ctx context.Context, response *Response) error
+	// GetResponses lists a client's responses newest first, each with the
+	// questionnaire version it answered. An empty therapistID matches any therapist.
+	GetResponses(ctx context.Context, clientID, therapistID string) ([]*AnsweredQuestionnaire, error)
+}
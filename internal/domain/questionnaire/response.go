@@ -0,0 +1,156 @@
+package questionnaire
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const maxTextAnswerLength = 5000
+
+// Answer is a client's answer to one question. Only the field matching the
+// question type is kept: Text, Choices (one for single choice), Scale or Date.
+type Answer struct {
+	QuestionID string   `json:"question_id"`
+	Text       string   `json:"text,omitempty"`
+	Choices    []string `json:"choices,omitempty"`
+	Scale      *int     `json:"scale,omitempty"`
+	Date       string   `json:"date,omitempty"`
+}
+
+func (a Answer) isEmpty() bool {
+	return strings.TrimSpace(a.Text) == "" && len(a.Choices) == 0 && a.Scale == nil && strings.TrimSpace(a.Date) == ""
+}
+
+// Response is one submission of a questionnaire by a client, tied to the
+// version of the form that was filled in
+type Response struct {
+	ID              string
+	QuestionnaireID string
+	Version         int
+	TherapistID     string
+	ClientID        string
+	Answers         []Answer
+	SubmittedAt     time.Time
+}
+
+// NewResponse checks the answers against the questionnaire. Answers to
+// questions hidden by their condition are dropped; required questions that are
+// shown must be answered.
+func NewResponse(q *Questionnaire, clientID string, answers []Answer) (*Response, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	byQuestion := make(map[string]Answer, len(answers))
+	for _, answer := range answers {
+		answer.QuestionID = strings.TrimSpace(answer.QuestionID)
+		if _, ok := q.question(answer.QuestionID); !ok {
+			return nil, fmt.Errorf("unknown question %q", answer.QuestionID)
+		}
+		if _, exists := byQuestion[answer.QuestionID]; exists {
+			return nil, fmt.Errorf("question %q answered more than once", answer.QuestionID)
+		}
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	accepted := make(map[string]Answer, len(answers))
+	validated := make([]Answer, 0, len(answers))
+	for _, question := range q.Questions {
+		if !question.shownFor(accepted) {
+			continue
+		}
+
+		answer, ok := byQuestion[question.ID]
+		if !ok || answer.isEmpty() {
+			if question.Required {
+				return nil, fmt.Errorf("question %q is required", question.ID)
+			}
+			continue
+		}
+
+		answer, err := question.validateAnswer(answer)
+		if err != nil {
+			return nil, fmt.Errorf("question %q: %w", question.ID, err)
+		}
+
+		accepted[question.ID] = answer
+		validated = append(validated, answer)
+	}
+
+	return &Response{
+		ID:              generateID(),
+		QuestionnaireID: q.ID,
+		Version:         q.Version,
+		TherapistID:     q.TherapistID,
+		ClientID:        clientID,
+		Answers:         validated,
+		SubmittedAt:     time.Now(),
+	}, nil
+}
+
+// shownFor reports whether the question applies given the answers accepted so
+// far. A question whose source question was hidden or skipped is hidden too.
+func (question Question) shownFor(accepted map[string]Answer) bool {
+	if question.ShowIf == nil {
+		return true
+	}
+
+	source, ok := accepted[question.ShowIf.QuestionID]
+	if !ok {
+		return false
+	}
+
+	for _, choice := range source.Choices {
+		if slices.Contains(question.ShowIf.AnyOf, choice) {
+			return true
+		}
+	}
+	return false
+}
+
+func (question Question) validateAnswer(answer Answer) (Answer, error) {
+	validated := Answer{QuestionID: question.ID}
+
+	switch question.Type {
+	case TypeText:
+		text := strings.TrimSpace(answer.Text)
+		if len(text) > maxTextAnswerLength {
+			return validated, errors.New("answer must be 5000 characters or less")
+		}
+		validated.Text = text
+	case TypeSingleChoice, TypeMultiChoice:
+		if question.Type == TypeSingleChoice && len(answer.Choices) != 1 {
+			return validated, errors.New("choose exactly one option")
+		}
+		for _, choice := range answer.Choices {
+			choice = strings.TrimSpace(choice)
+			if !slices.Contains(question.Options, choice) {
+				return validated, fmt.Errorf("%q is not one of the options", choice)
+			}
+			if slices.Contains(validated.Choices, choice) {
+				return validated, fmt.Errorf("%q chosen more than once", choice)
+			}
+			validated.Choices = append(validated.Choices, choice)
+		}
+	case TypeScale:
+		if answer.Scale == nil {
+			return validated, errors.New("a scale value is required")
+		}
+		if *answer.Scale < question.Scale.Min || *answer.Scale > question.Scale.Max {
+			return validated, fmt.Errorf("value must be between %d and %d", question.Scale.Min, question.Scale.Max)
+		}
+		value := *answer.Scale
+		validated.Scale = &value
+	case TypeDate:
+		date := strings.TrimSpace(answer.Date)
+		if _, err := time.Parse(DateLayout, date); err != nil {
+			return validated, errors.New("date must be YYYY-MM-DD")
+		}
+		validated.Date = date
+	}
+
+	return validated, nil
+}
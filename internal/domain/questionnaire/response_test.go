@@ -0,0 +1,139 @@
+package questionnaire
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewResponse(t *testing.T) {
+	q, err := NewQuestionnaire("therapist-123", "Adult intake", "", intakeQuestions())
+	if err != nil {
+		t.Fatalf("Failed to create questionnaire: %v", err)
+	}
+
+	scale := func(v int) *int { return &v }
+
+	tests := []struct {
+		name        string
+		answers     []Answer
+		errString   string
+		wantAnswers []string
+	}{
+		{
+			name: "required answers only",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "  Trouble sleeping  "},
+				{QuestionID: "prior", Choices: []string{"No"}},
+			},
+			wantAnswers: []string{"reason", "prior"},
+		},
+		{
+			name: "every question",
+			answers: []Answer{
+				{QuestionID: "q6", Date: "2025-01-15"},
+				{QuestionID: "reason", Text: "Stress at work"},
+				{QuestionID: "prior", Choices: []string{"Yes"}},
+				{QuestionID: "prior_details", Text: "CBT helped"},
+				{QuestionID: "concerns", Choices: []string{"Sleep", "Mood"}},
+				{QuestionID: "stress", Scale: scale(7)},
+			},
+			wantAnswers: []string{"reason", "prior", "prior_details", "concerns", "stress", "q6"},
+		},
+		{
+			name: "answers to hidden questions are dropped",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "Stress at work"},
+				{QuestionID: "prior", Choices: []string{"No"}},
+				{QuestionID: "prior_details", Text: "Not applicable"},
+			},
+			wantAnswers: []string{"reason", "prior"},
+		},
+		{
+			name: "shown conditional question is required",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "Stress at work"},
+				{QuestionID: "prior", Choices: []string{"Yes"}},
+			},
+			errString: `question "prior_details" is required`,
+		},
+		{
+			name:      "missing required answer",
+			answers:   []Answer{{QuestionID: "prior", Choices: []string{"No"}}},
+			errString: `question "reason" is required`,
+		},
+		{
+			name: "unknown question",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "x"},
+				{QuestionID: "shoe_size", Text: "42"},
+			},
+			errString: `unknown question "shoe_size"`,
+		},
+		{
+			name: "two options for a single choice",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "x"},
+				{QuestionID: "prior", Choices: []string{"Yes", "No"}},
+			},
+			errString: "choose exactly one option",
+		},
+		{
+			name: "choice that is not an option",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "x"},
+				{QuestionID: "prior", Choices: []string{"No"}},
+				{QuestionID: "concerns", Choices: []string{"Diet"}},
+			},
+			errString: `"Diet" is not one of the options`,
+		},
+		{
+			name: "scale out of range",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "x"},
+				{QuestionID: "prior", Choices: []string{"No"}},
+				{QuestionID: "stress", Scale: scale(11)},
+			},
+			errString: "value must be between 0 and 10",
+		},
+		{
+			name: "badly formatted date",
+			answers: []Answer{
+				{QuestionID: "reason", Text: "x"},
+				{QuestionID: "prior", Choices: []string{"No"}},
+				{QuestionID: "q6", Date: "15/01/2025"},
+			},
+			errString: "date must be YYYY-MM-DD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := NewResponse(q, "client-123", tt.answers)
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewResponse() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewResponse() unexpected error = %v", err)
+			}
+
+			var got []string
+			for _, answer := range response.Answers {
+				got = append(got, answer.QuestionID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantAnswers, ",") {
+				t.Errorf("NewResponse() answered %v, want %v", got, tt.wantAnswers)
+			}
+
+			if response.Version != q.Version || response.TherapistID != q.TherapistID {
+				t.Errorf("NewResponse() = v%d for %q, want v%d for %q", response.Version, response.TherapistID, q.Version, q.TherapistID)
+			}
+			if response.Answers[0].Text != strings.TrimSpace(response.Answers[0].Text) {
+				t.Errorf("NewResponse() did not trim text answer %q", response.Answers[0].Text)
+			}
+		})
+	}
+}
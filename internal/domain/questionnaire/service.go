@@ -0,0 +1,50 @@
+package questionnaire
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrQuestionnaireServiceUnavailable = errors.New("questionnaire service unavailable")
+	ErrUnauthorizedAccess              = errors.New("unauthorized access to questionnaire data")
+	ErrInvalidQuestionnaireData        = errors.New("invalid questionnaire data")
+	ErrInvalidResponseData             = errors.New("invalid questionnaire response")
+	ErrQuestionnaireInactive           = errors.New("questionnaire is not accepting responses")
+	ErrOutdatedVersion                 = errors.New("questionnaire has changed since it was loaded")
+	ErrClientNotAssigned               = errors.New("client is not assigned to this therapist")
+)
+
+type Service interface {
+	// Therapists
+	CreateQuestionnaire(ctx context.Context, therapistUserID string, req QuestionnaireRequest) (*Questionnaire, error)
+	UpdateQuestionnaire(ctx context.Context, therapistUserID, questionnaireID string, req QuestionnaireRequest) (*Questionnaire, error)
+	SetQuestionnaireActive(ctx context.Context, therapistUserID, questionnaireID string, active bool) (*Questionnaire, error)
+	GetTherapistQuestionnaires(ctx context.Context, therapistUserID string) ([]*Questionnaire, error)
+	GetClientResponses(ctx context.Context, therapistUserID, clientUserID string) ([]*AnsweredQuestionnaire, error)
+
+	// Clients
+	GetAssignedQuestionnaires(ctx context.Context, clientUserID string) ([]*Questionnaire, error)
+	SubmitResponse(ctx context.Context, clientUserID string, req SubmitResponseRequest) (*Response, error)
+	GetOwnResponses(ctx context.Context, clientUserID string) ([]*AnsweredQuestionnaire, error)
+}
+
+type QuestionnaireRequest struct {
+	Title       string
+	Description string
+	Questions   []Question
+}
+
+// SubmitResponseRequest names the version the client filled in, so a form that
+// changed in the meantime is not answered against the wrong questions
+type SubmitResponseRequest struct {
+	QuestionnaireID string
+	Version         int
+	Answers         []Answer
+}
+
+// AnsweredQuestionnaire pairs a response with the questionnaire version it answered
+type AnsweredQuestionnaire struct {
+	Questionnaire *Questionnaire
+	Response      *Response
+}
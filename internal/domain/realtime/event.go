@@ -0,0 +1,151 @@
+package realtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidEvent   = errors.New("invalid realtime event")
+	ErrTooManyStreams = errors.New("too many open event streams")
+)
+
+// Event types pushed to users. Payloads carry IDs only: clients fetch the
+// content through the regular endpoints, so nothing sensitive passes through
+// the broker or sits in a stream buffer.
+const (
+	// EventMessageCreated goes to the recipient of a new message
+	EventMessageCreated = "message.created"
+	// EventConnectionAccepted goes to a therapist when a client accepts their
+	// offered spot and is assigned to them
+	EventConnectionAccepted = "connection.accepted"
+	// EventSpotOffered goes to a waitlisted client when a therapist offers them a spot
+	EventSpotOffered = "waitlist.spot_offered"
+	// EventTherapistAvailable goes to every waitlisted client when the
+	// therapist starts accepting clients again
+	EventTherapistAvailable = "waitlist.therapist_available"
+	// EventSafetyAlertRaised goes to the client's therapist when a safety alert is raised
+	EventSafetyAlertRaised = "safety.alert_raised"
+	// EventSafetyAlertEscalated goes to every admin when a safety alert is
+	// escalated, either because nobody acknowledged it in time or because the
+	// client has no therapist
+	EventSafetyAlertEscalated = "safety.alert_escalated"
+)
+
+// Event is a change pushed to one user's open streams
+type Event struct {
+	ID         string
+	UserID     string
+	Type       string
+	Data       json.RawMessage
+	OccurredAt time.Time
+}
+
+// Publisher handles events for a user: delivering them to the user's streams
+// on every API replica, or turning them into notifications
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// Publishers hands each event to every publisher in turn, so one failing does
+// not keep it from the others. It returns the first error.
+type Publishers []Publisher
+
+func (p Publishers) Publish(ctx context.Context, event *Event) error {
+	var first error
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, event); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// Subscriber opens event streams for the signed-in user
+type Subscriber interface {
+	Subscribe(userID string) (Subscription, error)
+}
+
+// Subscription is one open stream. Events is closed when the subscription is
+// dropped, for example because it fell too far behind or the server is
+// shutting down; clients are expected to reconnect and refetch.
+type Subscription interface {
+	Events() <-chan *Event
+	Close()
+}
+
+// NewEvent builds an event for userID. data is encoded as JSON so the event
+// can be handed to a broker as is.
+func NewEvent(userID, eventType string, data interface{}, now time.Time) (*Event, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("%w: user ID is required", ErrInvalidEvent)
+	}
+
+	if strings.TrimSpace(eventType) == "" {
+		return nil, fmt.Errorf("%w: event type is required", ErrInvalidEvent)
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+
+	return &Event{
+		ID:         generateID(),
+		UserID:     userID,
+		Type:       eventType,
+		Data:       encoded,
+		OccurredAt: now,
+	}, nil
+}
+
+// MessageCreatedData is the payload of EventMessageCreated
+type MessageCreatedData struct {
+	ConversationID string `json:"conversation_id"`
+	MessageID      string `json:"message_id"`
+	ThreadID       string `json:"thread_id,omitempty"`
+	SenderID       string `json:"sender_id"`
+}
+
+// ConnectionAcceptedData is the payload of EventConnectionAccepted
+type ConnectionAcceptedData struct {
+	ClientID        string `json:"client_id"`
+	WaitlistEntryID string `json:"waitlist_entry_id"`
+}
+
+// SpotOfferedData is the payload of EventSpotOffered
+type SpotOfferedData struct {
+	WaitlistEntryID string     `json:"waitlist_entry_id"`
+	TherapistID     string     `json:"therapist_id"`
+	OfferExpiresAt  *time.Time `json:"offer_expires_at,omitempty"`
+}
+
+// TherapistAvailableData is the payload of EventTherapistAvailable
+type TherapistAvailableData struct {
+	WaitlistEntryID string `json:"waitlist_entry_id"`
+	TherapistID     string `json:"therapist_id"`
+}
+
+// SafetyAlertData is the payload of EventSafetyAlertRaised and
+// EventSafetyAlertEscalated. Like the alert itself it leaves out what the
+// client wrote.
+type SafetyAlertData struct {
+	AlertID  string `json:"alert_id"`
+	ClientID string `json:"client_id"`
+	Severity string `json:"severity"`
+	Source   string `json:"source"`
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,72 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewEvent(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	data := MessageCreatedData{ConversationID: "conversation-123", MessageID: "message-123", SenderID: "client-123"}
+
+	event, err := NewEvent("therapist-123", EventMessageCreated, data, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if event.ID == "" || event.UserID != "therapist-123" || event.Type != EventMessageCreated || !event.OccurredAt.Equal(now) {
+		t.Errorf("Unexpected event %+v", event)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(event.Data, &decoded); err != nil {
+		t.Fatalf("Expected JSON data: %v", err)
+	}
+	if decoded["message_id"] != "message-123" {
+		t.Errorf("Expected the payload to be encoded, got %s", event.Data)
+	}
+	if _, ok := decoded["thread_id"]; ok {
+		t.Error("Expected an empty thread ID to be left out")
+	}
+
+	if _, err := NewEvent(" ", EventMessageCreated, data, now); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Expected an error without a user, got %v", err)
+	}
+	if _, err := NewEvent("therapist-123", "", data, now); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Expected an error without a type, got %v", err)
+	}
+	if _, err := NewEvent("therapist-123", EventMessageCreated, make(chan int), now); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Expected an error for data that cannot be encoded, got %v", err)
+	}
+}
+
+type recordingPublisher struct {
+	events []*Event
+	err    error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event *Event) error {
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func TestPublishers_Publish(t *testing.T) {
+	event, err := NewEvent("client-123", EventSpotOffered, SpotOfferedData{WaitlistEntryID: "entry-123", TherapistID: "therapist-123"}, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	failure := errors.New("broker unavailable")
+	failing := &recordingPublisher{err: failure}
+	working := &recordingPublisher{}
+
+	err = Publishers{failing, working}.Publish(context.Background(), event)
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected the failing publisher's error, got %v", err)
+	}
+	if len(failing.events) != 1 || len(working.events) != 1 || working.events[0] != event {
+		t.Error("Expected every publisher to receive the event")
+	}
+}
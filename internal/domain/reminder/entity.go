@@ -0,0 +1,143 @@
+package reminder
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EventAppointmentReminder is the notification sent before an appointment
+const EventAppointmentReminder = "appointment.reminder"
+
+// JobKind is the scheduler job kind that sends one reminder
+const JobKind = "appointment.reminder"
+
+// MaxOffsets bounds how many reminders one appointment gets
+const MaxOffsets = 5
+
+// DefaultOffsets remind clients a day and an hour before their appointment
+var DefaultOffsets = []time.Duration{24 * time.Hour, time.Hour}
+
+// Appointment is what reminders need to know about a booked session
+type Appointment struct {
+	ID       string
+	ClientID string
+	StartsAt time.Time
+	// TimeZone is the IANA zone the start time is shown in; empty means UTC
+	TimeZone string
+}
+
+// Reminder is one reminder to send, kept as the payload of its job
+type Reminder struct {
+	AppointmentID string    `json:"appointment_id"`
+	UserID        string    `json:"user_id"`
+	StartsAt      time.Time `json:"starts_at"`
+	TimeZone      string    `json:"time_zone,omitempty"`
+	SendAt        time.Time `json:"send_at"`
+}
+
+func (a *Appointment) Validate() error {
+	if strings.TrimSpace(a.ID) == "" {
+		return errors.New("appointment ID is required")
+	}
+
+	if strings.TrimSpace(a.ClientID) == "" {
+		return errors.New("client ID is required")
+	}
+
+	if a.StartsAt.IsZero() {
+		return errors.New("appointment start time is required")
+	}
+
+	if a.TimeZone != "" {
+		if _, err := time.LoadLocation(a.TimeZone); err != nil {
+			return fmt.Errorf("unknown time zone: %s", a.TimeZone)
+		}
+	}
+
+	return nil
+}
+
+// Plan lists the reminders for the appointment, one per offset before its
+// start. Reminders whose time has already passed are left out, so a session
+// booked for this afternoon only gets the reminders still ahead.
+func Plan(appointment Appointment, offsets []time.Duration, now time.Time) []Reminder {
+	var reminders []Reminder
+	for _, offset := range offsets {
+		sendAt := appointment.StartsAt.Add(-offset)
+		if sendAt.Before(now) {
+			continue
+		}
+
+		reminders = append(reminders, Reminder{
+			AppointmentID: appointment.ID,
+			UserID:        appointment.ClientID,
+			StartsAt:      appointment.StartsAt,
+			TimeZone:      appointment.TimeZone,
+			SendAt:        sendAt,
+		})
+	}
+	return reminders
+}
+
+// JobKey groups the reminder jobs of one appointment
+func JobKey(appointmentID string) string {
+	return "appointment:" + appointmentID
+}
+
+// TemplateData is what the reminder's notification templates see. The start
+// time is given in the appointment's time zone.
+func (r *Reminder) TemplateData() map[string]interface{} {
+	location := time.UTC
+	if r.TimeZone != "" {
+		if loaded, err := time.LoadLocation(r.TimeZone); err == nil {
+			location = loaded
+		}
+	}
+
+	return map[string]interface{}{
+		"appointment_id":  r.AppointmentID,
+		"starts_at":       r.StartsAt.UTC().Format(time.RFC3339),
+		"starts_at_local": r.StartsAt.In(location).Format("2006-01-02 15:04 MST"),
+	}
+}
+
+// ParseOffsets reads a comma-separated list of durations before the
+// appointment, such as "24h,1h"
+func ParseOffsets(value string) ([]time.Duration, error) {
+	var offsets []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		offset, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset %q", part)
+		}
+		if offset <= 0 {
+			return nil, fmt.Errorf("reminder offset must be positive: %s", part)
+		}
+		if !slices.Contains(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	if len(offsets) == 0 {
+		return nil, errors.New("at least one reminder offset is required")
+	}
+
+	if len(offsets) > MaxOffsets {
+		return nil, fmt.Errorf("at most %d reminder offsets are allowed", MaxOffsets)
+	}
+
+	// Longest offset first, which is the earliest reminder
+	slices.SortFunc(offsets, func(a, b time.Duration) int {
+		return cmp.Compare(b, a)
+	})
+	return offsets, nil
+}
@@ -0,0 +1,105 @@
+package reminder
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseOffsets(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []time.Duration
+		wantErr bool
+	}{
+		{name: "defaults", value: "24h,1h", want: []time.Duration{24 * time.Hour, time.Hour}},
+		{name: "sorted earliest reminder first", value: "30m, 48h ,2h", want: []time.Duration{48 * time.Hour, 2 * time.Hour, 30 * time.Minute}},
+		{name: "duplicates dropped", value: "1h,60m", want: []time.Duration{time.Hour}},
+		{name: "empty", value: " , ", wantErr: true},
+		{name: "not a duration", value: "1 day", wantErr: true},
+		{name: "negative", value: "-1h", wantErr: true},
+		{name: "too many", value: "1h,2h,3h,4h,5h,6h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOffsets(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseOffsets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("ParseOffsets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppointment_Validate(t *testing.T) {
+	startsAt := time.Date(2026, 4, 2, 15, 0, 0, 0, time.UTC)
+
+	valid := Appointment{ID: "appointment-123", ClientID: "client-123", StartsAt: startsAt, TimeZone: "Europe/Zagreb"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	invalid := []Appointment{
+		{ClientID: "client-123", StartsAt: startsAt},
+		{ID: "appointment-123", StartsAt: startsAt},
+		{ID: "appointment-123", ClientID: "client-123"},
+		{ID: "appointment-123", ClientID: "client-123", StartsAt: startsAt, TimeZone: "Mars/Olympus"},
+	}
+	for _, appointment := range invalid {
+		if err := appointment.Validate(); err == nil {
+			t.Errorf("Expected an error for %+v", appointment)
+		}
+	}
+}
+
+func TestPlan(t *testing.T) {
+	startsAt := time.Date(2026, 4, 2, 15, 0, 0, 0, time.UTC)
+	appointment := Appointment{ID: "appointment-123", ClientID: "client-123", StartsAt: startsAt}
+
+	reminders := Plan(appointment, DefaultOffsets, startsAt.Add(-48*time.Hour))
+	if len(reminders) != 2 {
+		t.Fatalf("Expected two reminders, got %d", len(reminders))
+	}
+	if !reminders[0].SendAt.Equal(startsAt.Add(-24*time.Hour)) || !reminders[1].SendAt.Equal(startsAt.Add(-time.Hour)) {
+		t.Errorf("Unexpected reminder times %v and %v", reminders[0].SendAt, reminders[1].SendAt)
+	}
+	if reminders[0].UserID != "client-123" || reminders[0].AppointmentID != "appointment-123" {
+		t.Errorf("Unexpected reminder %+v", reminders[0])
+	}
+
+	// Booked this afternoon: only the reminder still ahead is planned
+	reminders = Plan(appointment, DefaultOffsets, startsAt.Add(-3*time.Hour))
+	if len(reminders) != 1 || !reminders[0].SendAt.Equal(startsAt.Add(-time.Hour)) {
+		t.Errorf("Expected only the hour-before reminder, got %+v", reminders)
+	}
+
+	if reminders := Plan(appointment, DefaultOffsets, startsAt); len(reminders) != 0 {
+		t.Errorf("Expected no reminders once the appointment starts, got %+v", reminders)
+	}
+}
+
+func TestReminder_TemplateData(t *testing.T) {
+	reminder := Reminder{
+		AppointmentID: "appointment-123",
+		UserID:        "client-123",
+		StartsAt:      time.Date(2026, 4, 2, 15, 0, 0, 0, time.UTC),
+		TimeZone:      "Europe/Zagreb",
+	}
+
+	data := reminder.TemplateData()
+	if data["starts_at_local"] != "2026-04-02 17:00 CEST" {
+		t.Errorf("Expected the start in the appointment's time zone, got %v", data["starts_at_local"])
+	}
+	if data["starts_at"] != "2026-04-02T15:00:00Z" {
+		t.Errorf("Unexpected start %v", data["starts_at"])
+	}
+
+	reminder.TimeZone = ""
+	if data := reminder.TemplateData(); data["starts_at_local"] != "2026-04-02 15:00 UTC" {
+		t.Errorf("Expected UTC without a time zone, got %v", data["starts_at_local"])
+	}
+}
@@ -0,0 +1,25 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrReminderServiceUnavailable = errors.New("reminder service unavailable")
+	ErrInvalidAppointment         = errors.New("invalid appointment")
+)
+
+// Service keeps appointment reminders in step with the appointments. Booking
+// calls ScheduleReminders whenever an appointment is made or moved, which
+// replaces the reminders not sent yet, and CancelReminders when it is cancelled.
+type Service interface {
+	ScheduleReminders(ctx context.Context, appointment Appointment) error
+	CancelReminders(ctx context.Context, appointmentID string) error
+}
+
+// Notifier sends a reminder through the notification layer, which applies the
+// user's channels, language and quiet hours
+type Notifier interface {
+	Notify(ctx context.Context, userID, eventType string, data map[string]interface{}) error
+}
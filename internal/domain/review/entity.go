@@ -0,0 +1,235 @@
+package review
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPublished Status = "published"
+	StatusFlagged   Status = "flagged"
+	StatusHidden    Status = "hidden"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+
+	maxBodyLength   = 2000
+	maxReplyLength  = 2000
+	maxReasonLength = 500
+)
+
+// Review is a client's rating of a therapist they have worked with. Reported
+// reviews stay visible as flagged until an admin hides or restores them.
+type Review struct {
+	ID               string
+	TherapistID      string
+	ClientID         string
+	Rating           int
+	Body             string
+	Status           Status
+	Reply            string
+	RepliedAt        *time.Time
+	OpenReports      int
+	ModeratedBy      *string
+	ModeratedAt      *time.Time
+	ModerationReason string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewReview(therapistID, clientID string, rating int, body string) (*Review, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if therapistID == clientID {
+		return nil, errors.New("client and therapist must be different users")
+	}
+
+	if err := validateRating(rating); err != nil {
+		return nil, err
+	}
+
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Review{
+		ID:          generateID(),
+		TherapistID: therapistID,
+		ClientID:    clientID,
+		Rating:      rating,
+		Body:        strings.TrimSpace(body),
+		Status:      StatusPublished,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Edit lets the author change their rating and text; hidden reviews stay as moderated
+func (r *Review) Edit(rating int, body string) error {
+	if r.Status == StatusHidden {
+		return ErrInvalidStatusTransition
+	}
+
+	if err := validateRating(rating); err != nil {
+		return err
+	}
+
+	if err := validateBody(body); err != nil {
+		return err
+	}
+
+	r.Rating = rating
+	r.Body = strings.TrimSpace(body)
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetReply records the therapist's public answer; an empty reply removes it
+func (r *Review) SetReply(reply string, now time.Time) error {
+	reply = strings.TrimSpace(reply)
+	if len(reply) > maxReplyLength {
+		return errors.New("reply must be 2000 characters or less")
+	}
+
+	if reply == "" {
+		r.Reply = ""
+		r.RepliedAt = nil
+	} else {
+		r.Reply = reply
+		r.RepliedAt = &now
+	}
+
+	r.UpdatedAt = now
+	return nil
+}
+
+// Flag puts a reported review in the moderation queue. Hidden reviews stay hidden.
+func (r *Review) Flag(now time.Time) {
+	if r.Status != StatusPublished {
+		return
+	}
+
+	r.Status = StatusFlagged
+	r.UpdatedAt = now
+}
+
+func (r *Review) Hide(adminID, reason string, now time.Time) error {
+	if r.Status == StatusHidden {
+		return ErrInvalidStatusTransition
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("a reason is required to hide a review")
+	}
+
+	if len(reason) > maxReasonLength {
+		return errors.New("reason must be 500 characters or less")
+	}
+
+	r.Status = StatusHidden
+	r.ModeratedBy = &adminID
+	r.ModeratedAt = &now
+	r.ModerationReason = reason
+	r.UpdatedAt = now
+	return nil
+}
+
+// Restore publishes a flagged or hidden review again, dismissing its reports
+func (r *Review) Restore(adminID string, now time.Time) error {
+	if r.Status == StatusPublished {
+		return ErrInvalidStatusTransition
+	}
+
+	r.Status = StatusPublished
+	r.ModeratedBy = &adminID
+	r.ModeratedAt = &now
+	r.ModerationReason = ""
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *Review) IsVisible() bool {
+	return r.Status != StatusHidden
+}
+
+func (r *Review) WrittenBy(clientID string) bool {
+	return r.ClientID == clientID
+}
+
+func (r *Review) IsAbout(therapistID string) bool {
+	return r.TherapistID == therapistID
+}
+
+// Report is a user's complaint about a review, open until an admin acts on the review
+type Report struct {
+	ID         string
+	ReviewID   string
+	ReporterID string
+	Reason     string
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func NewReport(reviewID, reporterID, reason string) (*Report, error) {
+	if strings.TrimSpace(reviewID) == "" {
+		return nil, errors.New("review ID is required")
+	}
+
+	if strings.TrimSpace(reporterID) == "" {
+		return nil, errors.New("reporter ID is required")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("a reason is required to report a review")
+	}
+
+	if len(reason) > maxReasonLength {
+		return nil, errors.New("reason must be 500 characters or less")
+	}
+
+	return &Report{
+		ID:         generateID(),
+		ReviewID:   reviewID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+func validateRating(rating int) error {
+	if rating < MinRating || rating > MaxRating {
+		return errors.New("rating must be between 1 and 5")
+	}
+	return nil
+}
+
+func validateBody(body string) error {
+	if len(strings.TrimSpace(body)) > maxBodyLength {
+		return errors.New("review text must be 2000 characters or less")
+	}
+	return nil
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
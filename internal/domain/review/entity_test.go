@@ -0,0 +1,224 @@
+package review
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewReview(t *testing.T) {
+	tests := []struct {
+		name        string
+		therapistID string
+		clientID    string
+		rating      int
+		body        string
+		wantErr     bool
+		errString   string
+	}{
+		{
+			name:        "valid review",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			rating:      5,
+			body:        "  Really helped me through a hard year.  ",
+			wantErr:     false,
+		},
+		{
+			name:        "rating only",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			rating:      3,
+			wantErr:     false,
+		},
+		{
+			name:        "empty therapist ID",
+			therapistID: "",
+			clientID:    "client-123",
+			rating:      4,
+			wantErr:     true,
+			errString:   "therapist ID is required",
+		},
+		{
+			name:        "empty client ID",
+			therapistID: "therapist-123",
+			clientID:    "",
+			rating:      4,
+			wantErr:     true,
+			errString:   "client ID is required",
+		},
+		{
+			name:        "reviewing yourself",
+			therapistID: "user-123",
+			clientID:    "user-123",
+			rating:      5,
+			wantErr:     true,
+			errString:   "client and therapist must be different users",
+		},
+		{
+			name:        "rating too low",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			rating:      0,
+			wantErr:     true,
+			errString:   "rating must be between 1 and 5",
+		},
+		{
+			name:        "rating too high",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			rating:      6,
+			wantErr:     true,
+			errString:   "rating must be between 1 and 5",
+		},
+		{
+			name:        "body too long",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			rating:      4,
+			body:        strings.Repeat("a", 2001),
+			wantErr:     true,
+			errString:   "review text must be 2000 characters or less",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, err := NewReview(tt.therapistID, tt.clientID, tt.rating, tt.body)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NewReview() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errString {
+					t.Errorf("NewReview() error = %v, want %v", err.Error(), tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("NewReview() unexpected error = %v", err)
+				return
+			}
+
+			if review.Status != StatusPublished {
+				t.Errorf("NewReview() Status = %v, want %v", review.Status, StatusPublished)
+			}
+
+			if review.Body != strings.TrimSpace(tt.body) {
+				t.Errorf("NewReview() Body = %q, want trimmed %q", review.Body, strings.TrimSpace(tt.body))
+			}
+
+			if !review.IsVisible() {
+				t.Error("NewReview() review should be visible")
+			}
+		})
+	}
+}
+
+func TestReview_Reply(t *testing.T) {
+	review, err := NewReview("therapist-123", "client-123", 4, "Good listener")
+	if err != nil {
+		t.Fatalf("Failed to create review: %v", err)
+	}
+
+	now := time.Now()
+	if err := review.SetReply(" Thank you! ", now); err != nil {
+		t.Fatalf("SetReply() unexpected error = %v", err)
+	}
+
+	if review.Reply != "Thank you!" || review.RepliedAt == nil {
+		t.Errorf("SetReply() Reply = %q, RepliedAt = %v", review.Reply, review.RepliedAt)
+	}
+
+	if err := review.SetReply(strings.Repeat("a", 2001), now); err == nil {
+		t.Error("SetReply() expected error for an overlong reply")
+	}
+
+	if err := review.SetReply("", now); err != nil {
+		t.Fatalf("SetReply() clearing unexpected error = %v", err)
+	}
+
+	if review.Reply != "" || review.RepliedAt != nil {
+		t.Error("SetReply() with empty text should remove the reply")
+	}
+}
+
+func TestReview_ModerationLifecycle(t *testing.T) {
+	review, err := NewReview("therapist-123", "client-123", 1, "Rude and late")
+	if err != nil {
+		t.Fatalf("Failed to create review: %v", err)
+	}
+
+	now := time.Now()
+
+	if err := review.Restore("admin-123", now); err != ErrInvalidStatusTransition {
+		t.Errorf("Restore() published review error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	review.Flag(now)
+	if review.Status != StatusFlagged {
+		t.Errorf("Flag() Status = %v, want %v", review.Status, StatusFlagged)
+	}
+
+	if !review.IsVisible() {
+		t.Error("Flag() flagged reviews should stay visible until moderated")
+	}
+
+	if err := review.Hide("admin-123", " ", now); err == nil {
+		t.Error("Hide() expected error without a reason")
+	}
+
+	if err := review.Hide("admin-123", "Personal information", now); err != nil {
+		t.Fatalf("Hide() unexpected error = %v", err)
+	}
+
+	if review.IsVisible() {
+		t.Error("Hide() review should no longer be visible")
+	}
+
+	if review.ModeratedBy == nil || *review.ModeratedBy != "admin-123" {
+		t.Error("Hide() should record the moderating admin")
+	}
+
+	if err := review.Hide("admin-123", "Again", now); err != ErrInvalidStatusTransition {
+		t.Errorf("Hide() twice error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	review.Flag(now)
+	if review.Status != StatusHidden {
+		t.Error("Flag() should not resurface a hidden review")
+	}
+
+	if err := review.Edit(5, "Changed my mind"); err != ErrInvalidStatusTransition {
+		t.Errorf("Edit() hidden review error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	if err := review.Restore("admin-123", now); err != nil {
+		t.Fatalf("Restore() unexpected error = %v", err)
+	}
+
+	if review.Status != StatusPublished || review.ModerationReason != "" {
+		t.Errorf("Restore() Status = %v, ModerationReason = %q", review.Status, review.ModerationReason)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	if _, err := NewReport("review-123", "user-123", "  "); err == nil {
+		t.Error("NewReport() expected error without a reason")
+	}
+
+	if _, err := NewReport("review-123", "user-123", strings.Repeat("a", 501)); err == nil {
+		t.Error("NewReport() expected error for an overlong reason")
+	}
+
+	report, err := NewReport("review-123", "user-123", " Spam ")
+	if err != nil {
+		t.Fatalf("NewReport() unexpected error = %v", err)
+	}
+
+	if report.Reason != "Spam" || report.ResolvedAt != nil {
+		t.Errorf("NewReport() Reason = %q, ResolvedAt = %v", report.Reason, report.ResolvedAt)
+	}
+}
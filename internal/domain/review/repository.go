@@ -0,0 +1,33 @@
+package review
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrReviewNotFound          = errors.New("review not found")
+	ErrReviewAlreadyExists     = errors.New("client has already reviewed this therapist")
+	ErrAlreadyReported         = errors.New("review has already been reported by this user")
+	ErrInvalidStatusTransition = errors.New("review cannot change to the requested status")
+)
+
+// Repository stores reviews and keeps the rating summary on therapist profiles
+// in step with the visible reviews
+type Repository interface {
+	Create(ctx context.Context, review *Review) error
+	GetByID(ctx context.Context, id string) (*Review, error)
+	Update(ctx context.Context, review *Review) error
+	Delete(ctx context.Context, id string) error
+	GetVisibleByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*Review], error)
+	GetByClientID(ctx context.Context, clientID string) ([]*Review, error)
+	GetFlagged(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Review], error)
+
+	AddReport(ctx context.Context, report *Report) error
+	GetOpenReports(ctx context.Context, reviewID string) ([]*Report, error)
+
+	// HasRelationship reports whether the client is or was ever assigned to the therapist
+	HasRelationship(ctx context.Context, clientID, therapistID string) (bool, error)
+}
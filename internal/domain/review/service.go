@@ -0,0 +1,52 @@
+package review
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var (
+	ErrReviewServiceUnavailable = errors.New("review service unavailable")
+	ErrUnauthorizedAccess       = errors.New("unauthorized access to review data")
+	ErrNoRelationship           = errors.New("only clients who have worked with this therapist can review them")
+	ErrInvalidReviewData        = errors.New("invalid review data")
+)
+
+type Service interface {
+	// Clients
+	CreateReview(ctx context.Context, clientUserID string, req CreateReviewRequest) (*Review, error)
+	UpdateReview(ctx context.Context, clientUserID, reviewID string, req UpdateReviewRequest) (*Review, error)
+	DeleteReview(ctx context.Context, clientUserID, reviewID string) error
+	GetClientReviews(ctx context.Context, clientUserID string) ([]*Review, error)
+
+	// Public
+	GetTherapistReviews(ctx context.Context, therapistUserID string, page pagination.PageRequest) (*pagination.Page[*Review], error)
+	ReportReview(ctx context.Context, reporterUserID, reviewID, reason string) (*Review, error)
+
+	// Therapists
+	ReplyToReview(ctx context.Context, therapistUserID, reviewID, reply string) (*Review, error)
+
+	// Admin moderation
+	GetModerationQueue(ctx context.Context, adminUserID string, page pagination.PageRequest) (*pagination.Page[*ModerationItem], error)
+	HideReview(ctx context.Context, adminUserID, reviewID, reason string) (*Review, error)
+	RestoreReview(ctx context.Context, adminUserID, reviewID string) (*Review, error)
+}
+
+type CreateReviewRequest struct {
+	TherapistID string
+	Rating      int
+	Body        string
+}
+
+type UpdateReviewRequest struct {
+	Rating int
+	Body   string
+}
+
+// ModerationItem is a flagged review together with the reports that put it in the queue
+type ModerationItem struct {
+	Review  *Review
+	Reports []*Report
+}
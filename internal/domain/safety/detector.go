@@ -0,0 +1,162 @@
+package safety
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type Severity string
+
+const (
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+type Category string
+
+const (
+	CategorySuicide      Category = "suicide"
+	CategorySelfHarm     Category = "self_harm"
+	CategoryHarmToOthers Category = "harm_to_others"
+)
+
+// Signal is one indicator of acute risk found in a client's text or answers
+type Signal struct {
+	Category  Category `json:"category"`
+	Severity  Severity `json:"severity"`
+	Indicator string   `json:"indicator"`
+}
+
+// Phrase is a keyword rule. Words match whole words after normalization; a
+// trailing * on the last word matches any word starting with it, which covers
+// inflected forms such as "samoubojstvo" and "samoubojstva".
+type Phrase struct {
+	Text     string
+	Category Category
+	Severity Severity
+}
+
+// Detector is a rule-based screen for acute risk. It is deliberately simple
+// and errs towards raising an alert: a therapist dismissing a false positive
+// costs far less than a missed one.
+type Detector struct {
+	phrases map[string][]Phrase
+}
+
+// NewDetector builds a detector from keyword lists keyed by ISO 639-1 language
+func NewDetector(phrases map[string][]Phrase) *Detector {
+	return &Detector{phrases: phrases}
+}
+
+// DefaultDetector uses the built-in keyword lists
+func DefaultDetector() *Detector {
+	return NewDetector(defaultPhrases)
+}
+
+// ScanText checks text against every language's keywords, since clients do not
+// always write in their preferred language. Each phrase is reported once.
+func (d *Detector) ScanText(text string) []Signal {
+	normalized := " " + normalizeText(text) + " "
+	if strings.TrimSpace(normalized) == "" {
+		return nil
+	}
+
+	var signals []Signal
+	seen := make(map[string]bool)
+	for _, phrases := range d.phrases {
+		for _, phrase := range phrases {
+			if seen[phrase.Text] {
+				continue
+			}
+			if matchPhrase(normalized, phrase.Text) {
+				seen[phrase.Text] = true
+				signals = append(signals, Signal{
+					Category:  phrase.Category,
+					Severity:  phrase.Severity,
+					Indicator: phrase.Text,
+				})
+			}
+		}
+	}
+
+	// Critical signals first, so the alert leads with the most urgent
+	sort.SliceStable(signals, func(i, j int) bool {
+		if signals[i].Severity != signals[j].Severity {
+			return signals[i].Severity == SeverityCritical
+		}
+		return signals[i].Indicator < signals[j].Indicator
+	})
+
+	return signals
+}
+
+// CriticalItemSignal turns an endorsed critical assessment item into a signal.
+// Answers above the item's threshold are treated as critical.
+func CriticalItemSignal(instrumentCode string, item, response, minResponse int, reason string) Signal {
+	severity := SeverityHigh
+	if response > minResponse {
+		severity = SeverityCritical
+	}
+
+	return Signal{
+		Category:  CategorySuicide,
+		Severity:  severity,
+		Indicator: fmt.Sprintf("%s item %d: %s", instrumentCode, item, reason),
+	}
+}
+
+// HighestSeverity returns the most severe of the signals
+func HighestSeverity(signals []Signal) Severity {
+	for _, signal := range signals {
+		if signal.Severity == SeverityCritical {
+			return SeverityCritical
+		}
+	}
+	return SeverityHigh
+}
+
+// matchPhrase reports whether the normalized phrase occurs as whole words in
+// text, which must be normalized and padded with spaces
+func matchPhrase(text, phrase string) bool {
+	if prefix, ok := strings.CutSuffix(phrase, "*"); ok {
+		return strings.Contains(text, " "+normalizeText(prefix))
+	}
+	return strings.Contains(text, " "+normalizeText(phrase)+" ")
+}
+
+// normalizeText lowercases text, folds Croatian diacritics, drops apostrophes
+// so "don't" matches "dont", and collapses everything else that is not a
+// letter or digit into single spaces
+func normalizeText(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(text) {
+		if folded, ok := diacritics[r]; ok {
+			r = folded
+		}
+
+		switch {
+		case r == '\'' || r == '’':
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		default:
+			space = true
+		}
+	}
+	return b.String()
+}
+
+var diacritics = map[rune]rune{
+	'č': 'c',
+	'ć': 'c',
+	'š': 's',
+	'ž': 'z',
+	'đ': 'd',
+}
@@ -0,0 +1,120 @@
+package safety
+
+import (
+	"testing"
+)
+
+func TestDetector_ScanText(t *testing.T) {
+	detector := DefaultDetector()
+
+	tests := []struct {
+		name       string
+		text       string
+		indicators []string
+		severity   Severity
+	}{
+		{
+			name: "ordinary entry",
+			text: "Work was stressful but the walk after dinner helped.",
+		},
+		{
+			name:       "statement of intent",
+			text:       "Some days I think I will just kill myself.",
+			indicators: []string{"kill myself"},
+			severity:   SeverityCritical,
+		},
+		{
+			name:       "case and punctuation are ignored",
+			text:       "I want to DIE... honestly",
+			indicators: []string{"want to die"},
+			severity:   SeverityCritical,
+		},
+		{
+			name:       "apostrophes are dropped",
+			text:       "I don’t want to live like this",
+			indicators: []string{"don't want to live"},
+			severity:   SeverityHigh,
+		},
+		{
+			name:       "prefix matches inflected words",
+			text:       "We talked about suicidal thoughts in session",
+			indicators: []string{"suicid*"},
+			severity:   SeverityHigh,
+		},
+		{
+			name:       "croatian without diacritics",
+			text:       "ne zelim vise zivjeti",
+			indicators: []string{"ne želim više živjeti"},
+			severity:   SeverityHigh,
+		},
+		{
+			name:       "croatian with diacritics",
+			text:       "Ponekad mislim da ću se ubiti.",
+			indicators: []string{"ću se ubiti"},
+			severity:   SeverityCritical,
+		},
+		{
+			name: "words inside other words do not match",
+			text: "The skill myself and others learned was useful",
+		},
+		{
+			name:       "critical signals come first",
+			text:       "I keep cutting myself and want to die",
+			indicators: []string{"want to die", "cutting myself"},
+			severity:   SeverityCritical,
+		},
+		{
+			name:       "shared phrases are reported once",
+			text:       "suicidalne misli",
+			indicators: []string{"suicid*"},
+			severity:   SeverityHigh,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signals := detector.ScanText(tt.text)
+
+			if len(signals) != len(tt.indicators) {
+				t.Fatalf("Expected %d signals, got %+v", len(tt.indicators), signals)
+			}
+
+			for i, indicator := range tt.indicators {
+				if signals[i].Indicator != indicator {
+					t.Errorf("Expected signal %d to be %q, got %q", i, indicator, signals[i].Indicator)
+				}
+			}
+
+			if len(signals) > 0 && HighestSeverity(signals) != tt.severity {
+				t.Errorf("Expected severity %s, got %s", tt.severity, HighestSeverity(signals))
+			}
+		})
+	}
+}
+
+func TestCriticalItemSignal(t *testing.T) {
+	signal := CriticalItemSignal("PHQ-9", 9, 1, 1, "Thoughts of death or self-harm")
+	if signal.Severity != SeverityHigh {
+		t.Errorf("Expected the lowest endorsing answer to be high, got %s", signal.Severity)
+	}
+	if signal.Indicator != "PHQ-9 item 9: Thoughts of death or self-harm" {
+		t.Errorf("Unexpected indicator %q", signal.Indicator)
+	}
+
+	signal = CriticalItemSignal("PHQ-9", 9, 3, 1, "Thoughts of death or self-harm")
+	if signal.Severity != SeverityCritical {
+		t.Errorf("Expected a higher answer to be critical, got %s", signal.Severity)
+	}
+}
+
+func TestCrisisResourcesFor(t *testing.T) {
+	if resources := CrisisResourcesFor("HR"); len(resources) == 0 || resources[0].Country != "HR" {
+		t.Errorf("Expected Croatian resources, got %+v", resources)
+	}
+
+	fallback := CrisisResourcesFor("de")
+	english := CrisisResourcesFor(DefaultResourceLanguage)
+	if len(fallback) != len(english) || fallback[0].Name != english[0].Name {
+		t.Errorf("Expected English resources for an unsupported language, got %+v", fallback)
+	}
+}
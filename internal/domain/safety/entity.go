@@ -0,0 +1,144 @@
+package safety
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Source string
+
+const (
+	SourceJournalEntry Source = "journal_entry"
+	SourceAssessment   Source = "assessment"
+	SourceMessage      Source = "message"
+)
+
+type Status string
+
+const (
+	StatusOpen         Status = "open"
+	StatusAcknowledged Status = "acknowledged"
+)
+
+const maxNoteLength = 2000
+
+// Unacknowledged alerts go to the admins once these delays pass
+var escalationDelays = map[Severity]time.Duration{
+	SeverityCritical: 30 * time.Minute,
+	SeverityHigh:     4 * time.Hour,
+}
+
+// Alert tells a client's therapist that something the client wrote or answered
+// suggests acute risk. It names the source and the matched indicators but not
+// the content, so private journal entries and messages stay private. An alert nobody
+// acknowledges in time is escalated to the admins; clients without a therapist
+// are escalated straight away.
+type Alert struct {
+	ID              string
+	ClientID        string
+	TherapistID     string
+	Source          Source
+	SourceID        string
+	Severity        Severity
+	Signals         []Signal
+	Status          Status
+	EscalationDueAt time.Time
+	EscalatedAt     *time.Time
+	AcknowledgedBy  string
+	AcknowledgedAt  *time.Time
+	Note            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewAlert(clientID, therapistID string, source Source, sourceID string, signals []Signal, now time.Time) (*Alert, error) {
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if source != SourceJournalEntry && source != SourceAssessment && source != SourceMessage {
+		return nil, fmt.Errorf("unknown alert source %q", source)
+	}
+
+	if strings.TrimSpace(sourceID) == "" {
+		return nil, errors.New("source ID is required")
+	}
+
+	if len(signals) == 0 {
+		return nil, errors.New("an alert needs at least one signal")
+	}
+
+	severity := HighestSeverity(signals)
+	alert := &Alert{
+		ID:              generateID(),
+		ClientID:        clientID,
+		TherapistID:     therapistID,
+		Source:          source,
+		SourceID:        sourceID,
+		Severity:        severity,
+		Signals:         signals,
+		Status:          StatusOpen,
+		EscalationDueAt: now.Add(escalationDelays[severity]),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	if therapistID == "" {
+		alert.Escalate(now)
+	}
+
+	return alert, nil
+}
+
+func (a *Alert) IsOpen() bool {
+	return a.Status == StatusOpen
+}
+
+func (a *Alert) IsEscalated() bool {
+	return a.EscalatedAt != nil
+}
+
+// Escalate hands an open alert to the admins. Escalating twice keeps the first time.
+func (a *Alert) Escalate(now time.Time) {
+	if !a.IsOpen() || a.IsEscalated() {
+		return
+	}
+	a.EscalatedAt = &now
+	a.UpdatedAt = now
+}
+
+// Acknowledge records who took responsibility for the alert and, optionally,
+// what they did about it
+func (a *Alert) Acknowledge(userID, note string, now time.Time) error {
+	if !a.IsOpen() {
+		return ErrAlertAlreadyAcknowledged
+	}
+
+	note = strings.TrimSpace(note)
+	if len(note) > maxNoteLength {
+		return fmt.Errorf("note must be %d characters or less", maxNoteLength)
+	}
+
+	a.Status = StatusAcknowledged
+	a.AcknowledgedBy = userID
+	a.AcknowledgedAt = &now
+	a.Note = note
+	a.UpdatedAt = now
+	return nil
+}
+
+func (a *Alert) AssignedTo(therapistID string) bool {
+	return a.TherapistID != "" && a.TherapistID == therapistID
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
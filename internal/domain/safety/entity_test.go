@@ -0,0 +1,86 @@
+package safety
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAlert(t *testing.T) {
+	now := time.Date(2026, 3, 10, 21, 0, 0, 0, time.UTC)
+	critical := []Signal{
+		{Category: CategorySelfHarm, Severity: SeverityHigh, Indicator: "cut myself"},
+		{Category: CategorySuicide, Severity: SeverityCritical, Indicator: "kill myself"},
+	}
+	high := []Signal{{Category: CategorySelfHarm, Severity: SeverityHigh, Indicator: "cut myself"}}
+
+	alert, err := NewAlert("client-123", "therapist-123", SourceJournalEntry, "entry-123", critical, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if alert.Severity != SeverityCritical || !alert.IsOpen() || alert.IsEscalated() {
+		t.Errorf("Expected an open critical alert, got %+v", alert)
+	}
+	if !alert.EscalationDueAt.Equal(now.Add(30 * time.Minute)) {
+		t.Errorf("Expected critical alerts to escalate after 30 minutes, got %v", alert.EscalationDueAt)
+	}
+
+	alert, err = NewAlert("client-123", "therapist-123", SourceAssessment, "assessment-123", high, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !alert.EscalationDueAt.Equal(now.Add(4 * time.Hour)) {
+		t.Errorf("Expected high alerts to escalate after 4 hours, got %v", alert.EscalationDueAt)
+	}
+
+	alert, err = NewAlert("client-123", "", SourceJournalEntry, "entry-123", high, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !alert.IsEscalated() || !alert.EscalatedAt.Equal(now) {
+		t.Errorf("Expected an alert without a therapist to be escalated at once, got %+v", alert)
+	}
+
+	if _, err := NewAlert("client-123", "therapist-123", SourceJournalEntry, "entry-123", nil, now); err == nil {
+		t.Error("Expected an error for an alert without signals")
+	}
+	if _, err := NewAlert("client-123", "therapist-123", Source("forum_post"), "post-123", high, now); err == nil {
+		t.Error("Expected an error for an unknown source")
+	}
+}
+
+func TestAlert_Acknowledge(t *testing.T) {
+	now := time.Date(2026, 3, 10, 21, 0, 0, 0, time.UTC)
+	signals := []Signal{{Category: CategorySuicide, Severity: SeverityCritical, Indicator: "kill myself"}}
+
+	alert, err := NewAlert("client-123", "therapist-123", SourceJournalEntry, "entry-123", signals, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !alert.AssignedTo("therapist-123") || alert.AssignedTo("therapist-456") {
+		t.Error("Expected the alert to belong to its therapist only")
+	}
+
+	if err := alert.Acknowledge("therapist-123", strings.Repeat("a", maxNoteLength+1), now); err == nil {
+		t.Error("Expected an error for an overlong note")
+	}
+
+	later := now.Add(10 * time.Minute)
+	if err := alert.Acknowledge("therapist-123", "  Called the client  ", later); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if alert.IsOpen() || alert.AcknowledgedBy != "therapist-123" || alert.Note != "Called the client" || !alert.AcknowledgedAt.Equal(later) {
+		t.Errorf("Expected the acknowledgement to be recorded, got %+v", alert)
+	}
+
+	if err := alert.Acknowledge("admin-123", "", later); !errors.Is(err, ErrAlertAlreadyAcknowledged) {
+		t.Errorf("Expected ErrAlertAlreadyAcknowledged, got %v", err)
+	}
+
+	alert.Escalate(later)
+	if alert.IsEscalated() {
+		t.Error("Expected an acknowledged alert not to escalate")
+	}
+}
@@ -0,0 +1,60 @@
+package safety
+
+// The built-in keyword lists cover explicit statements of intent and plan as
+// critical, and talk of suicide, self-harm or not wanting to live as high.
+// Croatian phrases are written with diacritics; matching folds them so text
+// typed without them matches too.
+var defaultPhrases = map[string][]Phrase{
+	"en": {
+		{Text: "kill myself", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "killing myself", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "end my life", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "take my own life", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "end it all", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "want to die", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "hang myself", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "suicide note", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "suicid*", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "better off dead", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "no reason to live", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "don't want to live", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "don't want to be alive", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "hurt myself", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "hurting myself", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "harm myself", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "cut myself", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "cutting myself", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "self harm*", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "going to kill him", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+		{Text: "going to kill her", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+		{Text: "going to kill them", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+		{Text: "want to kill someone", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+	},
+	"hr": {
+		{Text: "ubit ću se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "ubiti se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "ću se ubiti", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "da se ubijem", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "ubijem se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "ubio bih se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "ubila bih se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "oduzeti si život", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "okončati život", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "želim umrijeti", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "objesiti se", Category: CategorySuicide, Severity: SeverityCritical},
+		{Text: "samoubojstv*", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "suicid*", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "bolje da me nema", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "ne želim živjeti", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "ne želim više živjeti", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "nema smisla živjeti", Category: CategorySuicide, Severity: SeverityHigh},
+		{Text: "samoozljed*", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "porezati se", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "režem se", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "ozlijediti se", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "nauditi sebi", Category: CategorySelfHarm, Severity: SeverityHigh},
+		{Text: "ubit ću ga", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+		{Text: "ubit ću je", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+		{Text: "ubit ću ih", Category: CategoryHarmToOthers, Severity: SeverityCritical},
+	},
+}
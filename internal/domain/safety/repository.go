@@ -0,0 +1,27 @@
+package safety
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrAlertNotFound            = errors.New("safety alert not found")
+	ErrAlertAlreadyAcknowledged = errors.New("safety alert has already been acknowledged")
+)
+
+type Repository interface {
+	Create(ctx context.Context, alert *Alert) error
+	GetByID(ctx context.Context, id string) (*Alert, error)
+	Update(ctx context.Context, alert *Alert) error
+	// HasOpenForSource reports whether an unacknowledged alert already exists
+	// for the journal entry or assessment
+	HasOpenForSource(ctx context.Context, source Source, sourceID string) (bool, error)
+	// GetByTherapistID lists a therapist's alerts, newest first
+	GetByTherapistID(ctx context.Context, therapistID string, openOnly bool) ([]*Alert, error)
+	// GetEscalated lists escalated alerts, newest first
+	GetEscalated(ctx context.Context, openOnly bool) ([]*Alert, error)
+	// EscalateOverdue escalates open alerts past their deadline and returns them
+	EscalateOverdue(ctx context.Context, now time.Time) ([]*Alert, error)
+}
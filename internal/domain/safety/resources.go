@@ -0,0 +1,70 @@
+package safety
+
+import "strings"
+
+// DefaultResourceLanguage is used when there are no resources in the client's language
+const DefaultResourceLanguage = "en"
+
+// CrisisResource is a service a client can contact straight away
+type CrisisResource struct {
+	Name        string
+	Description string
+	Phone       string
+	URL         string
+	Country     string
+}
+
+var crisisResources = map[string][]CrisisResource{
+	"en": {
+		{
+			Name:        "Emergency services",
+			Description: "If you or someone else is in immediate danger, call your local emergency number.",
+			Phone:       "112",
+		},
+		{
+			Name:        "988 Suicide & Crisis Lifeline",
+			Description: "Free, confidential support 24/7 by call or text.",
+			Phone:       "988",
+			URL:         "https://988lifeline.org",
+			Country:     "US",
+		},
+		{
+			Name:        "Samaritans",
+			Description: "Someone to talk to, day or night.",
+			Phone:       "116 123",
+			URL:         "https://www.samaritans.org",
+			Country:     "GB",
+		},
+	},
+	"hr": {
+		{
+			Name:        "Hitne službe",
+			Description: "Ako ste vi ili netko drugi u neposrednoj opasnosti, nazovite broj za hitne slučajeve.",
+			Phone:       "112",
+			Country:     "HR",
+		},
+		{
+			Name:        "Plavi telefon",
+			Description: "Savjetovanje i podrška u kriznim situacijama.",
+			Phone:       "01 4833 888",
+			URL:         "https://www.plavi-telefon.hr",
+			Country:     "HR",
+		},
+		{
+			Name:        "Hrabri telefon",
+			Description: "Besplatna linija za djecu i mlade.",
+			Phone:       "116 111",
+			URL:         "https://hrabritelefon.hr",
+			Country:     "HR",
+		},
+	},
+}
+
+// CrisisResourcesFor returns the crisis resources in an ISO 639-1 language,
+// falling back to English
+func CrisisResourcesFor(language string) []CrisisResource {
+	if resources, ok := crisisResources[strings.ToLower(strings.TrimSpace(language))]; ok {
+		return resources
+	}
+	return crisisResources[DefaultResourceLanguage]
+}
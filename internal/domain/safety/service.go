@@ -0,0 +1,33 @@
+package safety
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrSafetyServiceUnavailable = errors.New("safety service unavailable")
+	ErrUnauthorizedAccess       = errors.New("unauthorized access to safety alerts")
+	ErrInvalidAlertData         = errors.New("invalid safety alert data")
+)
+
+type Service interface {
+	// Public
+	GetCrisisResources(language string) []CrisisResource
+
+	// Clients, in their preferred language
+	GetClientCrisisResources(ctx context.Context, clientUserID string) []CrisisResource
+
+	// Therapists
+	GetTherapistAlerts(ctx context.Context, therapistUserID string, openOnly bool) ([]*Alert, error)
+
+	// Therapists acknowledge their own alerts; admins any alert
+	AcknowledgeAlert(ctx context.Context, userID, alertID, note string) (*Alert, error)
+
+	// Admins
+	GetEscalatedAlerts(ctx context.Context, adminUserID string, openOnly bool) ([]*Alert, error)
+
+	// EscalateOverdueAlerts escalates alerts nobody acknowledged in time and
+	// returns how many were escalated
+	EscalateOverdueAlerts(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxAttempts is how often a job is tried before it is given up on
+const MaxAttempts = 5
+
+// recurringKeyPrefix marks the keys of recurring jobs. Only one unfinished job
+// may exist under such a key.
+const recurringKeyPrefix = "recurring:"
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobDone      JobStatus = "done"
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+)
+
+// Job is a unit of work that runs once RunAt has passed. Kind picks the
+// handler; Key groups the jobs scheduled for the same thing, such as the
+// reminders of one appointment, so they can be replaced or cancelled together.
+type Job struct {
+	ID        string
+	Kind      string
+	Key       string
+	Payload   json.RawMessage
+	RunAt     time.Time
+	Status    JobStatus
+	Attempts  int
+	LastError string
+	// ClaimedUntil is when the claim of the instance running the job expires
+	ClaimedUntil *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewJob creates a pending job. The payload is stored as JSON.
+func NewJob(kind, key string, payload interface{}, runAt, now time.Time) (*Job, error) {
+	if strings.TrimSpace(kind) == "" {
+		return nil, errors.New("job kind is required")
+	}
+
+	if strings.TrimSpace(key) == "" {
+		return nil, errors.New("job key is required")
+	}
+
+	if runAt.IsZero() {
+		return nil, errors.New("job run time is required")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("job payload cannot be encoded: %w", err)
+	}
+
+	return &Job{
+		ID:        generateID(),
+		Kind:      kind,
+		Key:       key,
+		Payload:   data,
+		RunAt:     runAt,
+		Status:    JobPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// DecodePayload reads the payload into v
+func (j *Job) DecodePayload(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+func (j *Job) MarkDone(now time.Time) {
+	j.Status = JobDone
+	j.Attempts++
+	j.LastError = ""
+	j.UpdatedAt = now
+}
+
+// MarkFailed records a failed run. The job is retried after a growing delay
+// until MaxAttempts is reached, or given up on straight away when retrying
+// cannot help.
+func (j *Job) MarkFailed(cause error, retry bool, now time.Time) {
+	j.Attempts++
+	j.LastError = cause.Error()
+	j.UpdatedAt = now
+
+	if !retry || j.Attempts >= MaxAttempts {
+		j.Status = JobFailed
+		return
+	}
+
+	j.Status = JobPending
+	j.RunAt = now.Add(time.Duration(j.Attempts*j.Attempts) * time.Minute)
+}
+
+// Reschedule puts a recurring job back for its next run. A failed run is
+// recorded but not retried early: the next run does the same work.
+func (j *Job) Reschedule(cause error, runAt, now time.Time) {
+	j.Status = JobPending
+	j.RunAt = runAt
+	j.UpdatedAt = now
+	if cause != nil {
+		j.Attempts++
+		j.LastError = cause.Error()
+		return
+	}
+	j.Attempts = 0
+	j.LastError = ""
+}
+
+// RecurringKey is the key of the recurring job of the given kind
+func RecurringKey(kind string) string {
+	return recurringKeyPrefix + kind
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
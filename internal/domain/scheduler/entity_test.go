@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewJob(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	runAt := now.Add(time.Hour)
+
+	job, err := NewJob("appointment.reminder", "appointment:123", map[string]string{"user_id": "client-123"}, runAt, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if job.ID == "" || job.Status != JobPending || !job.RunAt.Equal(runAt) {
+		t.Errorf("Unexpected job %+v", job)
+	}
+
+	var payload map[string]string
+	if err := job.DecodePayload(&payload); err != nil || payload["user_id"] != "client-123" {
+		t.Errorf("Expected the payload to round-trip, got %v (%v)", payload, err)
+	}
+
+	if _, err := NewJob("", "appointment:123", nil, runAt, now); err == nil {
+		t.Error("Expected an error without a kind")
+	}
+	if _, err := NewJob("appointment.reminder", " ", nil, runAt, now); err == nil {
+		t.Error("Expected an error without a key")
+	}
+	if _, err := NewJob("appointment.reminder", "appointment:123", nil, time.Time{}, now); err == nil {
+		t.Error("Expected an error without a run time")
+	}
+	if _, err := NewJob("appointment.reminder", "appointment:123", make(chan int), runAt, now); err == nil {
+		t.Error("Expected an error for a payload that cannot be encoded")
+	}
+}
+
+func TestJob_Runs(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	job, err := NewJob("appointment.reminder", "appointment:123", nil, now, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	job.MarkFailed(errors.New("database unavailable"), true, now)
+	if job.Status != JobPending || job.Attempts != 1 || !job.RunAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected a retry after a minute, got %+v", job)
+	}
+
+	job.MarkFailed(errors.New("database unavailable"), true, now)
+	if !job.RunAt.Equal(now.Add(4 * time.Minute)) {
+		t.Errorf("Expected the retry delay to grow, got %v", job.RunAt)
+	}
+
+	job.MarkDone(now.Add(5 * time.Minute))
+	if job.Status != JobDone || job.Attempts != 3 || job.LastError != "" {
+		t.Errorf("Expected the job to be done, got %+v", job)
+	}
+
+	exhausted, _ := NewJob("appointment.reminder", "appointment:123", nil, now, now)
+	for exhausted.Attempts < MaxAttempts {
+		exhausted.MarkFailed(errors.New("database unavailable"), true, now)
+	}
+	if exhausted.Status != JobFailed || exhausted.LastError != "database unavailable" {
+		t.Errorf("Expected the job to fail after %d attempts, got %+v", MaxAttempts, exhausted)
+	}
+
+	permanent, _ := NewJob("appointment.reminder", "appointment:123", nil, now, now)
+	permanent.MarkFailed(errors.New("invalid payload"), false, now)
+	if permanent.Status != JobFailed || permanent.Attempts != 1 {
+		t.Errorf("Expected the job to fail without retrying, got %+v", permanent)
+	}
+}
+
+func TestJob_Reschedule(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	job, err := NewJob("message.retention", RecurringKey("message.retention"), nil, now, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if job.Key != "recurring:message.retention" {
+		t.Errorf("Unexpected recurring key %q", job.Key)
+	}
+
+	// Failed runs are not retried early and never give the job up
+	for i := 0; i < MaxAttempts+1; i++ {
+		job.Reschedule(errors.New("database unavailable"), now.Add(time.Hour), now)
+	}
+	if job.Status != JobPending || job.Attempts != MaxAttempts+1 || !job.RunAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("Expected the job to wait for its next run, got %+v", job)
+	}
+
+	job.Reschedule(nil, now.Add(2*time.Hour), now.Add(time.Hour))
+	if job.Status != JobPending || job.Attempts != 0 || job.LastError != "" || !job.RunAt.Equal(now.Add(2*time.Hour)) {
+		t.Errorf("Expected a successful run to clear the failures, got %+v", job)
+	}
+}
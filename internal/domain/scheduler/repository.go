@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrJobClaimLost = errors.New("scheduled job is no longer claimed by this runner")
+)
+
+type Repository interface {
+	// ReplaceJobs cancels the unfinished jobs under key and adds jobs in their
+	// place, in one transaction
+	ReplaceJobs(ctx context.Context, key string, jobs []*Job, now time.Time) error
+	// CancelJobs cancels the unfinished jobs under key and returns how many
+	// there were. A job that is running finishes its run but is not retried.
+	CancelJobs(ctx context.Context, key string, now time.Time) (int64, error)
+	// AddRecurringJob adds the first run of a recurring job unless its key
+	// already has an unfinished job. Instances may call it at the same time.
+	AddRecurringJob(ctx context.Context, job *Job) error
+	// ClaimDueJobs marks up to limit due jobs as running and returns them.
+	// Claims expire after lease, so jobs held by an instance that stopped are
+	// picked up again. Instances never claim the same job.
+	ClaimDueJobs(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*Job, error)
+	// UpdateJob records the outcome of a claimed run. It returns
+	// ErrJobClaimLost without writing when the job was cancelled or its claim
+	// expired and another instance took it over.
+	UpdateJob(ctx context.Context, job *Job) error
+}
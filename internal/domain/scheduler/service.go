@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrSchedulerUnavailable = errors.New("scheduler unavailable")
+	ErrInvalidJob           = errors.New("invalid job")
+	ErrUnknownJobKind       = errors.New("no handler for job kind")
+	// ErrPermanent marks a handler error that retrying cannot fix
+	ErrPermanent = errors.New("job cannot succeed")
+)
+
+// Service runs jobs at their scheduled time. It is safe to run on every API
+// instance at once: each due job is run by one of them.
+type Service interface {
+	// Schedule replaces the unfinished jobs under key with jobs. Scheduling no
+	// jobs cancels them.
+	Schedule(ctx context.Context, key string, jobs []*Job) error
+	// Cancel cancels the unfinished jobs under key and returns how many there were
+	Cancel(ctx context.Context, key string) (int64, error)
+	// RunDueJobs runs the jobs that are due and returns how many succeeded
+	RunDueJobs(ctx context.Context) (int, error)
+}
+
+// Handler runs the jobs of one kind. Errors wrapping ErrPermanent fail the job
+// at once; other errors are retried.
+type Handler interface {
+	Handle(ctx context.Context, job *Job) error
+}
+
+// Task is work repeated on a schedule, such as purging expired messages. It
+// returns how many items it handled.
+type Task func(ctx context.Context) (int64, error)
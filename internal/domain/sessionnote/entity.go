@@ -0,0 +1,187 @@
+package sessionnote
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Template string
+
+const (
+	// TemplateSOAP structures a note as Subjective, Objective, Assessment, Plan
+	TemplateSOAP Template = "soap"
+	// TemplateDAP structures a note as Data, Assessment, Plan
+	TemplateDAP Template = "dap"
+)
+
+const (
+	// SessionDateLayout is the format of session dates
+	SessionDateLayout = "2006-01-02"
+
+	maxSectionLength   = 20000
+	maxAmendmentLength = 20000
+	maxReasonLength    = 500
+)
+
+// templateSections lists each template's sections in the order they are written
+var templateSections = map[Template][]string{
+	TemplateSOAP: {"subjective", "objective", "assessment", "plan"},
+	TemplateDAP:  {"data", "assessment", "plan"},
+}
+
+// Note is a therapist's progress note for one session. Notes are append-only:
+// once written the content never changes, and corrections are added as amendments.
+// The content is encrypted at rest by the repository.
+type Note struct {
+	ID               string
+	ClientID         string
+	TherapistID      string
+	Template         Template
+	SessionDate      time.Time
+	Sections         []Section
+	Amendments       []*Amendment
+	SharedWithClient bool
+	SharedAt         *time.Time
+	CreatedAt        time.Time
+}
+
+// Section is one heading of the note's template with the therapist's text
+type Section struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// Amendment corrects or adds to a note after it was written
+type Amendment struct {
+	ID          string
+	NoteID      string
+	TherapistID string
+	Reason      string
+	Content     string
+	CreatedAt   time.Time
+}
+
+// TemplateSections returns the section names of a template, or nil for an unknown template
+func TemplateSections(template Template) []string {
+	return templateSections[template]
+}
+
+func NewNote(therapistID, clientID string, template Template, sessionDate time.Time, sections map[string]string, now time.Time) (*Note, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	names, ok := templateSections[template]
+	if !ok {
+		return nil, fmt.Errorf("unknown template %q - must be soap or dap", template)
+	}
+
+	sessionDate = time.Date(sessionDate.Year(), sessionDate.Month(), sessionDate.Day(), 0, 0, 0, 0, time.UTC)
+	if sessionDate.After(now) {
+		return nil, errors.New("session date cannot be in the future")
+	}
+
+	for name := range sections {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("%s notes have no %q section", template, name)
+		}
+	}
+
+	noteSections := make([]Section, 0, len(names))
+	written := false
+	for _, name := range names {
+		content := strings.TrimSpace(sections[name])
+		if len(content) > maxSectionLength {
+			return nil, fmt.Errorf("%s section must be %d characters or less", name, maxSectionLength)
+		}
+		written = written || content != ""
+		noteSections = append(noteSections, Section{Name: name, Content: content})
+	}
+
+	if !written {
+		return nil, errors.New("at least one section must be filled in")
+	}
+
+	return &Note{
+		ID:          generateID(),
+		ClientID:    clientID,
+		TherapistID: therapistID,
+		Template:    template,
+		SessionDate: sessionDate,
+		Sections:    noteSections,
+		CreatedAt:   now,
+	}, nil
+}
+
+// Amend appends a correction to the note. Only the note's author can amend it.
+func (n *Note) Amend(therapistID, reason, content string, now time.Time) (*Amendment, error) {
+	if !n.AuthoredBy(therapistID) {
+		return nil, errors.New("only the author can amend a note")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("amendment reason is required")
+	}
+
+	if len(reason) > maxReasonLength {
+		return nil, fmt.Errorf("amendment reason must be %d characters or less", maxReasonLength)
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, errors.New("amendment content is required")
+	}
+
+	if len(content) > maxAmendmentLength {
+		return nil, fmt.Errorf("amendment must be %d characters or less", maxAmendmentLength)
+	}
+
+	amendment := &Amendment{
+		ID:          generateID(),
+		NoteID:      n.ID,
+		TherapistID: therapistID,
+		Reason:      reason,
+		Content:     content,
+		CreatedAt:   now,
+	}
+
+	n.Amendments = append(n.Amendments, amendment)
+	return amendment, nil
+}
+
+// SetShared controls whether the client can read the note. Notes are private to
+// the therapist until shared explicitly.
+func (n *Note) SetShared(shared bool, now time.Time) {
+	if shared == n.SharedWithClient {
+		return
+	}
+
+	n.SharedWithClient = shared
+	if shared {
+		n.SharedAt = &now
+	} else {
+		n.SharedAt = nil
+	}
+}
+
+func (n *Note) AuthoredBy(therapistID string) bool {
+	return n.TherapistID == therapistID
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,168 @@
+package sessionnote
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewNote(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		template  Template
+		date      time.Time
+		sections  map[string]string
+		errString string
+	}{
+		{
+			name:     "complete SOAP note",
+			template: TemplateSOAP,
+			date:     today,
+			sections: map[string]string{
+				"subjective": "Reports better sleep",
+				"objective":  "Calm, good eye contact",
+				"assessment": "Improving",
+				"plan":       "Continue weekly CBT",
+			},
+		},
+		{
+			name:     "partial DAP note",
+			template: TemplateDAP,
+			date:     today.AddDate(0, 0, -2),
+			sections: map[string]string{"data": "Discussed work stress"},
+		},
+		{
+			name:      "unknown template",
+			template:  "birp",
+			date:      today,
+			sections:  map[string]string{"plan": "Follow up"},
+			errString: "unknown template",
+		},
+		{
+			name:      "section from another template",
+			template:  TemplateDAP,
+			date:      today,
+			sections:  map[string]string{"subjective": "Reports better sleep"},
+			errString: `dap notes have no "subjective" section`,
+		},
+		{
+			name:      "empty note",
+			template:  TemplateSOAP,
+			date:      today,
+			sections:  map[string]string{"plan": "   "},
+			errString: "at least one section must be filled in",
+		},
+		{
+			name:      "future session",
+			template:  TemplateSOAP,
+			date:      today.AddDate(0, 0, 1),
+			sections:  map[string]string{"plan": "Follow up"},
+			errString: "session date cannot be in the future",
+		},
+		{
+			name:      "section too long",
+			template:  TemplateSOAP,
+			date:      today,
+			sections:  map[string]string{"plan": strings.Repeat("a", maxSectionLength+1)},
+			errString: "plan section must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			note, err := NewNote("therapist-123", "client-456", tt.template, tt.date, tt.sections, now)
+
+			if tt.errString != "" {
+				if err == nil {
+					t.Fatalf("Expected error containing %q, got nil", tt.errString)
+				}
+				if !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("Expected error containing %q, got %q", tt.errString, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// Every template section is kept, in template order
+			names := TemplateSections(tt.template)
+			if len(note.Sections) != len(names) {
+				t.Fatalf("Expected %d sections, got %d", len(names), len(note.Sections))
+			}
+			for i, section := range note.Sections {
+				if section.Name != names[i] {
+					t.Errorf("Section %d = %q, want %q", i, section.Name, names[i])
+				}
+				if section.Content != strings.TrimSpace(tt.sections[section.Name]) {
+					t.Errorf("Section %q content = %q", section.Name, section.Content)
+				}
+			}
+
+			if note.SharedWithClient {
+				t.Error("New notes must not be shared with the client")
+			}
+		})
+	}
+}
+
+func TestNote_Amend(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	note, err := NewNote("therapist-123", "client-456", TemplateSOAP, now, map[string]string{"plan": "Weekly sessions"}, now)
+	if err != nil {
+		t.Fatalf("Failed to create note: %v", err)
+	}
+
+	if _, err := note.Amend("therapist-999", "Typo", "Biweekly sessions", now); err == nil {
+		t.Error("Expected error when someone other than the author amends")
+	}
+
+	if _, err := note.Amend("therapist-123", "", "Biweekly sessions", now); err == nil {
+		t.Error("Expected error without a reason")
+	}
+
+	if _, err := note.Amend("therapist-123", "Typo", "  ", now); err == nil {
+		t.Error("Expected error without content")
+	}
+
+	amendment, err := note.Amend("therapist-123", " Wrong frequency ", "Biweekly sessions", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if amendment.NoteID != note.ID || amendment.Reason != "Wrong frequency" {
+		t.Errorf("Unexpected amendment %+v", amendment)
+	}
+
+	if len(note.Amendments) != 1 || note.Sections[3].Content != "Weekly sessions" {
+		t.Error("Amending must append without changing the original content")
+	}
+}
+
+func TestNote_SetShared(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	note, err := NewNote("therapist-123", "client-456", TemplateDAP, now, map[string]string{"data": "Session one"}, now)
+	if err != nil {
+		t.Fatalf("Failed to create note: %v", err)
+	}
+
+	note.SetShared(true, now)
+	if !note.SharedWithClient || note.SharedAt == nil || !note.SharedAt.Equal(now) {
+		t.Errorf("Expected note shared at %v, got %v %v", now, note.SharedWithClient, note.SharedAt)
+	}
+
+	// Sharing again keeps the original share time
+	note.SetShared(true, now.Add(time.Hour))
+	if !note.SharedAt.Equal(now) {
+		t.Errorf("Expected share time to stay %v, got %v", now, note.SharedAt)
+	}
+
+	note.SetShared(false, now)
+	if note.SharedWithClient || note.SharedAt != nil {
+		t.Error("Expected note to be private again")
+	}
+}
@@ -0,0 +1,22 @@
+package sessionnote
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNoteNotFound = errors.New("session note not found")
+)
+
+// Repository stores notes encrypted; implementations decrypt on read, so callers
+// only ever see plaintext notes
+type Repository interface {
+	Create(ctx context.Context, note *Note) error
+	GetByID(ctx context.Context, id string) (*Note, error)
+	AddAmendment(ctx context.Context, amendment *Amendment) error
+	UpdateSharing(ctx context.Context, note *Note) error
+	// GetByClientID lists a client's notes with their amendments, newest session
+	// first. An empty therapistID matches notes by any therapist.
+	GetByClientID(ctx context.Context, clientID, therapistID string, sharedOnly bool) ([]*Note, error)
+}
@@ -0,0 +1,37 @@
+package sessionnote
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoteServiceUnavailable = errors.New("session note service unavailable")
+	ErrUnauthorizedAccess     = errors.New("unauthorized access to session note")
+	ErrInvalidNoteData        = errors.New("invalid session note data")
+	ErrClientNotAssigned      = errors.New("client is not assigned to this therapist")
+)
+
+type Service interface {
+	// Therapists
+	CreateNote(ctx context.Context, therapistUserID string, req CreateNoteRequest) (*Note, error)
+	AmendNote(ctx context.Context, therapistUserID, noteID string, req AmendNoteRequest) (*Note, error)
+	SetNoteShared(ctx context.Context, therapistUserID, noteID string, shared bool) (*Note, error)
+	GetClientNotes(ctx context.Context, therapistUserID, clientUserID string) ([]*Note, error)
+
+	// Clients
+	GetSharedNotes(ctx context.Context, clientUserID string) ([]*Note, error)
+}
+
+type CreateNoteRequest struct {
+	ClientID    string
+	Template    Template
+	SessionDate time.Time
+	Sections    map[string]string
+}
+
+type AmendNoteRequest struct {
+	Reason  string
+	Content string
+}
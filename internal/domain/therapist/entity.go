@@ -10,15 +10,29 @@ type TherapistProfile struct {
 	UserID             string
 	FirstName          string
 	LastName           string
+	Slug               string
 	LicenseNumber      string
 	Specializations    []string
+	Languages          []TherapistLanguage
 	Phone              string
 	Bio                string
 	IsAcceptingClients bool
+	OffersInPerson     bool
+	OffersRemote       bool
+	Verification       LicenseVerification
+	Rating             Rating
+	Photo              *ProfilePhoto
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
 
+// Rating summarises the visible client reviews of a therapist. It is maintained
+// alongside the reviews and never written through profile updates.
+type Rating struct {
+	Average *float64
+	Count   int
+}
+
 func NewTherapistProfile(userID, firstName, lastName, licenseNumber string) (*TherapistProfile, error) {
 	if err := validateUserID(userID); err != nil {
 		return nil, err
@@ -41,9 +55,13 @@ func NewTherapistProfile(userID, firstName, lastName, licenseNumber string) (*Th
 		UserID:             userID,
 		FirstName:          strings.TrimSpace(firstName),
 		LastName:           strings.TrimSpace(lastName),
+		Slug:               GenerateSlug(firstName, lastName),
 		LicenseNumber:      strings.TrimSpace(licenseNumber),
 		Specializations:    []string{},
+		Languages:          []TherapistLanguage{},
 		IsAcceptingClients: true,
+		OffersInPerson:     true,
+		Verification:       LicenseVerification{Status: VerificationUnverified},
 		CreatedAt:          now,
 		UpdatedAt:          now,
 	}, nil
@@ -69,7 +87,13 @@ func (t *TherapistProfile) UpdateLicenseNumber(licenseNumber string) error {
 		return err
 	}
 
-	t.LicenseNumber = strings.TrimSpace(licenseNumber)
+	licenseNumber = strings.TrimSpace(licenseNumber)
+	// A different license has to go through review again
+	if licenseNumber != t.LicenseNumber {
+		t.resetVerification()
+	}
+
+	t.LicenseNumber = licenseNumber
 	t.UpdatedAt = time.Now()
 	return nil
 }
@@ -0,0 +1,77 @@
+package therapist
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/language"
+)
+
+type LanguageProficiency string
+
+const (
+	ProficiencyNative         LanguageProficiency = "native"
+	ProficiencyFluent         LanguageProficiency = "fluent"
+	ProficiencyConversational LanguageProficiency = "conversational"
+	ProficiencyBasic          LanguageProficiency = "basic"
+)
+
+// MaxTherapistLanguages caps how many spoken languages a profile can list
+const MaxTherapistLanguages = 10
+
+func IsValidProficiency(proficiency LanguageProficiency) bool {
+	switch proficiency {
+	case ProficiencyNative, ProficiencyFluent, ProficiencyConversational, ProficiencyBasic:
+		return true
+	}
+	return false
+}
+
+// TherapistLanguage is a language a therapist can hold sessions in,
+// identified by its ISO 639-1 code
+type TherapistLanguage struct {
+	Code        string              `json:"code"`
+	Proficiency LanguageProficiency `json:"proficiency"`
+}
+
+// SetLanguages replaces the spoken languages on the profile. Codes are
+// normalized and checked against the bundled ISO 639-1 list.
+func (t *TherapistProfile) SetLanguages(languages []TherapistLanguage) error {
+	if len(languages) > MaxTherapistLanguages {
+		return fmt.Errorf("at most %d languages can be listed", MaxTherapistLanguages)
+	}
+
+	normalized := make([]TherapistLanguage, 0, len(languages))
+	seen := make(map[string]bool, len(languages))
+	for _, spoken := range languages {
+		code := language.Normalize(spoken.Code)
+		if !language.IsValid(code) {
+			return fmt.Errorf("unsupported language code: %s", spoken.Code)
+		}
+
+		if !IsValidProficiency(spoken.Proficiency) {
+			return fmt.Errorf("invalid proficiency for language %s", code)
+		}
+
+		if seen[code] {
+			return fmt.Errorf("language %s is listed more than once", code)
+		}
+		seen[code] = true
+
+		normalized = append(normalized, TherapistLanguage{Code: code, Proficiency: spoken.Proficiency})
+	}
+
+	t.Languages = normalized
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func (t *TherapistProfile) SpeaksLanguage(code string) bool {
+	code = language.Normalize(code)
+	for _, spoken := range t.Languages {
+		if spoken.Code == code {
+			return true
+		}
+	}
+	return false
+}
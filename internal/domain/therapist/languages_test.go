@@ -0,0 +1,78 @@
+package therapist
+
+import "testing"
+
+func TestTherapistProfile_SetLanguages(t *testing.T) {
+	tests := []struct {
+		name      string
+		languages []TherapistLanguage
+		wantErr   bool
+		errString string
+	}{
+		{
+			name: "valid languages",
+			languages: []TherapistLanguage{
+				{Code: "hr", Proficiency: ProficiencyNative},
+				{Code: "EN", Proficiency: ProficiencyFluent},
+				{Code: "de", Proficiency: ProficiencyConversational},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "unknown code",
+			languages: []TherapistLanguage{{Code: "xx", Proficiency: ProficiencyNative}},
+			wantErr:   true,
+			errString: "unsupported language code: xx",
+		},
+		{
+			name:      "invalid proficiency",
+			languages: []TherapistLanguage{{Code: "hr", Proficiency: "expert"}},
+			wantErr:   true,
+			errString: "invalid proficiency for language hr",
+		},
+		{
+			name: "duplicate code",
+			languages: []TherapistLanguage{
+				{Code: "hr", Proficiency: ProficiencyNative},
+				{Code: "HR", Proficiency: ProficiencyBasic},
+			},
+			wantErr:   true,
+			errString: "language hr is listed more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := NewTherapistProfile("therapist-123", "Jane", "Smith", "LIC-12345")
+			if err != nil {
+				t.Fatalf("Failed to create therapist profile: %v", err)
+			}
+
+			err = profile.SetLanguages(tt.languages)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SetLanguages() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errString {
+					t.Errorf("SetLanguages() error = %v, want %v", err.Error(), tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("SetLanguages() unexpected error = %v", err)
+				return
+			}
+
+			if len(profile.Languages) != len(tt.languages) {
+				t.Errorf("SetLanguages() stored %d languages, want %d", len(profile.Languages), len(tt.languages))
+			}
+
+			if !profile.SpeaksLanguage("en") {
+				t.Error("SetLanguages() should normalize codes to lowercase")
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package therapist
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type SessionModality string
+
+const (
+	ModalityInPerson SessionModality = "in_person"
+	ModalityRemote   SessionModality = "remote"
+)
+
+// MaxPracticeLocations caps how many offices a single therapist can list
+const MaxPracticeLocations = 10
+
+// GeoPoint is a WGS84 coordinate in decimal degrees
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (p GeoPoint) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+
+	return nil
+}
+
+// PracticeLocation is an office where a therapist sees clients in person.
+// Coordinates are supplied by the therapist; there is no server-side geocoding.
+type PracticeLocation struct {
+	ID           string
+	TherapistID  string
+	Label        string
+	AddressLine1 string
+	AddressLine2 string
+	City         string
+	Region       string
+	PostalCode   string
+	Country      string
+	Coordinates  GeoPoint
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type PracticeAddress struct {
+	Label        string
+	AddressLine1 string
+	AddressLine2 string
+	City         string
+	Region       string
+	PostalCode   string
+	Country      string
+}
+
+func NewPracticeLocation(therapistID string, address PracticeAddress, coordinates GeoPoint) (*PracticeLocation, error) {
+	if err := validateUserID(therapistID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	location := &PracticeLocation{
+		ID:          generateID(),
+		TherapistID: therapistID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := location.Update(address, coordinates); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (l *PracticeLocation) Update(address PracticeAddress, coordinates GeoPoint) error {
+	if err := validatePracticeAddress(address); err != nil {
+		return err
+	}
+
+	if err := coordinates.Validate(); err != nil {
+		return err
+	}
+
+	l.Label = strings.TrimSpace(address.Label)
+	l.AddressLine1 = strings.TrimSpace(address.AddressLine1)
+	l.AddressLine2 = strings.TrimSpace(address.AddressLine2)
+	l.City = strings.TrimSpace(address.City)
+	l.Region = strings.TrimSpace(address.Region)
+	l.PostalCode = strings.TrimSpace(address.PostalCode)
+	l.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+	l.Coordinates = coordinates
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+func (l *PracticeLocation) BelongsToTherapist(therapistID string) bool {
+	return l.TherapistID == therapistID
+}
+
+// SetModalities records whether the therapist sees clients in person, remotely, or both
+func (t *TherapistProfile) SetModalities(inPerson, remote bool) error {
+	if !inPerson && !remote {
+		return errors.New("at least one session modality must be offered")
+	}
+
+	t.OffersInPerson = inPerson
+	t.OffersRemote = remote
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func (t *TherapistProfile) OffersModality(modality SessionModality) bool {
+	switch modality {
+	case ModalityInPerson:
+		return t.OffersInPerson
+	case ModalityRemote:
+		return t.OffersRemote
+	default:
+		return false
+	}
+}
+
+func IsValidModality(modality SessionModality) bool {
+	return modality == ModalityInPerson || modality == ModalityRemote
+}
+
+func validatePracticeAddress(address PracticeAddress) error {
+	if strings.TrimSpace(address.AddressLine1) == "" {
+		return errors.New("address line 1 is required")
+	}
+
+	if len(strings.TrimSpace(address.AddressLine1)) > 255 || len(strings.TrimSpace(address.AddressLine2)) > 255 {
+		return errors.New("address lines must be 255 characters or less")
+	}
+
+	city := strings.TrimSpace(address.City)
+	if city == "" {
+		return errors.New("city is required")
+	}
+
+	if len(city) > 100 || len(strings.TrimSpace(address.Region)) > 100 || len(strings.TrimSpace(address.Label)) > 100 {
+		return errors.New("label, city and region must be 100 characters or less")
+	}
+
+	if len(strings.TrimSpace(address.PostalCode)) > 20 {
+		return errors.New("postal code must be 20 characters or less")
+	}
+
+	country := strings.TrimSpace(address.Country)
+	if len(country) != 2 {
+		return errors.New("country must be a two-letter ISO 3166 code")
+	}
+
+	for _, r := range country {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return errors.New("country must be a two-letter ISO 3166 code")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package therapist
+
+import "testing"
+
+func TestNewPracticeLocation(t *testing.T) {
+	validAddress := PracticeAddress{
+		Label:        "Downtown office",
+		AddressLine1: "1 Market St",
+		City:         "San Francisco",
+		Region:       "CA",
+		PostalCode:   "94105",
+		Country:      "us",
+	}
+	validPoint := GeoPoint{Latitude: 37.7936, Longitude: -122.3958}
+
+	tests := []struct {
+		name        string
+		therapistID string
+		address     PracticeAddress
+		coordinates GeoPoint
+		wantErr     bool
+		errString   string
+	}{
+		{
+			name:        "valid location",
+			therapistID: "therapist-123",
+			address:     validAddress,
+			coordinates: validPoint,
+			wantErr:     false,
+		},
+		{
+			name:        "missing therapist ID",
+			therapistID: "",
+			address:     validAddress,
+			coordinates: validPoint,
+			wantErr:     true,
+			errString:   "user ID is required",
+		},
+		{
+			name:        "missing street address",
+			therapistID: "therapist-123",
+			address:     PracticeAddress{City: "San Francisco", Country: "US"},
+			coordinates: validPoint,
+			wantErr:     true,
+			errString:   "address line 1 is required",
+		},
+		{
+			name:        "invalid country code",
+			therapistID: "therapist-123",
+			address:     PracticeAddress{AddressLine1: "1 Market St", City: "San Francisco", Country: "USA"},
+			coordinates: validPoint,
+			wantErr:     true,
+			errString:   "country must be a two-letter ISO 3166 code",
+		},
+		{
+			name:        "latitude out of range",
+			therapistID: "therapist-123",
+			address:     validAddress,
+			coordinates: GeoPoint{Latitude: -91, Longitude: 0},
+			wantErr:     true,
+			errString:   "latitude must be between -90 and 90",
+		},
+		{
+			name:        "longitude out of range",
+			therapistID: "therapist-123",
+			address:     validAddress,
+			coordinates: GeoPoint{Latitude: 0, Longitude: 181},
+			wantErr:     true,
+			errString:   "longitude must be between -180 and 180",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, err := NewPracticeLocation(tt.therapistID, tt.address, tt.coordinates)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NewPracticeLocation() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errString {
+					t.Errorf("NewPracticeLocation() error = %v, want %v", err.Error(), tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("NewPracticeLocation() unexpected error = %v", err)
+				return
+			}
+
+			if location.Country != "US" {
+				t.Errorf("NewPracticeLocation() Country = %v, want %v", location.Country, "US")
+			}
+
+			if location.ID == "" {
+				t.Error("NewPracticeLocation() should generate an ID")
+			}
+		})
+	}
+}
+
+func TestTherapistProfile_SetModalities(t *testing.T) {
+	profile, err := NewTherapistProfile("therapist-123", "Jane", "Smith", "LIC-12345")
+	if err != nil {
+		t.Fatalf("Failed to create therapist profile: %v", err)
+	}
+
+	if !profile.OffersModality(ModalityInPerson) || profile.OffersModality(ModalityRemote) {
+		t.Error("NewTherapistProfile() should default to in-person only")
+	}
+
+	if err := profile.SetModalities(false, false); err == nil {
+		t.Error("SetModalities() with nothing offered expected error but got none")
+	}
+
+	if err := profile.SetModalities(false, true); err != nil {
+		t.Fatalf("SetModalities() unexpected error = %v", err)
+	}
+
+	if profile.OffersModality(ModalityInPerson) || !profile.OffersModality(ModalityRemote) {
+		t.Error("SetModalities() should switch the profile to remote only")
+	}
+}
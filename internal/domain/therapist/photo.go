@@ -0,0 +1,42 @@
+package therapist
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// PhotoKeyPrefix is where profile photos live in media storage; each upload
+// gets its own key below it and one object per media.ProfilePhotoVariants entry
+const PhotoKeyPrefix = "photos/therapists"
+
+// ProfilePhoto points at the stored renditions of a therapist's photo
+type ProfilePhoto struct {
+	Key        string
+	UploadedAt time.Time
+}
+
+// SetPhoto replaces the profile photo and returns the previous one, if any, so its files can be removed
+func (t *TherapistProfile) SetPhoto(key string, now time.Time) (*ProfilePhoto, error) {
+	if !strings.HasPrefix(key, PhotoKeyPrefix+"/"+t.UserID+"/") {
+		return nil, errors.New("photo key must belong to the therapist")
+	}
+
+	previous := t.Photo
+	t.Photo = &ProfilePhoto{
+		Key:        key,
+		UploadedAt: now,
+	}
+	t.UpdatedAt = now
+	return previous, nil
+}
+
+// RemovePhoto clears the profile photo and returns the removed one, if any
+func (t *TherapistProfile) RemovePhoto(now time.Time) *ProfilePhoto {
+	previous := t.Photo
+	if previous != nil {
+		t.Photo = nil
+		t.UpdatedAt = now
+	}
+	return previous
+}
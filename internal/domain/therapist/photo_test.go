@@ -0,0 +1,47 @@
+package therapist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTherapistProfile_Photo(t *testing.T) {
+	profile, err := NewTherapistProfile("therapist-123", "Ana", "Horvat", "LIC-123")
+	if err != nil {
+		t.Fatalf("Failed to create therapist profile: %v", err)
+	}
+
+	now := time.Now()
+
+	if _, err := profile.SetPhoto(PhotoKeyPrefix+"/someone-else/abc", now); err == nil {
+		t.Error("SetPhoto() expected error for another therapist's key")
+	}
+
+	previous, err := profile.SetPhoto(PhotoKeyPrefix+"/therapist-123/first", now)
+	if err != nil {
+		t.Fatalf("SetPhoto() unexpected error = %v", err)
+	}
+	if previous != nil {
+		t.Errorf("SetPhoto() previous = %v, want nil", previous)
+	}
+
+	previous, err = profile.SetPhoto(PhotoKeyPrefix+"/therapist-123/second", now)
+	if err != nil {
+		t.Fatalf("SetPhoto() unexpected error = %v", err)
+	}
+	if previous == nil || previous.Key != PhotoKeyPrefix+"/therapist-123/first" {
+		t.Errorf("SetPhoto() previous = %v, want the first photo", previous)
+	}
+
+	removed := profile.RemovePhoto(now)
+	if removed == nil || removed.Key != PhotoKeyPrefix+"/therapist-123/second" {
+		t.Errorf("RemovePhoto() = %v, want the second photo", removed)
+	}
+	if profile.Photo != nil {
+		t.Error("RemovePhoto() should clear the photo")
+	}
+
+	if profile.RemovePhoto(now) != nil {
+		t.Error("RemovePhoto() without a photo should return nil")
+	}
+}
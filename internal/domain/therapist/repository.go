@@ -3,6 +3,9 @@ package therapist
 import (
 	"context"
 	"errors"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
@@ -10,25 +13,80 @@ var (
 	ErrTherapistProfileAlreadyExists = errors.New("therapist profile already exists")
 	ErrInvalidTherapistData          = errors.New("invalid therapist data")
 	ErrLicenseNumberAlreadyExists    = errors.New("license number already exists")
+	ErrInvalidVerificationTransition = errors.New("license verification cannot change to the requested status")
+	ErrLicenseExpired                = errors.New("license has expired")
+	ErrVerificationDocumentNotFound  = errors.New("verification document not found")
+	ErrVerificationDocumentTooLarge  = errors.New("verification document exceeds the maximum size")
+	ErrUnsupportedDocumentType       = errors.New("verification document must be a PDF, JPEG or PNG")
+	ErrPracticeLocationNotFound      = errors.New("practice location not found")
+	ErrTherapyNotInCatalog           = errors.New("specialization must be an active therapy from the catalog")
+	ErrSlugAlreadyExists             = errors.New("profile slug already exists")
 )
 
 type TherapistRepository interface {
 	Create(ctx context.Context, profile *TherapistProfile) error
 	GetByUserID(ctx context.Context, userID string) (*TherapistProfile, error)
 	GetByLicenseNumber(ctx context.Context, licenseNumber string) (*TherapistProfile, error)
+	// GetBySlug finds a profile by its current slug or by one it used before
+	GetBySlug(ctx context.Context, slug string) (*TherapistProfile, error)
+	// GetTakenSlugs lists slugs equal to base or of the form base-N that belong,
+	// now or historically, to a therapist other than exceptTherapistID
+	GetTakenSlugs(ctx context.Context, base, exceptTherapistID string) ([]string, error)
 	Update(ctx context.Context, profile *TherapistProfile) error
 	Delete(ctx context.Context, userID string) error
-	GetAcceptingClients(ctx context.Context) ([]*TherapistProfile, error)
-	GetBySpecialization(ctx context.Context, specialization string) ([]*TherapistProfile, error)
-	SearchTherapists(ctx context.Context, filters TherapistSearchFilters) ([]*TherapistProfile, error)
+	// GetAcceptingClients and GetBySpecialization page through publicly listed therapists by name
+	GetAcceptingClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*TherapistProfile], error)
+	GetBySpecialization(ctx context.Context, specialization string, page pagination.PageRequest) (*pagination.Page[*TherapistProfile], error)
+	SearchTherapists(ctx context.Context, filters TherapistSearchFilters) (*TherapistSearchPage, error)
 	ExistsByUserID(ctx context.Context, userID string) (bool, error)
 	ExistsByLicenseNumber(ctx context.Context, licenseNumber string) (bool, error)
+	GetByVerificationStatus(ctx context.Context, status VerificationStatus) ([]*TherapistProfile, error)
+	ExpireLicenses(ctx context.Context, now time.Time) (int64, error)
+	AddVerificationDocument(ctx context.Context, document *VerificationDocument) error
+	GetVerificationDocuments(ctx context.Context, therapistID string) ([]*VerificationDocument, error)
+	GetVerificationDocument(ctx context.Context, id string) (*VerificationDocument, error)
+	AddPracticeLocation(ctx context.Context, location *PracticeLocation) error
+	UpdatePracticeLocation(ctx context.Context, location *PracticeLocation) error
+	DeletePracticeLocation(ctx context.Context, id string) error
+	GetPracticeLocation(ctx context.Context, id string) (*PracticeLocation, error)
+	GetPracticeLocations(ctx context.Context, therapistID string) ([]*PracticeLocation, error)
 }
 
 type TherapistSearchFilters struct {
 	Specializations  []string
 	AcceptingClients *bool
 	SearchText       string
-	Limit            int
-	Offset           int
+	Modality         SessionModality
+	Languages        []string
+	TherapyID        string
+	Near             *GeoPoint
+	RadiusKm         float64
+	Sort             SearchSort
+	Page             pagination.PageRequest
+}
+
+// TherapistSearchResult is a matched profile plus any per-query data.
+// DistanceKm is set when the search was anchored with Near and points at the closest office.
+// Rank and Snippet are set for free-text searches; Snippet wraps matched bio words in <mark> tags.
+type TherapistSearchResult struct {
+	Profile    *TherapistProfile
+	DistanceKm *float64
+	Rank       *float64
+	Snippet    string
+}
+
+// TherapistSearchPage is one page of results plus the total and facet counts across every match
+type TherapistSearchPage struct {
+	Results    []*TherapistSearchResult
+	NextCursor string
+	Total      int
+	Facets     TherapistSearchFacets
+}
+
+type TherapistSearchFacets struct {
+	Specializations map[string]int
+	Accepting       int
+	NotAccepting    int
+	Modalities      map[SessionModality]int
+	Languages       map[string]int
 }
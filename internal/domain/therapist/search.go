@@ -0,0 +1,51 @@
+package therapist
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SearchSort orders therapist search results. The zero value keeps the default:
+// nearest first for location searches, then relevance for text searches, then name.
+type SearchSort string
+
+const (
+	SortByRelevance SearchSort = "relevance"
+	SortByName      SearchSort = "name"
+	SortByNewest    SearchSort = "newest"
+	SortByRating    SearchSort = "rating"
+)
+
+func IsValidSearchSort(sort SearchSort) bool {
+	return sort == SortByRelevance || sort == SortByName || sort == SortByNewest || sort == SortByRating
+}
+
+const (
+	// MaxSearchTerms bounds how many words of free text a single search uses
+	MaxSearchTerms      = 8
+	maxSearchTermLength = 64
+)
+
+// ParseSearchTerms splits free search text into lowercase words, dropping
+// punctuation and duplicates so the result is safe to hand to a query builder.
+func ParseSearchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(words))
+	var terms []string
+	for _, word := range words {
+		if len(word) > maxSearchTermLength || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+
+		if len(terms) == MaxSearchTerms {
+			break
+		}
+	}
+
+	return terms
+}
@@ -0,0 +1,55 @@
+package therapist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSearchTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "multiple words",
+			text: "Anxiety  Depression",
+			want: []string{"anxiety", "depression"},
+		},
+		{
+			name: "strips tsquery operators and punctuation",
+			text: "anx:* & (trauma) | !grief's",
+			want: []string{"anx", "trauma", "grief", "s"},
+		},
+		{
+			name: "drops duplicates",
+			text: "CBT cbt Cbt",
+			want: []string{"cbt"},
+		},
+		{
+			name: "only punctuation",
+			text: "  &|!  ",
+			want: nil,
+		},
+		{
+			name: "caps number of terms",
+			text: "a b c d e f g h i j",
+			want: []string{"a", "b", "c", "d", "e", "f", "g", "h"},
+		},
+		{
+			name: "drops overlong terms",
+			text: strings.Repeat("x", 65) + " couples",
+			want: []string{"couples"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseSearchTerms(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSearchTerms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
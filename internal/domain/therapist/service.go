@@ -3,17 +3,29 @@ package therapist
 import (
 	"context"
 	"errors"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
 	ErrTherapistServiceUnavailable = errors.New("therapist service unavailable")
 	ErrUnauthorizedAccess          = errors.New("unauthorized access to therapist data")
+	ErrVerificationEvidenceMissing = errors.New("at least one verification document is required")
+	ErrInvalidVerificationData     = errors.New("invalid license verification data")
+	ErrInvalidLocationData         = errors.New("invalid practice location data")
+	ErrInvalidSearchSort           = errors.New("invalid search sort")
+	ErrTooManyPracticeLocations    = errors.New("practice location limit reached")
+	ErrInvalidLanguageData         = errors.New("invalid language data")
+	ErrInvalidSlug                 = errors.New("invalid profile slug")
 )
 
 type TherapistService interface {
 	CreateProfile(ctx context.Context, userID string, req CreateProfileRequest) (*TherapistProfile, error)
 	GetProfile(ctx context.Context, userID string) (*TherapistProfile, error)
 	GetByLicenseNumber(ctx context.Context, licenseNumber string) (*TherapistProfile, error)
+	GetBySlug(ctx context.Context, slug string) (*TherapistProfile, error)
+	UpdateSlug(ctx context.Context, userID, slug string) (*TherapistProfile, error)
 	UpdatePersonalInfo(ctx context.Context, userID string, req UpdatePersonalInfoRequest) (*TherapistProfile, error)
 	UpdateLicenseNumber(ctx context.Context, userID string, licenseNumber string) (*TherapistProfile, error)
 	UpdateContactInfo(ctx context.Context, userID string, req UpdateContactInfoRequest) (*TherapistProfile, error)
@@ -22,11 +34,41 @@ type TherapistService interface {
 	AddSpecialization(ctx context.Context, userID, specialization string) (*TherapistProfile, error)
 	RemoveSpecialization(ctx context.Context, userID, specialization string) (*TherapistProfile, error)
 	SetAcceptingClients(ctx context.Context, userID string, accepting bool) (*TherapistProfile, error)
-	GetAcceptingClients(ctx context.Context) ([]*TherapistProfile, error)
-	GetBySpecialization(ctx context.Context, specialization string) ([]*TherapistProfile, error)
-	SearchTherapists(ctx context.Context, filters TherapistSearchFilters) ([]*TherapistProfile, error)
+	GetAcceptingClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*TherapistProfile], error)
+	GetBySpecialization(ctx context.Context, specialization string, page pagination.PageRequest) (*pagination.Page[*TherapistProfile], error)
+	SearchTherapists(ctx context.Context, filters TherapistSearchFilters) (*TherapistSearchPage, error)
 	DeleteProfile(ctx context.Context, userID string) error
 	ValidateLicenseNumber(ctx context.Context, licenseNumber string) error
+
+	// License verification
+	UploadVerificationDocument(ctx context.Context, userID string, req UploadVerificationDocumentRequest) (*VerificationDocument, error)
+	GetVerificationDocuments(ctx context.Context, userID string) ([]*VerificationDocument, error)
+	SubmitLicenseVerification(ctx context.Context, userID string, req SubmitVerificationRequest) (*TherapistProfile, error)
+	GetPendingVerifications(ctx context.Context, adminUserID string) ([]*TherapistProfile, error)
+	GetTherapistVerificationDocuments(ctx context.Context, adminUserID, therapistUserID string) ([]*VerificationDocument, error)
+	GetVerificationDocument(ctx context.Context, adminUserID, documentID string) (*VerificationDocument, error)
+	ApproveVerification(ctx context.Context, adminUserID, therapistUserID string) (*TherapistProfile, error)
+	RejectVerification(ctx context.Context, adminUserID, therapistUserID, reason string) (*TherapistProfile, error)
+	ExpireLicenses(ctx context.Context) (int64, error)
+
+	// Practice locations and modalities
+	SetModalities(ctx context.Context, userID string, inPerson, remote bool) (*TherapistProfile, error)
+	AddPracticeLocation(ctx context.Context, userID string, req PracticeLocationRequest) (*PracticeLocation, error)
+	UpdatePracticeLocation(ctx context.Context, userID, locationID string, req PracticeLocationRequest) (*PracticeLocation, error)
+	DeletePracticeLocation(ctx context.Context, userID, locationID string) error
+	GetPracticeLocations(ctx context.Context, therapistUserID string) ([]*PracticeLocation, error)
+
+	// Spoken languages
+	SetLanguages(ctx context.Context, userID string, languages []TherapistLanguage) (*TherapistProfile, error)
+
+	// Profile photo
+	UploadPhoto(ctx context.Context, userID string, content []byte) (*TherapistProfile, error)
+	DeletePhoto(ctx context.Context, userID string) (*TherapistProfile, error)
+}
+
+// AvailabilityNotifier is informed when a therapist starts accepting clients again
+type AvailabilityNotifier interface {
+	NotifyTherapistAvailable(ctx context.Context, therapistUserID string) (int, error)
 }
 
 type CreateProfileRequest struct {
@@ -37,6 +79,23 @@ type CreateProfileRequest struct {
 	Bio           string
 }
 
+type UploadVerificationDocumentRequest struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type SubmitVerificationRequest struct {
+	Jurisdiction     string
+	LicenseType      string
+	LicenseExpiresAt time.Time
+}
+
+type PracticeLocationRequest struct {
+	Address     PracticeAddress
+	Coordinates GeoPoint
+}
+
 type UpdatePersonalInfoRequest struct {
 	FirstName string
 	LastName  string
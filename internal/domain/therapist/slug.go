@@ -0,0 +1,119 @@
+package therapist
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	minSlugLength = 3
+	maxSlugLength = 100
+
+	// fallbackSlug is used when a name has no letters that fold to ASCII
+	fallbackSlug = "therapist"
+)
+
+// Letters that do not decompose into an ASCII base letter plus combining marks
+var slugTransliterations = map[rune]string{
+	'đ': "d",
+	'ð': "d",
+	'ł': "l",
+	'ø': "o",
+	'æ': "ae",
+	'œ': "oe",
+	'ß': "ss",
+	'þ': "th",
+	'ı': "i",
+}
+
+// GenerateSlug builds a URL slug from a therapist's name, folding accented
+// letters to ASCII, e.g. "Ana Marić" becomes "ana-maric"
+func GenerateSlug(firstName, lastName string) string {
+	name := norm.NFD.String(strings.ToLower(firstName + " " + lastName))
+
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range name {
+		replacement, ok := slugTransliterations[r]
+		switch {
+		case ok:
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			replacement = string(r)
+		case unicode.Is(unicode.Mn, r):
+			continue
+		default:
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(replacement)
+	}
+
+	slug := truncateSlug(b.String(), maxSlugLength)
+	if len(slug) < minSlugLength {
+		return fallbackSlug
+	}
+	return slug
+}
+
+// SlugCandidate returns the n-th choice for a generated slug: the base itself
+// first, then the base with a numeric suffix ("ana-maric-2", "ana-maric-3", ...)
+func SlugCandidate(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+
+	suffix := "-" + strconv.Itoa(n)
+	return truncateSlug(base, maxSlugLength-len(suffix)) + suffix
+}
+
+// SetSlug changes the public profile address. The previous slug keeps
+// redirecting to the profile, so links shared earlier stay valid.
+func (t *TherapistProfile) SetSlug(slug string) error {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if err := validateSlug(slug); err != nil {
+		return err
+	}
+
+	t.Slug = slug
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func truncateSlug(slug string, length int) string {
+	if len(slug) > length {
+		slug = slug[:length]
+	}
+	return strings.TrimRight(slug, "-")
+}
+
+func validateSlug(slug string) error {
+	if len(slug) < minSlugLength {
+		return errors.New("slug must be at least 3 characters")
+	}
+
+	if len(slug) > maxSlugLength {
+		return errors.New("slug must not exceed 100 characters")
+	}
+
+	for _, char := range slug {
+		if !((char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-') {
+			return errors.New("slug must contain only lowercase letters, numbers, and hyphens")
+		}
+	}
+
+	if strings.HasPrefix(slug, "-") || strings.HasSuffix(slug, "-") || strings.Contains(slug, "--") {
+		return errors.New("slug must not start or end with a hyphen or contain consecutive hyphens")
+	}
+
+	return nil
+}
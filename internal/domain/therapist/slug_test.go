@@ -0,0 +1,69 @@
+package therapist
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name      string
+		firstName string
+		lastName  string
+		want      string
+	}{
+		{"plain name", "Jane", "Smith", "jane-smith"},
+		{"accents are folded", "Ana", "Marić", "ana-maric"},
+		{"letters without decomposition", "Đorđe", "Łukasz", "dorde-lukasz"},
+		{"punctuation collapses to one hyphen", "  Mary-Jane ", "O'Neil, PhD", "mary-jane-o-neil-phd"},
+		{"no ASCII letters falls back", "Иван", "Петров", fallbackSlug},
+		{"long names are cut to the length limit", strings.Repeat("a", 99), "b", strings.Repeat("a", 99)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateSlug(tt.firstName, tt.lastName); got != tt.want {
+				t.Errorf("GenerateSlug(%q, %q) = %q, want %q", tt.firstName, tt.lastName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugCandidate(t *testing.T) {
+	if got := SlugCandidate("jane-smith", 1); got != "jane-smith" {
+		t.Errorf("SlugCandidate(1) = %q, want %q", got, "jane-smith")
+	}
+
+	if got := SlugCandidate("jane-smith", 3); got != "jane-smith-3" {
+		t.Errorf("SlugCandidate(3) = %q, want %q", got, "jane-smith-3")
+	}
+
+	long := strings.Repeat("a", maxSlugLength)
+	if got := SlugCandidate(long, 12); len(got) != maxSlugLength || !strings.HasSuffix(got, "-12") {
+		t.Errorf("SlugCandidate() = %q, want %d characters ending in -12", got, maxSlugLength)
+	}
+}
+
+func TestTherapistProfile_SetSlug(t *testing.T) {
+	profile, err := NewTherapistProfile("therapist-123", "Ana", "Horvat", "LIC-123")
+	if err != nil {
+		t.Fatalf("Failed to create therapist profile: %v", err)
+	}
+
+	if profile.Slug != "ana-horvat" {
+		t.Errorf("NewTherapistProfile() Slug = %q, want %q", profile.Slug, "ana-horvat")
+	}
+
+	for _, invalid := range []string{"", "ab", "ana_horvat", "-ana", "ana-", "ana--horvat", strings.Repeat("a", 101)} {
+		if err := profile.SetSlug(invalid); err == nil {
+			t.Errorf("SetSlug(%q) expected error", invalid)
+		}
+	}
+
+	if err := profile.SetSlug(" Dr-Ana-Horvat "); err != nil {
+		t.Fatalf("SetSlug() unexpected error = %v", err)
+	}
+	if profile.Slug != "dr-ana-horvat" {
+		t.Errorf("SetSlug() Slug = %q, want %q", profile.Slug, "dr-ana-horvat")
+	}
+}
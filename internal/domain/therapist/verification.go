@@ -0,0 +1,218 @@
+package therapist
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type VerificationStatus string
+
+const (
+	VerificationUnverified VerificationStatus = "unverified"
+	VerificationSubmitted  VerificationStatus = "submitted"
+	VerificationVerified   VerificationStatus = "verified"
+	VerificationRejected   VerificationStatus = "rejected"
+	VerificationExpired    VerificationStatus = "expired"
+)
+
+// MaxVerificationDocumentSize caps a single uploaded evidence file
+const MaxVerificationDocumentSize = 10 << 20
+
+var allowedVerificationContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+// LicenseVerification tracks where a therapist's license review stands.
+// Only verified profiles with an unexpired license are publicly listed.
+type LicenseVerification struct {
+	Status           VerificationStatus
+	Jurisdiction     string
+	LicenseType      string
+	LicenseExpiresAt *time.Time
+	SubmittedAt      *time.Time
+	ReviewedAt       *time.Time
+	ReviewedBy       *string
+	RejectionReason  string
+}
+
+// VerificationDocument is a piece of evidence (license scan, registry extract) uploaded for review
+type VerificationDocument struct {
+	ID          string
+	TherapistID string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Content     []byte
+	UploadedAt  time.Time
+}
+
+func NewVerificationDocument(therapistID, fileName, contentType string, content []byte) (*VerificationDocument, error) {
+	if err := validateUserID(therapistID); err != nil {
+		return nil, err
+	}
+
+	fileName = filepath.Base(strings.TrimSpace(fileName))
+	if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+		return nil, errors.New("file name is required")
+	}
+
+	if len(fileName) > 255 {
+		return nil, errors.New("file name must be 255 characters or less")
+	}
+
+	if len(content) == 0 {
+		return nil, errors.New("document is empty")
+	}
+
+	if len(content) > MaxVerificationDocumentSize {
+		return nil, ErrVerificationDocumentTooLarge
+	}
+
+	if !allowedVerificationContentTypes[contentType] {
+		return nil, ErrUnsupportedDocumentType
+	}
+
+	return &VerificationDocument{
+		ID:          generateID(),
+		TherapistID: therapistID,
+		FileName:    fileName,
+		ContentType: contentType,
+		SizeBytes:   int64(len(content)),
+		Content:     content,
+		UploadedAt:  time.Now(),
+	}, nil
+}
+
+// SubmitForVerification records the license details and queues the profile for admin review
+func (t *TherapistProfile) SubmitForVerification(jurisdiction, licenseType string, licenseExpiresAt, now time.Time) error {
+	switch t.Verification.Status {
+	case VerificationUnverified, VerificationRejected, VerificationExpired:
+	default:
+		return ErrInvalidVerificationTransition
+	}
+
+	if err := validateJurisdiction(jurisdiction); err != nil {
+		return err
+	}
+
+	if err := validateLicenseType(licenseType); err != nil {
+		return err
+	}
+
+	if !licenseExpiresAt.After(now) {
+		return errors.New("license expiry date must be in the future")
+	}
+
+	t.Verification = LicenseVerification{
+		Status:           VerificationSubmitted,
+		Jurisdiction:     strings.TrimSpace(jurisdiction),
+		LicenseType:      strings.TrimSpace(licenseType),
+		LicenseExpiresAt: &licenseExpiresAt,
+		SubmittedAt:      &now,
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+func (t *TherapistProfile) ApproveVerification(reviewerID string, now time.Time) error {
+	if t.Verification.Status != VerificationSubmitted {
+		return ErrInvalidVerificationTransition
+	}
+
+	// The license may have lapsed while the submission sat in the queue
+	if t.Verification.LicenseExpiresAt == nil || !t.Verification.LicenseExpiresAt.After(now) {
+		return ErrLicenseExpired
+	}
+
+	t.Verification.Status = VerificationVerified
+	t.Verification.ReviewedAt = &now
+	t.Verification.ReviewedBy = &reviewerID
+	t.Verification.RejectionReason = ""
+	t.UpdatedAt = now
+	return nil
+}
+
+func (t *TherapistProfile) RejectVerification(reviewerID, reason string, now time.Time) error {
+	if t.Verification.Status != VerificationSubmitted {
+		return ErrInvalidVerificationTransition
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("rejection reason is required")
+	}
+
+	t.Verification.Status = VerificationRejected
+	t.Verification.ReviewedAt = &now
+	t.Verification.ReviewedBy = &reviewerID
+	t.Verification.RejectionReason = reason
+	t.UpdatedAt = now
+	return nil
+}
+
+// ExpireVerification moves a verified profile to expired once its license lapses
+func (t *TherapistProfile) ExpireVerification(now time.Time) error {
+	if t.Verification.Status != VerificationVerified {
+		return ErrInvalidVerificationTransition
+	}
+
+	if t.Verification.LicenseExpiresAt != nil && t.Verification.LicenseExpiresAt.After(now) {
+		return ErrInvalidVerificationTransition
+	}
+
+	t.Verification.Status = VerificationExpired
+	t.UpdatedAt = now
+	return nil
+}
+
+func (t *TherapistProfile) IsVerified(now time.Time) bool {
+	return t.Verification.Status == VerificationVerified &&
+		t.Verification.LicenseExpiresAt != nil &&
+		t.Verification.LicenseExpiresAt.After(now)
+}
+
+func (t *TherapistProfile) resetVerification() {
+	t.Verification = LicenseVerification{
+		Status: VerificationUnverified,
+	}
+}
+
+func validateJurisdiction(jurisdiction string) error {
+	jurisdiction = strings.TrimSpace(jurisdiction)
+	if jurisdiction == "" {
+		return errors.New("license jurisdiction is required")
+	}
+
+	if len(jurisdiction) > 100 {
+		return errors.New("license jurisdiction must be 100 characters or less")
+	}
+
+	return nil
+}
+
+func validateLicenseType(licenseType string) error {
+	licenseType = strings.TrimSpace(licenseType)
+	if licenseType == "" {
+		return errors.New("license type is required")
+	}
+
+	if len(licenseType) > 100 {
+		return errors.New("license type must be 100 characters or less")
+	}
+
+	return nil
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,261 @@
+package therapist
+
+import (
+	"testing"
+	"time"
+)
+
+func newVerificationTestProfile(t *testing.T) *TherapistProfile {
+	t.Helper()
+
+	profile, err := NewTherapistProfile("therapist-123", "Jane", "Smith", "LIC-12345")
+	if err != nil {
+		t.Fatalf("Failed to create therapist profile: %v", err)
+	}
+	return profile
+}
+
+func TestTherapistProfile_SubmitForVerification(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		jurisdiction string
+		licenseType  string
+		expiresAt    time.Time
+		wantErr      bool
+		errString    string
+	}{
+		{
+			name:         "valid submission",
+			jurisdiction: "California",
+			licenseType:  "LMFT",
+			expiresAt:    now.AddDate(1, 0, 0),
+			wantErr:      false,
+		},
+		{
+			name:         "missing jurisdiction",
+			jurisdiction: "  ",
+			licenseType:  "LMFT",
+			expiresAt:    now.AddDate(1, 0, 0),
+			wantErr:      true,
+			errString:    "license jurisdiction is required",
+		},
+		{
+			name:         "missing license type",
+			jurisdiction: "California",
+			licenseType:  "",
+			expiresAt:    now.AddDate(1, 0, 0),
+			wantErr:      true,
+			errString:    "license type is required",
+		},
+		{
+			name:         "license already expired",
+			jurisdiction: "California",
+			licenseType:  "LMFT",
+			expiresAt:    now.AddDate(0, 0, -1),
+			wantErr:      true,
+			errString:    "license expiry date must be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := newVerificationTestProfile(t)
+
+			err := profile.SubmitForVerification(tt.jurisdiction, tt.licenseType, tt.expiresAt, now)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SubmitForVerification() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errString {
+					t.Errorf("SubmitForVerification() error = %v, want %v", err.Error(), tt.errString)
+				}
+				if profile.Verification.Status != VerificationUnverified {
+					t.Errorf("SubmitForVerification() Status = %v, want %v", profile.Verification.Status, VerificationUnverified)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("SubmitForVerification() unexpected error = %v", err)
+				return
+			}
+
+			if profile.Verification.Status != VerificationSubmitted {
+				t.Errorf("SubmitForVerification() Status = %v, want %v", profile.Verification.Status, VerificationSubmitted)
+			}
+
+			if profile.Verification.SubmittedAt == nil {
+				t.Error("SubmitForVerification() should set SubmittedAt")
+			}
+		})
+	}
+}
+
+func TestTherapistProfile_VerificationLifecycle(t *testing.T) {
+	profile := newVerificationTestProfile(t)
+	now := time.Now()
+	expiresAt := now.AddDate(1, 0, 0)
+
+	if profile.IsVerified(now) {
+		t.Error("IsVerified() new profile should not be verified")
+	}
+
+	if err := profile.ApproveVerification("admin-123", now); err != ErrInvalidVerificationTransition {
+		t.Errorf("ApproveVerification() before submission error = %v, want %v", err, ErrInvalidVerificationTransition)
+	}
+
+	if err := profile.SubmitForVerification("California", "LMFT", expiresAt, now); err != nil {
+		t.Fatalf("SubmitForVerification() unexpected error = %v", err)
+	}
+
+	if err := profile.SubmitForVerification("California", "LMFT", expiresAt, now); err != ErrInvalidVerificationTransition {
+		t.Errorf("SubmitForVerification() twice error = %v, want %v", err, ErrInvalidVerificationTransition)
+	}
+
+	if err := profile.ApproveVerification("admin-123", expiresAt.Add(time.Hour)); err != ErrLicenseExpired {
+		t.Errorf("ApproveVerification() after license expiry error = %v, want %v", err, ErrLicenseExpired)
+	}
+
+	if err := profile.ApproveVerification("admin-123", now); err != nil {
+		t.Fatalf("ApproveVerification() unexpected error = %v", err)
+	}
+
+	if !profile.IsVerified(now) {
+		t.Error("ApproveVerification() profile should be verified")
+	}
+
+	if profile.Verification.ReviewedBy == nil || *profile.Verification.ReviewedBy != "admin-123" {
+		t.Error("ApproveVerification() should record the reviewer")
+	}
+
+	if err := profile.ExpireVerification(now); err != ErrInvalidVerificationTransition {
+		t.Errorf("ExpireVerification() before license expiry error = %v, want %v", err, ErrInvalidVerificationTransition)
+	}
+
+	if profile.IsVerified(expiresAt.Add(time.Hour)) {
+		t.Error("IsVerified() should be false once the license lapses")
+	}
+
+	if err := profile.ExpireVerification(expiresAt.Add(time.Hour)); err != nil {
+		t.Errorf("ExpireVerification() unexpected error = %v", err)
+	}
+
+	if profile.Verification.Status != VerificationExpired {
+		t.Errorf("ExpireVerification() Status = %v, want %v", profile.Verification.Status, VerificationExpired)
+	}
+
+	// An expired license can be renewed by submitting again
+	renewedAt := expiresAt.Add(time.Hour)
+	if err := profile.SubmitForVerification("California", "LMFT", renewedAt.AddDate(2, 0, 0), renewedAt); err != nil {
+		t.Errorf("SubmitForVerification() after expiry unexpected error = %v", err)
+	}
+}
+
+func TestTherapistProfile_RejectVerification(t *testing.T) {
+	profile := newVerificationTestProfile(t)
+	now := time.Now()
+
+	if err := profile.SubmitForVerification("California", "LMFT", now.AddDate(1, 0, 0), now); err != nil {
+		t.Fatalf("SubmitForVerification() unexpected error = %v", err)
+	}
+
+	if err := profile.RejectVerification("admin-123", " ", now); err == nil {
+		t.Error("RejectVerification() without reason expected error but got none")
+	}
+
+	if err := profile.RejectVerification("admin-123", "License number not found in registry", now); err != nil {
+		t.Fatalf("RejectVerification() unexpected error = %v", err)
+	}
+
+	if profile.Verification.Status != VerificationRejected {
+		t.Errorf("RejectVerification() Status = %v, want %v", profile.Verification.Status, VerificationRejected)
+	}
+
+	if profile.Verification.RejectionReason == "" {
+		t.Error("RejectVerification() should record the reason")
+	}
+}
+
+func TestTherapistProfile_UpdateLicenseNumberResetsVerification(t *testing.T) {
+	profile := newVerificationTestProfile(t)
+	now := time.Now()
+
+	if err := profile.SubmitForVerification("California", "LMFT", now.AddDate(1, 0, 0), now); err != nil {
+		t.Fatalf("SubmitForVerification() unexpected error = %v", err)
+	}
+	if err := profile.ApproveVerification("admin-123", now); err != nil {
+		t.Fatalf("ApproveVerification() unexpected error = %v", err)
+	}
+
+	if err := profile.UpdateLicenseNumber("LIC-12345"); err != nil {
+		t.Fatalf("UpdateLicenseNumber() unexpected error = %v", err)
+	}
+	if !profile.IsVerified(now) {
+		t.Error("UpdateLicenseNumber() with the same number should keep verification")
+	}
+
+	if err := profile.UpdateLicenseNumber("LIC-99999"); err != nil {
+		t.Fatalf("UpdateLicenseNumber() unexpected error = %v", err)
+	}
+	if profile.Verification.Status != VerificationUnverified {
+		t.Errorf("UpdateLicenseNumber() Status = %v, want %v", profile.Verification.Status, VerificationUnverified)
+	}
+}
+
+func TestNewVerificationDocument(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		contentType string
+		content     []byte
+		wantErr     error
+	}{
+		{
+			name:        "valid pdf",
+			fileName:    "license.pdf",
+			contentType: "application/pdf",
+			content:     []byte("%PDF-1.4"),
+		},
+		{
+			name:        "unsupported type",
+			fileName:    "license.html",
+			contentType: "text/html; charset=utf-8",
+			content:     []byte("<html></html>"),
+			wantErr:     ErrUnsupportedDocumentType,
+		},
+		{
+			name:        "too large",
+			fileName:    "license.png",
+			contentType: "image/png",
+			content:     make([]byte, MaxVerificationDocumentSize+1),
+			wantErr:     ErrVerificationDocumentTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := NewVerificationDocument("therapist-123", tt.fileName, tt.contentType, tt.content)
+
+			if err != tt.wantErr {
+				t.Errorf("NewVerificationDocument() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if err == nil && document.SizeBytes != int64(len(tt.content)) {
+				t.Errorf("NewVerificationDocument() SizeBytes = %v, want %v", document.SizeBytes, len(tt.content))
+			}
+		})
+	}
+
+	document, err := NewVerificationDocument("therapist-123", "../../etc/license.pdf", "application/pdf", []byte("%PDF-1.4"))
+	if err != nil {
+		t.Fatalf("NewVerificationDocument() unexpected error = %v", err)
+	}
+	if document.FileName != "license.pdf" {
+		t.Errorf("NewVerificationDocument() FileName = %v, want %v", document.FileName, "license.pdf")
+	}
+}
@@ -3,6 +3,8 @@ package therapy
 import (
 	"context"
 	"errors"
+
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 var (
@@ -13,8 +15,8 @@ var (
 type Repository interface {
 	Create(ctx context.Context, therapy *Therapy) error
 	GetByID(ctx context.Context, id string) (*Therapy, error)
-	GetAll(ctx context.Context) ([]*Therapy, error)
-	GetAllActive(ctx context.Context) ([]*Therapy, error)
+	GetAll(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Therapy], error)
+	GetAllActive(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Therapy], error)
 	Update(ctx context.Context, therapy *Therapy) error
 	Delete(ctx context.Context, id string) error
 }
@@ -1,12 +1,16 @@
 package therapy
 
-import "context"
+import (
+	"context"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
 
 type Service interface {
 	CreateTherapy(ctx context.Context, id, title, shortDescription, icon, detailedInfo, whenNeeded string) (*Therapy, error)
 	GetTherapy(ctx context.Context, id string) (*Therapy, error)
-	ListTherapies(ctx context.Context) ([]*Therapy, error)
-	ListActiveTherapies(ctx context.Context) ([]*Therapy, error)
+	ListTherapies(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Therapy], error)
+	ListActiveTherapies(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*Therapy], error)
 	UpdateTherapy(ctx context.Context, therapy *Therapy) error
 	DeleteTherapy(ctx context.Context, id string) error
 }
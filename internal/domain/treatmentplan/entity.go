@@ -0,0 +1,404 @@
+package treatmentplan
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusActive       Status = "active"
+	StatusCompleted    Status = "completed"
+	StatusDiscontinued Status = "discontinued"
+)
+
+type GoalStatus string
+
+const (
+	GoalNotStarted   GoalStatus = "not_started"
+	GoalInProgress   GoalStatus = "in_progress"
+	GoalAchieved     GoalStatus = "achieved"
+	GoalDiscontinued GoalStatus = "discontinued"
+)
+
+const (
+	// DateLayout is the format of goal target dates
+	DateLayout = "2006-01-02"
+
+	DefaultReviewIntervalDays = 90
+
+	maxTitleLength        = 200
+	maxProblems           = 20
+	maxGoals              = 50
+	maxInterventions      = 20
+	maxItemIDLength       = 50
+	maxDescriptionLength  = 1000
+	maxInterventionLength = 500
+	maxProgressNoteLength = 5000
+	maxReviewIntervalDays = 365
+)
+
+// Plan is a client's treatment plan: the problems being worked on, measurable
+// goals for each and the interventions used to reach them. Plans are reviewed
+// on a fixed interval; NextReviewAt tells the therapist when one is due.
+type Plan struct {
+	ID                 string
+	ClientID           string
+	TherapistID        string
+	Title              string
+	Status             Status
+	Problems           []Problem
+	Goals              []Goal
+	Progress           []*ProgressUpdate
+	ReviewIntervalDays int
+	LastReviewedAt     *time.Time
+	NextReviewAt       time.Time
+	SharedWithClient   bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	ClosedAt           *time.Time
+}
+
+// Problem is a presenting issue the plan addresses. IDs are chosen by the
+// therapist (or generated) and stay stable when the plan is edited.
+type Problem struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// Goal is a measurable objective for one of the plan's problems
+type Goal struct {
+	ID            string     `json:"id"`
+	ProblemID     string     `json:"problem_id"`
+	Description   string     `json:"description"`
+	Measure       string     `json:"measure"`
+	Interventions []string   `json:"interventions,omitempty"`
+	TargetDate    *time.Time `json:"target_date,omitempty"`
+	Status        GoalStatus `json:"status"`
+}
+
+// ProgressUpdate records how a goal is going at a point in time
+type ProgressUpdate struct {
+	ID          string
+	PlanID      string
+	GoalID      string
+	TherapistID string
+	Note        string
+	Status      GoalStatus
+	CreatedAt   time.Time
+}
+
+func NewPlan(therapistID, clientID, title string, problems []Problem, goals []Goal, reviewIntervalDays int, now time.Time) (*Plan, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	plan := &Plan{
+		ID:          generateID(),
+		ClientID:    clientID,
+		TherapistID: therapistID,
+		Status:      StatusActive,
+		CreatedAt:   now,
+	}
+
+	if err := plan.Revise(title, problems, goals, reviewIntervalDays, now); err != nil {
+		return nil, err
+	}
+
+	plan.NextReviewAt = now.AddDate(0, 0, plan.ReviewIntervalDays)
+	return plan, nil
+}
+
+// Revise replaces the plan's problems and goals. Goals keep their status when
+// their ID is unchanged; new goals start as not started.
+func (p *Plan) Revise(title string, problems []Problem, goals []Goal, reviewIntervalDays int, now time.Time) error {
+	if p.Status != StatusActive {
+		return ErrPlanClosed
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.New("title is required")
+	}
+
+	if len(title) > maxTitleLength {
+		return errors.New("title must be 200 characters or less")
+	}
+
+	if reviewIntervalDays == 0 {
+		reviewIntervalDays = DefaultReviewIntervalDays
+	}
+
+	if reviewIntervalDays < 1 || reviewIntervalDays > maxReviewIntervalDays {
+		return fmt.Errorf("review interval must be between 1 and %d days", maxReviewIntervalDays)
+	}
+
+	normalizedProblems, err := normalizeProblems(problems)
+	if err != nil {
+		return err
+	}
+
+	normalizedGoals, err := normalizeGoals(goals, normalizedProblems)
+	if err != nil {
+		return err
+	}
+
+	for i, goal := range normalizedGoals {
+		if existing, ok := p.goal(goal.ID); ok {
+			normalizedGoals[i].Status = existing.Status
+		}
+	}
+
+	// A shorter interval brings the next review forward
+	if p.ReviewIntervalDays != 0 && reviewIntervalDays != p.ReviewIntervalDays {
+		from := p.CreatedAt
+		if p.LastReviewedAt != nil {
+			from = *p.LastReviewedAt
+		}
+		p.NextReviewAt = from.AddDate(0, 0, reviewIntervalDays)
+	}
+
+	p.Title = title
+	p.Problems = normalizedProblems
+	p.Goals = normalizedGoals
+	p.ReviewIntervalDays = reviewIntervalDays
+	p.UpdatedAt = now
+	return nil
+}
+
+// RecordProgress adds a progress note for a goal and moves the goal to the given status
+func (p *Plan) RecordProgress(therapistID, goalID, note string, status GoalStatus, now time.Time) (*ProgressUpdate, error) {
+	if p.Status != StatusActive {
+		return nil, ErrPlanClosed
+	}
+
+	index := slices.IndexFunc(p.Goals, func(goal Goal) bool { return goal.ID == goalID })
+	if index < 0 {
+		return nil, fmt.Errorf("plan has no goal %q", goalID)
+	}
+
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil, errors.New("progress note is required")
+	}
+
+	if len(note) > maxProgressNoteLength {
+		return nil, fmt.Errorf("progress note must be %d characters or less", maxProgressNoteLength)
+	}
+
+	if status == "" {
+		status = p.Goals[index].Status
+		if status == GoalNotStarted {
+			status = GoalInProgress
+		}
+	}
+
+	if !validGoalStatus(status) {
+		return nil, fmt.Errorf("unknown goal status %q", status)
+	}
+
+	update := &ProgressUpdate{
+		ID:          generateID(),
+		PlanID:      p.ID,
+		GoalID:      goalID,
+		TherapistID: therapistID,
+		Note:        note,
+		Status:      status,
+		CreatedAt:   now,
+	}
+
+	p.Goals[index].Status = status
+	p.Progress = append(p.Progress, update)
+	p.UpdatedAt = now
+	return update, nil
+}
+
+// MarkReviewed records a periodic review and schedules the next one
+func (p *Plan) MarkReviewed(now time.Time) error {
+	if p.Status != StatusActive {
+		return ErrPlanClosed
+	}
+
+	p.LastReviewedAt = &now
+	p.NextReviewAt = now.AddDate(0, 0, p.ReviewIntervalDays)
+	p.UpdatedAt = now
+	return nil
+}
+
+// Close ends an active plan as completed or discontinued. Closed plans are kept
+// read-only and a new plan can be started.
+func (p *Plan) Close(status Status, now time.Time) error {
+	if status != StatusCompleted && status != StatusDiscontinued {
+		return fmt.Errorf("plans can only be closed as completed or discontinued")
+	}
+
+	if p.Status != StatusActive {
+		return ErrPlanClosed
+	}
+
+	p.Status = status
+	p.ClosedAt = &now
+	p.UpdatedAt = now
+	return nil
+}
+
+// SetShared controls whether the client can read the plan
+func (p *Plan) SetShared(shared bool, now time.Time) {
+	p.SharedWithClient = shared
+	p.UpdatedAt = now
+}
+
+// ReviewDue reports whether the plan's periodic review is due
+func (p *Plan) ReviewDue(now time.Time) bool {
+	return p.Status == StatusActive && !p.NextReviewAt.After(now)
+}
+
+func (p *Plan) OwnedBy(therapistID string) bool {
+	return p.TherapistID == therapistID
+}
+
+func (p *Plan) goal(id string) (Goal, bool) {
+	for _, goal := range p.Goals {
+		if goal.ID == id {
+			return goal, true
+		}
+	}
+	return Goal{}, false
+}
+
+func normalizeProblems(problems []Problem) ([]Problem, error) {
+	if len(problems) == 0 {
+		return nil, errors.New("at least one problem is required")
+	}
+
+	if len(problems) > maxProblems {
+		return nil, fmt.Errorf("a plan can have at most %d problems", maxProblems)
+	}
+
+	normalized := make([]Problem, 0, len(problems))
+	for i, problem := range problems {
+		problem.ID = strings.TrimSpace(problem.ID)
+		if problem.ID == "" {
+			problem.ID = fmt.Sprintf("p%d", i+1)
+		}
+
+		if len(problem.ID) > maxItemIDLength {
+			return nil, fmt.Errorf("problem %d: ID must be 50 characters or less", i+1)
+		}
+
+		if slices.ContainsFunc(normalized, func(p Problem) bool { return p.ID == problem.ID }) {
+			return nil, fmt.Errorf("problem %d: duplicate problem ID %q", i+1, problem.ID)
+		}
+
+		problem.Description = strings.TrimSpace(problem.Description)
+		if problem.Description == "" {
+			return nil, fmt.Errorf("problem %d: description is required", i+1)
+		}
+
+		if len(problem.Description) > maxDescriptionLength {
+			return nil, fmt.Errorf("problem %d: description must be 1000 characters or less", i+1)
+		}
+
+		normalized = append(normalized, problem)
+	}
+
+	return normalized, nil
+}
+
+func normalizeGoals(goals []Goal, problems []Problem) ([]Goal, error) {
+	if len(goals) == 0 {
+		return nil, errors.New("at least one goal is required")
+	}
+
+	if len(goals) > maxGoals {
+		return nil, fmt.Errorf("a plan can have at most %d goals", maxGoals)
+	}
+
+	normalized := make([]Goal, 0, len(goals))
+	for i, goal := range goals {
+		goal.ID = strings.TrimSpace(goal.ID)
+		if goal.ID == "" {
+			goal.ID = fmt.Sprintf("g%d", i+1)
+		}
+
+		if len(goal.ID) > maxItemIDLength {
+			return nil, fmt.Errorf("goal %d: ID must be 50 characters or less", i+1)
+		}
+
+		if slices.ContainsFunc(normalized, func(g Goal) bool { return g.ID == goal.ID }) {
+			return nil, fmt.Errorf("goal %d: duplicate goal ID %q", i+1, goal.ID)
+		}
+
+		goal.ProblemID = strings.TrimSpace(goal.ProblemID)
+		if !slices.ContainsFunc(problems, func(p Problem) bool { return p.ID == goal.ProblemID }) {
+			return nil, fmt.Errorf("goal %d: must refer to one of the plan's problems", i+1)
+		}
+
+		goal.Description = strings.TrimSpace(goal.Description)
+		if goal.Description == "" {
+			return nil, fmt.Errorf("goal %d: description is required", i+1)
+		}
+
+		goal.Measure = strings.TrimSpace(goal.Measure)
+		if goal.Measure == "" {
+			return nil, fmt.Errorf("goal %d: a measure of success is required", i+1)
+		}
+
+		if len(goal.Description) > maxDescriptionLength || len(goal.Measure) > maxDescriptionLength {
+			return nil, fmt.Errorf("goal %d: description and measure must be 1000 characters or less", i+1)
+		}
+
+		if len(goal.Interventions) > maxInterventions {
+			return nil, fmt.Errorf("goal %d: at most %d interventions", i+1, maxInterventions)
+		}
+
+		interventions := make([]string, 0, len(goal.Interventions))
+		for _, intervention := range goal.Interventions {
+			intervention = strings.TrimSpace(intervention)
+			if intervention == "" {
+				continue
+			}
+			if len(intervention) > maxInterventionLength {
+				return nil, fmt.Errorf("goal %d: interventions must be 500 characters or less", i+1)
+			}
+			interventions = append(interventions, intervention)
+		}
+		goal.Interventions = interventions
+
+		if goal.TargetDate != nil {
+			date := time.Date(goal.TargetDate.Year(), goal.TargetDate.Month(), goal.TargetDate.Day(), 0, 0, 0, 0, time.UTC)
+			goal.TargetDate = &date
+		}
+
+		goal.Status = GoalNotStarted
+		normalized = append(normalized, goal)
+	}
+
+	return normalized, nil
+}
+
+func validGoalStatus(status GoalStatus) bool {
+	switch status {
+	case GoalNotStarted, GoalInProgress, GoalAchieved, GoalDiscontinued:
+		return true
+	}
+	return false
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,193 @@
+package treatmentplan
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func samplePlan(t *testing.T, now time.Time) *Plan {
+	t.Helper()
+
+	plan, err := NewPlan("therapist-123", "client-123", "Anxiety and sleep",
+		[]Problem{{ID: "anxiety", Description: "Persistent worry"}, {Description: "Poor sleep"}},
+		[]Goal{
+			{ID: "gad7", ProblemID: "anxiety", Description: "Reduce anxiety", Measure: "GAD-7 below 10"},
+			{ProblemID: "p2", Description: "Sleep through the night", Measure: "5 nights a week", Interventions: []string{" Sleep diary ", ""}},
+		},
+		30, now)
+	if err != nil {
+		t.Fatalf("Failed to create plan: %v", err)
+	}
+	return plan
+}
+
+func TestNewPlan(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	problems := []Problem{{ID: "anxiety", Description: "Persistent worry"}}
+
+	tests := []struct {
+		name      string
+		problems  []Problem
+		goals     []Goal
+		interval  int
+		errString string
+	}{
+		{
+			name:     "default review interval",
+			problems: problems,
+			goals:    []Goal{{ProblemID: "anxiety", Description: "Reduce anxiety", Measure: "GAD-7 below 10"}},
+		},
+		{
+			name:      "no goals",
+			problems:  problems,
+			errString: "at least one goal is required",
+		},
+		{
+			name:      "goal for an unknown problem",
+			problems:  problems,
+			goals:     []Goal{{ProblemID: "sleep", Description: "Sleep better", Measure: "Sleep diary"}},
+			errString: "must refer to one of the plan's problems",
+		},
+		{
+			name:      "goal without a measure",
+			problems:  problems,
+			goals:     []Goal{{ProblemID: "anxiety", Description: "Reduce anxiety"}},
+			errString: "a measure of success is required",
+		},
+		{
+			name:      "duplicate problem IDs",
+			problems:  []Problem{{ID: "a", Description: "One"}, {ID: "a", Description: "Two"}},
+			goals:     []Goal{{ProblemID: "a", Description: "Goal", Measure: "Measure"}},
+			errString: `duplicate problem ID "a"`,
+		},
+		{
+			name:      "review interval too long",
+			problems:  problems,
+			goals:     []Goal{{ProblemID: "anxiety", Description: "Reduce anxiety", Measure: "GAD-7 below 10"}},
+			interval:  400,
+			errString: "review interval must be between 1 and 365 days",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := NewPlan("therapist-123", "client-123", "Plan", tt.problems, tt.goals, tt.interval, now)
+			if tt.errString != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errString) {
+					t.Errorf("NewPlan() error = %v, want error containing %q", err, tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewPlan() unexpected error = %v", err)
+			}
+
+			if plan.Status != StatusActive || plan.Goals[0].Status != GoalNotStarted {
+				t.Errorf("NewPlan() status = %q, goal status = %q", plan.Status, plan.Goals[0].Status)
+			}
+			if want := now.AddDate(0, 0, DefaultReviewIntervalDays); !plan.NextReviewAt.Equal(want) {
+				t.Errorf("NewPlan() NextReviewAt = %v, want %v", plan.NextReviewAt, want)
+			}
+		})
+	}
+}
+
+func TestNewPlan_DefaultIDs(t *testing.T) {
+	plan := samplePlan(t, time.Now())
+
+	if plan.Problems[1].ID != "p2" || plan.Goals[1].ID != "g2" {
+		t.Errorf("default IDs = %q, %q, want p2, g2", plan.Problems[1].ID, plan.Goals[1].ID)
+	}
+	if got := plan.Goals[1].Interventions; len(got) != 1 || got[0] != "Sleep diary" {
+		t.Errorf("interventions = %q, want [Sleep diary]", got)
+	}
+}
+
+func TestPlan_RecordProgress(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	plan := samplePlan(t, now)
+
+	update, err := plan.RecordProgress("therapist-123", "gad7", "Score down to 12", "", now)
+	if err != nil {
+		t.Fatalf("RecordProgress() unexpected error = %v", err)
+	}
+	if update.Status != GoalInProgress || plan.Goals[0].Status != GoalInProgress {
+		t.Errorf("RecordProgress() without status = %q, want in_progress", update.Status)
+	}
+
+	if _, err := plan.RecordProgress("therapist-123", "gad7", "Score 8 twice", GoalAchieved, now); err != nil {
+		t.Fatalf("RecordProgress() unexpected error = %v", err)
+	}
+	if plan.Goals[0].Status != GoalAchieved || len(plan.Progress) != 2 {
+		t.Errorf("RecordProgress() goal status = %q with %d updates", plan.Goals[0].Status, len(plan.Progress))
+	}
+
+	if _, err := plan.RecordProgress("therapist-123", "missing", "Note", "", now); err == nil {
+		t.Error("RecordProgress() expected error for unknown goal")
+	}
+	if _, err := plan.RecordProgress("therapist-123", "gad7", "Note", "done", now); err == nil {
+		t.Error("RecordProgress() expected error for unknown status")
+	}
+}
+
+func TestPlan_Revise(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	plan := samplePlan(t, now)
+
+	if _, err := plan.RecordProgress("therapist-123", "gad7", "Started", "", now); err != nil {
+		t.Fatalf("RecordProgress() unexpected error = %v", err)
+	}
+
+	later := now.AddDate(0, 0, 7)
+	err := plan.Revise("Anxiety", []Problem{{ID: "anxiety", Description: "Persistent worry"}},
+		[]Goal{
+			{ID: "gad7", ProblemID: "anxiety", Description: "Reduce anxiety", Measure: "GAD-7 below 5"},
+			{ID: "exposure", ProblemID: "anxiety", Description: "Attend meetings", Measure: "3 a week"},
+		},
+		14, later)
+	if err != nil {
+		t.Fatalf("Revise() unexpected error = %v", err)
+	}
+
+	if plan.Goals[0].Status != GoalInProgress || plan.Goals[1].Status != GoalNotStarted {
+		t.Errorf("Revise() goal statuses = %q, %q", plan.Goals[0].Status, plan.Goals[1].Status)
+	}
+	if want := now.AddDate(0, 0, 14); !plan.NextReviewAt.Equal(want) {
+		t.Errorf("Revise() NextReviewAt = %v, want %v", plan.NextReviewAt, want)
+	}
+}
+
+func TestPlan_ReviewAndClose(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	plan := samplePlan(t, now)
+
+	dueAt := now.AddDate(0, 0, 30)
+	if plan.ReviewDue(dueAt.Add(-time.Second)) || !plan.ReviewDue(dueAt) {
+		t.Errorf("ReviewDue() should turn true at %v", dueAt)
+	}
+
+	if err := plan.MarkReviewed(dueAt); err != nil {
+		t.Fatalf("MarkReviewed() unexpected error = %v", err)
+	}
+	if want := dueAt.AddDate(0, 0, 30); !plan.NextReviewAt.Equal(want) {
+		t.Errorf("MarkReviewed() NextReviewAt = %v, want %v", plan.NextReviewAt, want)
+	}
+
+	if err := plan.Close(StatusActive, now); err == nil {
+		t.Error("Close() expected error for active status")
+	}
+	if err := plan.Close(StatusCompleted, now); err != nil {
+		t.Fatalf("Close() unexpected error = %v", err)
+	}
+	if plan.ReviewDue(dueAt.AddDate(1, 0, 0)) {
+		t.Error("ReviewDue() should be false for closed plans")
+	}
+	if err := plan.Close(StatusDiscontinued, now); err != ErrPlanClosed {
+		t.Errorf("Close() error = %v, want ErrPlanClosed", err)
+	}
+	if _, err := plan.RecordProgress("therapist-123", "gad7", "Note", "", now); err != ErrPlanClosed {
+		t.Errorf("RecordProgress() error = %v, want ErrPlanClosed", err)
+	}
+}
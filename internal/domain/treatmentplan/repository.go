@@ -0,0 +1,27 @@
+package treatmentplan
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrPlanNotFound     = errors.New("treatment plan not found")
+	ErrActivePlanExists = errors.New("client already has an active treatment plan with this therapist")
+	ErrPlanClosed       = errors.New("treatment plan is closed")
+)
+
+type Repository interface {
+	Create(ctx context.Context, plan *Plan) error
+	// GetByID returns the plan with its progress updates, oldest first
+	GetByID(ctx context.Context, id string) (*Plan, error)
+	Update(ctx context.Context, plan *Plan) error
+	// AddProgress stores the update together with the goal status it changed
+	AddProgress(ctx context.Context, plan *Plan, update *ProgressUpdate) error
+	// GetByClientID lists a client's plans, active first and newest first. An
+	// empty therapistID matches plans by any therapist.
+	GetByClientID(ctx context.Context, clientID, therapistID string, sharedOnly bool) ([]*Plan, error)
+	// GetDueForReview lists a therapist's active plans whose review is due by the given time
+	GetDueForReview(ctx context.Context, therapistID string, by time.Time) ([]*Plan, error)
+}
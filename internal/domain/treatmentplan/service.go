@@ -0,0 +1,46 @@
+package treatmentplan
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrPlanServiceUnavailable = errors.New("treatment plan service unavailable")
+	ErrUnauthorizedAccess     = errors.New("unauthorized access to treatment plan")
+	ErrInvalidPlanData        = errors.New("invalid treatment plan data")
+	ErrClientNotAssigned      = errors.New("client is not assigned to this therapist")
+)
+
+type Service interface {
+	// Therapists
+	CreatePlan(ctx context.Context, therapistUserID string, req CreatePlanRequest) (*Plan, error)
+	UpdatePlan(ctx context.Context, therapistUserID, planID string, req PlanContent) (*Plan, error)
+	RecordProgress(ctx context.Context, therapistUserID, planID string, req ProgressRequest) (*Plan, error)
+	MarkReviewed(ctx context.Context, therapistUserID, planID string) (*Plan, error)
+	ClosePlan(ctx context.Context, therapistUserID, planID string, status Status) (*Plan, error)
+	SetPlanShared(ctx context.Context, therapistUserID, planID string, shared bool) (*Plan, error)
+	GetClientPlans(ctx context.Context, therapistUserID, clientUserID string) ([]*Plan, error)
+	GetPlansDueForReview(ctx context.Context, therapistUserID string) ([]*Plan, error)
+
+	// Clients
+	GetSharedPlans(ctx context.Context, clientUserID string) ([]*Plan, error)
+}
+
+type PlanContent struct {
+	Title              string
+	Problems           []Problem
+	Goals              []Goal
+	ReviewIntervalDays int
+}
+
+type CreatePlanRequest struct {
+	ClientID string
+	PlanContent
+}
+
+type ProgressRequest struct {
+	GoalID string
+	Note   string
+	Status GoalStatus
+}
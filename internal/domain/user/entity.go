@@ -15,6 +15,9 @@ type UserRole string
 const (
 	RoleClient    UserRole = "client"
 	RoleTherapist UserRole = "therapist"
+	RoleAdmin     UserRole = "admin"
+	// RoleGuardian is a parent or legal guardian managing a minor client's account
+	RoleGuardian UserRole = "guardian"
 )
 
 type User struct {
@@ -171,6 +174,14 @@ func (u *User) IsTherapist() bool {
 	return u.Role == RoleTherapist
 }
 
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+func (u *User) IsGuardian() bool {
+	return u.Role == RoleGuardian
+}
+
 func (u *User) SetActive(active bool) {
 	u.IsActive = active
 	u.UpdatedAt = time.Now()
@@ -181,7 +192,7 @@ func validateRole(role UserRole) error {
 		return errors.New("role is required")
 	}
 
-	if role != RoleClient && role != RoleTherapist {
+	if role != RoleClient && role != RoleTherapist && role != RoleAdmin && role != RoleGuardian {
 		return errors.New("invalid user role")
 	}
 
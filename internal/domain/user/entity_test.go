@@ -291,6 +291,13 @@ func TestNewUserWithRole(t *testing.T) {
 			role:     RoleTherapist,
 			wantErr:  false,
 		},
+		{
+			name:     "valid admin user creation",
+			email:    "admin@example.com",
+			password: "SecurePass123!",
+			role:     RoleAdmin,
+			wantErr:  false,
+		},
 		{
 			name:      "invalid role",
 			email:     "user@example.com",
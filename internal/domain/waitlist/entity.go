@@ -0,0 +1,247 @@
+package waitlist
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+type EntryStatus string
+
+const (
+	StatusWaiting  EntryStatus = "waiting"
+	StatusOffered  EntryStatus = "offered"
+	StatusAccepted EntryStatus = "accepted"
+	StatusDeclined EntryStatus = "declined"
+	StatusExpired  EntryStatus = "expired"
+	StatusRemoved  EntryStatus = "removed"
+)
+
+type SessionFormat string
+
+const (
+	FormatAny      SessionFormat = "any"
+	FormatInPerson SessionFormat = "in_person"
+	FormatRemote   SessionFormat = "remote"
+)
+
+const (
+	// DefaultEntryTTL is how long a client stays on a waitlist before the entry lapses
+	DefaultEntryTTL = 90 * 24 * time.Hour
+
+	// OfferTTL is how long a client has to respond to an offered spot
+	OfferTTL = 72 * time.Hour
+)
+
+type Preferences struct {
+	SessionFormat  SessionFormat `json:"session_format"`
+	PreferredDays  []string      `json:"preferred_days"`
+	PreferredTimes []string      `json:"preferred_times"`
+	Notes          string        `json:"notes"`
+}
+
+type Entry struct {
+	ID             string
+	TherapistID    string
+	ClientID       string
+	Position       int
+	Preferences    Preferences
+	Status         EntryStatus
+	NotifiedAt     *time.Time
+	OfferedAt      *time.Time
+	OfferExpiresAt *time.Time
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewEntry(therapistID, clientID string, preferences Preferences) (*Entry, error) {
+	if strings.TrimSpace(therapistID) == "" {
+		return nil, errors.New("therapist ID is required")
+	}
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, errors.New("client ID is required")
+	}
+
+	if therapistID == clientID {
+		return nil, errors.New("client and therapist must be different users")
+	}
+
+	preferences, err := normalizePreferences(preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Entry{
+		ID:          generateID(),
+		TherapistID: therapistID,
+		ClientID:    clientID,
+		Preferences: preferences,
+		Status:      StatusWaiting,
+		ExpiresAt:   now.Add(DefaultEntryTTL),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (e *Entry) UpdatePreferences(preferences Preferences) error {
+	if !e.IsActive() {
+		return ErrInvalidStatusTransition
+	}
+
+	preferences, err := normalizePreferences(preferences)
+	if err != nil {
+		return err
+	}
+
+	e.Preferences = preferences
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Offer reserves the next free spot for this client until the offer expires
+func (e *Entry) Offer(now time.Time) error {
+	if e.Status != StatusWaiting {
+		return ErrInvalidStatusTransition
+	}
+
+	if e.IsExpired(now) {
+		return ErrEntryExpired
+	}
+
+	offerExpiresAt := now.Add(OfferTTL)
+	e.Status = StatusOffered
+	e.OfferedAt = &now
+	e.OfferExpiresAt = &offerExpiresAt
+	e.UpdatedAt = now
+	return nil
+}
+
+func (e *Entry) Accept(now time.Time) error {
+	if e.Status != StatusOffered {
+		return ErrInvalidStatusTransition
+	}
+
+	if e.IsExpired(now) {
+		return ErrEntryExpired
+	}
+
+	e.Status = StatusAccepted
+	e.UpdatedAt = now
+	return nil
+}
+
+func (e *Entry) Decline(now time.Time) error {
+	if e.Status != StatusOffered {
+		return ErrInvalidStatusTransition
+	}
+
+	e.Status = StatusDeclined
+	e.UpdatedAt = now
+	return nil
+}
+
+func (e *Entry) Remove() error {
+	if !e.IsActive() {
+		return ErrInvalidStatusTransition
+	}
+
+	e.Status = StatusRemoved
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+func (e *Entry) Expire(now time.Time) {
+	e.Status = StatusExpired
+	e.UpdatedAt = now
+}
+
+func (e *Entry) MarkNotified(now time.Time) {
+	e.NotifiedAt = &now
+	e.UpdatedAt = now
+}
+
+// IsExpired reports whether the entry (or its pending offer) has lapsed at the given time
+func (e *Entry) IsExpired(now time.Time) bool {
+	if e.Status == StatusExpired {
+		return true
+	}
+
+	if e.Status == StatusOffered && e.OfferExpiresAt != nil {
+		return !now.Before(*e.OfferExpiresAt)
+	}
+
+	return !now.Before(e.ExpiresAt)
+}
+
+func (e *Entry) IsActive() bool {
+	return e.Status == StatusWaiting || e.Status == StatusOffered
+}
+
+func (e *Entry) BelongsToClient(clientID string) bool {
+	return e.ClientID == clientID
+}
+
+func normalizePreferences(preferences Preferences) (Preferences, error) {
+	switch preferences.SessionFormat {
+	case "":
+		preferences.SessionFormat = FormatAny
+	case FormatAny, FormatInPerson, FormatRemote:
+	default:
+		return preferences, errors.New("session format must be 'any', 'in_person' or 'remote'")
+	}
+
+	days := make([]string, 0, len(preferences.PreferredDays))
+	for _, day := range preferences.PreferredDays {
+		day = strings.ToLower(strings.TrimSpace(day))
+		if day == "" {
+			continue
+		}
+		if !isValidWeekday(day) {
+			return preferences, errors.New("preferred days must be weekday names")
+		}
+		days = append(days, day)
+	}
+	preferences.PreferredDays = days
+
+	times := make([]string, 0, len(preferences.PreferredTimes))
+	for _, slot := range preferences.PreferredTimes {
+		slot = strings.ToLower(strings.TrimSpace(slot))
+		if slot == "" {
+			continue
+		}
+		if slot != "morning" && slot != "afternoon" && slot != "evening" {
+			return preferences, errors.New("preferred times must be 'morning', 'afternoon' or 'evening'")
+		}
+		times = append(times, slot)
+	}
+	preferences.PreferredTimes = times
+
+	preferences.Notes = strings.TrimSpace(preferences.Notes)
+	if len(preferences.Notes) > 500 {
+		return preferences, errors.New("notes must be 500 characters or less")
+	}
+
+	return preferences, nil
+}
+
+func isValidWeekday(day string) bool {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == day {
+			return true
+		}
+	}
+	return false
+}
+
+func generateID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(bytes)
+}
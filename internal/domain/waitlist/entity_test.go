@@ -0,0 +1,188 @@
+package waitlist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewEntry(t *testing.T) {
+	tests := []struct {
+		name        string
+		therapistID string
+		clientID    string
+		preferences Preferences
+		wantErr     bool
+		errString   string
+	}{
+		{
+			name:        "valid entry with default preferences",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			wantErr:     false,
+		},
+		{
+			name:        "valid entry with preferences",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			preferences: Preferences{
+				SessionFormat:  FormatRemote,
+				PreferredDays:  []string{"Monday", " friday "},
+				PreferredTimes: []string{"Evening"},
+			},
+			wantErr: false,
+		},
+		{
+			name:        "empty therapist ID",
+			therapistID: "",
+			clientID:    "client-123",
+			wantErr:     true,
+			errString:   "therapist ID is required",
+		},
+		{
+			name:        "empty client ID",
+			therapistID: "therapist-123",
+			clientID:    "",
+			wantErr:     true,
+			errString:   "client ID is required",
+		},
+		{
+			name:        "invalid session format",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			preferences: Preferences{SessionFormat: "phone"},
+			wantErr:     true,
+			errString:   "session format must be 'any', 'in_person' or 'remote'",
+		},
+		{
+			name:        "invalid preferred day",
+			therapistID: "therapist-123",
+			clientID:    "client-123",
+			preferences: Preferences{PreferredDays: []string{"someday"}},
+			wantErr:     true,
+			errString:   "preferred days must be weekday names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewEntry(tt.therapistID, tt.clientID, tt.preferences)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NewEntry() expected error but got none")
+					return
+				}
+				if err.Error() != tt.errString {
+					t.Errorf("NewEntry() error = %v, want %v", err.Error(), tt.errString)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("NewEntry() unexpected error = %v", err)
+				return
+			}
+
+			if entry.Status != StatusWaiting {
+				t.Errorf("NewEntry() Status = %v, want %v", entry.Status, StatusWaiting)
+			}
+
+			if entry.Preferences.SessionFormat == "" {
+				t.Error("NewEntry() should default SessionFormat")
+			}
+
+			for _, day := range entry.Preferences.PreferredDays {
+				if day != "monday" && day != "friday" {
+					t.Errorf("NewEntry() PreferredDays should be normalized, got %v", day)
+				}
+			}
+
+			if !entry.ExpiresAt.After(entry.CreatedAt) {
+				t.Error("NewEntry() ExpiresAt should be after CreatedAt")
+			}
+		})
+	}
+}
+
+func TestEntry_OfferLifecycle(t *testing.T) {
+	entry, err := NewEntry("therapist-123", "client-123", Preferences{})
+	if err != nil {
+		t.Fatalf("Failed to create waitlist entry: %v", err)
+	}
+
+	now := time.Now()
+
+	if err := entry.Accept(now); err != ErrInvalidStatusTransition {
+		t.Errorf("Accept() before offer error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	if err := entry.Offer(now); err != nil {
+		t.Fatalf("Offer() unexpected error = %v", err)
+	}
+
+	if entry.Status != StatusOffered {
+		t.Errorf("Offer() Status = %v, want %v", entry.Status, StatusOffered)
+	}
+
+	if entry.OfferExpiresAt == nil || !entry.OfferExpiresAt.Equal(now.Add(OfferTTL)) {
+		t.Error("Offer() should set OfferExpiresAt")
+	}
+
+	if err := entry.Offer(now); err != ErrInvalidStatusTransition {
+		t.Errorf("Offer() twice error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	if err := entry.Accept(now.Add(OfferTTL + time.Minute)); err != ErrEntryExpired {
+		t.Errorf("Accept() after offer expiry error = %v, want %v", err, ErrEntryExpired)
+	}
+
+	if err := entry.Accept(now.Add(time.Hour)); err != nil {
+		t.Errorf("Accept() unexpected error = %v", err)
+	}
+
+	if entry.IsActive() {
+		t.Error("Accept() entry should no longer be active")
+	}
+}
+
+func TestEntry_DeclineAndRemove(t *testing.T) {
+	entry, err := NewEntry("therapist-123", "client-123", Preferences{})
+	if err != nil {
+		t.Fatalf("Failed to create waitlist entry: %v", err)
+	}
+
+	if err := entry.Decline(time.Now()); err != ErrInvalidStatusTransition {
+		t.Errorf("Decline() without offer error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+
+	if err := entry.Remove(); err != nil {
+		t.Errorf("Remove() unexpected error = %v", err)
+	}
+
+	if entry.Status != StatusRemoved {
+		t.Errorf("Remove() Status = %v, want %v", entry.Status, StatusRemoved)
+	}
+
+	if err := entry.Remove(); err != ErrInvalidStatusTransition {
+		t.Errorf("Remove() twice error = %v, want %v", err, ErrInvalidStatusTransition)
+	}
+}
+
+func TestEntry_IsExpired(t *testing.T) {
+	entry, err := NewEntry("therapist-123", "client-123", Preferences{})
+	if err != nil {
+		t.Fatalf("Failed to create waitlist entry: %v", err)
+	}
+
+	if entry.IsExpired(time.Now()) {
+		t.Error("IsExpired() new entry should not be expired")
+	}
+
+	if !entry.IsExpired(entry.ExpiresAt.Add(time.Second)) {
+		t.Error("IsExpired() should be true after ExpiresAt")
+	}
+
+	if err := entry.Offer(entry.ExpiresAt.Add(time.Second)); err != ErrEntryExpired {
+		t.Errorf("Offer() on expired entry error = %v, want %v", err, ErrEntryExpired)
+	}
+}
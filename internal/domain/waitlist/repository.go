@@ -0,0 +1,24 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrEntryNotFound           = errors.New("waitlist entry not found")
+	ErrAlreadyOnWaitlist       = errors.New("client is already on this therapist's waitlist")
+	ErrInvalidStatusTransition = errors.New("waitlist entry cannot change to the requested status")
+	ErrEntryExpired            = errors.New("waitlist entry has expired")
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *Entry) error
+	GetByID(ctx context.Context, id string) (*Entry, error)
+	Update(ctx context.Context, entry *Entry) error
+	GetActiveByClientID(ctx context.Context, clientID string) ([]*Entry, error)
+	GetActiveByTherapistID(ctx context.Context, therapistID string) ([]*Entry, error)
+	GetNextWaiting(ctx context.Context, therapistID string) (*Entry, error)
+	ExpireStale(ctx context.Context, now time.Time) (int64, error)
+}
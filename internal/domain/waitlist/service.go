@@ -0,0 +1,38 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrWaitlistServiceUnavailable = errors.New("waitlist service unavailable")
+	ErrUnauthorizedAccess         = errors.New("unauthorized access to waitlist data")
+	ErrTherapistAcceptingClients  = errors.New("therapist is currently accepting clients")
+	ErrNoWaitingClients           = errors.New("no clients are waiting for this therapist")
+	ErrInvalidWaitlistData        = errors.New("invalid waitlist data")
+)
+
+type Service interface {
+	JoinWaitlist(ctx context.Context, clientUserID string, req JoinWaitlistRequest) (*Entry, error)
+	UpdatePreferences(ctx context.Context, clientUserID, entryID string, preferences Preferences) (*Entry, error)
+	LeaveWaitlist(ctx context.Context, clientUserID, entryID string) error
+	GetClientWaitlists(ctx context.Context, clientUserID string) ([]*Entry, error)
+	GetTherapistWaitlist(ctx context.Context, therapistUserID string) ([]*Entry, error)
+	OfferNextSpot(ctx context.Context, therapistUserID string) (*Entry, error)
+	RespondToOffer(ctx context.Context, clientUserID, entryID string, accept bool) (*Entry, error)
+	NotifyTherapistAvailable(ctx context.Context, therapistUserID string) (int, error)
+	ExpireStaleEntries(ctx context.Context) (int64, error)
+}
+
+// Notifier publishes waitlist events to other systems. It returns an error
+// when an event could not be published.
+type Notifier interface {
+	NotifyTherapistAvailable(ctx context.Context, entry *Entry) error
+	NotifySpotOffered(ctx context.Context, entry *Entry) error
+}
+
+type JoinWaitlistRequest struct {
+	TherapistID string
+	Preferences Preferences
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/goran/thappy/internal/domain/article"
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 type ArticleHandler struct {
@@ -129,25 +131,36 @@ func (h *ArticleHandler) listArticles(w http.ResponseWriter, r *http.Request) {
 	publishedOnly := r.URL.Query().Get("published") == "true"
 	category := r.URL.Query().Get("category")
 
-	var articles []*article.Article
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	page := pageQuery.ToDomain()
+
+	var articles *pagination.Page[*article.Article]
 	var err error
 
 	// Filter by category and published status
 	if category != "" {
 		if publishedOnly {
-			articles, err = h.articleService.ListPublishedArticlesByCategory(r.Context(), category)
+			articles, err = h.articleService.ListPublishedArticlesByCategory(r.Context(), category, page)
 		} else {
-			articles, err = h.articleService.ListArticlesByCategory(r.Context(), category)
+			articles, err = h.articleService.ListArticlesByCategory(r.Context(), category, page)
 		}
 	} else {
 		if publishedOnly {
-			articles, err = h.articleService.ListPublishedArticles(r.Context())
+			articles, err = h.articleService.ListPublishedArticles(r.Context(), page)
 		} else {
-			articles, err = h.articleService.ListArticles(r.Context())
+			articles, err = h.articleService.ListArticles(r.Context(), page)
 		}
 	}
 
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get articles")
 		return
 	}
@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+)
+
+type AssessmentHandler struct {
+	assessmentService assessmentDomain.Service
+	safetyService     safetyDomain.Service
+}
+
+func NewAssessmentHandler(assessmentService assessmentDomain.Service, safetyService safetyDomain.Service) *AssessmentHandler {
+	return &AssessmentHandler{
+		assessmentService: assessmentService,
+		safetyService:     safetyService,
+	}
+}
+
+// GetInstruments lists the built-in instruments with their items and scoring bands
+func (h *AssessmentHandler) GetInstruments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToInstrumentListResponse(h.assessmentService.GetInstruments()))
+}
+
+// HandleTherapistAssessments serves GET (a client's assessments) and POST (assign an instrument) on /api/therapist/assessments
+func (h *AssessmentHandler) HandleTherapistAssessments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientAssessments(w, r)
+	case http.MethodPost:
+		h.AssignAssessment(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *AssessmentHandler) GetClientAssessments(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	assessments, err := h.assessmentService.GetClientAssessments(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToAssessmentListResponse(assessments))
+}
+
+func (h *AssessmentHandler) AssignAssessment(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req AssignAssessmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assessment, err := h.assessmentService.AssignAssessment(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := AssessmentResponse{
+		Assessment: ToAssessmentResponse(assessment),
+		Message:    "Assessment assigned successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *AssessmentHandler) CancelAssessment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CancelAssessmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assessment, err := h.assessmentService.CancelAssessment(r.Context(), userID, req.AssessmentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := AssessmentResponse{
+		Assessment: ToAssessmentResponse(assessment),
+		Message:    "Assessment cancelled successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetScoreHistory returns a client's completed scores on one instrument, oldest first
+func (h *AssessmentHandler) GetScoreHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	clientID := strings.TrimSpace(query.Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	instrumentCode := strings.TrimSpace(query.Get("instrument"))
+	if instrumentCode == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingInstrument.Error())
+		return
+	}
+
+	assessments, err := h.assessmentService.GetScoreHistory(r.Context(), userID, clientID, instrumentCode)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	instrument, _ := assessmentDomain.GetInstrument(instrumentCode)
+	h.writeJSONResponse(w, http.StatusOK, ToScoreHistoryResponse(instrument.Code, clientID, assessments))
+}
+
+func (h *AssessmentHandler) GetOwnAssessments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	assessments, err := h.assessmentService.GetOwnAssessments(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToAssessmentListResponse(assessments))
+}
+
+func (h *AssessmentHandler) CompleteAssessment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CompleteAssessmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assessment, err := h.assessmentService.CompleteAssessment(r.Context(), userID, req.AssessmentID, req.Responses)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := AssessmentResponse{
+		Assessment: ToAssessmentResponse(assessment),
+		Message:    "Assessment completed successfully",
+	}
+	// Endorsing a critical item raises a safety alert; show the client where to get help now
+	if assessment.Score.HasCriticalItems() {
+		response.CrisisResources = ToCrisisResourceList(h.safetyService.GetClientCrisisResources(r.Context(), userID))
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *AssessmentHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *AssessmentHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *AssessmentHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, assessmentDomain.ErrAssessmentNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Assessment not found")
+	case errors.Is(err, assessmentDomain.ErrUnknownInstrument):
+		h.writeErrorResponse(w, http.StatusBadRequest, "Unknown instrument - must be one of PHQ-9, GAD-7 or PCL-5")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, assessmentDomain.ErrAlreadyAssigned):
+		h.writeErrorResponse(w, http.StatusConflict, "Client already has this instrument open")
+	case errors.Is(err, assessmentDomain.ErrInvalidStatusTransition):
+		h.writeErrorResponse(w, http.StatusConflict, "Assessment is no longer open")
+	case errors.Is(err, assessmentDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, assessmentDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, assessmentDomain.ErrAssessmentServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Assessment service temporarily unavailable")
+	case errors.Is(err, assessmentDomain.ErrInvalidAssessmentData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled assessment service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *AssessmentHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
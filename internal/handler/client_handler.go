@@ -38,10 +38,10 @@ func (h *ClientHandler) CreateProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	createReq := clientDomain.CreateProfileRequest{
-		FirstName:        req.FirstName,
-		LastName:         req.LastName,
-		Phone:            req.Phone,
-		EmergencyContact: req.EmergencyContact,
+		FirstName:         req.FirstName,
+		LastName:          req.LastName,
+		Phone:             req.Phone,
+		EmergencyContacts: toDomainEmergencyContacts(req.EmergencyContacts),
 	}
 
 	profile, err := h.clientService.CreateProfile(r.Context(), userID, createReq)
@@ -128,12 +128,12 @@ func (h *ClientHandler) UpdateContactInfo(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	updateReq := clientDomain.UpdateContactInfoRequest{
-		Phone:            req.Phone,
-		EmergencyContact: req.EmergencyContact,
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	profile, err := h.clientService.UpdateContactInfo(r.Context(), userID, updateReq)
+	profile, err := h.clientService.UpdateContactInfo(r.Context(), userID, req.ToDomain())
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
@@ -178,6 +178,33 @@ func (h *ClientHandler) SetDateOfBirth(w http.ResponseWriter, r *http.Request) {
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
 
+func (h *ClientHandler) SetPreferredLanguage(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SetPreferredLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	profile, err := h.clientService.SetPreferredLanguage(r.Context(), userID, req.PreferredLanguage)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ClientProfileResponse{
+		Profile: ToClientProfileResponse(profile),
+		Message: "Preferred language updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
 func (h *ClientHandler) DeleteProfile(w http.ResponseWriter, r *http.Request) {
 	userID, err := h.getUserIDFromContext(r)
 	if err != nil {
@@ -223,9 +250,11 @@ func (h *ClientHandler) handleServiceError(w http.ResponseWriter, err error) {
 	case errors.Is(err, clientDomain.ErrClientProfileAlreadyExists):
 		h.writeErrorResponse(w, http.StatusConflict, "Client profile already exists")
 	case errors.Is(err, clientDomain.ErrInvalidClientData):
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid client data")
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
 	case errors.Is(err, clientDomain.ErrUnauthorizedAccess):
 		h.writeErrorResponse(w, http.StatusForbidden, "Access denied - client role required")
+	case errors.Is(err, clientDomain.ErrManagedByGuardian):
+		h.writeErrorResponse(w, http.StatusForbidden, "Your guardian manages your date of birth until you come of age")
 	case errors.Is(err, clientDomain.ErrClientServiceUnavailable):
 		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Client service temporarily unavailable")
 	default:
@@ -0,0 +1,524 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+)
+
+type ConsentHandler struct {
+	consentService consentDomain.Service
+}
+
+func NewConsentHandler(consentService consentDomain.Service) *ConsentHandler {
+	return &ConsentHandler{
+		consentService: consentService,
+	}
+}
+
+// HandleAdminDocuments serves GET (list), POST (create) and PUT (update) on /api/admin/consents
+func (h *ConsentHandler) HandleAdminDocuments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.ListDocuments(w, r)
+	case http.MethodPost:
+		h.CreateDocument(w, r)
+	case http.MethodPut:
+		h.UpdateDocument(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// HandleAdminVersions serves GET (version history) and POST (publish) on /api/admin/consents/versions
+func (h *ConsentHandler) HandleAdminVersions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetVersions(w, r)
+	case http.MethodPost:
+		h.PublishVersion(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *ConsentHandler) ListDocuments(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	documents, err := h.consentService.ListDocuments(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentDocumentListResponse(documents))
+}
+
+func (h *ConsentHandler) CreateDocument(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateConsentDocumentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	document, err := h.consentService.CreateDocument(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConsentDocumentResponse{
+		Document: ToConsentDocumentResponse(document),
+		Message:  "Consent document published successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *ConsentHandler) UpdateDocument(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateConsentDocumentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	document, err := h.consentService.UpdateDocument(r.Context(), userID, req.DocumentID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConsentDocumentResponse{
+		Document: ToConsentDocumentResponse(document),
+		Message:  "Consent document updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *ConsentHandler) GetVersions(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	documentID := strings.TrimSpace(r.URL.Query().Get("document_id"))
+	if documentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingConsentDocumentID.Error())
+		return
+	}
+
+	versions, err := h.consentService.GetVersions(r.Context(), userID, documentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentVersionListResponse(versions))
+}
+
+// PublishVersion publishes new text for a document; clients are asked to sign it again
+func (h *ConsentHandler) PublishVersion(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req PublishConsentVersionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	document, err := h.consentService.PublishVersion(r.Context(), userID, req.DocumentID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConsentDocumentResponse{
+		Document: ToConsentDocumentResponse(document),
+		Message:  "New version published successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// GetOwnStatus lists every consent document with whether the client still has to sign it
+func (h *ConsentHandler) GetOwnStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	statuses, err := h.consentService.GetOwnStatus(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentStatusResponse(statuses))
+}
+
+func (h *ConsentHandler) Sign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SignConsentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	signature, err := h.consentService.Sign(r.Context(), userID, req.ToDomain(remoteIP(r), r.UserAgent()))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConsentSignatureResponse{
+		Signature: ToConsentSignatureResponse(signature),
+		Message:   "Consent signed successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *ConsentHandler) GetOwnSignatures(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	signatures, err := h.consentService.GetOwnSignatures(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentSignatureListResponse(signatures))
+}
+
+// DownloadSignedRecord sends a signature as a PDF of the signed text and signature details
+func (h *ConsentHandler) DownloadSignedRecord(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	signatureID := strings.TrimSpace(r.URL.Query().Get("signature_id"))
+	if signatureID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingSignatureID.Error())
+		return
+	}
+
+	record, err := h.consentService.GetSignedRecord(r.Context(), userID, signatureID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeRecord(w, signatureID, record)
+}
+
+// GetClientStatus shows a therapist which consents an assigned client has signed
+func (h *ConsentHandler) GetClientStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	statuses, err := h.consentService.GetClientStatus(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentStatusResponse(statuses))
+}
+
+// GetMinorStatus lists every consent document with whether the guardian still has to sign it for the minor
+func (h *ConsentHandler) GetMinorStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	statuses, err := h.consentService.GetMinorStatus(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConsentStatusResponse(statuses))
+}
+
+func (h *ConsentHandler) SignForMinor(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SignMinorConsentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	signature, err := h.consentService.SignForMinor(r.Context(), userID, strings.TrimSpace(req.ClientID), req.ToDomain(remoteIP(r), r.UserAgent()))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConsentSignatureResponse{
+		Signature: ToConsentSignatureResponse(signature),
+		Message:   "Consent signed on behalf of the client",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// DownloadMinorSignedRecord sends one of the minor's signatures as a PDF
+func (h *ConsentHandler) DownloadMinorSignedRecord(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	signatureID := strings.TrimSpace(r.URL.Query().Get("signature_id"))
+	if signatureID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingSignatureID.Error())
+		return
+	}
+
+	record, err := h.consentService.GetMinorSignedRecord(r.Context(), userID, clientID, signatureID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeRecord(w, signatureID, record)
+}
+
+// remoteIP is the address the request came from. The API is served directly,
+// so forwarding headers, which clients can set freely, are not trusted.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Helper methods
+
+func (h *ConsentHandler) writeRecord(w http.ResponseWriter, signatureID string, record []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Length", strconv.Itoa(len(record)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "consent-" + signatureID + ".pdf"}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(record); err != nil {
+		log.Printf("Error writing signed consent record: %v", err)
+	}
+}
+
+func (h *ConsentHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *ConsentHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *ConsentHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, consentDomain.ErrDocumentNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Consent document not found")
+	case errors.Is(err, consentDomain.ErrVersionNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Consent document version not found")
+	case errors.Is(err, consentDomain.ErrSignatureNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Consent signature not found")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, consentDomain.ErrAlreadySigned):
+		h.writeErrorResponse(w, http.StatusConflict, "This version is already signed")
+	case errors.Is(err, consentDomain.ErrVersionSuperseded):
+		h.writeErrorResponse(w, http.StatusConflict, "A newer version has been published - please review and sign it")
+	case errors.Is(err, consentDomain.ErrDocumentHashMismatch):
+		h.writeErrorResponse(w, http.StatusConflict, "Document text does not match the published version - please reload it")
+	case errors.Is(err, consentDomain.ErrVersionConflict):
+		h.writeErrorResponse(w, http.StatusConflict, "Another version was published at the same time - please try again")
+	case errors.Is(err, consentDomain.ErrGuardianConsentRequired):
+		h.writeErrorResponse(w, http.StatusForbidden, "Consent for a minor must be given by their guardian")
+	case errors.Is(err, guardianDomain.ErrGuardianshipNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Guardianship not found")
+	case errors.Is(err, guardianDomain.ErrGuardianshipEnded):
+		h.writeErrorResponse(w, http.StatusForbidden, "Guardianship has ended")
+	case errors.Is(err, guardianDomain.ErrClientNotMinor):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client has come of age and gives their own consent")
+	case errors.Is(err, guardianDomain.ErrScopeNotGranted):
+		h.writeErrorResponse(w, http.StatusForbidden, "You have no access to this client's consent forms")
+	case errors.Is(err, guardianDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, guardianDomain.ErrGuardianServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Guardian service temporarily unavailable")
+	case errors.Is(err, consentDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, consentDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, consentDomain.ErrConsentServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Consent service temporarily unavailable")
+	case errors.Is(err, consentDomain.ErrInvalidConsentData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled consent service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *ConsentHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
@@ -1,16 +1,35 @@
 package handler
 
 import (
+	"encoding/json"
+	"html"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	articleDomain "github.com/goran/thappy/internal/domain/article"
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	"github.com/goran/thappy/internal/domain/language"
+	"github.com/goran/thappy/internal/domain/media"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	"github.com/goran/thappy/internal/domain/pagination"
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
 	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
 	"github.com/goran/thappy/internal/domain/user"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
 )
 
 // Request DTOs
@@ -30,10 +49,10 @@ type UpdateProfileRequest struct {
 
 // Client Profile Request DTOs
 type CreateClientProfileRequest struct {
-	FirstName        string `json:"first_name"`
-	LastName         string `json:"last_name"`
-	Phone            string `json:"phone,omitempty"`
-	EmergencyContact string `json:"emergency_contact,omitempty"`
+	FirstName         string                 `json:"first_name"`
+	LastName          string                 `json:"last_name"`
+	Phone             string                 `json:"phone,omitempty"`
+	EmergencyContacts []EmergencyContactData `json:"emergency_contacts,omitempty"`
 }
 
 type UpdateClientPersonalInfoRequest struct {
@@ -41,15 +60,21 @@ type UpdateClientPersonalInfoRequest struct {
 	LastName  string `json:"last_name"`
 }
 
+// UpdateClientContactInfoRequest replaces the phone and, when present, the
+// whole list of emergency contacts; an empty list removes them all
 type UpdateClientContactInfoRequest struct {
-	Phone            string `json:"phone,omitempty"`
-	EmergencyContact string `json:"emergency_contact,omitempty"`
+	Phone             string                  `json:"phone,omitempty"`
+	EmergencyContacts *[]EmergencyContactData `json:"emergency_contacts,omitempty"`
 }
 
 type SetDateOfBirthRequest struct {
 	DateOfBirth *string `json:"date_of_birth"`
 }
 
+type SetPreferredLanguageRequest struct {
+	PreferredLanguage *string `json:"preferred_language"`
+}
+
 // Therapist Profile Request DTOs
 type CreateTherapistProfileRequest struct {
 	FirstName     string `json:"first_name"`
@@ -96,8 +121,49 @@ type SearchTherapistsRequest struct {
 	SearchText       string   `json:"search_text,omitempty"`
 	Specializations  []string `json:"specializations,omitempty"`
 	AcceptingClients *bool    `json:"accepting_clients,omitempty"`
-	Limit            int      `json:"limit,omitempty"`
-	Offset           int      `json:"offset,omitempty"`
+	Modality         string   `json:"modality,omitempty"`
+	Languages        []string `json:"languages,omitempty"`
+	NearLatitude     *float64 `json:"near_latitude,omitempty"`
+	NearLongitude    *float64 `json:"near_longitude,omitempty"`
+	RadiusKm         float64  `json:"radius_km,omitempty"`
+	Sort             string   `json:"sort,omitempty"`
+	PageQuery
+}
+
+// PageQuery is the cursor and page size accepted by every list endpoint
+type PageQuery struct {
+	Cursor string `json:"cursor,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+type UpdateProfileSlugRequest struct {
+	Slug string `json:"slug"`
+}
+
+type SetTherapistLanguagesRequest struct {
+	Languages []TherapistLanguageData `json:"languages"`
+}
+
+type SetModalitiesRequest struct {
+	OffersInPerson *bool `json:"offers_in_person"`
+	OffersRemote   *bool `json:"offers_remote"`
+}
+
+type PracticeLocationRequest struct {
+	LocationID   string   `json:"location_id,omitempty"`
+	Label        string   `json:"label,omitempty"`
+	AddressLine1 string   `json:"address_line1"`
+	AddressLine2 string   `json:"address_line2,omitempty"`
+	City         string   `json:"city"`
+	Region       string   `json:"region,omitempty"`
+	PostalCode   string   `json:"postal_code,omitempty"`
+	Country      string   `json:"country"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
+}
+
+type DeletePracticeLocationRequest struct {
+	LocationID string `json:"location_id"`
 }
 
 // Role-based Registration Request
@@ -124,16 +190,27 @@ type ClientProfileResponse struct {
 }
 
 type ClientProfileData struct {
-	UserID           string     `json:"user_id"`
-	FirstName        string     `json:"first_name"`
-	LastName         string     `json:"last_name"`
-	Phone            string     `json:"phone,omitempty"`
-	EmergencyContact string     `json:"emergency_contact,omitempty"`
-	DateOfBirth      *time.Time `json:"date_of_birth,omitempty"`
-	TherapistID      *string    `json:"therapist_id,omitempty"`
-	Notes            string     `json:"notes,omitempty"`
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at"`
+	UserID            string                 `json:"user_id"`
+	FirstName         string                 `json:"first_name"`
+	LastName          string                 `json:"last_name"`
+	Phone             string                 `json:"phone,omitempty"`
+	EmergencyContacts []EmergencyContactData `json:"emergency_contacts"`
+	DateOfBirth       *time.Time             `json:"date_of_birth,omitempty"`
+	TherapistID       *string                `json:"therapist_id,omitempty"`
+	PreferredLanguage *string                `json:"preferred_language,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+type EmergencyContactData struct {
+	Name             string `json:"name"`
+	Relationship     string `json:"relationship"`
+	Phone            string `json:"phone,omitempty"`
+	Email            string `json:"email,omitempty"`
+	Priority         int    `json:"priority"`
+	ConsentToContact bool   `json:"consent_to_contact"`
+	// NeedsCompletion is only set in responses, for contacts without a phone or email
+	NeedsCompletion bool `json:"needs_completion,omitempty"`
 }
 
 // Therapist Profile Response DTOs
@@ -143,16 +220,98 @@ type TherapistProfileResponse struct {
 }
 
 type TherapistProfileData struct {
-	UserID           string    `json:"user_id"`
-	FirstName        string    `json:"first_name"`
-	LastName         string    `json:"last_name"`
-	LicenseNumber    string    `json:"license_number"`
-	Phone            string    `json:"phone,omitempty"`
-	Bio              string    `json:"bio,omitempty"`
-	Specializations  []string  `json:"specializations"`
-	AcceptingClients bool      `json:"accepting_clients"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	UserID             string                  `json:"user_id"`
+	FirstName          string                  `json:"first_name"`
+	LastName           string                  `json:"last_name"`
+	Slug               string                  `json:"slug"`
+	LicenseNumber      string                  `json:"license_number"`
+	Phone              string                  `json:"phone,omitempty"`
+	Bio                string                  `json:"bio,omitempty"`
+	Specializations    []string                `json:"specializations"`
+	Languages          []TherapistLanguageData `json:"languages"`
+	AcceptingClients   bool                    `json:"accepting_clients"`
+	OffersInPerson     bool                    `json:"offers_in_person"`
+	OffersRemote       bool                    `json:"offers_remote"`
+	VerificationStatus string                  `json:"verification_status"`
+	RatingAverage      *float64                `json:"rating_average,omitempty"`
+	ReviewCount        int                     `json:"review_count"`
+	PhotoURL           string                  `json:"photo_url,omitempty"`
+	ThumbnailURL       string                  `json:"thumbnail_url,omitempty"`
+	Locations          []PracticeLocationData  `json:"locations,omitempty"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+}
+
+type TherapistLanguageData struct {
+	Code        string `json:"code"`
+	Name        string `json:"name,omitempty"`
+	Proficiency string `json:"proficiency"`
+}
+
+type LanguageData struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type LanguageListResponse struct {
+	Languages []LanguageData `json:"languages"`
+	Total     int            `json:"total"`
+}
+
+type TherapistSearchResultData struct {
+	TherapistProfileData
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+	Rank       *float64 `json:"rank,omitempty"`
+	Snippet    string   `json:"snippet,omitempty"`
+}
+
+type TherapistSearchResponse struct {
+	Therapists []TherapistSearchResultData `json:"therapists"`
+	NextCursor string                      `json:"next_cursor,omitempty"`
+	Total      int                         `json:"total"`
+	Facets     TherapistSearchFacetsData   `json:"facets"`
+	Message    string                      `json:"message,omitempty"`
+}
+
+type TherapistListResponse struct {
+	Therapists []TherapistProfileData `json:"therapists"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+	Message    string                 `json:"message,omitempty"`
+}
+
+type TherapistSearchFacetsData struct {
+	Specializations  map[string]int     `json:"specializations"`
+	AcceptingClients AcceptingFacetData `json:"accepting_clients"`
+	Modalities       map[string]int     `json:"modalities"`
+	Languages        map[string]int     `json:"languages"`
+}
+
+type AcceptingFacetData struct {
+	Accepting    int `json:"accepting"`
+	NotAccepting int `json:"not_accepting"`
+}
+
+type PracticeLocationData struct {
+	ID           string  `json:"id"`
+	Label        string  `json:"label,omitempty"`
+	AddressLine1 string  `json:"address_line1"`
+	AddressLine2 string  `json:"address_line2,omitempty"`
+	City         string  `json:"city"`
+	Region       string  `json:"region,omitempty"`
+	PostalCode   string  `json:"postal_code,omitempty"`
+	Country      string  `json:"country"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+}
+
+type PracticeLocationResponse struct {
+	Location PracticeLocationData `json:"location"`
+	Message  string               `json:"message,omitempty"`
+}
+
+type PracticeLocationListResponse struct {
+	Locations []PracticeLocationData `json:"locations"`
+	Total     int                    `json:"total"`
 }
 
 type RegisterResponse struct {
@@ -194,31 +353,160 @@ func ToUserResponse(user *user.User) UserResponse {
 
 func ToClientProfileResponse(profile *clientDomain.ClientProfile) ClientProfileData {
 	return ClientProfileData{
-		UserID:           profile.UserID,
-		FirstName:        profile.FirstName,
-		LastName:         profile.LastName,
-		Phone:            profile.Phone,
-		EmergencyContact: profile.EmergencyContact,
-		DateOfBirth:      profile.DateOfBirth,
-		TherapistID:      profile.TherapistID,
-		Notes:            profile.Notes,
-		CreatedAt:        profile.CreatedAt,
-		UpdatedAt:        profile.UpdatedAt,
+		UserID:            profile.UserID,
+		FirstName:         profile.FirstName,
+		LastName:          profile.LastName,
+		Phone:             profile.Phone,
+		EmergencyContacts: ToEmergencyContactList(profile.EmergencyContacts),
+		DateOfBirth:       profile.DateOfBirth,
+		TherapistID:       profile.TherapistID,
+		PreferredLanguage: profile.PreferredLanguage,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
 	}
 }
 
 func ToTherapistProfileResponse(profile *therapistDomain.TherapistProfile) TherapistProfileData {
+	var photoURL, thumbnailURL string
+	if profile.Photo != nil {
+		photoURL = MediaURL(media.VariantKey(profile.Photo.Key, media.VariantLarge))
+		thumbnailURL = MediaURL(media.VariantKey(profile.Photo.Key, media.VariantThumbnail))
+	}
+
 	return TherapistProfileData{
-		UserID:           profile.UserID,
-		FirstName:        profile.FirstName,
-		LastName:         profile.LastName,
-		LicenseNumber:    profile.LicenseNumber,
-		Phone:            profile.Phone,
-		Bio:              profile.Bio,
-		Specializations:  profile.Specializations,
-		AcceptingClients: profile.IsAcceptingClients,
-		CreatedAt:        profile.CreatedAt,
-		UpdatedAt:        profile.UpdatedAt,
+		UserID:             profile.UserID,
+		FirstName:          profile.FirstName,
+		LastName:           profile.LastName,
+		Slug:               profile.Slug,
+		LicenseNumber:      profile.LicenseNumber,
+		Phone:              profile.Phone,
+		Bio:                profile.Bio,
+		Specializations:    profile.Specializations,
+		Languages:          ToTherapistLanguagesResponse(profile.Languages),
+		AcceptingClients:   profile.IsAcceptingClients,
+		OffersInPerson:     profile.OffersInPerson,
+		OffersRemote:       profile.OffersRemote,
+		VerificationStatus: string(profile.Verification.Status),
+		RatingAverage:      profile.Rating.Average,
+		ReviewCount:        profile.Rating.Count,
+		PhotoURL:           photoURL,
+		ThumbnailURL:       thumbnailURL,
+		CreatedAt:          profile.CreatedAt,
+		UpdatedAt:          profile.UpdatedAt,
+	}
+}
+
+func ToTherapistLanguagesResponse(languages []therapistDomain.TherapistLanguage) []TherapistLanguageData {
+	data := make([]TherapistLanguageData, len(languages))
+	for i, spoken := range languages {
+		data[i] = TherapistLanguageData{
+			Code:        spoken.Code,
+			Name:        language.Name(spoken.Code),
+			Proficiency: string(spoken.Proficiency),
+		}
+	}
+	return data
+}
+
+func ToLanguageListResponse(languages []language.Language) LanguageListResponse {
+	data := make([]LanguageData, len(languages))
+	for i, l := range languages {
+		data[i] = LanguageData{Code: l.Code, Name: l.Name}
+	}
+
+	return LanguageListResponse{
+		Languages: data,
+		Total:     len(data),
+	}
+}
+
+func ToTherapistSearchResultResponse(result *therapistDomain.TherapistSearchResult) TherapistSearchResultData {
+	return TherapistSearchResultData{
+		TherapistProfileData: ToTherapistProfileResponse(result.Profile),
+		DistanceKm:           result.DistanceKm,
+		Rank:                 result.Rank,
+		Snippet:              escapeSearchSnippet(result.Snippet),
+	}
+}
+
+func ToTherapistSearchResponse(page *therapistDomain.TherapistSearchPage) TherapistSearchResponse {
+	therapists := make([]TherapistSearchResultData, len(page.Results))
+	for i, result := range page.Results {
+		therapists[i] = ToTherapistSearchResultResponse(result)
+	}
+
+	modalities := make(map[string]int, len(page.Facets.Modalities))
+	for modality, count := range page.Facets.Modalities {
+		modalities[string(modality)] = count
+	}
+
+	specializations := page.Facets.Specializations
+	if specializations == nil {
+		specializations = map[string]int{}
+	}
+
+	languages := page.Facets.Languages
+	if languages == nil {
+		languages = map[string]int{}
+	}
+
+	return TherapistSearchResponse{
+		Therapists: therapists,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
+		Facets: TherapistSearchFacetsData{
+			Specializations: specializations,
+			AcceptingClients: AcceptingFacetData{
+				Accepting:    page.Facets.Accepting,
+				NotAccepting: page.Facets.NotAccepting,
+			},
+			Modalities: modalities,
+			Languages:  languages,
+		},
+	}
+}
+
+// escapeSearchSnippet HTML-escapes bio text while keeping the <mark> highlight tags added by search
+func escapeSearchSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, "&lt;mark&gt;", "<mark>")
+	return strings.ReplaceAll(escaped, "&lt;/mark&gt;", "</mark>")
+}
+
+func ToPracticeLocationResponse(location *therapistDomain.PracticeLocation) PracticeLocationData {
+	return PracticeLocationData{
+		ID:           location.ID,
+		Label:        location.Label,
+		AddressLine1: location.AddressLine1,
+		AddressLine2: location.AddressLine2,
+		City:         location.City,
+		Region:       location.Region,
+		PostalCode:   location.PostalCode,
+		Country:      location.Country,
+		Latitude:     location.Coordinates.Latitude,
+		Longitude:    location.Coordinates.Longitude,
+	}
+}
+
+func ToTherapistListResponse(page *pagination.Page[*therapistDomain.TherapistProfile]) TherapistListResponse {
+	responses := make([]TherapistProfileData, len(page.Items))
+	for i, profile := range page.Items {
+		responses[i] = ToTherapistProfileResponse(profile)
+	}
+	return TherapistListResponse{
+		Therapists: responses,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToPracticeLocationListResponse(locations []*therapistDomain.PracticeLocation) PracticeLocationListResponse {
+	responses := make([]PracticeLocationData, len(locations))
+	for i, location := range locations {
+		responses[i] = ToPracticeLocationResponse(location)
+	}
+	return PracticeLocationListResponse{
+		Locations: responses,
+		Total:     len(responses),
 	}
 }
 
@@ -237,13 +525,14 @@ func ToTherapyResponse(therapy *therapyDomain.Therapy) TherapyResponse {
 	}
 }
 
-func ToTherapyListResponse(therapies []*therapyDomain.Therapy) TherapyListResponse {
-	responses := make([]TherapyResponse, len(therapies))
-	for i, t := range therapies {
+func ToTherapyListResponse(page *pagination.Page[*therapyDomain.Therapy]) TherapyListResponse {
+	responses := make([]TherapyResponse, len(page.Items))
+	for i, t := range page.Items {
 		responses[i] = ToTherapyResponse(t)
 	}
 	return TherapyListResponse{
-		Therapies: responses,
+		Therapies:  responses,
+		NextCursor: page.NextCursor,
 	}
 }
 
@@ -282,7 +571,7 @@ func (r *RegisterWithRoleRequest) Validate() error {
 	if r.Password == "" {
 		return ErrMissingPassword
 	}
-	if r.Role != user.RoleClient && r.Role != user.RoleTherapist {
+	if r.Role != user.RoleClient && r.Role != user.RoleTherapist && r.Role != user.RoleGuardian {
 		return ErrInvalidRole
 	}
 	return nil
@@ -296,7 +585,7 @@ func (r *CreateClientProfileRequest) Validate() error {
 	if r.LastName == "" {
 		return ErrMissingLastName
 	}
-	return nil
+	return validateEmergencyContacts(r.EmergencyContacts)
 }
 
 func (r *UpdateClientPersonalInfoRequest) Validate() error {
@@ -310,9 +599,75 @@ func (r *UpdateClientPersonalInfoRequest) Validate() error {
 }
 
 func (r *UpdateClientContactInfoRequest) Validate() error {
+	if r.EmergencyContacts != nil {
+		return validateEmergencyContacts(*r.EmergencyContacts)
+	}
+	return nil
+}
+
+func (r *UpdateClientContactInfoRequest) ToDomain() clientDomain.UpdateContactInfoRequest {
+	req := clientDomain.UpdateContactInfoRequest{
+		Phone: r.Phone,
+	}
+	if r.EmergencyContacts != nil {
+		contacts := toDomainEmergencyContacts(*r.EmergencyContacts)
+		req.EmergencyContacts = &contacts
+	}
+	return req
+}
+
+func ToEmergencyContactList(contacts []clientDomain.EmergencyContact) []EmergencyContactData {
+	data := make([]EmergencyContactData, len(contacts))
+	for i, contact := range contacts {
+		data[i] = EmergencyContactData{
+			Name:             contact.Name,
+			Relationship:     string(contact.Relationship),
+			Phone:            contact.Phone,
+			Email:            contact.Email,
+			Priority:         contact.Priority,
+			ConsentToContact: contact.ConsentToContact,
+			NeedsCompletion:  contact.NeedsCompletion(),
+		}
+	}
+	return data
+}
+
+// validateEmergencyContacts checks the fields the API requires; the profile
+// checks the rest and normalizes phone numbers
+func validateEmergencyContacts(contacts []EmergencyContactData) error {
+	for _, contact := range contacts {
+		if strings.TrimSpace(contact.Name) == "" {
+			return ErrMissingContactName
+		}
+		if !clientDomain.IsValidContactRelationship(contactRelationship(contact.Relationship)) {
+			return ErrInvalidContactRelationship
+		}
+		if strings.TrimSpace(contact.Phone) == "" && strings.TrimSpace(contact.Email) == "" {
+			return ErrMissingContactMethod
+		}
+	}
 	return nil
 }
 
+func toDomainEmergencyContacts(contacts []EmergencyContactData) []clientDomain.EmergencyContact {
+	domainContacts := make([]clientDomain.EmergencyContact, len(contacts))
+	for i, contact := range contacts {
+		domainContacts[i] = clientDomain.EmergencyContact{
+			Name:             contact.Name,
+			Relationship:     contactRelationship(contact.Relationship),
+			Phone:            contact.Phone,
+			Email:            contact.Email,
+			Priority:         contact.Priority,
+			ConsentToContact: contact.ConsentToContact,
+		}
+	}
+	return domainContacts
+}
+
+func contactRelationship(relationship string) clientDomain.ContactRelationship {
+	return clientDomain.ContactRelationship(strings.ToLower(strings.TrimSpace(relationship)))
+}
+
 // Therapist Profile Validation Functions
 func (r *CreateTherapistProfileRequest) Validate() error {
 	if r.FirstName == "" {
@@ -413,7 +768,8 @@ type CreateTherapyResponse struct {
 }
 
 type TherapyListResponse struct {
-	Therapies []TherapyResponse `json:"therapies"`
+	Therapies  []TherapyResponse `json:"therapies"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 type TherapyDetailResponse struct {
@@ -501,7 +857,8 @@ type CreateArticleResponse struct {
 }
 
 type ArticleListResponse struct {
-	Articles []ArticleSummaryResponse `json:"articles"`
+	Articles   []ArticleSummaryResponse `json:"articles"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
 }
 
 type ArticleDetailResponse struct {
@@ -543,13 +900,14 @@ func ToArticleSummaryResponse(a *articleDomain.Article) ArticleSummaryResponse {
 	}
 }
 
-func ToArticleListResponse(articles []*articleDomain.Article) ArticleListResponse {
-	responses := make([]ArticleSummaryResponse, len(articles))
-	for i, a := range articles {
+func ToArticleListResponse(page *pagination.Page[*articleDomain.Article]) ArticleListResponse {
+	responses := make([]ArticleSummaryResponse, len(page.Items))
+	for i, a := range page.Items {
 		responses[i] = ToArticleSummaryResponse(a)
 	}
 	return ArticleListResponse{
-		Articles: responses,
+		Articles:   responses,
+		NextCursor: page.NextCursor,
 	}
 }
 
@@ -605,49 +963,3137 @@ func (r *SearchTherapistsRequest) FromQueryParams(params url.Values) error {
 		r.AcceptingClients = &accepting
 	}
 
-	// Parse limit
+	// Parse languages from comma-separated ISO 639-1 codes
+	if codes := params.Get("languages"); codes != "" {
+		for _, code := range strings.Split(codes, ",") {
+			r.Languages = append(r.Languages, language.Normalize(code))
+		}
+	}
+
+	r.Modality = strings.TrimSpace(params.Get("modality"))
+	r.Sort = strings.TrimSpace(params.Get("sort"))
+
+	// Parse near as "lat,lon"
+	if near := params.Get("near"); near != "" {
+		parts := strings.Split(near, ",")
+		if len(parts) != 2 {
+			return ErrInvalidNearValue
+		}
+		latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		if err != nil {
+			return ErrInvalidNearValue
+		}
+		longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return ErrInvalidNearValue
+		}
+		r.NearLatitude = &latitude
+		r.NearLongitude = &longitude
+	}
+
+	// Parse radius_km
+	if radiusStr := params.Get("radius_km"); radiusStr != "" {
+		radius, err := strconv.ParseFloat(radiusStr, 64)
+		if err != nil || radius <= 0 {
+			return ErrInvalidRadiusValue
+		}
+		r.RadiusKm = radius
+	}
+
+	return r.PageQuery.FromQueryParams(params)
+}
+
+func (q *PageQuery) FromQueryParams(params url.Values) error {
+	q.Cursor = strings.TrimSpace(params.Get("cursor"))
+
 	if limitStr := params.Get("limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil || limit < 0 {
 			return ErrInvalidLimitValue
 		}
-		r.Limit = limit
-	}
-
-	// Parse offset
-	if offsetStr := params.Get("offset"); offsetStr != "" {
-		offset, err := strconv.Atoi(offsetStr)
-		if err != nil || offset < 0 {
-			return ErrInvalidOffsetValue
-		}
-		r.Offset = offset
+		q.Limit = limit
 	}
 
 	return nil
 }
 
+// ToDomain applies the default page size and caps oversized pages
+func (q PageQuery) ToDomain() pagination.PageRequest {
+	return pagination.PageRequest{Cursor: q.Cursor, Limit: q.Limit}.Normalize()
+}
+
 func (r *SearchTherapistsRequest) Validate() error {
 	if r.Limit < 0 {
 		return ErrInvalidLimitValue
 	}
-	if r.Offset < 0 {
-		return ErrInvalidOffsetValue
+	if r.Modality != "" && !therapistDomain.IsValidModality(therapistDomain.SessionModality(r.Modality)) {
+		return ErrInvalidModalityValue
+	}
+	if r.Sort != "" && !therapistDomain.IsValidSearchSort(therapistDomain.SearchSort(r.Sort)) {
+		return ErrInvalidSortValue
+	}
+	for _, code := range r.Languages {
+		if !language.IsValid(language.Normalize(code)) {
+			return ErrInvalidLanguagesValue
+		}
+	}
+	if r.NearLatitude == nil {
+		if r.RadiusKm > 0 {
+			return ErrRadiusWithoutNear
+		}
+		return nil
+	}
+	if *r.NearLatitude < -90 || *r.NearLatitude > 90 || *r.NearLongitude < -180 || *r.NearLongitude > 180 {
+		return ErrInvalidNearValue
 	}
-	if r.Limit > 100 {
-		r.Limit = 100 // Cap at 100 for performance
+	if r.RadiusKm == 0 {
+		r.RadiusKm = 25 // Default radius
 	}
-	if r.Limit == 0 {
-		r.Limit = 20 // Default limit
+	if r.RadiusKm > 500 {
+		r.RadiusKm = 500 // Cap at 500 km for performance
 	}
 	return nil
 }
 
 func (r *SearchTherapistsRequest) ToTherapistSearchFilters() therapistDomain.TherapistSearchFilters {
-	return therapistDomain.TherapistSearchFilters{
+	filters := therapistDomain.TherapistSearchFilters{
 		SearchText:       r.SearchText,
 		Specializations:  r.Specializations,
 		AcceptingClients: r.AcceptingClients,
-		Limit:            r.Limit,
-		Offset:           r.Offset,
+		Modality:         therapistDomain.SessionModality(r.Modality),
+		Languages:        r.Languages,
+		Sort:             therapistDomain.SearchSort(r.Sort),
+		Page:             r.PageQuery.ToDomain(),
+	}
+
+	if r.NearLatitude != nil && r.NearLongitude != nil {
+		filters.Near = &therapistDomain.GeoPoint{
+			Latitude:  *r.NearLatitude,
+			Longitude: *r.NearLongitude,
+		}
+		filters.RadiusKm = r.RadiusKm
+	}
+
+	return filters
+}
+
+func (r *UpdateProfileSlugRequest) Validate() error {
+	if strings.TrimSpace(r.Slug) == "" {
+		return ErrMissingProfileSlug
+	}
+	return nil
+}
+
+func (r *SetTherapistLanguagesRequest) Validate() error {
+	if r.Languages == nil {
+		return ErrMissingLanguages
+	}
+	return nil
+}
+
+func (r *SetTherapistLanguagesRequest) ToDomain() []therapistDomain.TherapistLanguage {
+	languages := make([]therapistDomain.TherapistLanguage, len(r.Languages))
+	for i, spoken := range r.Languages {
+		languages[i] = therapistDomain.TherapistLanguage{
+			Code:        spoken.Code,
+			Proficiency: therapistDomain.LanguageProficiency(spoken.Proficiency),
+		}
+	}
+	return languages
+}
+
+func (r *SetModalitiesRequest) Validate() error {
+	if r.OffersInPerson == nil || r.OffersRemote == nil {
+		return ErrMissingModalities
+	}
+	return nil
+}
+
+func (r *PracticeLocationRequest) Validate() error {
+	if strings.TrimSpace(r.AddressLine1) == "" {
+		return ErrMissingAddress
+	}
+	if strings.TrimSpace(r.City) == "" {
+		return ErrMissingCity
+	}
+	if strings.TrimSpace(r.Country) == "" {
+		return ErrMissingCountry
+	}
+	if r.Latitude == nil || r.Longitude == nil {
+		return ErrMissingCoordinates
+	}
+	return nil
+}
+
+func (r *PracticeLocationRequest) ToDomain() therapistDomain.PracticeLocationRequest {
+	return therapistDomain.PracticeLocationRequest{
+		Address: therapistDomain.PracticeAddress{
+			Label:        r.Label,
+			AddressLine1: r.AddressLine1,
+			AddressLine2: r.AddressLine2,
+			City:         r.City,
+			Region:       r.Region,
+			PostalCode:   r.PostalCode,
+			Country:      r.Country,
+		},
+		Coordinates: therapistDomain.GeoPoint{
+			Latitude:  *r.Latitude,
+			Longitude: *r.Longitude,
+		},
+	}
+}
+
+func (r *DeletePracticeLocationRequest) Validate() error {
+	if strings.TrimSpace(r.LocationID) == "" {
+		return ErrMissingLocationID
+	}
+	return nil
+}
+
+// Waitlist Request DTOs
+type WaitlistPreferencesData struct {
+	SessionFormat  string   `json:"session_format,omitempty"`
+	PreferredDays  []string `json:"preferred_days,omitempty"`
+	PreferredTimes []string `json:"preferred_times,omitempty"`
+	Notes          string   `json:"notes,omitempty"`
+}
+
+type JoinWaitlistRequest struct {
+	TherapistID string                  `json:"therapist_id"`
+	Preferences WaitlistPreferencesData `json:"preferences"`
+}
+
+type UpdateWaitlistPreferencesRequest struct {
+	EntryID     string                  `json:"entry_id"`
+	Preferences WaitlistPreferencesData `json:"preferences"`
+}
+
+type WaitlistEntryActionRequest struct {
+	EntryID string `json:"entry_id"`
+}
+
+type RespondToWaitlistOfferRequest struct {
+	EntryID string `json:"entry_id"`
+	Accept  bool   `json:"accept"`
+}
+
+// Waitlist Response DTOs
+type WaitlistEntryData struct {
+	ID             string                  `json:"id"`
+	TherapistID    string                  `json:"therapist_id"`
+	ClientID       string                  `json:"client_id"`
+	Position       int                     `json:"position,omitempty"`
+	Status         string                  `json:"status"`
+	Preferences    WaitlistPreferencesData `json:"preferences"`
+	NotifiedAt     *time.Time              `json:"notified_at,omitempty"`
+	OfferedAt      *time.Time              `json:"offered_at,omitempty"`
+	OfferExpiresAt *time.Time              `json:"offer_expires_at,omitempty"`
+	ExpiresAt      time.Time               `json:"expires_at"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+type WaitlistEntryResponse struct {
+	Entry   WaitlistEntryData `json:"entry"`
+	Message string            `json:"message,omitempty"`
+}
+
+type WaitlistListResponse struct {
+	Entries []WaitlistEntryData `json:"entries"`
+	Total   int                 `json:"total"`
+}
+
+// Waitlist Helper Functions
+func (p WaitlistPreferencesData) ToDomain() waitlistDomain.Preferences {
+	return waitlistDomain.Preferences{
+		SessionFormat:  waitlistDomain.SessionFormat(p.SessionFormat),
+		PreferredDays:  p.PreferredDays,
+		PreferredTimes: p.PreferredTimes,
+		Notes:          p.Notes,
+	}
+}
+
+func ToWaitlistEntryResponse(entry *waitlistDomain.Entry) WaitlistEntryData {
+	return WaitlistEntryData{
+		ID:          entry.ID,
+		TherapistID: entry.TherapistID,
+		ClientID:    entry.ClientID,
+		Position:    entry.Position,
+		Status:      string(entry.Status),
+		Preferences: WaitlistPreferencesData{
+			SessionFormat:  string(entry.Preferences.SessionFormat),
+			PreferredDays:  entry.Preferences.PreferredDays,
+			PreferredTimes: entry.Preferences.PreferredTimes,
+			Notes:          entry.Preferences.Notes,
+		},
+		NotifiedAt:     entry.NotifiedAt,
+		OfferedAt:      entry.OfferedAt,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		ExpiresAt:      entry.ExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
+
+func ToWaitlistListResponse(entries []*waitlistDomain.Entry) WaitlistListResponse {
+	responses := make([]WaitlistEntryData, len(entries))
+	for i, entry := range entries {
+		responses[i] = ToWaitlistEntryResponse(entry)
+	}
+	return WaitlistListResponse{
+		Entries: responses,
+		Total:   len(responses),
+	}
+}
+
+// Waitlist Validation Functions
+func (r *JoinWaitlistRequest) Validate() error {
+	if strings.TrimSpace(r.TherapistID) == "" {
+		return ErrMissingTherapistID
+	}
+	return nil
+}
+
+func (r *UpdateWaitlistPreferencesRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingWaitlistEntryID
+	}
+	return nil
+}
+
+func (r *WaitlistEntryActionRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingWaitlistEntryID
+	}
+	return nil
+}
+
+func (r *RespondToWaitlistOfferRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingWaitlistEntryID
+	}
+	return nil
+}
+
+// License Verification Request DTOs
+type SubmitLicenseVerificationRequest struct {
+	Jurisdiction     string `json:"jurisdiction"`
+	LicenseType      string `json:"license_type"`
+	LicenseExpiresAt string `json:"license_expires_at"`
+}
+
+type ApproveVerificationRequest struct {
+	TherapistID string `json:"therapist_id"`
+}
+
+type RejectVerificationRequest struct {
+	TherapistID string `json:"therapist_id"`
+	Reason      string `json:"reason"`
+}
+
+// License Verification Response DTOs
+type LicenseVerificationData struct {
+	Status           string     `json:"status"`
+	Jurisdiction     string     `json:"jurisdiction,omitempty"`
+	LicenseType      string     `json:"license_type,omitempty"`
+	LicenseExpiresAt *time.Time `json:"license_expires_at,omitempty"`
+	SubmittedAt      *time.Time `json:"submitted_at,omitempty"`
+	ReviewedAt       *time.Time `json:"reviewed_at,omitempty"`
+	RejectionReason  string     `json:"rejection_reason,omitempty"`
+}
+
+type VerificationDocumentData struct {
+	ID          string    `json:"id"`
+	TherapistID string    `json:"therapist_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+type TherapistVerificationData struct {
+	Profile      TherapistProfileData    `json:"profile"`
+	Verification LicenseVerificationData `json:"verification"`
+}
+
+type TherapistVerificationResponse struct {
+	Verification TherapistVerificationData `json:"verification"`
+	Message      string                    `json:"message,omitempty"`
+}
+
+type VerificationListResponse struct {
+	Therapists []TherapistVerificationData `json:"therapists"`
+	Total      int                         `json:"total"`
+}
+
+type VerificationDocumentResponse struct {
+	Document VerificationDocumentData `json:"document"`
+	Message  string                   `json:"message,omitempty"`
+}
+
+type VerificationDocumentListResponse struct {
+	Documents []VerificationDocumentData `json:"documents"`
+	Total     int                        `json:"total"`
+}
+
+// License Verification Helper Functions
+func ToTherapistVerificationResponse(profile *therapistDomain.TherapistProfile) TherapistVerificationData {
+	verification := profile.Verification
+	return TherapistVerificationData{
+		Profile: ToTherapistProfileResponse(profile),
+		Verification: LicenseVerificationData{
+			Status:           string(verification.Status),
+			Jurisdiction:     verification.Jurisdiction,
+			LicenseType:      verification.LicenseType,
+			LicenseExpiresAt: verification.LicenseExpiresAt,
+			SubmittedAt:      verification.SubmittedAt,
+			ReviewedAt:       verification.ReviewedAt,
+			RejectionReason:  verification.RejectionReason,
+		},
+	}
+}
+
+func ToVerificationListResponse(profiles []*therapistDomain.TherapistProfile) VerificationListResponse {
+	responses := make([]TherapistVerificationData, len(profiles))
+	for i, profile := range profiles {
+		responses[i] = ToTherapistVerificationResponse(profile)
+	}
+	return VerificationListResponse{
+		Therapists: responses,
+		Total:      len(responses),
+	}
+}
+
+func ToVerificationDocumentResponse(document *therapistDomain.VerificationDocument) VerificationDocumentData {
+	return VerificationDocumentData{
+		ID:          document.ID,
+		TherapistID: document.TherapistID,
+		FileName:    document.FileName,
+		ContentType: document.ContentType,
+		SizeBytes:   document.SizeBytes,
+		UploadedAt:  document.UploadedAt,
+	}
+}
+
+func ToVerificationDocumentListResponse(documents []*therapistDomain.VerificationDocument) VerificationDocumentListResponse {
+	responses := make([]VerificationDocumentData, len(documents))
+	for i, document := range documents {
+		responses[i] = ToVerificationDocumentResponse(document)
+	}
+	return VerificationDocumentListResponse{
+		Documents: responses,
+		Total:     len(responses),
+	}
+}
+
+// License Verification Validation Functions
+func (r *SubmitLicenseVerificationRequest) Validate() error {
+	if strings.TrimSpace(r.Jurisdiction) == "" {
+		return ErrMissingLicenseJurisdiction
+	}
+	if strings.TrimSpace(r.LicenseType) == "" {
+		return ErrMissingLicenseType
+	}
+	if _, err := time.Parse("2006-01-02", r.LicenseExpiresAt); err != nil {
+		return ErrInvalidLicenseExpiryDate
+	}
+	return nil
+}
+
+func (r *SubmitLicenseVerificationRequest) ToDomain() therapistDomain.SubmitVerificationRequest {
+	// Validate has already checked the date format
+	expiresAt, _ := time.Parse("2006-01-02", r.LicenseExpiresAt)
+	return therapistDomain.SubmitVerificationRequest{
+		Jurisdiction:     r.Jurisdiction,
+		LicenseType:      r.LicenseType,
+		LicenseExpiresAt: expiresAt,
+	}
+}
+
+func (r *ApproveVerificationRequest) Validate() error {
+	if strings.TrimSpace(r.TherapistID) == "" {
+		return ErrMissingTherapistID
+	}
+	return nil
+}
+
+func (r *RejectVerificationRequest) Validate() error {
+	if strings.TrimSpace(r.TherapistID) == "" {
+		return ErrMissingTherapistID
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return ErrMissingRejectionReason
+	}
+	return nil
+}
+
+// Review Request DTOs
+type CreateReviewRequest struct {
+	TherapistID string `json:"therapist_id"`
+	Rating      int    `json:"rating"`
+	Body        string `json:"body"`
+}
+
+type UpdateReviewRequest struct {
+	ReviewID string `json:"review_id"`
+	Rating   int    `json:"rating"`
+	Body     string `json:"body"`
+}
+
+type ReviewActionRequest struct {
+	ReviewID string `json:"review_id"`
+}
+
+type ReportReviewRequest struct {
+	ReviewID string `json:"review_id"`
+	Reason   string `json:"reason"`
+}
+
+type ReplyToReviewRequest struct {
+	ReviewID string `json:"review_id"`
+	Reply    string `json:"reply"`
+}
+
+type HideReviewRequest struct {
+	ReviewID string `json:"review_id"`
+	Reason   string `json:"reason"`
+}
+
+// Review Response DTOs
+type ReviewData struct {
+	ID          string     `json:"id"`
+	TherapistID string     `json:"therapist_id"`
+	Rating      int        `json:"rating"`
+	Body        string     `json:"body,omitempty"`
+	Status      string     `json:"status"`
+	Reply       string     `json:"reply,omitempty"`
+	RepliedAt   *time.Time `json:"replied_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type ReviewResponse struct {
+	Review  ReviewData `json:"review"`
+	Message string     `json:"message,omitempty"`
+}
+
+type ReviewListResponse struct {
+	Reviews    []ReviewData `json:"reviews"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+type ReviewReportData struct {
+	ID         string    `json:"id"`
+	ReporterID string    `json:"reporter_id"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ModeratedReviewData is the admin view of a review, including its author and moderation history
+type ModeratedReviewData struct {
+	ReviewData
+	ClientID         string             `json:"client_id"`
+	OpenReports      int                `json:"open_reports"`
+	Reports          []ReviewReportData `json:"reports,omitempty"`
+	ModeratedBy      *string            `json:"moderated_by,omitempty"`
+	ModeratedAt      *time.Time         `json:"moderated_at,omitempty"`
+	ModerationReason string             `json:"moderation_reason,omitempty"`
+}
+
+type ModeratedReviewResponse struct {
+	Review  ModeratedReviewData `json:"review"`
+	Message string              `json:"message,omitempty"`
+}
+
+type ModerationQueueResponse struct {
+	Reviews    []ModeratedReviewData `json:"reviews"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+// Review Helper Functions
+func ToReviewResponse(review *reviewDomain.Review) ReviewData {
+	return ReviewData{
+		ID:          review.ID,
+		TherapistID: review.TherapistID,
+		Rating:      review.Rating,
+		Body:        review.Body,
+		Status:      string(review.Status),
+		Reply:       review.Reply,
+		RepliedAt:   review.RepliedAt,
+		CreatedAt:   review.CreatedAt,
+		UpdatedAt:   review.UpdatedAt,
+	}
+}
+
+func ToReviewListResponse(reviews []*reviewDomain.Review) ReviewListResponse {
+	responses := make([]ReviewData, len(reviews))
+	for i, review := range reviews {
+		responses[i] = ToReviewResponse(review)
+	}
+	return ReviewListResponse{
+		Reviews: responses,
+	}
+}
+
+func ToReviewPageResponse(page *pagination.Page[*reviewDomain.Review]) ReviewListResponse {
+	response := ToReviewListResponse(page.Items)
+	response.NextCursor = page.NextCursor
+	return response
+}
+
+func ToModeratedReviewResponse(review *reviewDomain.Review, reports []*reviewDomain.Report) ModeratedReviewData {
+	reportResponses := make([]ReviewReportData, len(reports))
+	for i, report := range reports {
+		reportResponses[i] = ReviewReportData{
+			ID:         report.ID,
+			ReporterID: report.ReporterID,
+			Reason:     report.Reason,
+			CreatedAt:  report.CreatedAt,
+		}
+	}
+	return ModeratedReviewData{
+		ReviewData:       ToReviewResponse(review),
+		ClientID:         review.ClientID,
+		OpenReports:      review.OpenReports,
+		Reports:          reportResponses,
+		ModeratedBy:      review.ModeratedBy,
+		ModeratedAt:      review.ModeratedAt,
+		ModerationReason: review.ModerationReason,
+	}
+}
+
+func ToModerationQueueResponse(page *pagination.Page[*reviewDomain.ModerationItem]) ModerationQueueResponse {
+	responses := make([]ModeratedReviewData, len(page.Items))
+	for i, item := range page.Items {
+		responses[i] = ToModeratedReviewResponse(item.Review, item.Reports)
+	}
+	return ModerationQueueResponse{
+		Reviews:    responses,
+		NextCursor: page.NextCursor,
+	}
+}
+
+// Review Validation Functions
+func validateRating(rating int) error {
+	if rating < reviewDomain.MinRating || rating > reviewDomain.MaxRating {
+		return ErrInvalidRatingValue
+	}
+	return nil
+}
+
+func (r *CreateReviewRequest) Validate() error {
+	if strings.TrimSpace(r.TherapistID) == "" {
+		return ErrMissingTherapistID
+	}
+	return validateRating(r.Rating)
+}
+
+func (r *UpdateReviewRequest) Validate() error {
+	if strings.TrimSpace(r.ReviewID) == "" {
+		return ErrMissingReviewID
+	}
+	return validateRating(r.Rating)
+}
+
+func (r *ReviewActionRequest) Validate() error {
+	if strings.TrimSpace(r.ReviewID) == "" {
+		return ErrMissingReviewID
+	}
+	return nil
+}
+
+func (r *ReportReviewRequest) Validate() error {
+	if strings.TrimSpace(r.ReviewID) == "" {
+		return ErrMissingReviewID
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return ErrMissingReportReason
+	}
+	return nil
+}
+
+func (r *ReplyToReviewRequest) Validate() error {
+	if strings.TrimSpace(r.ReviewID) == "" {
+		return ErrMissingReviewID
+	}
+	return nil
+}
+
+func (r *HideReviewRequest) Validate() error {
+	if strings.TrimSpace(r.ReviewID) == "" {
+		return ErrMissingReviewID
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return ErrMissingModerationReason
+	}
+	return nil
+}
+
+// Questionnaire Request DTOs
+type QuestionnaireRequest struct {
+	QuestionnaireID string         `json:"questionnaire_id,omitempty"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description,omitempty"`
+	Questions       []QuestionData `json:"questions"`
+}
+
+type SetQuestionnaireActiveRequest struct {
+	QuestionnaireID string `json:"questionnaire_id"`
+	Active          *bool  `json:"active"`
+}
+
+type SubmitQuestionnaireRequest struct {
+	QuestionnaireID string       `json:"questionnaire_id"`
+	Version         int          `json:"version"`
+	Answers         []AnswerData `json:"answers"`
+}
+
+// Questionnaire Response DTOs
+type QuestionData struct {
+	ID       string          `json:"id,omitempty"`
+	Type     string          `json:"type"`
+	Prompt   string          `json:"prompt"`
+	HelpText string          `json:"help_text,omitempty"`
+	Required bool            `json:"required"`
+	Options  []string        `json:"options,omitempty"`
+	Scale    *ScaleRangeData `json:"scale,omitempty"`
+	ShowIf   *ConditionData  `json:"show_if,omitempty"`
+}
+
+type ScaleRangeData struct {
+	Min      int    `json:"min"`
+	Max      int    `json:"max"`
+	MinLabel string `json:"min_label,omitempty"`
+	MaxLabel string `json:"max_label,omitempty"`
+}
+
+type ConditionData struct {
+	QuestionID string   `json:"question_id"`
+	AnyOf      []string `json:"any_of"`
+}
+
+type AnswerData struct {
+	QuestionID string   `json:"question_id"`
+	Text       string   `json:"text,omitempty"`
+	Choices    []string `json:"choices,omitempty"`
+	Scale      *int     `json:"scale,omitempty"`
+	Date       string   `json:"date,omitempty"`
+}
+
+type QuestionnaireData struct {
+	ID          string         `json:"id"`
+	TherapistID string         `json:"therapist_id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Version     int            `json:"version"`
+	Questions   []QuestionData `json:"questions"`
+	IsActive    bool           `json:"is_active"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type QuestionnaireResponse struct {
+	Questionnaire QuestionnaireData `json:"questionnaire"`
+	Message       string            `json:"message,omitempty"`
+}
+
+type QuestionnaireListResponse struct {
+	Questionnaires []QuestionnaireData `json:"questionnaires"`
+}
+
+// QuestionnaireAnswersData is one submitted response to a questionnaire
+type QuestionnaireAnswersData struct {
+	ID              string       `json:"id"`
+	QuestionnaireID string       `json:"questionnaire_id"`
+	Version         int          `json:"version"`
+	ClientID        string       `json:"client_id"`
+	Answers         []AnswerData `json:"answers"`
+	SubmittedAt     time.Time    `json:"submitted_at"`
+}
+
+type QuestionnaireAnswersResponse struct {
+	Response QuestionnaireAnswersData `json:"response"`
+	Message  string                   `json:"message,omitempty"`
+}
+
+// AnsweredQuestionnaireData is a response together with the questionnaire version it answered
+type AnsweredQuestionnaireData struct {
+	Questionnaire QuestionnaireData        `json:"questionnaire"`
+	Response      QuestionnaireAnswersData `json:"response"`
+}
+
+type AnsweredQuestionnaireListResponse struct {
+	Responses []AnsweredQuestionnaireData `json:"responses"`
+}
+
+// Questionnaire Helper Functions
+func ToQuestionnaireResponse(questionnaire *questionnaireDomain.Questionnaire) QuestionnaireData {
+	questions := make([]QuestionData, len(questionnaire.Questions))
+	for i, question := range questionnaire.Questions {
+		questions[i] = QuestionData{
+			ID:       question.ID,
+			Type:     string(question.Type),
+			Prompt:   question.Prompt,
+			HelpText: question.HelpText,
+			Required: question.Required,
+			Options:  question.Options,
+		}
+		if question.Scale != nil {
+			questions[i].Scale = &ScaleRangeData{
+				Min:      question.Scale.Min,
+				Max:      question.Scale.Max,
+				MinLabel: question.Scale.MinLabel,
+				MaxLabel: question.Scale.MaxLabel,
+			}
+		}
+		if question.ShowIf != nil {
+			questions[i].ShowIf = &ConditionData{
+				QuestionID: question.ShowIf.QuestionID,
+				AnyOf:      question.ShowIf.AnyOf,
+			}
+		}
+	}
+
+	return QuestionnaireData{
+		ID:          questionnaire.ID,
+		TherapistID: questionnaire.TherapistID,
+		Title:       questionnaire.Title,
+		Description: questionnaire.Description,
+		Version:     questionnaire.Version,
+		Questions:   questions,
+		IsActive:    questionnaire.IsActive,
+		CreatedAt:   questionnaire.CreatedAt,
+		UpdatedAt:   questionnaire.UpdatedAt,
+	}
+}
+
+func ToQuestionnaireListResponse(questionnaires []*questionnaireDomain.Questionnaire) QuestionnaireListResponse {
+	responses := make([]QuestionnaireData, len(questionnaires))
+	for i, questionnaire := range questionnaires {
+		responses[i] = ToQuestionnaireResponse(questionnaire)
+	}
+	return QuestionnaireListResponse{
+		Questionnaires: responses,
+	}
+}
+
+func ToQuestionnaireAnswersResponse(response *questionnaireDomain.Response) QuestionnaireAnswersData {
+	answers := make([]AnswerData, len(response.Answers))
+	for i, answer := range response.Answers {
+		answers[i] = AnswerData{
+			QuestionID: answer.QuestionID,
+			Text:       answer.Text,
+			Choices:    answer.Choices,
+			Scale:      answer.Scale,
+			Date:       answer.Date,
+		}
+	}
+
+	return QuestionnaireAnswersData{
+		ID:              response.ID,
+		QuestionnaireID: response.QuestionnaireID,
+		Version:         response.Version,
+		ClientID:        response.ClientID,
+		Answers:         answers,
+		SubmittedAt:     response.SubmittedAt,
+	}
+}
+
+func ToAnsweredQuestionnaireListResponse(answered []*questionnaireDomain.AnsweredQuestionnaire) AnsweredQuestionnaireListResponse {
+	responses := make([]AnsweredQuestionnaireData, len(answered))
+	for i, item := range answered {
+		responses[i] = AnsweredQuestionnaireData{
+			Questionnaire: ToQuestionnaireResponse(item.Questionnaire),
+			Response:      ToQuestionnaireAnswersResponse(item.Response),
+		}
+	}
+	return AnsweredQuestionnaireListResponse{
+		Responses: responses,
+	}
+}
+
+func (r *QuestionnaireRequest) Validate() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return ErrMissingQuestionnaireTitle
+	}
+	if len(r.Questions) == 0 {
+		return ErrMissingQuestions
+	}
+	return nil
+}
+
+func (r *QuestionnaireRequest) ToDomain() questionnaireDomain.QuestionnaireRequest {
+	questions := make([]questionnaireDomain.Question, len(r.Questions))
+	for i, question := range r.Questions {
+		questions[i] = questionnaireDomain.Question{
+			ID:       question.ID,
+			Type:     questionnaireDomain.QuestionType(question.Type),
+			Prompt:   question.Prompt,
+			HelpText: question.HelpText,
+			Required: question.Required,
+			Options:  question.Options,
+		}
+		if question.Scale != nil {
+			questions[i].Scale = &questionnaireDomain.ScaleRange{
+				Min:      question.Scale.Min,
+				Max:      question.Scale.Max,
+				MinLabel: question.Scale.MinLabel,
+				MaxLabel: question.Scale.MaxLabel,
+			}
+		}
+		if question.ShowIf != nil {
+			questions[i].ShowIf = &questionnaireDomain.Condition{
+				QuestionID: question.ShowIf.QuestionID,
+				AnyOf:      question.ShowIf.AnyOf,
+			}
+		}
+	}
+
+	return questionnaireDomain.QuestionnaireRequest{
+		Title:       r.Title,
+		Description: r.Description,
+		Questions:   questions,
+	}
+}
+
+func (r *SetQuestionnaireActiveRequest) Validate() error {
+	if strings.TrimSpace(r.QuestionnaireID) == "" {
+		return ErrMissingQuestionnaireID
+	}
+	if r.Active == nil {
+		return ErrMissingActiveValue
+	}
+	return nil
+}
+
+func (r *SubmitQuestionnaireRequest) Validate() error {
+	if strings.TrimSpace(r.QuestionnaireID) == "" {
+		return ErrMissingQuestionnaireID
+	}
+	if r.Version < 1 {
+		return ErrMissingQuestionnaireVersion
+	}
+	return nil
+}
+
+func (r *SubmitQuestionnaireRequest) ToDomain() questionnaireDomain.SubmitResponseRequest {
+	answers := make([]questionnaireDomain.Answer, len(r.Answers))
+	for i, answer := range r.Answers {
+		answers[i] = questionnaireDomain.Answer{
+			QuestionID: answer.QuestionID,
+			Text:       answer.Text,
+			Choices:    answer.Choices,
+			Scale:      answer.Scale,
+			Date:       answer.Date,
+		}
+	}
+
+	return questionnaireDomain.SubmitResponseRequest{
+		QuestionnaireID: r.QuestionnaireID,
+		Version:         r.Version,
+		Answers:         answers,
+	}
+}
+
+// Assessment Request DTOs
+type AssignAssessmentRequest struct {
+	ClientID   string     `json:"client_id"`
+	Instrument string     `json:"instrument"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+}
+
+type CancelAssessmentRequest struct {
+	AssessmentID string `json:"assessment_id"`
+}
+
+type CompleteAssessmentRequest struct {
+	AssessmentID string `json:"assessment_id"`
+	Responses    []int  `json:"responses"`
+}
+
+// Assessment Response DTOs
+type InstrumentOptionData struct {
+	Value int    `json:"value"`
+	Label string `json:"label"`
+}
+
+type SeverityBandData struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+	Min   int    `json:"min"`
+	Max   int    `json:"max"`
+}
+
+type SubscaleData struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Items []int  `json:"items"`
+}
+
+type InstrumentData struct {
+	Code         string                 `json:"code"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	Instructions string                 `json:"instructions"`
+	Items        []string               `json:"items"`
+	Options      []InstrumentOptionData `json:"options"`
+	MaxScore     int                    `json:"max_score"`
+	Bands        []SeverityBandData     `json:"severity_bands"`
+	Subscales    []SubscaleData         `json:"subscales,omitempty"`
+}
+
+type InstrumentListResponse struct {
+	Instruments []InstrumentData `json:"instruments"`
+}
+
+type CriticalItemData struct {
+	Item     int    `json:"item"`
+	Response int    `json:"response"`
+	Reason   string `json:"reason"`
+}
+
+type AssessmentScoreData struct {
+	Total         int                `json:"total"`
+	MaxScore      int                `json:"max_score"`
+	Severity      string             `json:"severity"`
+	SeverityLabel string             `json:"severity_label"`
+	Subscales     map[string]int     `json:"subscales,omitempty"`
+	CriticalItems []CriticalItemData `json:"critical_items,omitempty"`
+}
+
+type AssessmentData struct {
+	ID             string               `json:"id"`
+	Instrument     string               `json:"instrument"`
+	InstrumentName string               `json:"instrument_name"`
+	TherapistID    string               `json:"therapist_id"`
+	ClientID       string               `json:"client_id"`
+	Status         string               `json:"status"`
+	Responses      []int                `json:"responses,omitempty"`
+	Score          *AssessmentScoreData `json:"score,omitempty"`
+	DueAt          *time.Time           `json:"due_at,omitempty"`
+	AssignedAt     time.Time            `json:"assigned_at"`
+	CompletedAt    *time.Time           `json:"completed_at,omitempty"`
+}
+
+type AssessmentResponse struct {
+	Assessment      AssessmentData       `json:"assessment"`
+	Message         string               `json:"message,omitempty"`
+	CrisisResources []CrisisResourceData `json:"crisis_resources,omitempty"`
+}
+
+type AssessmentListResponse struct {
+	Assessments []AssessmentData `json:"assessments"`
+}
+
+// ScoreHistoryPointData is one completed administration in a client's score history
+type ScoreHistoryPointData struct {
+	AssessmentID string              `json:"assessment_id"`
+	CompletedAt  time.Time           `json:"completed_at"`
+	Score        AssessmentScoreData `json:"score"`
+}
+
+type ScoreHistoryResponse struct {
+	Instrument string                  `json:"instrument"`
+	ClientID   string                  `json:"client_id"`
+	History    []ScoreHistoryPointData `json:"history"`
+}
+
+// Assessment Helper Functions
+func ToInstrumentResponse(instrument *assessmentDomain.Instrument) InstrumentData {
+	options := make([]InstrumentOptionData, len(instrument.Options))
+	for i, option := range instrument.Options {
+		options[i] = InstrumentOptionData{Value: option.Value, Label: option.Label}
+	}
+
+	bands := make([]SeverityBandData, len(instrument.Bands))
+	for i, band := range instrument.Bands {
+		bands[i] = SeverityBandData{Code: band.Code, Label: band.Label, Min: band.Min, Max: band.Max}
+	}
+
+	var subscales []SubscaleData
+	for _, subscale := range instrument.Subscales {
+		subscales = append(subscales, SubscaleData{Code: subscale.Code, Name: subscale.Name, Items: subscale.Items})
+	}
+
+	return InstrumentData{
+		Code:         instrument.Code,
+		Name:         instrument.Name,
+		Description:  instrument.Description,
+		Instructions: instrument.Instructions,
+		Items:        instrument.Items,
+		Options:      options,
+		MaxScore:     instrument.MaxScore(),
+		Bands:        bands,
+		Subscales:    subscales,
+	}
+}
+
+func ToInstrumentListResponse(instruments []*assessmentDomain.Instrument) InstrumentListResponse {
+	responses := make([]InstrumentData, len(instruments))
+	for i, instrument := range instruments {
+		responses[i] = ToInstrumentResponse(instrument)
+	}
+	return InstrumentListResponse{
+		Instruments: responses,
+	}
+}
+
+// toAssessmentScoreData spells out the severity band and the reasons behind
+// flagged items so clients of the API do not need their own copy of the instrument
+func toAssessmentScoreData(assessment *assessmentDomain.Assessment) AssessmentScoreData {
+	instrument := assessment.Instrument()
+	score := assessment.Score
+
+	data := AssessmentScoreData{
+		Total:     score.Total,
+		MaxScore:  instrument.MaxScore(),
+		Severity:  score.Severity,
+		Subscales: score.Subscales,
+	}
+
+	if band, ok := instrument.Band(score.Severity); ok {
+		data.SeverityLabel = band.Label
+	}
+
+	for _, item := range score.CriticalItems {
+		critical := CriticalItemData{Item: item}
+		if item <= len(assessment.Responses) {
+			critical.Response = assessment.Responses[item-1]
+		}
+		for _, definition := range instrument.CriticalItems {
+			if definition.Item == item {
+				critical.Reason = definition.Reason
+			}
+		}
+		data.CriticalItems = append(data.CriticalItems, critical)
+	}
+
+	return data
+}
+
+func ToAssessmentResponse(assessment *assessmentDomain.Assessment) AssessmentData {
+	data := AssessmentData{
+		ID:             assessment.ID,
+		Instrument:     assessment.InstrumentCode,
+		InstrumentName: assessment.Instrument().Name,
+		TherapistID:    assessment.TherapistID,
+		ClientID:       assessment.ClientID,
+		Status:         string(assessment.Status),
+		Responses:      assessment.Responses,
+		DueAt:          assessment.DueAt,
+		AssignedAt:     assessment.AssignedAt,
+		CompletedAt:    assessment.CompletedAt,
+	}
+
+	if assessment.Score != nil {
+		score := toAssessmentScoreData(assessment)
+		data.Score = &score
+	}
+
+	return data
+}
+
+func ToAssessmentListResponse(assessments []*assessmentDomain.Assessment) AssessmentListResponse {
+	responses := make([]AssessmentData, len(assessments))
+	for i, assessment := range assessments {
+		responses[i] = ToAssessmentResponse(assessment)
+	}
+	return AssessmentListResponse{
+		Assessments: responses,
+	}
+}
+
+func ToScoreHistoryResponse(instrumentCode, clientID string, assessments []*assessmentDomain.Assessment) ScoreHistoryResponse {
+	history := make([]ScoreHistoryPointData, 0, len(assessments))
+	for _, assessment := range assessments {
+		if assessment.Score == nil || assessment.CompletedAt == nil {
+			continue
+		}
+		history = append(history, ScoreHistoryPointData{
+			AssessmentID: assessment.ID,
+			CompletedAt:  *assessment.CompletedAt,
+			Score:        toAssessmentScoreData(assessment),
+		})
+	}
+
+	return ScoreHistoryResponse{
+		Instrument: instrumentCode,
+		ClientID:   clientID,
+		History:    history,
+	}
+}
+
+func (r *AssignAssessmentRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	if strings.TrimSpace(r.Instrument) == "" {
+		return ErrMissingInstrument
+	}
+	return nil
+}
+
+func (r *AssignAssessmentRequest) ToDomain() assessmentDomain.AssignRequest {
+	return assessmentDomain.AssignRequest{
+		ClientID:       r.ClientID,
+		InstrumentCode: r.Instrument,
+		DueAt:          r.DueAt,
+	}
+}
+
+func (r *CancelAssessmentRequest) Validate() error {
+	if strings.TrimSpace(r.AssessmentID) == "" {
+		return ErrMissingAssessmentID
+	}
+	return nil
+}
+
+func (r *CompleteAssessmentRequest) Validate() error {
+	if strings.TrimSpace(r.AssessmentID) == "" {
+		return ErrMissingAssessmentID
+	}
+	if len(r.Responses) == 0 {
+		return ErrMissingAssessmentResponses
+	}
+	return nil
+}
+
+// Session Note Request DTOs
+type CreateSessionNoteRequest struct {
+	ClientID    string            `json:"client_id"`
+	Template    string            `json:"template"`
+	SessionDate string            `json:"session_date"`
+	Sections    map[string]string `json:"sections"`
+}
+
+type AmendSessionNoteRequest struct {
+	NoteID  string `json:"note_id"`
+	Reason  string `json:"reason"`
+	Content string `json:"content"`
+}
+
+type ShareSessionNoteRequest struct {
+	NoteID string `json:"note_id"`
+	Shared *bool  `json:"shared"`
+}
+
+// Session Note Response DTOs
+type NoteSectionData struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+type NoteAmendmentData struct {
+	ID          string    `json:"id"`
+	TherapistID string    `json:"therapist_id"`
+	Reason      string    `json:"reason"`
+	Content     string    `json:"content"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type SessionNoteData struct {
+	ID               string              `json:"id"`
+	ClientID         string              `json:"client_id"`
+	TherapistID      string              `json:"therapist_id"`
+	Template         string              `json:"template"`
+	SessionDate      string              `json:"session_date"`
+	Sections         []NoteSectionData   `json:"sections"`
+	Amendments       []NoteAmendmentData `json:"amendments"`
+	SharedWithClient bool                `json:"shared_with_client"`
+	SharedAt         *time.Time          `json:"shared_at,omitempty"`
+	CreatedAt        time.Time           `json:"created_at"`
+}
+
+type SessionNoteResponse struct {
+	Note    SessionNoteData `json:"note"`
+	Message string          `json:"message,omitempty"`
+}
+
+type SessionNoteListResponse struct {
+	Notes []SessionNoteData `json:"notes"`
+}
+
+// Session Note Helper Functions
+func ToSessionNoteResponse(note *sessionNoteDomain.Note) SessionNoteData {
+	sections := make([]NoteSectionData, len(note.Sections))
+	for i, section := range note.Sections {
+		sections[i] = NoteSectionData{Name: section.Name, Content: section.Content}
+	}
+
+	amendments := make([]NoteAmendmentData, len(note.Amendments))
+	for i, amendment := range note.Amendments {
+		amendments[i] = NoteAmendmentData{
+			ID:          amendment.ID,
+			TherapistID: amendment.TherapistID,
+			Reason:      amendment.Reason,
+			Content:     amendment.Content,
+			CreatedAt:   amendment.CreatedAt,
+		}
+	}
+
+	return SessionNoteData{
+		ID:               note.ID,
+		ClientID:         note.ClientID,
+		TherapistID:      note.TherapistID,
+		Template:         string(note.Template),
+		SessionDate:      note.SessionDate.Format(sessionNoteDomain.SessionDateLayout),
+		Sections:         sections,
+		Amendments:       amendments,
+		SharedWithClient: note.SharedWithClient,
+		SharedAt:         note.SharedAt,
+		CreatedAt:        note.CreatedAt,
+	}
+}
+
+func ToSessionNoteListResponse(notes []*sessionNoteDomain.Note) SessionNoteListResponse {
+	responses := make([]SessionNoteData, len(notes))
+	for i, note := range notes {
+		responses[i] = ToSessionNoteResponse(note)
+	}
+	return SessionNoteListResponse{
+		Notes: responses,
+	}
+}
+
+func (r *CreateSessionNoteRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	if strings.TrimSpace(r.Template) == "" {
+		return ErrMissingNoteTemplate
+	}
+	if _, err := time.Parse(sessionNoteDomain.SessionDateLayout, r.SessionDate); err != nil {
+		return ErrInvalidSessionDate
+	}
+	if len(r.Sections) == 0 {
+		return ErrMissingNoteSections
+	}
+	return nil
+}
+
+func (r *CreateSessionNoteRequest) ToDomain() sessionNoteDomain.CreateNoteRequest {
+	sessionDate, _ := time.Parse(sessionNoteDomain.SessionDateLayout, r.SessionDate)
+
+	return sessionNoteDomain.CreateNoteRequest{
+		ClientID:    r.ClientID,
+		Template:    sessionNoteDomain.Template(strings.ToLower(strings.TrimSpace(r.Template))),
+		SessionDate: sessionDate,
+		Sections:    r.Sections,
+	}
+}
+
+func (r *AmendSessionNoteRequest) Validate() error {
+	if strings.TrimSpace(r.NoteID) == "" {
+		return ErrMissingNoteID
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return ErrMissingAmendmentReason
+	}
+	if strings.TrimSpace(r.Content) == "" {
+		return ErrMissingAmendmentContent
+	}
+	return nil
+}
+
+func (r *AmendSessionNoteRequest) ToDomain() sessionNoteDomain.AmendNoteRequest {
+	return sessionNoteDomain.AmendNoteRequest{
+		Reason:  r.Reason,
+		Content: r.Content,
+	}
+}
+
+func (r *ShareSessionNoteRequest) Validate() error {
+	if strings.TrimSpace(r.NoteID) == "" {
+		return ErrMissingNoteID
+	}
+	if r.Shared == nil {
+		return ErrMissingSharedValue
+	}
+	return nil
+}
+
+// Treatment Plan Request DTOs
+type TreatmentPlanProblemData struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description"`
+}
+
+type TreatmentPlanGoalRequest struct {
+	ID            string   `json:"id,omitempty"`
+	ProblemID     string   `json:"problem_id"`
+	Description   string   `json:"description"`
+	Measure       string   `json:"measure"`
+	Interventions []string `json:"interventions,omitempty"`
+	TargetDate    string   `json:"target_date,omitempty"`
+}
+
+type TreatmentPlanContentRequest struct {
+	Title              string                     `json:"title"`
+	Problems           []TreatmentPlanProblemData `json:"problems"`
+	Goals              []TreatmentPlanGoalRequest `json:"goals"`
+	ReviewIntervalDays int                        `json:"review_interval_days,omitempty"`
+}
+
+type CreateTreatmentPlanRequest struct {
+	ClientID string `json:"client_id"`
+	TreatmentPlanContentRequest
+}
+
+type UpdateTreatmentPlanRequest struct {
+	PlanID string `json:"plan_id"`
+	TreatmentPlanContentRequest
+}
+
+type RecordPlanProgressRequest struct {
+	PlanID string `json:"plan_id"`
+	GoalID string `json:"goal_id"`
+	Note   string `json:"note"`
+	Status string `json:"status,omitempty"`
+}
+
+type ReviewTreatmentPlanRequest struct {
+	PlanID string `json:"plan_id"`
+}
+
+type CloseTreatmentPlanRequest struct {
+	PlanID string `json:"plan_id"`
+	Status string `json:"status"`
+}
+
+type ShareTreatmentPlanRequest struct {
+	PlanID string `json:"plan_id"`
+	Shared *bool  `json:"shared"`
+}
+
+// Treatment Plan Response DTOs
+type TreatmentPlanGoalData struct {
+	ID            string   `json:"id"`
+	ProblemID     string   `json:"problem_id"`
+	Description   string   `json:"description"`
+	Measure       string   `json:"measure"`
+	Interventions []string `json:"interventions"`
+	TargetDate    string   `json:"target_date,omitempty"`
+	Status        string   `json:"status"`
+}
+
+type PlanProgressData struct {
+	ID          string    `json:"id"`
+	GoalID      string    `json:"goal_id"`
+	TherapistID string    `json:"therapist_id"`
+	Note        string    `json:"note"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type TreatmentPlanData struct {
+	ID                 string                     `json:"id"`
+	ClientID           string                     `json:"client_id"`
+	TherapistID        string                     `json:"therapist_id"`
+	Title              string                     `json:"title"`
+	Status             string                     `json:"status"`
+	Problems           []TreatmentPlanProblemData `json:"problems"`
+	Goals              []TreatmentPlanGoalData    `json:"goals"`
+	Progress           []PlanProgressData         `json:"progress"`
+	ReviewIntervalDays int                        `json:"review_interval_days"`
+	LastReviewedAt     *time.Time                 `json:"last_reviewed_at,omitempty"`
+	NextReviewAt       time.Time                  `json:"next_review_at"`
+	ReviewDue          bool                       `json:"review_due"`
+	SharedWithClient   bool                       `json:"shared_with_client"`
+	CreatedAt          time.Time                  `json:"created_at"`
+	UpdatedAt          time.Time                  `json:"updated_at"`
+	ClosedAt           *time.Time                 `json:"closed_at,omitempty"`
+}
+
+type TreatmentPlanResponse struct {
+	Plan    TreatmentPlanData `json:"plan"`
+	Message string            `json:"message,omitempty"`
+}
+
+type TreatmentPlanListResponse struct {
+	Plans []TreatmentPlanData `json:"plans"`
+}
+
+// Treatment Plan Helper Functions
+func ToTreatmentPlanResponse(plan *treatmentPlanDomain.Plan) TreatmentPlanData {
+	problems := make([]TreatmentPlanProblemData, len(plan.Problems))
+	for i, problem := range plan.Problems {
+		problems[i] = TreatmentPlanProblemData{ID: problem.ID, Description: problem.Description}
+	}
+
+	goals := make([]TreatmentPlanGoalData, len(plan.Goals))
+	for i, goal := range plan.Goals {
+		goals[i] = TreatmentPlanGoalData{
+			ID:            goal.ID,
+			ProblemID:     goal.ProblemID,
+			Description:   goal.Description,
+			Measure:       goal.Measure,
+			Interventions: goal.Interventions,
+			Status:        string(goal.Status),
+		}
+		if goals[i].Interventions == nil {
+			goals[i].Interventions = []string{}
+		}
+		if goal.TargetDate != nil {
+			goals[i].TargetDate = goal.TargetDate.Format(treatmentPlanDomain.DateLayout)
+		}
+	}
+
+	progress := make([]PlanProgressData, len(plan.Progress))
+	for i, update := range plan.Progress {
+		progress[i] = PlanProgressData{
+			ID:          update.ID,
+			GoalID:      update.GoalID,
+			TherapistID: update.TherapistID,
+			Note:        update.Note,
+			Status:      string(update.Status),
+			CreatedAt:   update.CreatedAt,
+		}
+	}
+
+	return TreatmentPlanData{
+		ID:                 plan.ID,
+		ClientID:           plan.ClientID,
+		TherapistID:        plan.TherapistID,
+		Title:              plan.Title,
+		Status:             string(plan.Status),
+		Problems:           problems,
+		Goals:              goals,
+		Progress:           progress,
+		ReviewIntervalDays: plan.ReviewIntervalDays,
+		LastReviewedAt:     plan.LastReviewedAt,
+		NextReviewAt:       plan.NextReviewAt,
+		ReviewDue:          plan.ReviewDue(time.Now()),
+		SharedWithClient:   plan.SharedWithClient,
+		CreatedAt:          plan.CreatedAt,
+		UpdatedAt:          plan.UpdatedAt,
+		ClosedAt:           plan.ClosedAt,
+	}
+}
+
+func ToTreatmentPlanListResponse(plans []*treatmentPlanDomain.Plan) TreatmentPlanListResponse {
+	responses := make([]TreatmentPlanData, len(plans))
+	for i, plan := range plans {
+		responses[i] = ToTreatmentPlanResponse(plan)
+	}
+	return TreatmentPlanListResponse{
+		Plans: responses,
+	}
+}
+
+func (r *TreatmentPlanContentRequest) Validate() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return ErrMissingPlanTitle
+	}
+	if len(r.Problems) == 0 {
+		return ErrMissingPlanProblems
+	}
+	if len(r.Goals) == 0 {
+		return ErrMissingPlanGoals
+	}
+	for _, goal := range r.Goals {
+		if goal.TargetDate == "" {
+			continue
+		}
+		if _, err := time.Parse(treatmentPlanDomain.DateLayout, goal.TargetDate); err != nil {
+			return ErrInvalidTargetDate
+		}
+	}
+	return nil
+}
+
+func (r *TreatmentPlanContentRequest) ToDomain() treatmentPlanDomain.PlanContent {
+	problems := make([]treatmentPlanDomain.Problem, len(r.Problems))
+	for i, problem := range r.Problems {
+		problems[i] = treatmentPlanDomain.Problem{ID: problem.ID, Description: problem.Description}
+	}
+
+	goals := make([]treatmentPlanDomain.Goal, len(r.Goals))
+	for i, goal := range r.Goals {
+		goals[i] = treatmentPlanDomain.Goal{
+			ID:            goal.ID,
+			ProblemID:     goal.ProblemID,
+			Description:   goal.Description,
+			Measure:       goal.Measure,
+			Interventions: goal.Interventions,
+		}
+		if targetDate, err := time.Parse(treatmentPlanDomain.DateLayout, goal.TargetDate); err == nil {
+			goals[i].TargetDate = &targetDate
+		}
+	}
+
+	return treatmentPlanDomain.PlanContent{
+		Title:              r.Title,
+		Problems:           problems,
+		Goals:              goals,
+		ReviewIntervalDays: r.ReviewIntervalDays,
+	}
+}
+
+func (r *CreateTreatmentPlanRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	return r.TreatmentPlanContentRequest.Validate()
+}
+
+func (r *CreateTreatmentPlanRequest) ToDomain() treatmentPlanDomain.CreatePlanRequest {
+	return treatmentPlanDomain.CreatePlanRequest{
+		ClientID:    r.ClientID,
+		PlanContent: r.TreatmentPlanContentRequest.ToDomain(),
+	}
+}
+
+func (r *UpdateTreatmentPlanRequest) Validate() error {
+	if strings.TrimSpace(r.PlanID) == "" {
+		return ErrMissingPlanID
+	}
+	return r.TreatmentPlanContentRequest.Validate()
+}
+
+func (r *RecordPlanProgressRequest) Validate() error {
+	if strings.TrimSpace(r.PlanID) == "" {
+		return ErrMissingPlanID
+	}
+	if strings.TrimSpace(r.GoalID) == "" {
+		return ErrMissingGoalID
+	}
+	if strings.TrimSpace(r.Note) == "" {
+		return ErrMissingProgressNote
+	}
+	return nil
+}
+
+func (r *RecordPlanProgressRequest) ToDomain() treatmentPlanDomain.ProgressRequest {
+	return treatmentPlanDomain.ProgressRequest{
+		GoalID: strings.TrimSpace(r.GoalID),
+		Note:   r.Note,
+		Status: treatmentPlanDomain.GoalStatus(strings.ToLower(strings.TrimSpace(r.Status))),
+	}
+}
+
+func (r *ReviewTreatmentPlanRequest) Validate() error {
+	if strings.TrimSpace(r.PlanID) == "" {
+		return ErrMissingPlanID
+	}
+	return nil
+}
+
+func (r *CloseTreatmentPlanRequest) Validate() error {
+	if strings.TrimSpace(r.PlanID) == "" {
+		return ErrMissingPlanID
+	}
+	if strings.TrimSpace(r.Status) == "" {
+		return ErrMissingPlanStatus
+	}
+	return nil
+}
+
+func (r *ShareTreatmentPlanRequest) Validate() error {
+	if strings.TrimSpace(r.PlanID) == "" {
+		return ErrMissingPlanID
+	}
+	if r.Shared == nil {
+		return ErrMissingSharedValue
+	}
+	return nil
+}
+
+// Journal Request DTOs
+type JournalEntryContentRequest struct {
+	MoodScore       *int     `json:"mood_score"`
+	Emotions        []string `json:"emotions,omitempty"`
+	Text            string   `json:"text,omitempty"`
+	SleepHours      *float64 `json:"sleep_hours,omitempty"`
+	SleepQuality    *int     `json:"sleep_quality,omitempty"`
+	ActivityMinutes *int     `json:"activity_minutes,omitempty"`
+}
+
+type CreateJournalEntryRequest struct {
+	EntryDate string `json:"entry_date"`
+	JournalEntryContentRequest
+}
+
+type UpdateJournalEntryRequest struct {
+	EntryID string `json:"entry_id"`
+	JournalEntryContentRequest
+}
+
+type DeleteJournalEntryRequest struct {
+	EntryID string `json:"entry_id"`
+}
+
+type ShareJournalEntryRequest struct {
+	EntryID string `json:"entry_id"`
+	Shared  *bool  `json:"shared"`
+}
+
+// JournalEntriesQuery limits an entry listing to a date range
+type JournalEntriesQuery struct {
+	From time.Time
+	To   time.Time
+}
+
+type JournalTrendsQuery struct {
+	Weeks int
+}
+
+// Journal Response DTOs
+type JournalEntryData struct {
+	ID                  string     `json:"id"`
+	ClientID            string     `json:"client_id"`
+	EntryDate           string     `json:"entry_date"`
+	MoodScore           int        `json:"mood_score"`
+	Emotions            []string   `json:"emotions"`
+	Text                string     `json:"text,omitempty"`
+	SleepHours          *float64   `json:"sleep_hours,omitempty"`
+	SleepQuality        *int       `json:"sleep_quality,omitempty"`
+	ActivityMinutes     *int       `json:"activity_minutes,omitempty"`
+	SharedWithTherapist bool       `json:"shared_with_therapist"`
+	SharedAt            *time.Time `json:"shared_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type JournalEntryResponse struct {
+	Entry           JournalEntryData     `json:"entry"`
+	Message         string               `json:"message,omitempty"`
+	CrisisResources []CrisisResourceData `json:"crisis_resources,omitempty"`
+}
+
+type JournalEntryListResponse struct {
+	Entries []JournalEntryData `json:"entries"`
+}
+
+type JournalWeekData struct {
+	WeekStart              string   `json:"week_start"`
+	Entries                int      `json:"entries"`
+	AverageMood            *float64 `json:"average_mood"`
+	AverageSleepHours      *float64 `json:"average_sleep_hours"`
+	AverageActivityMinutes *float64 `json:"average_activity_minutes"`
+}
+
+type EmotionCountData struct {
+	Emotion string `json:"emotion"`
+	Count   int    `json:"count"`
+}
+
+type JournalTrendsResponse struct {
+	From          string             `json:"from"`
+	To            string             `json:"to"`
+	Weeks         []JournalWeekData  `json:"weeks"`
+	TotalEntries  int                `json:"total_entries"`
+	AverageMood   *float64           `json:"average_mood"`
+	TopEmotions   []EmotionCountData `json:"top_emotions"`
+	CurrentStreak int                `json:"current_streak"`
+	LongestStreak int                `json:"longest_streak"`
+}
+
+// Journal Helper Functions
+func ToJournalEntryResponse(entry *journalDomain.Entry) JournalEntryData {
+	emotions := entry.Emotions
+	if emotions == nil {
+		emotions = []string{}
+	}
+
+	return JournalEntryData{
+		ID:                  entry.ID,
+		ClientID:            entry.ClientID,
+		EntryDate:           entry.EntryDate.Format(journalDomain.DateLayout),
+		MoodScore:           entry.MoodScore,
+		Emotions:            emotions,
+		Text:                entry.Text,
+		SleepHours:          entry.SleepHours,
+		SleepQuality:        entry.SleepQuality,
+		ActivityMinutes:     entry.ActivityMinutes,
+		SharedWithTherapist: entry.SharedWithTherapist,
+		SharedAt:            entry.SharedAt,
+		CreatedAt:           entry.CreatedAt,
+		UpdatedAt:           entry.UpdatedAt,
+	}
+}
+
+func ToJournalEntryListResponse(entries []*journalDomain.Entry) JournalEntryListResponse {
+	responses := make([]JournalEntryData, len(entries))
+	for i, entry := range entries {
+		responses[i] = ToJournalEntryResponse(entry)
+	}
+	return JournalEntryListResponse{
+		Entries: responses,
+	}
+}
+
+func ToJournalTrendsResponse(trends *journalDomain.Trends) JournalTrendsResponse {
+	weeks := make([]JournalWeekData, len(trends.Weeks))
+	for i, week := range trends.Weeks {
+		weeks[i] = JournalWeekData{
+			WeekStart:              week.WeekStart.Format(journalDomain.DateLayout),
+			Entries:                week.Entries,
+			AverageMood:            week.AverageMood,
+			AverageSleepHours:      week.AverageSleepHours,
+			AverageActivityMinutes: week.AverageActivityMinutes,
+		}
+	}
+
+	emotions := make([]EmotionCountData, len(trends.TopEmotions))
+	for i, emotion := range trends.TopEmotions {
+		emotions[i] = EmotionCountData{Emotion: emotion.Emotion, Count: emotion.Count}
+	}
+
+	return JournalTrendsResponse{
+		From:          trends.From.Format(journalDomain.DateLayout),
+		To:            trends.To.Format(journalDomain.DateLayout),
+		Weeks:         weeks,
+		TotalEntries:  trends.TotalEntries,
+		AverageMood:   trends.AverageMood,
+		TopEmotions:   emotions,
+		CurrentStreak: trends.CurrentStreak,
+		LongestStreak: trends.LongestStreak,
+	}
+}
+
+// FromQueryParams reads the optional from and to dates (YYYY-MM-DD)
+func (q *JournalEntriesQuery) FromQueryParams(params url.Values) error {
+	for _, param := range []struct {
+		name   string
+		target *time.Time
+	}{
+		{"from", &q.From},
+		{"to", &q.To},
+	} {
+		value := strings.TrimSpace(params.Get(param.name))
+		if value == "" {
+			continue
+		}
+
+		date, err := time.Parse(journalDomain.DateLayout, value)
+		if err != nil {
+			return ErrInvalidJournalPeriod
+		}
+		*param.target = date
+	}
+
+	return nil
+}
+
+func (q JournalEntriesQuery) ToDomain() journalDomain.Period {
+	return journalDomain.Period{From: q.From, To: q.To}
+}
+
+// FromQueryParams reads the optional number of weeks; zero means the default
+func (q *JournalTrendsQuery) FromQueryParams(params url.Values) error {
+	value := strings.TrimSpace(params.Get("weeks"))
+	if value == "" {
+		return nil
+	}
+
+	weeks, err := strconv.Atoi(value)
+	if err != nil || weeks < 1 || weeks > journalDomain.MaxTrendWeeks {
+		return ErrInvalidWeeksValue
+	}
+	q.Weeks = weeks
+
+	return nil
+}
+
+func (r *JournalEntryContentRequest) Validate() error {
+	if r.MoodScore == nil {
+		return ErrMissingMoodScore
+	}
+	return nil
+}
+
+func (r *JournalEntryContentRequest) ToDomain() journalDomain.EntryContent {
+	return journalDomain.EntryContent{
+		MoodScore:       *r.MoodScore,
+		Emotions:        r.Emotions,
+		Text:            r.Text,
+		SleepHours:      r.SleepHours,
+		SleepQuality:    r.SleepQuality,
+		ActivityMinutes: r.ActivityMinutes,
+	}
+}
+
+func (r *CreateJournalEntryRequest) Validate() error {
+	if _, err := time.Parse(journalDomain.DateLayout, r.EntryDate); err != nil {
+		return ErrInvalidEntryDate
+	}
+	return r.JournalEntryContentRequest.Validate()
+}
+
+func (r *CreateJournalEntryRequest) ToDomain() journalDomain.CreateEntryRequest {
+	entryDate, _ := time.Parse(journalDomain.DateLayout, r.EntryDate)
+
+	return journalDomain.CreateEntryRequest{
+		EntryDate:    entryDate,
+		EntryContent: r.JournalEntryContentRequest.ToDomain(),
+	}
+}
+
+func (r *UpdateJournalEntryRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingEntryID
+	}
+	return r.JournalEntryContentRequest.Validate()
+}
+
+func (r *DeleteJournalEntryRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingEntryID
+	}
+	return nil
+}
+
+func (r *ShareJournalEntryRequest) Validate() error {
+	if strings.TrimSpace(r.EntryID) == "" {
+		return ErrMissingEntryID
+	}
+	if r.Shared == nil {
+		return ErrMissingSharedValue
+	}
+	return nil
+}
+
+// Homework Request DTOs
+type CreateHomeworkRequest struct {
+	ClientID     string     `json:"client_id"`
+	Title        string     `json:"title"`
+	Instructions string     `json:"instructions,omitempty"`
+	ResourceType string     `json:"resource_type,omitempty"`
+	ResourceID   string     `json:"resource_id,omitempty"`
+	DueAt        *time.Time `json:"due_at,omitempty"`
+}
+
+type CancelHomeworkRequest struct {
+	AssignmentID string `json:"assignment_id"`
+}
+
+type CompleteHomeworkRequest struct {
+	AssignmentID string `json:"assignment_id"`
+	Response     string `json:"response,omitempty"`
+	Reflection   string `json:"reflection,omitempty"`
+	Helpfulness  *int   `json:"helpfulness,omitempty"`
+}
+
+// HomeworkQuery filters homework listings by status, or "overdue"
+type HomeworkQuery struct {
+	Status string
+}
+
+// Homework Response DTOs
+type HomeworkSubmissionData struct {
+	Response      string    `json:"response,omitempty"`
+	Reflection    string    `json:"reflection,omitempty"`
+	Helpfulness   *int      `json:"helpfulness,omitempty"`
+	CompletedAt   time.Time `json:"completed_at"`
+	CompletedLate bool      `json:"completed_late"`
+}
+
+type HomeworkAssignmentData struct {
+	ID           string                  `json:"id"`
+	TherapistID  string                  `json:"therapist_id"`
+	ClientID     string                  `json:"client_id"`
+	Title        string                  `json:"title"`
+	Instructions string                  `json:"instructions,omitempty"`
+	ResourceType string                  `json:"resource_type,omitempty"`
+	ResourceID   string                  `json:"resource_id,omitempty"`
+	DueAt        *time.Time              `json:"due_at,omitempty"`
+	Status       string                  `json:"status"`
+	Overdue      bool                    `json:"overdue"`
+	Submission   *HomeworkSubmissionData `json:"submission,omitempty"`
+	AssignedAt   time.Time               `json:"assigned_at"`
+	UpdatedAt    time.Time               `json:"updated_at"`
+}
+
+type HomeworkResponse struct {
+	Assignment HomeworkAssignmentData `json:"assignment"`
+	Message    string                 `json:"message,omitempty"`
+}
+
+type HomeworkListResponse struct {
+	Assignments []HomeworkAssignmentData `json:"assignments"`
+}
+
+// Homework Helper Functions
+func ToHomeworkResponse(assignment *homeworkDomain.Assignment) HomeworkAssignmentData {
+	data := HomeworkAssignmentData{
+		ID:           assignment.ID,
+		TherapistID:  assignment.TherapistID,
+		ClientID:     assignment.ClientID,
+		Title:        assignment.Title,
+		Instructions: assignment.Instructions,
+		ResourceType: string(assignment.ResourceType),
+		ResourceID:   assignment.ResourceID,
+		DueAt:        assignment.DueAt,
+		Status:       string(assignment.Status),
+		Overdue:      assignment.IsOverdue(time.Now()),
+		AssignedAt:   assignment.AssignedAt,
+		UpdatedAt:    assignment.UpdatedAt,
+	}
+
+	if submission := assignment.Submission; submission != nil {
+		data.Submission = &HomeworkSubmissionData{
+			Response:      submission.Response,
+			Reflection:    submission.Reflection,
+			Helpfulness:   submission.Helpfulness,
+			CompletedAt:   submission.CompletedAt,
+			CompletedLate: assignment.CompletedLate(),
+		}
+	}
+
+	return data
+}
+
+func ToHomeworkListResponse(assignments []*homeworkDomain.Assignment) HomeworkListResponse {
+	responses := make([]HomeworkAssignmentData, len(assignments))
+	for i, assignment := range assignments {
+		responses[i] = ToHomeworkResponse(assignment)
+	}
+	return HomeworkListResponse{
+		Assignments: responses,
+	}
+}
+
+func (q *HomeworkQuery) FromQueryParams(params url.Values) error {
+	q.Status = strings.ToLower(strings.TrimSpace(params.Get("status")))
+	if !homeworkDomain.IsValidStatusFilter(homeworkDomain.StatusFilter(q.Status)) {
+		return ErrInvalidHomeworkStatus
+	}
+	return nil
+}
+
+func (q HomeworkQuery) ToDomain() homeworkDomain.StatusFilter {
+	return homeworkDomain.StatusFilter(q.Status)
+}
+
+func (r *CreateHomeworkRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	if strings.TrimSpace(r.Title) == "" {
+		return ErrMissingHomeworkTitle
+	}
+	return nil
+}
+
+func (r *CreateHomeworkRequest) ToDomain() homeworkDomain.CreateAssignmentRequest {
+	return homeworkDomain.CreateAssignmentRequest{
+		ClientID: r.ClientID,
+		AssignmentDetails: homeworkDomain.AssignmentDetails{
+			Title:        r.Title,
+			Instructions: r.Instructions,
+			ResourceType: homeworkDomain.ResourceType(strings.ToLower(strings.TrimSpace(r.ResourceType))),
+			ResourceID:   r.ResourceID,
+			DueAt:        r.DueAt,
+		},
+	}
+}
+
+func (r *CancelHomeworkRequest) Validate() error {
+	if strings.TrimSpace(r.AssignmentID) == "" {
+		return ErrMissingAssignmentID
+	}
+	return nil
+}
+
+func (r *CompleteHomeworkRequest) Validate() error {
+	if strings.TrimSpace(r.AssignmentID) == "" {
+		return ErrMissingAssignmentID
+	}
+	return nil
+}
+
+func (r *CompleteHomeworkRequest) ToDomain() homeworkDomain.CompleteAssignmentRequest {
+	return homeworkDomain.CompleteAssignmentRequest{
+		Response:    r.Response,
+		Reflection:  r.Reflection,
+		Helpfulness: r.Helpfulness,
+	}
+}
+
+// Consent Request DTOs
+type CreateConsentDocumentRequest struct {
+	Title    string `json:"title"`
+	Kind     string `json:"kind"`
+	Required *bool  `json:"required,omitempty"`
+	Body     string `json:"body"`
+}
+
+type UpdateConsentDocumentRequest struct {
+	DocumentID string `json:"document_id"`
+	Title      string `json:"title"`
+	Kind       string `json:"kind"`
+	Required   *bool  `json:"required,omitempty"`
+}
+
+type PublishConsentVersionRequest struct {
+	DocumentID    string `json:"document_id"`
+	Body          string `json:"body"`
+	ChangeSummary string `json:"change_summary,omitempty"`
+}
+
+type SignConsentRequest struct {
+	VersionID    string `json:"version_id"`
+	DocumentHash string `json:"document_hash,omitempty"`
+	TypedName    string `json:"typed_name"`
+}
+
+// SignMinorConsentRequest is a guardian signing on behalf of the minor named by client_id
+type SignMinorConsentRequest struct {
+	ClientID string `json:"client_id"`
+	SignConsentRequest
+}
+
+// Consent Response DTOs
+type ConsentVersionData struct {
+	ID            string    `json:"id"`
+	DocumentID    string    `json:"document_id"`
+	Number        int       `json:"number"`
+	Title         string    `json:"title"`
+	Body          string    `json:"body"`
+	ChangeSummary string    `json:"change_summary,omitempty"`
+	ContentHash   string    `json:"content_hash"`
+	PublishedAt   time.Time `json:"published_at"`
+}
+
+type ConsentDocumentData struct {
+	ID             string             `json:"id"`
+	Title          string             `json:"title"`
+	Kind           string             `json:"kind"`
+	Required       bool               `json:"required"`
+	CurrentVersion ConsentVersionData `json:"current_version"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+type ConsentDocumentResponse struct {
+	Document ConsentDocumentData `json:"document"`
+	Message  string              `json:"message,omitempty"`
+}
+
+type ConsentDocumentListResponse struct {
+	Documents []ConsentDocumentData `json:"documents"`
+}
+
+type ConsentVersionListResponse struct {
+	Versions []ConsentVersionData `json:"versions"`
+}
+
+type ConsentSignatureData struct {
+	ID                 string    `json:"id"`
+	SignedByGuardianID string    `json:"signed_by_guardian_id,omitempty"`
+	DocumentID         string    `json:"document_id"`
+	VersionID          string    `json:"version_id"`
+	VersionNumber      int       `json:"version_number"`
+	TypedName          string    `json:"typed_name"`
+	IPAddress          string    `json:"ip_address,omitempty"`
+	UserAgent          string    `json:"user_agent,omitempty"`
+	DocumentHash       string    `json:"document_hash"`
+	SignedAt           time.Time `json:"signed_at"`
+}
+
+type ConsentSignatureResponse struct {
+	Signature ConsentSignatureData `json:"signature"`
+	Message   string               `json:"message,omitempty"`
+}
+
+type ConsentSignatureListResponse struct {
+	Signatures []ConsentSignatureData `json:"signatures"`
+}
+
+type ConsentStatusData struct {
+	Document      ConsentDocumentData   `json:"document"`
+	State         string                `json:"state"`
+	Outstanding   bool                  `json:"outstanding"`
+	LastSignature *ConsentSignatureData `json:"last_signature,omitempty"`
+}
+
+type ConsentStatusResponse struct {
+	Documents         []ConsentStatusData `json:"documents"`
+	AllRequiredSigned bool                `json:"all_required_signed"`
+}
+
+// Consent Helper Functions
+func ToConsentVersionResponse(version *consentDomain.Version) ConsentVersionData {
+	return ConsentVersionData{
+		ID:            version.ID,
+		DocumentID:    version.DocumentID,
+		Number:        version.Number,
+		Title:         version.Title,
+		Body:          version.Body,
+		ChangeSummary: version.ChangeSummary,
+		ContentHash:   version.ContentHash,
+		PublishedAt:   version.PublishedAt,
+	}
+}
+
+func ToConsentDocumentResponse(document *consentDomain.Document) ConsentDocumentData {
+	data := ConsentDocumentData{
+		ID:        document.ID,
+		Title:     document.Title,
+		Kind:      string(document.Kind),
+		Required:  document.Required,
+		CreatedAt: document.CreatedAt,
+		UpdatedAt: document.UpdatedAt,
+	}
+
+	if document.CurrentVersion != nil {
+		data.CurrentVersion = ToConsentVersionResponse(document.CurrentVersion)
+	}
+
+	return data
+}
+
+func ToConsentDocumentListResponse(documents []*consentDomain.Document) ConsentDocumentListResponse {
+	responses := make([]ConsentDocumentData, len(documents))
+	for i, document := range documents {
+		responses[i] = ToConsentDocumentResponse(document)
+	}
+	return ConsentDocumentListResponse{
+		Documents: responses,
+	}
+}
+
+func ToConsentVersionListResponse(versions []*consentDomain.Version) ConsentVersionListResponse {
+	responses := make([]ConsentVersionData, len(versions))
+	for i, version := range versions {
+		responses[i] = ToConsentVersionResponse(version)
+	}
+	return ConsentVersionListResponse{
+		Versions: responses,
+	}
+}
+
+func ToConsentSignatureResponse(signature *consentDomain.Signature) ConsentSignatureData {
+	return ConsentSignatureData{
+		ID:                 signature.ID,
+		SignedByGuardianID: signature.GuardianID,
+		DocumentID:         signature.DocumentID,
+		VersionID:          signature.VersionID,
+		VersionNumber:      signature.VersionNumber,
+		TypedName:          signature.TypedName,
+		IPAddress:          signature.IPAddress,
+		UserAgent:          signature.UserAgent,
+		DocumentHash:       signature.DocumentHash,
+		SignedAt:           signature.SignedAt,
+	}
+}
+
+func ToConsentSignatureListResponse(signatures []*consentDomain.Signature) ConsentSignatureListResponse {
+	responses := make([]ConsentSignatureData, len(signatures))
+	for i, signature := range signatures {
+		responses[i] = ToConsentSignatureResponse(signature)
+	}
+	return ConsentSignatureListResponse{
+		Signatures: responses,
+	}
+}
+
+func ToConsentStatusResponse(statuses []consentDomain.DocumentStatus) ConsentStatusResponse {
+	responses := make([]ConsentStatusData, len(statuses))
+	for i, status := range statuses {
+		responses[i] = ConsentStatusData{
+			Document:    ToConsentDocumentResponse(status.Document),
+			State:       string(status.State),
+			Outstanding: status.Outstanding(),
+		}
+		if status.LastSignature != nil {
+			signature := ToConsentSignatureResponse(status.LastSignature)
+			responses[i].LastSignature = &signature
+		}
+	}
+	return ConsentStatusResponse{
+		Documents:         responses,
+		AllRequiredSigned: !consentDomain.HasOutstanding(statuses),
+	}
+}
+
+func (r *CreateConsentDocumentRequest) Validate() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return ErrMissingConsentTitle
+	}
+	if !consentDomain.IsValidKind(consentKind(r.Kind)) {
+		return ErrInvalidConsentKind
+	}
+	if strings.TrimSpace(r.Body) == "" {
+		return ErrMissingConsentBody
+	}
+	return nil
+}
+
+// ToDomain makes documents required unless the request says otherwise
+func (r *CreateConsentDocumentRequest) ToDomain() consentDomain.CreateDocumentRequest {
+	required := true
+	if r.Required != nil {
+		required = *r.Required
+	}
+
+	return consentDomain.CreateDocumentRequest{
+		DocumentDetails: consentDomain.DocumentDetails{
+			Title:    r.Title,
+			Kind:     consentKind(r.Kind),
+			Required: required,
+		},
+		Body: r.Body,
+	}
+}
+
+func (r *UpdateConsentDocumentRequest) Validate() error {
+	if strings.TrimSpace(r.DocumentID) == "" {
+		return ErrMissingConsentDocumentID
+	}
+	if strings.TrimSpace(r.Title) == "" {
+		return ErrMissingConsentTitle
+	}
+	if !consentDomain.IsValidKind(consentKind(r.Kind)) {
+		return ErrInvalidConsentKind
+	}
+	if r.Required == nil {
+		return ErrMissingRequiredValue
+	}
+	return nil
+}
+
+func (r *UpdateConsentDocumentRequest) ToDomain() consentDomain.DocumentDetails {
+	return consentDomain.DocumentDetails{
+		Title:    r.Title,
+		Kind:     consentKind(r.Kind),
+		Required: *r.Required,
+	}
+}
+
+func (r *PublishConsentVersionRequest) Validate() error {
+	if strings.TrimSpace(r.DocumentID) == "" {
+		return ErrMissingConsentDocumentID
+	}
+	if strings.TrimSpace(r.Body) == "" {
+		return ErrMissingConsentBody
+	}
+	return nil
+}
+
+func (r *PublishConsentVersionRequest) ToDomain() consentDomain.PublishVersionRequest {
+	return consentDomain.PublishVersionRequest{
+		Body:          r.Body,
+		ChangeSummary: r.ChangeSummary,
+	}
+}
+
+func (r *SignConsentRequest) Validate() error {
+	if strings.TrimSpace(r.VersionID) == "" {
+		return ErrMissingConsentVersionID
+	}
+	if strings.TrimSpace(r.TypedName) == "" {
+		return ErrMissingTypedName
+	}
+	return nil
+}
+
+func (r *SignMinorConsentRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	return r.SignConsentRequest.Validate()
+}
+
+// ToDomain adds where the signature came from, which the handler reads off the request
+func (r *SignConsentRequest) ToDomain(ipAddress, userAgent string) consentDomain.SignRequest {
+	return consentDomain.SignRequest{
+		VersionID:    strings.TrimSpace(r.VersionID),
+		DocumentHash: strings.TrimSpace(r.DocumentHash),
+		TypedName:    r.TypedName,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+	}
+}
+
+func consentKind(kind string) consentDomain.Kind {
+	return consentDomain.Kind(strings.ToLower(strings.TrimSpace(kind)))
+}
+
+// Guardian Request DTOs
+type RegisterMinorRequest struct {
+	Email        string `json:"email"`
+	Password     string `json:"password"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	DateOfBirth  string `json:"date_of_birth"`
+	Relationship string `json:"relationship"`
+}
+
+type UpdateMinorRequest struct {
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	DateOfBirth string `json:"date_of_birth"`
+}
+
+type AddGuardianRequest struct {
+	ClientID     string `json:"client_id"`
+	Email        string `json:"email"`
+	Relationship string `json:"relationship"`
+}
+
+// UpdateGuardianScopesRequest replaces a guardian's scopes; an empty list removes them all
+type UpdateGuardianScopesRequest struct {
+	GuardianshipID string    `json:"guardianship_id"`
+	Scopes         *[]string `json:"scopes"`
+}
+
+type RevokeGuardianshipRequest struct {
+	GuardianshipID string `json:"guardianship_id"`
+}
+
+// Guardian Response DTOs
+type GuardianshipData struct {
+	ID           string     `json:"id"`
+	GuardianID   string     `json:"guardian_id"`
+	ClientID     string     `json:"client_id"`
+	Relationship string     `json:"relationship"`
+	Scopes       []string   `json:"scopes"`
+	Status       string     `json:"status"`
+	EndReason    string     `json:"end_reason,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	EndedAt      *time.Time `json:"ended_at,omitempty"`
+}
+
+type GuardianshipResponse struct {
+	Guardianship GuardianshipData `json:"guardianship"`
+	Message      string           `json:"message,omitempty"`
+}
+
+type GuardianshipListResponse struct {
+	Guardianships []GuardianshipData `json:"guardianships"`
+}
+
+type MinorData struct {
+	ClientID     string           `json:"client_id"`
+	FirstName    string           `json:"first_name"`
+	LastName     string           `json:"last_name"`
+	DateOfBirth  *time.Time       `json:"date_of_birth,omitempty"`
+	MajorityDate *time.Time       `json:"majority_date,omitempty"`
+	Guardianship GuardianshipData `json:"guardianship"`
+}
+
+type MinorResponse struct {
+	Minor   MinorData `json:"minor"`
+	Message string    `json:"message,omitempty"`
+}
+
+type MinorListResponse struct {
+	Minors []MinorData `json:"minors"`
+}
+
+type GuardianHomeworkData struct {
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Instructions string     `json:"instructions,omitempty"`
+	Status       string     `json:"status"`
+	DueAt        *time.Time `json:"due_at,omitempty"`
+	AssignedAt   time.Time  `json:"assigned_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+type GuardianHomeworkListResponse struct {
+	Assignments []GuardianHomeworkData `json:"assignments"`
+}
+
+// Guardian Helper Functions
+func ToGuardianshipResponse(guardianship *guardianDomain.Guardianship) GuardianshipData {
+	scopes := make([]string, len(guardianship.Scopes))
+	for i, scope := range guardianship.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return GuardianshipData{
+		ID:           guardianship.ID,
+		GuardianID:   guardianship.GuardianID,
+		ClientID:     guardianship.ClientID,
+		Relationship: string(guardianship.Relationship),
+		Scopes:       scopes,
+		Status:       string(guardianship.Status),
+		EndReason:    string(guardianship.EndReason),
+		CreatedAt:    guardianship.CreatedAt,
+		UpdatedAt:    guardianship.UpdatedAt,
+		EndedAt:      guardianship.EndedAt,
+	}
+}
+
+func ToGuardianshipListResponse(guardianships []*guardianDomain.Guardianship) GuardianshipListResponse {
+	responses := make([]GuardianshipData, len(guardianships))
+	for i, guardianship := range guardianships {
+		responses[i] = ToGuardianshipResponse(guardianship)
+	}
+
+	return GuardianshipListResponse{
+		Guardianships: responses,
+	}
+}
+
+func ToMinorResponse(minor *guardianDomain.Minor) MinorData {
+	return MinorData{
+		ClientID:     minor.Guardianship.ClientID,
+		FirstName:    minor.FirstName,
+		LastName:     minor.LastName,
+		DateOfBirth:  minor.DateOfBirth,
+		MajorityDate: minor.MajorityDate,
+		Guardianship: ToGuardianshipResponse(minor.Guardianship),
+	}
+}
+
+func ToMinorListResponse(minors []*guardianDomain.Minor) MinorListResponse {
+	responses := make([]MinorData, len(minors))
+	for i, minor := range minors {
+		responses[i] = ToMinorResponse(minor)
+	}
+
+	return MinorListResponse{
+		Minors: responses,
+	}
+}
+
+func ToGuardianHomeworkListResponse(items []*guardianDomain.HomeworkItem) GuardianHomeworkListResponse {
+	responses := make([]GuardianHomeworkData, len(items))
+	for i, item := range items {
+		responses[i] = GuardianHomeworkData{
+			ID:           item.ID,
+			Title:        item.Title,
+			Instructions: item.Instructions,
+			Status:       item.Status,
+			DueAt:        item.DueAt,
+			AssignedAt:   item.AssignedAt,
+			CompletedAt:  item.CompletedAt,
+		}
+	}
+
+	return GuardianHomeworkListResponse{
+		Assignments: responses,
+	}
+}
+
+func (r *RegisterMinorRequest) Validate() error {
+	if strings.TrimSpace(r.Email) == "" {
+		return ErrMissingEmail
+	}
+	if r.Password == "" {
+		return ErrMissingPassword
+	}
+	if strings.TrimSpace(r.FirstName) == "" {
+		return ErrMissingFirstName
+	}
+	if strings.TrimSpace(r.LastName) == "" {
+		return ErrMissingLastName
+	}
+	if err := validateDateOfBirth(r.DateOfBirth); err != nil {
+		return err
+	}
+	if !guardianDomain.IsValidRelationship(guardianRelationship(r.Relationship)) {
+		return ErrInvalidRelationship
+	}
+	return nil
+}
+
+func (r *RegisterMinorRequest) ToDomain() guardianDomain.RegisterMinorRequest {
+	return guardianDomain.RegisterMinorRequest{
+		Email:        r.Email,
+		Password:     r.Password,
+		FirstName:    r.FirstName,
+		LastName:     r.LastName,
+		DateOfBirth:  strings.TrimSpace(r.DateOfBirth),
+		Relationship: guardianRelationship(r.Relationship),
+	}
+}
+
+func (r *UpdateMinorRequest) Validate() error {
+	if strings.TrimSpace(r.FirstName) == "" {
+		return ErrMissingFirstName
+	}
+	if strings.TrimSpace(r.LastName) == "" {
+		return ErrMissingLastName
+	}
+	return validateDateOfBirth(r.DateOfBirth)
+}
+
+func (r *UpdateMinorRequest) ToDomain() guardianDomain.UpdateMinorRequest {
+	return guardianDomain.UpdateMinorRequest{
+		FirstName:   r.FirstName,
+		LastName:    r.LastName,
+		DateOfBirth: strings.TrimSpace(r.DateOfBirth),
+	}
+}
+
+func (r *AddGuardianRequest) Validate() error {
+	if strings.TrimSpace(r.ClientID) == "" {
+		return ErrMissingClientID
+	}
+	if strings.TrimSpace(r.Email) == "" {
+		return ErrMissingEmail
+	}
+	if !guardianDomain.IsValidRelationship(guardianRelationship(r.Relationship)) {
+		return ErrInvalidRelationship
+	}
+	return nil
+}
+
+func (r *AddGuardianRequest) ToDomain() guardianDomain.AddGuardianRequest {
+	return guardianDomain.AddGuardianRequest{
+		Email:        r.Email,
+		Relationship: guardianRelationship(r.Relationship),
+	}
+}
+
+func (r *UpdateGuardianScopesRequest) Validate() error {
+	if strings.TrimSpace(r.GuardianshipID) == "" {
+		return ErrMissingGuardianshipID
+	}
+	if r.Scopes == nil {
+		return ErrMissingGuardianScopes
+	}
+	for _, scope := range *r.Scopes {
+		if !guardianDomain.IsValidScope(guardianScope(scope)) {
+			return ErrInvalidGuardianScope
+		}
+	}
+	return nil
+}
+
+func (r *UpdateGuardianScopesRequest) ToDomain() []guardianDomain.Scope {
+	scopes := make([]guardianDomain.Scope, 0, len(*r.Scopes))
+	for _, scope := range *r.Scopes {
+		scopes = append(scopes, guardianScope(scope))
+	}
+	return scopes
+}
+
+func (r *RevokeGuardianshipRequest) Validate() error {
+	if strings.TrimSpace(r.GuardianshipID) == "" {
+		return ErrMissingGuardianshipID
+	}
+	return nil
+}
+
+func validateDateOfBirth(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return ErrMissingDateOfBirth
+	}
+	if _, err := time.Parse("2006-01-02", strings.TrimSpace(value)); err != nil {
+		return ErrInvalidDateOfBirth
+	}
+	return nil
+}
+
+func guardianRelationship(relationship string) guardianDomain.Relationship {
+	return guardianDomain.Relationship(strings.ToLower(strings.TrimSpace(relationship)))
+}
+
+func guardianScope(scope string) guardianDomain.Scope {
+	return guardianDomain.Scope(strings.ToLower(strings.TrimSpace(scope)))
+}
+
+// Safety Request DTOs
+type SafetyAlertsQuery struct {
+	Status string
+}
+
+type AcknowledgeSafetyAlertRequest struct {
+	AlertID string `json:"alert_id"`
+	Note    string `json:"note"`
+}
+
+// Safety Response DTOs
+type SafetySignalData struct {
+	Category  string `json:"category"`
+	Severity  string `json:"severity"`
+	Indicator string `json:"indicator"`
+}
+
+type SafetyAlertData struct {
+	ID              string             `json:"id"`
+	ClientID        string             `json:"client_id"`
+	TherapistID     string             `json:"therapist_id,omitempty"`
+	Source          string             `json:"source"`
+	SourceID        string             `json:"source_id"`
+	Severity        string             `json:"severity"`
+	Signals         []SafetySignalData `json:"signals"`
+	Status          string             `json:"status"`
+	EscalationDueAt time.Time          `json:"escalation_due_at"`
+	EscalatedAt     *time.Time         `json:"escalated_at,omitempty"`
+	AcknowledgedBy  string             `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt  *time.Time         `json:"acknowledged_at,omitempty"`
+	Note            string             `json:"note,omitempty"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+type SafetyAlertResponse struct {
+	Alert   SafetyAlertData `json:"alert"`
+	Message string          `json:"message,omitempty"`
+}
+
+type SafetyAlertListResponse struct {
+	Alerts []SafetyAlertData `json:"alerts"`
+}
+
+type CrisisResourceData struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Phone       string `json:"phone"`
+	URL         string `json:"url,omitempty"`
+	Country     string `json:"country,omitempty"`
+}
+
+type CrisisResourceListResponse struct {
+	Resources []CrisisResourceData `json:"resources"`
+}
+
+// Safety Helper Functions
+func ToSafetyAlertResponse(alert *safetyDomain.Alert) SafetyAlertData {
+	signals := make([]SafetySignalData, len(alert.Signals))
+	for i, signal := range alert.Signals {
+		signals[i] = SafetySignalData{
+			Category:  string(signal.Category),
+			Severity:  string(signal.Severity),
+			Indicator: signal.Indicator,
+		}
+	}
+
+	return SafetyAlertData{
+		ID:              alert.ID,
+		ClientID:        alert.ClientID,
+		TherapistID:     alert.TherapistID,
+		Source:          string(alert.Source),
+		SourceID:        alert.SourceID,
+		Severity:        string(alert.Severity),
+		Signals:         signals,
+		Status:          string(alert.Status),
+		EscalationDueAt: alert.EscalationDueAt,
+		EscalatedAt:     alert.EscalatedAt,
+		AcknowledgedBy:  alert.AcknowledgedBy,
+		AcknowledgedAt:  alert.AcknowledgedAt,
+		Note:            alert.Note,
+		CreatedAt:       alert.CreatedAt,
+		UpdatedAt:       alert.UpdatedAt,
+	}
+}
+
+func ToSafetyAlertListResponse(alerts []*safetyDomain.Alert) SafetyAlertListResponse {
+	responses := make([]SafetyAlertData, len(alerts))
+	for i, alert := range alerts {
+		responses[i] = ToSafetyAlertResponse(alert)
+	}
+	return SafetyAlertListResponse{
+		Alerts: responses,
+	}
+}
+
+func ToCrisisResourceList(resources []safetyDomain.CrisisResource) []CrisisResourceData {
+	data := make([]CrisisResourceData, len(resources))
+	for i, resource := range resources {
+		data[i] = CrisisResourceData{
+			Name:        resource.Name,
+			Description: resource.Description,
+			Phone:       resource.Phone,
+			URL:         resource.URL,
+			Country:     resource.Country,
+		}
+	}
+	return data
+}
+
+// FromQueryParams reads the optional status: open (the default) or all
+func (q *SafetyAlertsQuery) FromQueryParams(params url.Values) error {
+	q.Status = strings.ToLower(strings.TrimSpace(params.Get("status")))
+	if q.Status != "" && q.Status != "open" && q.Status != "all" {
+		return ErrInvalidSafetyAlertStatus
+	}
+	return nil
+}
+
+func (q SafetyAlertsQuery) OpenOnly() bool {
+	return q.Status != "all"
+}
+
+func (r *AcknowledgeSafetyAlertRequest) Validate() error {
+	if strings.TrimSpace(r.AlertID) == "" {
+		return ErrMissingSafetyAlertID
+	}
+	return nil
+}
+
+// Message Request DTOs
+type StartConversationRequest struct {
+	ParticipantID string `json:"participant_id"`
+}
+
+// SendMessageRequest is the JSON form of a message; messages with attachments
+// are sent as multipart/form-data with the same field names
+type SendMessageRequest struct {
+	ConversationID string `json:"conversation_id"`
+	ReplyToID      string `json:"reply_to_id,omitempty"`
+	Body           string `json:"body"`
+}
+
+type MarkConversationReadRequest struct {
+	ConversationID string `json:"conversation_id"`
+}
+
+type SetConversationRetentionRequest struct {
+	ConversationID string `json:"conversation_id"`
+	RetentionDays  int    `json:"retention_days"`
+}
+
+// MessagesQuery pages through a conversation's threads, or through the
+// replies of one thread when ThreadID is set
+type MessagesQuery struct {
+	ConversationID string
+	ThreadID       string
+	PageQuery
+}
+
+// Message Response DTOs
+type ConversationData struct {
+	ID            string     `json:"id"`
+	ClientID      string     `json:"client_id"`
+	TherapistID   string     `json:"therapist_id"`
+	RetentionDays int        `json:"retention_days"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type ConversationSummaryData struct {
+	ConversationData
+	UnreadCount int  `json:"unread_count"`
+	Active      bool `json:"active"`
+}
+
+type ConversationResponse struct {
+	Conversation ConversationData `json:"conversation"`
+	Message      string           `json:"message,omitempty"`
+}
+
+type ConversationListResponse struct {
+	Conversations []ConversationSummaryData `json:"conversations"`
+	NextCursor    string                    `json:"next_cursor,omitempty"`
+}
+
+type MessageAttachmentData struct {
+	ID          string `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+}
+
+type MessageData struct {
+	ID             string                  `json:"id"`
+	ConversationID string                  `json:"conversation_id"`
+	SenderID       string                  `json:"sender_id"`
+	ThreadID       string                  `json:"thread_id,omitempty"`
+	Body           string                  `json:"body"`
+	Attachments    []MessageAttachmentData `json:"attachments"`
+	ReplyCount     int                     `json:"reply_count"`
+	ReadAt         *time.Time              `json:"read_at,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+// SentMessageResponse carries crisis resources when a client's message suggested acute risk
+type SentMessageResponse struct {
+	Message         MessageData          `json:"message"`
+	CrisisResources []CrisisResourceData `json:"crisis_resources,omitempty"`
+}
+
+type MessageListResponse struct {
+	Messages   []MessageData `json:"messages"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+type MarkConversationReadResponse struct {
+	MarkedRead int64  `json:"marked_read"`
+	Message    string `json:"message"`
+}
+
+// Message Helper Functions
+func (r *StartConversationRequest) Validate() error {
+	if strings.TrimSpace(r.ParticipantID) == "" {
+		return ErrMissingParticipantID
+	}
+	return nil
+}
+
+func (r *SendMessageRequest) Validate() error {
+	if strings.TrimSpace(r.ConversationID) == "" {
+		return ErrMissingConversationID
+	}
+	return nil
+}
+
+func (r *SendMessageRequest) ToDomain(attachments []messageDomain.AttachmentUpload) messageDomain.SendMessageRequest {
+	return messageDomain.SendMessageRequest{
+		ConversationID: strings.TrimSpace(r.ConversationID),
+		ReplyToID:      strings.TrimSpace(r.ReplyToID),
+		Body:           r.Body,
+		Attachments:    attachments,
+	}
+}
+
+func (r *MarkConversationReadRequest) Validate() error {
+	if strings.TrimSpace(r.ConversationID) == "" {
+		return ErrMissingConversationID
+	}
+	return nil
+}
+
+func (r *SetConversationRetentionRequest) Validate() error {
+	if strings.TrimSpace(r.ConversationID) == "" {
+		return ErrMissingConversationID
+	}
+	if r.RetentionDays < messageDomain.MinRetentionDays || r.RetentionDays > messageDomain.MaxRetentionDays {
+		return ErrInvalidRetentionDays
+	}
+	return nil
+}
+
+func (q *MessagesQuery) FromQueryParams(params url.Values) error {
+	q.ConversationID = strings.TrimSpace(params.Get("conversation_id"))
+	if q.ConversationID == "" {
+		return ErrMissingConversationID
+	}
+
+	q.ThreadID = strings.TrimSpace(params.Get("thread_id"))
+	return q.PageQuery.FromQueryParams(params)
+}
+
+func (q MessagesQuery) ToDomain() messageDomain.MessagesQuery {
+	return messageDomain.MessagesQuery{
+		ConversationID: q.ConversationID,
+		ThreadID:       q.ThreadID,
+		Page:           q.PageQuery.ToDomain(),
+	}
+}
+
+func ToConversationResponse(conversation *messageDomain.Conversation) ConversationData {
+	return ConversationData{
+		ID:            conversation.ID,
+		ClientID:      conversation.ClientID,
+		TherapistID:   conversation.TherapistID,
+		RetentionDays: conversation.RetentionDays,
+		LastMessageAt: conversation.LastMessageAt,
+		CreatedAt:     conversation.CreatedAt,
+		UpdatedAt:     conversation.UpdatedAt,
+	}
+}
+
+func ToConversationPageResponse(page *pagination.Page[*messageDomain.ConversationSummary]) ConversationListResponse {
+	conversations := make([]ConversationSummaryData, len(page.Items))
+	for i, summary := range page.Items {
+		conversations[i] = ConversationSummaryData{
+			ConversationData: ToConversationResponse(summary.Conversation),
+			UnreadCount:      summary.UnreadCount,
+			Active:           summary.Active,
+		}
+	}
+	return ConversationListResponse{
+		Conversations: conversations,
+		NextCursor:    page.NextCursor,
+	}
+}
+
+func ToMessageResponse(message *messageDomain.Message) MessageData {
+	attachments := make([]MessageAttachmentData, len(message.Attachments))
+	for i, attachment := range message.Attachments {
+		attachments[i] = MessageAttachmentData{
+			ID:          attachment.ID,
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+		}
+	}
+
+	return MessageData{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		SenderID:       message.SenderID,
+		ThreadID:       message.ThreadID,
+		Body:           message.Body,
+		Attachments:    attachments,
+		ReplyCount:     message.ReplyCount,
+		ReadAt:         message.ReadAt,
+		CreatedAt:      message.CreatedAt,
+	}
+}
+
+func ToMessagePageResponse(page *pagination.Page[*messageDomain.Message]) MessageListResponse {
+	messages := make([]MessageData, len(page.Items))
+	for i, message := range page.Items {
+		messages[i] = ToMessageResponse(message)
+	}
+	return MessageListResponse{
+		Messages:   messages,
+		NextCursor: page.NextCursor,
+	}
+}
+
+// Realtime Response DTOs
+
+// RealtimeEventData is the data line of a streamed event
+type RealtimeEventData struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Data       json.RawMessage `json:"data"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// Realtime Helper Functions
+
+func ToRealtimeEventData(event *realtimeDomain.Event) RealtimeEventData {
+	return RealtimeEventData{
+		ID:         event.ID,
+		Type:       event.Type,
+		Data:       event.Data,
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+// Notification Request DTOs
+
+// MarkNotificationsReadRequest marks one notification read, or all of them
+// when All is set
+type MarkNotificationsReadRequest struct {
+	NotificationID string `json:"notification_id,omitempty"`
+	All            bool   `json:"all,omitempty"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	EmailEnabled bool                  `json:"email_enabled"`
+	InAppEnabled bool                  `json:"in_app_enabled"`
+	Language     *string               `json:"language,omitempty"`
+	QuietHours   *QuietHoursPreference `json:"quiet_hours,omitempty"`
+}
+
+// QuietHoursPreference gives quiet hours as "HH:MM" times in an IANA time zone
+type QuietHoursPreference struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"time_zone"`
+}
+
+type NotificationsQuery struct {
+	UnreadOnly bool
+	PageQuery
+}
+
+// Notification Response DTOs
+type NotificationData struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Notifications []NotificationData `json:"notifications"`
+	UnreadCount   int                `json:"unread_count"`
+	NextCursor    string             `json:"next_cursor,omitempty"`
+}
+
+type MarkNotificationsReadResponse struct {
+	Marked  int64  `json:"marked"`
+	Message string `json:"message"`
+}
+
+type NotificationPreferencesData struct {
+	EmailEnabled bool                  `json:"email_enabled"`
+	InAppEnabled bool                  `json:"in_app_enabled"`
+	Language     *string               `json:"language,omitempty"`
+	QuietHours   *QuietHoursPreference `json:"quiet_hours,omitempty"`
+	UpdatedAt    *time.Time            `json:"updated_at,omitempty"`
+}
+
+type NotificationPreferencesResponse struct {
+	Preferences NotificationPreferencesData `json:"preferences"`
+	Message     string                      `json:"message,omitempty"`
+}
+
+// Notification Helper Functions
+
+func (r *MarkNotificationsReadRequest) Validate() error {
+	if r.All && strings.TrimSpace(r.NotificationID) != "" {
+		return ErrAmbiguousNotificationRead
+	}
+	if !r.All && strings.TrimSpace(r.NotificationID) == "" {
+		return ErrMissingNotificationID
+	}
+	return nil
+}
+
+func (r *UpdateNotificationPreferencesRequest) ToDomain() notificationDomain.UpdatePreferencesRequest {
+	req := notificationDomain.UpdatePreferencesRequest{
+		EmailEnabled: r.EmailEnabled,
+		InAppEnabled: r.InAppEnabled,
+		Language:     r.Language,
+	}
+	if r.QuietHours != nil {
+		req.QuietHours = &notificationDomain.QuietHoursRequest{
+			Start:    r.QuietHours.Start,
+			End:      r.QuietHours.End,
+			TimeZone: r.QuietHours.TimeZone,
+		}
+	}
+	return req
+}
+
+// FromQueryParams reads the optional unread filter and the page
+func (q *NotificationsQuery) FromQueryParams(params url.Values) error {
+	if unreadStr := params.Get("unread"); unreadStr != "" {
+		unread, err := strconv.ParseBool(unreadStr)
+		if err != nil {
+			return ErrInvalidUnreadValue
+		}
+		q.UnreadOnly = unread
+	}
+	return q.PageQuery.FromQueryParams(params)
+}
+
+func (q NotificationsQuery) ToDomain() notificationDomain.InboxQuery {
+	return notificationDomain.InboxQuery{
+		UnreadOnly: q.UnreadOnly,
+		Page:       q.PageQuery.ToDomain(),
+	}
+}
+
+func ToNotificationPageResponse(page *pagination.Page[*notificationDomain.Notification], unreadCount int) NotificationListResponse {
+	notifications := make([]NotificationData, len(page.Items))
+	for i, notification := range page.Items {
+		notifications[i] = NotificationData{
+			ID:        notification.ID,
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			ReadAt:    notification.ReadAt,
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+	return NotificationListResponse{
+		Notifications: notifications,
+		UnreadCount:   unreadCount,
+		NextCursor:    page.NextCursor,
+	}
+}
+
+func ToNotificationPreferencesResponse(preferences *notificationDomain.Preferences) NotificationPreferencesData {
+	data := NotificationPreferencesData{
+		EmailEnabled: preferences.EmailEnabled,
+		InAppEnabled: preferences.InAppEnabled,
+		Language:     preferences.Language,
+	}
+	if !preferences.UpdatedAt.IsZero() {
+		data.UpdatedAt = &preferences.UpdatedAt
+	}
+	if quietHours := preferences.QuietHours; quietHours != nil {
+		data.QuietHours = &QuietHoursPreference{
+			Start:    quietHours.StartClock(),
+			End:      quietHours.EndClock(),
+			TimeZone: quietHours.TimeZone,
+		}
 	}
+	return data
 }
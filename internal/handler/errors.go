@@ -10,7 +10,7 @@ var (
 	ErrInvalidUserID                = errors.New("invalid user ID format")
 	ErrUnauthorized                 = errors.New("unauthorized")
 	ErrInternalServer               = errors.New("internal server error")
-	ErrInvalidRole                  = errors.New("invalid role - must be 'client' or 'therapist'")
+	ErrInvalidRole                  = errors.New("invalid role - must be 'client', 'therapist' or 'guardian'")
 	ErrMissingFirstName             = errors.New("first name is required")
 	ErrMissingLastName              = errors.New("last name is required")
 	ErrMissingPhone                 = errors.New("phone number is required")
@@ -31,5 +31,89 @@ var (
 	ErrMissingSlug                  = errors.New("article slug is required")
 	ErrInvalidAcceptingClientsValue = errors.New("invalid accepting_clients value - must be true or false")
 	ErrInvalidLimitValue            = errors.New("invalid limit value - must be a positive integer")
-	ErrInvalidOffsetValue           = errors.New("invalid offset value - must be a positive integer")
+	ErrMissingTherapistID           = errors.New("therapist ID is required")
+	ErrMissingWaitlistEntryID       = errors.New("waitlist entry ID is required")
+	ErrMissingLicenseJurisdiction   = errors.New("license jurisdiction is required")
+	ErrMissingLicenseType           = errors.New("license type is required")
+	ErrInvalidLicenseExpiryDate     = errors.New("invalid license expiry date - must be YYYY-MM-DD")
+	ErrMissingRejectionReason       = errors.New("rejection reason is required")
+	ErrMissingDocumentFile          = errors.New("document file is required")
+	ErrMissingPhotoFile             = errors.New("photo file is required")
+	ErrInvalidNearValue             = errors.New("invalid near value - must be 'latitude,longitude'")
+	ErrInvalidRadiusValue           = errors.New("invalid radius_km value - must be a positive number")
+	ErrRadiusWithoutNear            = errors.New("radius_km requires near")
+	ErrInvalidModalityValue         = errors.New("invalid modality value - must be 'remote' or 'in_person'")
+	ErrInvalidSortValue             = errors.New("invalid sort value - must be 'relevance', 'name', 'newest' or 'rating'")
+	ErrMissingModalities            = errors.New("offers_in_person and offers_remote are required")
+	ErrMissingLanguages             = errors.New("languages is required")
+	ErrMissingProfileSlug           = errors.New("profile slug is required")
+	ErrInvalidLanguagesValue        = errors.New("invalid languages value - must be comma-separated ISO 639-1 codes")
+	ErrMissingAddress               = errors.New("address line 1 is required")
+	ErrMissingCity                  = errors.New("city is required")
+	ErrMissingCountry               = errors.New("country is required")
+	ErrMissingCoordinates           = errors.New("latitude and longitude are required")
+	ErrMissingLocationID            = errors.New("location ID is required")
+	ErrMissingReviewID              = errors.New("review ID is required")
+	ErrInvalidRatingValue           = errors.New("invalid rating value - must be between 1 and 5")
+	ErrMissingReportReason          = errors.New("report reason is required")
+	ErrMissingModerationReason      = errors.New("moderation reason is required")
+	ErrMissingQuestionnaireID       = errors.New("questionnaire ID is required")
+	ErrMissingQuestionnaireTitle    = errors.New("questionnaire title is required")
+	ErrMissingQuestions             = errors.New("at least one question is required")
+	ErrMissingActiveValue           = errors.New("active is required")
+	ErrMissingQuestionnaireVersion  = errors.New("questionnaire version is required")
+	ErrMissingClientID              = errors.New("client ID is required")
+	ErrMissingInstrument            = errors.New("instrument is required")
+	ErrMissingAssessmentID          = errors.New("assessment ID is required")
+	ErrMissingAssessmentResponses   = errors.New("responses are required")
+	ErrMissingNoteID                = errors.New("note ID is required")
+	ErrMissingNoteTemplate          = errors.New("note template is required")
+	ErrInvalidSessionDate           = errors.New("invalid session date - must be YYYY-MM-DD")
+	ErrMissingNoteSections          = errors.New("note sections are required")
+	ErrMissingAmendmentReason       = errors.New("amendment reason is required")
+	ErrMissingAmendmentContent      = errors.New("amendment content is required")
+	ErrMissingSharedValue           = errors.New("shared is required")
+	ErrMissingPlanID                = errors.New("treatment plan ID is required")
+	ErrMissingPlanTitle             = errors.New("treatment plan title is required")
+	ErrMissingPlanProblems          = errors.New("at least one problem is required")
+	ErrMissingPlanGoals             = errors.New("at least one goal is required")
+	ErrInvalidTargetDate            = errors.New("invalid target date - must be YYYY-MM-DD")
+	ErrMissingGoalID                = errors.New("goal ID is required")
+	ErrMissingProgressNote          = errors.New("progress note is required")
+	ErrMissingPlanStatus            = errors.New("status is required")
+	ErrMissingEntryID               = errors.New("journal entry ID is required")
+	ErrInvalidEntryDate             = errors.New("invalid entry date - must be YYYY-MM-DD")
+	ErrMissingMoodScore             = errors.New("mood score is required")
+	ErrInvalidJournalPeriod         = errors.New("invalid from or to value - must be YYYY-MM-DD")
+	ErrInvalidWeeksValue            = errors.New("invalid weeks value - must be between 1 and 52")
+	ErrMissingAssignmentID          = errors.New("assignment ID is required")
+	ErrMissingHomeworkTitle         = errors.New("homework title is required")
+	ErrInvalidHomeworkStatus        = errors.New("invalid status value - must be 'assigned', 'completed', 'cancelled' or 'overdue'")
+	ErrMissingConsentDocumentID     = errors.New("consent document ID is required")
+	ErrMissingConsentTitle          = errors.New("consent document title is required")
+	ErrInvalidConsentKind           = errors.New("invalid kind value - must be 'informed_consent', 'privacy' or 'other'")
+	ErrMissingConsentBody           = errors.New("consent document body is required")
+	ErrMissingConsentVersionID      = errors.New("consent document version ID is required")
+	ErrMissingTypedName             = errors.New("typed name is required")
+	ErrMissingSignatureID           = errors.New("signature ID is required")
+	ErrMissingRequiredValue         = errors.New("required is required")
+	ErrMissingDateOfBirth           = errors.New("date of birth is required")
+	ErrInvalidDateOfBirth           = errors.New("invalid date of birth - must be YYYY-MM-DD")
+	ErrInvalidRelationship          = errors.New("invalid relationship value - must be 'parent' or 'legal_guardian'")
+	ErrMissingGuardianshipID        = errors.New("guardianship ID is required")
+	ErrMissingGuardianScopes        = errors.New("scopes are required")
+	ErrInvalidGuardianScope         = errors.New("invalid scope value - must be 'profile', 'consents' or 'homework'")
+	ErrMissingContactName           = errors.New("emergency contact name is required")
+	ErrInvalidContactRelationship   = errors.New("invalid relationship value - must be 'parent', 'partner', 'sibling', 'child', 'relative', 'friend' or 'other'")
+	ErrMissingContactMethod         = errors.New("emergency contact needs a phone number or an email")
+	ErrMissingSafetyAlertID         = errors.New("safety alert ID is required")
+	ErrInvalidSafetyAlertStatus     = errors.New("invalid status value - must be 'open' or 'all'")
+	ErrMissingParticipantID         = errors.New("participant ID is required")
+	ErrMissingConversationID        = errors.New("conversation ID is required")
+	ErrMissingAttachmentID          = errors.New("attachment ID is required")
+	ErrInvalidRetentionDays         = errors.New("invalid retention_days value - must be between 30 and 3650")
+	ErrInvalidMessageForm           = errors.New("invalid message form")
+	ErrMissingNotificationID        = errors.New("notification ID is required, or all must be true")
+	ErrAmbiguousNotificationRead    = errors.New("give either notification_id or all, not both")
+	ErrInvalidUnreadValue           = errors.New("invalid unread value - must be true or false")
 )
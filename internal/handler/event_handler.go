@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+)
+
+const (
+	// heartbeatInterval keeps idle streams open through proxies
+	heartbeatInterval = 25 * time.Second
+	// maxStreamDuration ends streams periodically so reconnecting clients
+	// present a current token again
+	maxStreamDuration = 30 * time.Minute
+	// reconnectDelay is how long clients wait before reconnecting
+	reconnectDelay = 3 * time.Second
+)
+
+type EventHandler struct {
+	subscriber realtimeDomain.Subscriber
+}
+
+func NewEventHandler(subscriber realtimeDomain.Subscriber) *EventHandler {
+	return &EventHandler{
+		subscriber: subscriber,
+	}
+}
+
+// Stream pushes the signed-in user's events as Server-Sent Events until the
+// client disconnects. Missed events are not replayed: clients refetch what
+// they show when they reconnect.
+func (h *EventHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	subscription, err := h.subscriber.Subscribe(userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+	defer subscription.Close()
+
+	// The stream outlives the server's write timeout
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Failed to lift write deadline for event stream: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if !h.send(w, controller, fmt.Sprintf("retry: %d\n\n", reconnectDelay.Milliseconds())) {
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	expiry := time.NewTimer(maxStreamDuration)
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-expiry.C:
+			return
+		case <-heartbeat.C:
+			if !h.send(w, controller, ": ping\n\n") {
+				return
+			}
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(ToRealtimeEventData(event))
+			if err != nil {
+				log.Printf("Error encoding realtime event %s: %v", event.ID, err)
+				continue
+			}
+
+			if !h.send(w, controller, fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)) {
+				return
+			}
+		}
+	}
+}
+
+// send writes one frame and flushes it; false means the client is gone
+func (h *EventHandler) send(w http.ResponseWriter, controller *http.ResponseController, frame string) bool {
+	if _, err := fmt.Fprint(w, frame); err != nil {
+		return false
+	}
+	return controller.Flush() == nil
+}
+
+// Helper methods
+
+func (h *EventHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *EventHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *EventHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, realtimeDomain.ErrTooManyStreams):
+		h.writeErrorResponse(w, http.StatusTooManyRequests, "Too many open event streams")
+	default:
+		log.Printf("Unhandled realtime error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *EventHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
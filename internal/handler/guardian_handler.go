@@ -0,0 +1,410 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type GuardianHandler struct {
+	guardianService guardianDomain.Service
+}
+
+func NewGuardianHandler(guardianService guardianDomain.Service) *GuardianHandler {
+	return &GuardianHandler{
+		guardianService: guardianService,
+	}
+}
+
+// HandleMinors serves GET (list) and POST (register a minor) on /api/guardian/minors
+func (h *GuardianHandler) HandleMinors(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetMinors(w, r)
+	case http.MethodPost:
+		h.RegisterMinor(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// HandleMinorProfile serves GET and PUT on /api/guardian/minors/profile
+func (h *GuardianHandler) HandleMinorProfile(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetMinor(w, r)
+	case http.MethodPut:
+		h.UpdateMinor(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *GuardianHandler) GetMinors(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	minors, err := h.guardianService.GetMinors(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToMinorListResponse(minors))
+}
+
+func (h *GuardianHandler) RegisterMinor(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req RegisterMinorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	minor, err := h.guardianService.RegisterMinor(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MinorResponse{
+		Minor:   ToMinorResponse(minor),
+		Message: "Minor registered successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *GuardianHandler) GetMinor(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	minor, err := h.guardianService.GetMinor(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, MinorResponse{Minor: ToMinorResponse(minor)})
+}
+
+func (h *GuardianHandler) UpdateMinor(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	var req UpdateMinorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	minor, err := h.guardianService.UpdateMinor(r.Context(), userID, clientID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MinorResponse{
+		Minor:   ToMinorResponse(minor),
+		Message: "Profile updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// AddGuardian links another guardian account to a minor, e.g. the second parent
+func (h *GuardianHandler) AddGuardian(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req AddGuardianRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	guardianship, err := h.guardianService.AddGuardian(r.Context(), userID, strings.TrimSpace(req.ClientID), req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := GuardianshipResponse{
+		Guardianship: ToGuardianshipResponse(guardianship),
+		Message:      "Guardian added successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// GetMinorHomework lists the minor's homework without their answers
+func (h *GuardianHandler) GetMinorHomework(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	items, err := h.guardianService.GetMinorHomework(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToGuardianHomeworkListResponse(items))
+}
+
+// GetClientGuardianships shows a therapist who looks after an assigned client
+func (h *GuardianHandler) GetClientGuardianships(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	guardianships, err := h.guardianService.GetClientGuardianships(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToGuardianshipListResponse(guardianships))
+}
+
+func (h *GuardianHandler) UpdateScopes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateGuardianScopesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	guardianship, err := h.guardianService.UpdateScopes(r.Context(), userID, strings.TrimSpace(req.GuardianshipID), req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := GuardianshipResponse{
+		Guardianship: ToGuardianshipResponse(guardianship),
+		Message:      "Guardian access updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *GuardianHandler) RevokeGuardianship(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req RevokeGuardianshipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	guardianship, err := h.guardianService.RevokeGuardianship(r.Context(), userID, strings.TrimSpace(req.GuardianshipID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := GuardianshipResponse{
+		Guardianship: ToGuardianshipResponse(guardianship),
+		Message:      "Guardianship revoked successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetOwnGuardianships shows clients who manages or managed their account
+func (h *GuardianHandler) GetOwnGuardianships(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	guardianships, err := h.guardianService.GetOwnGuardianships(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToGuardianshipListResponse(guardianships))
+}
+
+// Helper methods
+
+func (h *GuardianHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *GuardianHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *GuardianHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, guardianDomain.ErrGuardianshipNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Guardianship not found")
+	case errors.Is(err, guardianDomain.ErrGuardianNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "No active guardian account with this email")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, guardianDomain.ErrGuardianshipExists):
+		h.writeErrorResponse(w, http.StatusConflict, "Guardian is already linked to this client")
+	case errors.Is(err, userDomain.ErrUserAlreadyExists):
+		h.writeErrorResponse(w, http.StatusConflict, "An account with this email already exists")
+	case errors.Is(err, guardianDomain.ErrGuardianshipEnded):
+		h.writeErrorResponse(w, http.StatusConflict, "Guardianship has ended")
+	case errors.Is(err, guardianDomain.ErrClientNotMinor):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client has come of age and manages their own account")
+	case errors.Is(err, guardianDomain.ErrScopeNotGranted):
+		h.writeErrorResponse(w, http.StatusForbidden, "You have no access to this part of the client's account")
+	case errors.Is(err, guardianDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, guardianDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, guardianDomain.ErrGuardianServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Guardian service temporarily unavailable")
+	case errors.Is(err, guardianDomain.ErrInvalidGuardianData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled guardian service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *GuardianHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
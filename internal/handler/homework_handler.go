@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+)
+
+type HomeworkHandler struct {
+	homeworkService homeworkDomain.Service
+}
+
+func NewHomeworkHandler(homeworkService homeworkDomain.Service) *HomeworkHandler {
+	return &HomeworkHandler{
+		homeworkService: homeworkService,
+	}
+}
+
+// HandleTherapistHomework serves GET (a client's homework) and POST (give homework) on /api/therapist/homework
+func (h *HomeworkHandler) HandleTherapistHomework(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientAssignments(w, r)
+	case http.MethodPost:
+		h.CreateAssignment(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *HomeworkHandler) GetClientAssignments(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	var query HomeworkQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assignments, err := h.homeworkService.GetClientAssignments(r.Context(), userID, clientID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToHomeworkListResponse(assignments))
+}
+
+func (h *HomeworkHandler) CreateAssignment(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateHomeworkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assignment, err := h.homeworkService.CreateAssignment(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := HomeworkResponse{
+		Assignment: ToHomeworkResponse(assignment),
+		Message:    "Homework assigned successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *HomeworkHandler) CancelAssignment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CancelHomeworkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assignment, err := h.homeworkService.CancelAssignment(r.Context(), userID, req.AssignmentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := HomeworkResponse{
+		Assignment: ToHomeworkResponse(assignment),
+		Message:    "Homework cancelled successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetOverdueAssignments lists the therapist's overdue homework across all clients
+func (h *HomeworkHandler) GetOverdueAssignments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	assignments, err := h.homeworkService.GetOverdueAssignments(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToHomeworkListResponse(assignments))
+}
+
+func (h *HomeworkHandler) GetOwnAssignments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query HomeworkQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assignments, err := h.homeworkService.GetOwnAssignments(r.Context(), userID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToHomeworkListResponse(assignments))
+}
+
+func (h *HomeworkHandler) CompleteAssignment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CompleteHomeworkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assignment, err := h.homeworkService.CompleteAssignment(r.Context(), userID, req.AssignmentID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := HomeworkResponse{
+		Assignment: ToHomeworkResponse(assignment),
+		Message:    "Homework completed successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *HomeworkHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *HomeworkHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *HomeworkHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, homeworkDomain.ErrAssignmentNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Homework assignment not found")
+	case errors.Is(err, homeworkDomain.ErrResourceNotFound):
+		h.writeErrorResponse(w, http.StatusBadRequest, "Referenced article or therapy not found")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, homeworkDomain.ErrInvalidStatusTransition):
+		h.writeErrorResponse(w, http.StatusConflict, "Homework assignment is no longer open")
+	case errors.Is(err, homeworkDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, homeworkDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, homeworkDomain.ErrHomeworkServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Homework service temporarily unavailable")
+	case errors.Is(err, homeworkDomain.ErrInvalidAssignmentData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled homework service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *HomeworkHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
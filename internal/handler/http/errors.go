@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	"github.com/goran/thappy/internal/domain/user"
 )
 
@@ -44,6 +45,8 @@ func (eh *ErrorHandler) HandleServiceError(w http.ResponseWriter, err error) {
 		eh.responseWriter.WriteError(w, http.StatusUnauthorized, "Invalid token")
 	case errors.Is(err, user.ErrTokenExpired):
 		eh.responseWriter.WriteError(w, http.StatusUnauthorized, "Token expired")
+	case errors.Is(err, pagination.ErrInvalidCursor):
+		eh.responseWriter.WriteError(w, http.StatusBadRequest, err.Error())
 	default:
 		// Check for validation errors
 		if eh.isValidationError(err) {
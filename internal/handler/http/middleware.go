@@ -51,6 +51,20 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 	})
 }
 
+// RequireStreamAuth is RequireAuth for event streams. Browsers' EventSource
+// cannot set headers, so the token may come from the access_token query
+// parameter instead.
+func (m *AuthMiddleware) RequireStreamAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("access_token"); token != "" && r.Header.Get("Authorization") == "" {
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		m.RequireAuth(next).ServeHTTP(w, r)
+	})
+}
+
 func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		token := m.extractTokenFromHeader(r)
@@ -126,6 +140,10 @@ func (m *AuthMiddleware) RequireTherapistRole(next http.Handler) http.Handler {
 	return m.RequireRole(user.RoleTherapist)(next)
 }
 
+func (m *AuthMiddleware) RequireAdminRole(next http.Handler) http.Handler {
+	return m.RequireRole(user.RoleAdmin)(next)
+}
+
 func (m *AuthMiddleware) extractTokenFromHeader(r *http.Request) string {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
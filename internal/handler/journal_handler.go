@@ -0,0 +1,358 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+)
+
+type JournalHandler struct {
+	journalService journalDomain.Service
+	safetyService  safetyDomain.Service
+}
+
+func NewJournalHandler(journalService journalDomain.Service, safetyService safetyDomain.Service) *JournalHandler {
+	return &JournalHandler{
+		journalService: journalService,
+		safetyService:  safetyService,
+	}
+}
+
+// HandleClientJournal serves GET (own entries) and POST (write an entry) on /api/client/journal
+func (h *JournalHandler) HandleClientJournal(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetOwnEntries(w, r)
+	case http.MethodPost:
+		h.CreateEntry(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *JournalHandler) GetOwnEntries(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query JournalEntriesQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := h.journalService.GetOwnEntries(r.Context(), userID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToJournalEntryListResponse(entries))
+}
+
+func (h *JournalHandler) CreateEntry(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateJournalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.journalService.CreateEntry(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := JournalEntryResponse{
+		Entry:   ToJournalEntryResponse(entry),
+		Message: "Journal entry created successfully",
+	}
+	if entry.RiskFlagged {
+		response.CrisisResources = ToCrisisResourceList(h.safetyService.GetClientCrisisResources(r.Context(), userID))
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *JournalHandler) UpdateEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateJournalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.journalService.UpdateEntry(r.Context(), userID, req.EntryID, req.JournalEntryContentRequest.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := JournalEntryResponse{
+		Entry:   ToJournalEntryResponse(entry),
+		Message: "Journal entry updated successfully",
+	}
+	if entry.RiskFlagged {
+		response.CrisisResources = ToCrisisResourceList(h.safetyService.GetClientCrisisResources(r.Context(), userID))
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *JournalHandler) DeleteEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req DeleteJournalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.journalService.DeleteEntry(r.Context(), userID, req.EntryID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MessageResponse{
+		Message: "Journal entry deleted successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *JournalHandler) ShareEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ShareJournalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.journalService.SetEntryShared(r.Context(), userID, req.EntryID, *req.Shared)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := JournalEntryResponse{
+		Entry:   ToJournalEntryResponse(entry),
+		Message: "Journal entry sharing updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *JournalHandler) GetOwnTrends(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query JournalTrendsQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	trends, err := h.journalService.GetOwnTrends(r.Context(), userID, query.Weeks)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToJournalTrendsResponse(trends))
+}
+
+// GetClientEntries lists the entries an assigned client shared with the therapist
+func (h *JournalHandler) GetClientEntries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	var query JournalEntriesQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := h.journalService.GetClientEntries(r.Context(), userID, clientID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToJournalEntryListResponse(entries))
+}
+
+// GetClientTrends summarizes the entries an assigned client shared with the therapist
+func (h *JournalHandler) GetClientTrends(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	var query JournalTrendsQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	trends, err := h.journalService.GetClientTrends(r.Context(), userID, clientID, query.Weeks)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToJournalTrendsResponse(trends))
+}
+
+// Helper methods
+
+func (h *JournalHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	// Journal entries are private and must not linger in shared caches
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *JournalHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *JournalHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, journalDomain.ErrEntryNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Journal entry not found")
+	case errors.Is(err, journalDomain.ErrEntryExists):
+		h.writeErrorResponse(w, http.StatusConflict, "You already have a journal entry for this date")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, journalDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, journalDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, journalDomain.ErrJournalServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Journal service temporarily unavailable")
+	case errors.Is(err, journalDomain.ErrInvalidEntryData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled journal service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *JournalHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/goran/thappy/internal/domain/language"
+)
+
+// ListLanguages serves the bundled ISO 639-1 list that profile languages are validated against
+func (h *TherapistHandler) ListLanguages(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToLanguageListResponse(language.All()))
+}
+
+func (h *TherapistHandler) SetLanguages(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SetTherapistLanguagesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.SetLanguages(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistProfileResponse{
+		Profile: ToTherapistProfileResponse(profile),
+		Message: "Languages updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// HandlePracticeLocations serves GET (list own offices) and POST (add) on /api/therapist/locations
+func (h *TherapistHandler) HandlePracticeLocations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPracticeLocations(w, r)
+	case http.MethodPost:
+		h.AddPracticeLocation(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *TherapistHandler) GetPracticeLocations(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	// Resolve the profile first so non-therapists get the usual access error
+	if _, err := h.therapistService.GetProfile(r.Context(), userID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	locations, err := h.therapistService.GetPracticeLocations(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToPracticeLocationListResponse(locations))
+}
+
+func (h *TherapistHandler) AddPracticeLocation(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req PracticeLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	location, err := h.therapistService.AddPracticeLocation(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := PracticeLocationResponse{
+		Location: ToPracticeLocationResponse(location),
+		Message:  "Practice location added successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *TherapistHandler) UpdatePracticeLocation(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req PracticeLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if req.LocationID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingLocationID.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	location, err := h.therapistService.UpdatePracticeLocation(r.Context(), userID, req.LocationID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := PracticeLocationResponse{
+		Location: ToPracticeLocationResponse(location),
+		Message:  "Practice location updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) DeletePracticeLocation(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req DeletePracticeLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.therapistService.DeletePracticeLocation(r.Context(), userID, req.LocationID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MessageResponse{
+		Message: "Practice location deleted successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) SetModalities(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SetModalitiesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.SetModalities(r.Context(), userID, *req.OffersInPerson, *req.OffersRemote)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistProfileResponse{
+		Profile: ToTherapistProfileResponse(profile),
+		Message: "Session modalities updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
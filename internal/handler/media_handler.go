@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goran/thappy/internal/domain/media"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+)
+
+const mediaPathPrefix = "/media/"
+
+// publicMediaPrefixes are the storage prefixes that may be served without authentication
+var publicMediaPrefixes = []string{
+	therapistDomain.PhotoKeyPrefix + "/",
+}
+
+// MediaURL is the path under which the object stored at key is served
+func MediaURL(key string) string {
+	return mediaPathPrefix + key
+}
+
+type MediaHandler struct {
+	storage media.Storage
+}
+
+func NewMediaHandler(storage media.Storage) *MediaHandler {
+	return &MediaHandler{
+		storage: storage,
+	}
+}
+
+// ServeMedia streams public uploads such as profile photos. Every upload gets a
+// fresh key, so responses can be cached indefinitely.
+func (h *MediaHandler) ServeMedia(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, mediaPathPrefix)
+	if !isPublicMediaKey(key) {
+		http.NotFound(w, r)
+		return
+	}
+
+	object, err := h.storage.Get(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, media.ErrObjectNotFound) || errors.Is(err, media.ErrInvalidKey) {
+			http.NotFound(w, r)
+			return
+		}
+		log.Printf("Failed to read media object %s: %v", key, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", object.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(object.Content)))
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		w.Write(object.Content)
+	}
+}
+
+func isPublicMediaKey(key string) bool {
+	for _, prefix := range publicMediaPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,392 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	"github.com/goran/thappy/internal/domain/pagination"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+)
+
+// Room for multipart boundaries, headers and the text fields on top of the files
+const messageUploadOverhead = 1 << 20
+
+type MessageHandler struct {
+	messageService messageDomain.Service
+	safetyService  safetyDomain.Service
+}
+
+func NewMessageHandler(messageService messageDomain.Service, safetyService safetyDomain.Service) *MessageHandler {
+	return &MessageHandler{
+		messageService: messageService,
+		safetyService:  safetyService,
+	}
+}
+
+// HandleConversations serves GET (list own conversations) and POST (start one) on /api/conversations
+func (h *MessageHandler) HandleConversations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.ListConversations(w, r)
+	case http.MethodPost:
+		h.StartConversation(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *MessageHandler) ListConversations(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.messageService.ListConversations(r.Context(), userID, pageQuery.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToConversationPageResponse(page))
+}
+
+func (h *MessageHandler) StartConversation(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req StartConversationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	conversation, err := h.messageService.StartConversation(r.Context(), userID, strings.TrimSpace(req.ParticipantID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConversationResponse{
+		Conversation: ToConversationResponse(conversation),
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// HandleMessages serves GET (page through a conversation) and POST (send) on /api/conversations/messages
+func (h *MessageHandler) HandleMessages(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetMessages(w, r)
+	case http.MethodPost:
+		h.SendMessage(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *MessageHandler) GetMessages(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query MessagesQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.messageService.GetMessages(r.Context(), userID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToMessagePageResponse(page))
+}
+
+// SendMessage accepts JSON for text messages and multipart/form-data, with
+// files in "attachments", for messages with attachments
+func (h *MessageHandler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SendMessageRequest
+	var attachments []messageDomain.AttachmentUpload
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		req, attachments, err = h.readMultipartMessage(w, r)
+		if err != nil {
+			if errors.Is(err, messageDomain.ErrAttachmentTooLarge) {
+				h.handleServiceError(w, err)
+				return
+			}
+			h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	message, err := h.messageService.SendMessage(r.Context(), userID, req.ToDomain(attachments))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := SentMessageResponse{
+		Message: ToMessageResponse(message),
+	}
+	if message.RiskFlagged {
+		response.CrisisResources = ToCrisisResourceList(h.safetyService.GetClientCrisisResources(r.Context(), userID))
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// MarkRead sets read receipts on everything the other participant sent in a conversation
+func (h *MessageHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req MarkConversationReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	marked, err := h.messageService.MarkConversationRead(r.Context(), userID, strings.TrimSpace(req.ConversationID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MarkConversationReadResponse{
+		MarkedRead: marked,
+		Message:    "Conversation marked as read",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *MessageHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	attachmentID := strings.TrimSpace(r.URL.Query().Get("attachment_id"))
+	if attachmentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingAttachmentID.Error())
+		return
+	}
+
+	attachment, err := h.messageService.GetAttachment(r.Context(), userID, attachmentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(attachment.Content); err != nil {
+		log.Printf("Error writing message attachment: %v", err)
+	}
+}
+
+// SetRetention lets the therapist choose how long a conversation's messages are kept
+func (h *MessageHandler) SetRetention(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SetConversationRetentionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	conversation, err := h.messageService.SetRetention(r.Context(), userID, strings.TrimSpace(req.ConversationID), req.RetentionDays)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ConversationResponse{
+		Conversation: ToConversationResponse(conversation),
+		Message:      "Message retention updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// readMultipartMessage reads the text fields and attached files of a multipart message
+func (h *MessageHandler) readMultipartMessage(w http.ResponseWriter, r *http.Request) (SendMessageRequest, []messageDomain.AttachmentUpload, error) {
+	var req SendMessageRequest
+
+	r.Body = http.MaxBytesReader(w, r.Body, messageDomain.MaxAttachments*messageDomain.MaxAttachmentSize+messageUploadOverhead)
+	if err := r.ParseMultipartForm(messageDomain.MaxAttachmentSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return req, nil, messageDomain.ErrAttachmentTooLarge
+		}
+		return req, nil, ErrInvalidMessageForm
+	}
+
+	req.ConversationID = r.FormValue("conversation_id")
+	req.ReplyToID = r.FormValue("reply_to_id")
+	req.Body = r.FormValue("body")
+
+	var attachments []messageDomain.AttachmentUpload
+	for _, header := range r.MultipartForm.File["attachments"] {
+		file, err := header.Open()
+		if err != nil {
+			return req, nil, ErrInvalidMessageForm
+		}
+
+		content, err := io.ReadAll(io.LimitReader(file, messageDomain.MaxAttachmentSize+1))
+		file.Close()
+		if err != nil {
+			return req, nil, ErrInvalidMessageForm
+		}
+
+		// Trust the bytes, not the client-supplied Content-Type
+		attachments = append(attachments, messageDomain.AttachmentUpload{
+			FileName:    header.Filename,
+			ContentType: http.DetectContentType(content),
+			Content:     content,
+		})
+	}
+
+	return req, attachments, nil
+}
+
+// Helper methods
+
+func (h *MessageHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *MessageHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *MessageHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, messageDomain.ErrConversationNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Conversation not found")
+	case errors.Is(err, messageDomain.ErrMessageNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Message not found")
+	case errors.Is(err, messageDomain.ErrAttachmentNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Attachment not found")
+	case errors.Is(err, messageDomain.ErrNoActiveRelationship):
+		h.writeErrorResponse(w, http.StatusForbidden, "Messaging requires an active client-therapist relationship")
+	case errors.Is(err, messageDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, messageDomain.ErrAttachmentTooLarge):
+		h.writeErrorResponse(w, http.StatusRequestEntityTooLarge, "Attachment is too large")
+	case errors.Is(err, messageDomain.ErrUnsupportedAttachmentType):
+		h.writeErrorResponse(w, http.StatusUnsupportedMediaType, "Attachments must be PDF, JPEG or PNG files")
+	case errors.Is(err, messageDomain.ErrMessageServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Message service temporarily unavailable")
+	case errors.Is(err, messageDomain.ErrInvalidMessageData),
+		errors.Is(err, pagination.ErrInvalidCursor):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled message service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *MessageHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
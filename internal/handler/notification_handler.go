@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+type NotificationHandler struct {
+	notificationService notificationDomain.Service
+}
+
+func NewNotificationHandler(notificationService notificationDomain.Service) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// GetNotifications pages through the user's inbox, newest first, optionally
+// only the unread notifications
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query NotificationsQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.notificationService.GetNotifications(r.Context(), userID, query.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	unreadCount, err := h.notificationService.CountUnread(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToNotificationPageResponse(page, unreadCount))
+}
+
+// MarkRead marks one notification, or the whole inbox, read
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req MarkNotificationsReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var marked int64 = 1
+	if req.All {
+		marked, err = h.notificationService.MarkAllRead(r.Context(), userID)
+	} else {
+		err = h.notificationService.MarkRead(r.Context(), userID, strings.TrimSpace(req.NotificationID))
+	}
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MarkNotificationsReadResponse{
+		Marked:  marked,
+		Message: "Notifications marked as read",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// HandlePreferences serves GET (view) and PUT (replace) on /api/notifications/preferences
+func (h *NotificationHandler) HandlePreferences(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetPreferences(w, r)
+	case http.MethodPut:
+		h.UpdatePreferences(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	preferences, err := h.notificationService.GetPreferences(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := NotificationPreferencesResponse{
+		Preferences: ToNotificationPreferencesResponse(preferences),
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	preferences, err := h.notificationService.UpdatePreferences(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := NotificationPreferencesResponse{
+		Preferences: ToNotificationPreferencesResponse(preferences),
+		Message:     "Notification preferences updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *NotificationHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *NotificationHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *NotificationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, notificationDomain.ErrNotificationNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Notification not found")
+	case errors.Is(err, notificationDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, notificationDomain.ErrNotificationServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Notification service temporarily unavailable")
+	case errors.Is(err, notificationDomain.ErrInvalidPreferences),
+		errors.Is(err, pagination.ErrInvalidCursor):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled notification service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *NotificationHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
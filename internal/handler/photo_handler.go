@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/goran/thappy/internal/domain/media"
+)
+
+// Room for multipart boundaries and headers on top of the photo itself
+const photoUploadOverhead = 1 << 20
+
+// HandleProfilePhoto serves POST (upload or replace) and DELETE (remove) on /api/therapist/profile/photo
+func (h *TherapistHandler) HandleProfilePhoto(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.UploadPhoto(w, r)
+	case http.MethodDelete:
+		h.DeletePhoto(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *TherapistHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, media.MaxImageSize+photoUploadOverhead)
+	if err := r.ParseMultipartForm(media.MaxImageSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.handleServiceError(w, media.ErrImageTooLarge)
+			return
+		}
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingPhotoFile.Error())
+		return
+	}
+
+	file, _, err := r.FormFile("photo")
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingPhotoFile.Error())
+		return
+	}
+	defer file.Close()
+
+	// The service sniffs the bytes itself; the client-supplied Content-Type is ignored
+	content, err := io.ReadAll(io.LimitReader(file, media.MaxImageSize+1))
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingPhotoFile.Error())
+		return
+	}
+
+	profile, err := h.therapistService.UploadPhoto(r.Context(), userID, content)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistProfileResponse{
+		Profile: ToTherapistProfileResponse(profile),
+		Message: "Profile photo updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) DeletePhoto(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	profile, err := h.therapistService.DeletePhoto(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistProfileResponse{
+		Profile: ToTherapistProfileResponse(profile),
+		Message: "Profile photo removed successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
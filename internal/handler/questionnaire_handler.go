@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+)
+
+type QuestionnaireHandler struct {
+	questionnaireService questionnaireDomain.Service
+}
+
+func NewQuestionnaireHandler(questionnaireService questionnaireDomain.Service) *QuestionnaireHandler {
+	return &QuestionnaireHandler{
+		questionnaireService: questionnaireService,
+	}
+}
+
+// HandleTherapistQuestionnaires serves GET (list own forms) and POST (create a form) on /api/therapist/questionnaires
+func (h *QuestionnaireHandler) HandleTherapistQuestionnaires(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetTherapistQuestionnaires(w, r)
+	case http.MethodPost:
+		h.CreateQuestionnaire(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *QuestionnaireHandler) GetTherapistQuestionnaires(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	questionnaires, err := h.questionnaireService.GetTherapistQuestionnaires(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToQuestionnaireListResponse(questionnaires))
+}
+
+func (h *QuestionnaireHandler) CreateQuestionnaire(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req QuestionnaireRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionnaire, err := h.questionnaireService.CreateQuestionnaire(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := QuestionnaireResponse{
+		Questionnaire: ToQuestionnaireResponse(questionnaire),
+		Message:       "Questionnaire created successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// UpdateQuestionnaire replaces the questions and publishes them as a new version
+func (h *QuestionnaireHandler) UpdateQuestionnaire(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req QuestionnaireRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if strings.TrimSpace(req.QuestionnaireID) == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingQuestionnaireID.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionnaire, err := h.questionnaireService.UpdateQuestionnaire(r.Context(), userID, req.QuestionnaireID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := QuestionnaireResponse{
+		Questionnaire: ToQuestionnaireResponse(questionnaire),
+		Message:       "Questionnaire updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *QuestionnaireHandler) SetQuestionnaireActive(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SetQuestionnaireActiveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionnaire, err := h.questionnaireService.SetQuestionnaireActive(r.Context(), userID, req.QuestionnaireID, *req.Active)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := QuestionnaireResponse{
+		Questionnaire: ToQuestionnaireResponse(questionnaire),
+		Message:       "Questionnaire status updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetClientResponses lists what one of the therapist's clients submitted, newest first
+func (h *QuestionnaireHandler) GetClientResponses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	responses, err := h.questionnaireService.GetClientResponses(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToAnsweredQuestionnaireListResponse(responses))
+}
+
+// GetAssignedQuestionnaires lists the forms the client's therapist wants filled in
+func (h *QuestionnaireHandler) GetAssignedQuestionnaires(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	questionnaires, err := h.questionnaireService.GetAssignedQuestionnaires(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToQuestionnaireListResponse(questionnaires))
+}
+
+// HandleClientResponses serves GET (own submissions) and POST (submit a response) on /api/client/questionnaires/responses
+func (h *QuestionnaireHandler) HandleClientResponses(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetOwnResponses(w, r)
+	case http.MethodPost:
+		h.SubmitResponse(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *QuestionnaireHandler) GetOwnResponses(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	responses, err := h.questionnaireService.GetOwnResponses(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToAnsweredQuestionnaireListResponse(responses))
+}
+
+func (h *QuestionnaireHandler) SubmitResponse(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SubmitQuestionnaireRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	submitted, err := h.questionnaireService.SubmitResponse(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := QuestionnaireAnswersResponse{
+		Response: ToQuestionnaireAnswersResponse(submitted),
+		Message:  "Questionnaire submitted successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// Helper methods
+
+func (h *QuestionnaireHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *QuestionnaireHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *QuestionnaireHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, questionnaireDomain.ErrQuestionnaireNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Questionnaire not found")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, questionnaireDomain.ErrQuestionnaireInactive):
+		h.writeErrorResponse(w, http.StatusConflict, "Questionnaire is not accepting responses")
+	case errors.Is(err, questionnaireDomain.ErrOutdatedVersion):
+		h.writeErrorResponse(w, http.StatusConflict, "Questionnaire has changed - reload it and submit again")
+	case errors.Is(err, questionnaireDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, questionnaireDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, questionnaireDomain.ErrQuestionnaireServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Questionnaire service temporarily unavailable")
+	case errors.Is(err, questionnaireDomain.ErrInvalidQuestionnaireData),
+		errors.Is(err, questionnaireDomain.ErrInvalidResponseData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled questionnaire service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *QuestionnaireHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
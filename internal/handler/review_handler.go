@@ -0,0 +1,419 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+)
+
+type ReviewHandler struct {
+	reviewService reviewDomain.Service
+}
+
+func NewReviewHandler(reviewService reviewDomain.Service) *ReviewHandler {
+	return &ReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+// GetTherapistReviews pages through a therapist's visible reviews, newest first
+func (h *ReviewHandler) GetTherapistReviews(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	therapistID := strings.TrimSpace(r.URL.Query().Get("therapist_id"))
+	if therapistID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingTherapistID.Error())
+		return
+	}
+
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.reviewService.GetTherapistReviews(r.Context(), therapistID, pageQuery.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToReviewPageResponse(page))
+}
+
+// HandleClientReviews serves GET (list own reviews) and POST (write a review) on /api/client/reviews
+func (h *ReviewHandler) HandleClientReviews(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientReviews(w, r)
+	case http.MethodPost:
+		h.CreateReview(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	createReq := reviewDomain.CreateReviewRequest{
+		TherapistID: req.TherapistID,
+		Rating:      req.Rating,
+		Body:        req.Body,
+	}
+
+	review, err := h.reviewService.CreateReview(r.Context(), userID, createReq)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ReviewResponse{
+		Review:  ToReviewResponse(review),
+		Message: "Review published successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *ReviewHandler) GetClientReviews(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	reviews, err := h.reviewService.GetClientReviews(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToReviewListResponse(reviews))
+}
+
+func (h *ReviewHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updateReq := reviewDomain.UpdateReviewRequest{
+		Rating: req.Rating,
+		Body:   req.Body,
+	}
+
+	review, err := h.reviewService.UpdateReview(r.Context(), userID, req.ReviewID, updateReq)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ReviewResponse{
+		Review:  ToReviewResponse(review),
+		Message: "Review updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ReviewActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.reviewService.DeleteReview(r.Context(), userID, req.ReviewID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MessageResponse{
+		Message: "Review deleted successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *ReviewHandler) ReportReview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ReportReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = h.reviewService.ReportReview(r.Context(), userID, req.ReviewID, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MessageResponse{
+		Message: "Review reported - a moderator will look at it",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *ReviewHandler) ReplyToReview(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ReplyToReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.ReplyToReview(r.Context(), userID, req.ReviewID, req.Reply)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	message := "Reply published successfully"
+	if review.Reply == "" {
+		message = "Reply removed successfully"
+	}
+
+	response := ReviewResponse{
+		Review:  ToReviewResponse(review),
+		Message: message,
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetModerationQueue pages through flagged reviews, oldest first, with their open reports
+func (h *ReviewHandler) GetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	queue, err := h.reviewService.GetModerationQueue(r.Context(), userID, pageQuery.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToModerationQueueResponse(queue))
+}
+
+func (h *ReviewHandler) HideReview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req HideReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.HideReview(r.Context(), userID, req.ReviewID, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ModeratedReviewResponse{
+		Review:  ToModeratedReviewResponse(review, nil),
+		Message: "Review hidden",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *ReviewHandler) RestoreReview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ReviewActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.RestoreReview(r.Context(), userID, req.ReviewID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := ModeratedReviewResponse{
+		Review:  ToModeratedReviewResponse(review, nil),
+		Message: "Review restored and its reports dismissed",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *ReviewHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *ReviewHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *ReviewHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, reviewDomain.ErrReviewNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Review not found")
+	case errors.Is(err, reviewDomain.ErrReviewAlreadyExists):
+		h.writeErrorResponse(w, http.StatusConflict, "You have already reviewed this therapist - edit your existing review instead")
+	case errors.Is(err, reviewDomain.ErrAlreadyReported):
+		h.writeErrorResponse(w, http.StatusConflict, "You have already reported this review")
+	case errors.Is(err, reviewDomain.ErrInvalidStatusTransition):
+		h.writeErrorResponse(w, http.StatusConflict, "Review cannot be changed in its current state")
+	case errors.Is(err, reviewDomain.ErrNoRelationship):
+		h.writeErrorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, reviewDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, reviewDomain.ErrReviewServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Review service temporarily unavailable")
+	case errors.Is(err, therapistDomain.ErrTherapistProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Therapist profile not found")
+	case errors.Is(err, pagination.ErrInvalidCursor),
+		errors.Is(err, reviewDomain.ErrInvalidReviewData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled review service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *ReviewHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
@@ -4,20 +4,50 @@ import (
 	"net/http"
 
 	articleDomain "github.com/goran/thappy/internal/domain/article"
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	"github.com/goran/thappy/internal/domain/media"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
 	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
 	"github.com/goran/thappy/internal/domain/user"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
 	httpMiddleware "github.com/goran/thappy/internal/handler/http"
 )
 
 type Router struct {
-	userHandler      *UserHandler
-	clientHandler    *ClientHandler
-	therapistHandler *TherapistHandler
-	therapyHandler   *TherapyHandler
-	articleHandler   *ArticleHandler
-	authMiddleware   *httpMiddleware.AuthMiddleware
+	userHandler          *UserHandler
+	clientHandler        *ClientHandler
+	therapistHandler     *TherapistHandler
+	therapyHandler       *TherapyHandler
+	articleHandler       *ArticleHandler
+	waitlistHandler      *WaitlistHandler
+	reviewHandler        *ReviewHandler
+	questionnaireHandler *QuestionnaireHandler
+	assessmentHandler    *AssessmentHandler
+	sessionNoteHandler   *SessionNoteHandler
+	treatmentPlanHandler *TreatmentPlanHandler
+	journalHandler       *JournalHandler
+	homeworkHandler      *HomeworkHandler
+	consentHandler       *ConsentHandler
+	guardianHandler      *GuardianHandler
+	safetyHandler        *SafetyHandler
+	messageHandler       *MessageHandler
+	eventHandler         *EventHandler
+	notificationHandler  *NotificationHandler
+	mediaHandler         *MediaHandler
+	authMiddleware       *httpMiddleware.AuthMiddleware
 }
 
 func NewRouter(
@@ -26,15 +56,45 @@ func NewRouter(
 	therapistService therapistDomain.TherapistService,
 	therapyService therapyDomain.Service,
 	articleService articleDomain.Service,
+	waitlistService waitlistDomain.Service,
+	reviewService reviewDomain.Service,
+	questionnaireService questionnaireDomain.Service,
+	assessmentService assessmentDomain.Service,
+	sessionNoteService sessionNoteDomain.Service,
+	treatmentPlanService treatmentPlanDomain.Service,
+	journalService journalDomain.Service,
+	homeworkService homeworkDomain.Service,
+	consentService consentDomain.Service,
+	guardianService guardianDomain.Service,
+	safetyService safetyDomain.Service,
+	messageService messageDomain.Service,
+	eventSubscriber realtimeDomain.Subscriber,
+	notificationService notificationDomain.Service,
 	tokenService user.TokenService,
+	mediaStorage media.Storage,
 ) *Router {
 	return &Router{
-		userHandler:      NewUserHandler(userService),
-		clientHandler:    NewClientHandler(clientService),
-		therapistHandler: NewTherapistHandler(therapistService),
-		therapyHandler:   NewTherapyHandler(therapyService),
-		articleHandler:   NewArticleHandler(articleService),
-		authMiddleware:   httpMiddleware.NewAuthMiddleware(tokenService, userService),
+		userHandler:          NewUserHandler(userService),
+		clientHandler:        NewClientHandler(clientService),
+		therapistHandler:     NewTherapistHandler(therapistService),
+		therapyHandler:       NewTherapyHandler(therapyService, therapistService),
+		articleHandler:       NewArticleHandler(articleService),
+		waitlistHandler:      NewWaitlistHandler(waitlistService),
+		reviewHandler:        NewReviewHandler(reviewService),
+		questionnaireHandler: NewQuestionnaireHandler(questionnaireService),
+		assessmentHandler:    NewAssessmentHandler(assessmentService, safetyService),
+		sessionNoteHandler:   NewSessionNoteHandler(sessionNoteService),
+		treatmentPlanHandler: NewTreatmentPlanHandler(treatmentPlanService),
+		journalHandler:       NewJournalHandler(journalService, safetyService),
+		homeworkHandler:      NewHomeworkHandler(homeworkService),
+		consentHandler:       NewConsentHandler(consentService),
+		guardianHandler:      NewGuardianHandler(guardianService),
+		safetyHandler:        NewSafetyHandler(safetyService),
+		messageHandler:       NewMessageHandler(messageService, safetyService),
+		eventHandler:         NewEventHandler(eventSubscriber),
+		notificationHandler:  NewNotificationHandler(notificationService),
+		mediaHandler:         NewMediaHandler(mediaStorage),
+		authMiddleware:       httpMiddleware.NewAuthMiddleware(tokenService, userService),
 	}
 }
 
@@ -49,6 +109,9 @@ func (router *Router) SetupRoutes() http.Handler {
 	mux.HandleFunc("/api/register-with-role", router.userHandler.RegisterWithRole)
 	mux.HandleFunc("/api/login", router.userHandler.Login)
 
+	// Public media (profile photos)
+	mux.HandleFunc(mediaPathPrefix, router.mediaHandler.ServeMedia)
+
 	// Public therapy endpoints (for frontend to consume)
 	mux.HandleFunc("/api/therapies", router.therapyHandler.HandleTherapies)
 	mux.HandleFunc("/api/therapies/", router.therapyHandler.HandleTherapies)
@@ -57,10 +120,19 @@ func (router *Router) SetupRoutes() http.Handler {
 	mux.HandleFunc("/api/articles", router.articleHandler.HandleArticles)
 	mux.HandleFunc("/api/articles/", router.articleHandler.HandleArticles)
 
+	// Public assessment instrument catalog
+	mux.HandleFunc("/api/assessments/instruments", router.assessmentHandler.GetInstruments)
+
+	// Public crisis resources, available without signing in
+	mux.HandleFunc("/api/crisis-resources", router.safetyHandler.GetCrisisResources)
+
 	// Public therapist endpoints (for frontend to consume)
+	mux.HandleFunc("/api/languages", router.therapistHandler.ListLanguages)
 	mux.HandleFunc("/api/therapists/accepting", router.therapistHandler.GetAcceptingClients)
 	mux.HandleFunc("/api/therapists/search", router.therapistHandler.SearchTherapists)
+	mux.HandleFunc("/api/therapists/reviews", router.reviewHandler.GetTherapistReviews)
 	mux.HandleFunc("/api/therapists/profile/", router.therapistHandler.GetTherapistByLicenseNumber)
+	mux.HandleFunc(therapistSlugPathPrefix, router.therapistHandler.GetTherapistBySlug)
 	mux.HandleFunc("/api/therapists/", router.therapistHandler.GetTherapistByID)
 
 	// Protected endpoints (require authentication)
@@ -73,8 +145,145 @@ func (router *Router) SetupRoutes() http.Handler {
 	mux.Handle("/api/client/profile/personal-info", router.authMiddleware.RequireAuth(http.HandlerFunc(router.clientHandler.UpdatePersonalInfo)))
 	mux.Handle("/api/client/profile/contact-info", router.authMiddleware.RequireAuth(http.HandlerFunc(router.clientHandler.UpdateContactInfo)))
 	mux.Handle("/api/client/profile/date-of-birth", router.authMiddleware.RequireAuth(http.HandlerFunc(router.clientHandler.SetDateOfBirth)))
+	mux.Handle("/api/client/profile/preferred-language", router.authMiddleware.RequireAuth(http.HandlerFunc(router.clientHandler.SetPreferredLanguage)))
 	mux.Handle("/api/client/profile/delete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.clientHandler.DeleteProfile)))
 
+	// Client waitlist endpoints (require authentication)
+	mux.Handle("/api/client/waitlist", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.HandleClientWaitlist)))
+	mux.Handle("/api/client/waitlist/preferences", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.UpdatePreferences)))
+	mux.Handle("/api/client/waitlist/leave", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.LeaveWaitlist)))
+	mux.Handle("/api/client/waitlist/respond", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.RespondToOffer)))
+
+	// Client review endpoints (require authentication)
+	mux.Handle("/api/client/reviews", router.authMiddleware.RequireAuth(http.HandlerFunc(router.reviewHandler.HandleClientReviews)))
+	mux.Handle("/api/client/reviews/update", router.authMiddleware.RequireAuth(http.HandlerFunc(router.reviewHandler.UpdateReview)))
+	mux.Handle("/api/client/reviews/delete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.reviewHandler.DeleteReview)))
+
+	// Client intake questionnaire endpoints (require authentication)
+	mux.Handle("/api/client/questionnaires", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.GetAssignedQuestionnaires)))
+	mux.Handle("/api/client/questionnaires/responses", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.HandleClientResponses)))
+
+	// Client assessment endpoints (require authentication)
+	mux.Handle("/api/client/assessments", router.authMiddleware.RequireAuth(http.HandlerFunc(router.assessmentHandler.GetOwnAssessments)))
+	mux.Handle("/api/client/assessments/complete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.assessmentHandler.CompleteAssessment)))
+
+	// Session notes the client's therapists have shared (require authentication)
+	mux.Handle("/api/client/notes", router.authMiddleware.RequireAuth(http.HandlerFunc(router.sessionNoteHandler.GetSharedNotes)))
+	mux.Handle("/api/client/treatment-plans", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.GetSharedPlans)))
+	mux.Handle("/api/client/journal", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.HandleClientJournal)))
+	mux.Handle("/api/client/journal/update", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.UpdateEntry)))
+	mux.Handle("/api/client/journal/delete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.DeleteEntry)))
+	mux.Handle("/api/client/journal/share", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.ShareEntry)))
+	mux.Handle("/api/client/journal/trends", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.GetOwnTrends)))
+	mux.Handle("/api/client/homework", router.authMiddleware.RequireAuth(http.HandlerFunc(router.homeworkHandler.GetOwnAssignments)))
+	mux.Handle("/api/client/homework/complete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.homeworkHandler.CompleteAssignment)))
+
+	// Client consent forms (require authentication)
+	mux.Handle("/api/client/consents", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.GetOwnStatus)))
+	mux.Handle("/api/client/consents/sign", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.Sign)))
+	mux.Handle("/api/client/consents/signatures", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.GetOwnSignatures)))
+	mux.Handle("/api/client/consents/record", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.DownloadSignedRecord)))
+	mux.Handle("/api/client/guardians", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.GetOwnGuardianships)))
+	mux.Handle("/api/client/crisis-resources", router.authMiddleware.RequireAuth(http.HandlerFunc(router.safetyHandler.GetClientCrisisResources)))
+
+	// Guardian endpoints for managing minors (require authentication)
+	mux.Handle("/api/guardian/minors", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.HandleMinors)))
+	mux.Handle("/api/guardian/minors/profile", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.HandleMinorProfile)))
+	mux.Handle("/api/guardian/minors/guardians", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.AddGuardian)))
+	mux.Handle("/api/guardian/minors/homework", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.GetMinorHomework)))
+	mux.Handle("/api/guardian/consents", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.GetMinorStatus)))
+	mux.Handle("/api/guardian/consents/sign", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.SignForMinor)))
+	mux.Handle("/api/guardian/consents/record", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.DownloadMinorSignedRecord)))
+
+	// Messaging between clients and their therapists (require authentication)
+	mux.Handle("/api/conversations", router.authMiddleware.RequireAuth(http.HandlerFunc(router.messageHandler.HandleConversations)))
+	mux.Handle("/api/conversations/messages", router.authMiddleware.RequireAuth(http.HandlerFunc(router.messageHandler.HandleMessages)))
+	mux.Handle("/api/conversations/read", router.authMiddleware.RequireAuth(http.HandlerFunc(router.messageHandler.MarkRead)))
+	mux.Handle("/api/conversations/attachments", router.authMiddleware.RequireAuth(http.HandlerFunc(router.messageHandler.DownloadAttachment)))
+	mux.Handle("/api/conversations/retention", router.authMiddleware.RequireAuth(http.HandlerFunc(router.messageHandler.SetRetention)))
+
+	// Server-Sent Events stream of the signed-in user's realtime updates
+	mux.Handle("/api/events", router.authMiddleware.RequireStreamAuth(http.HandlerFunc(router.eventHandler.Stream)))
+
+	// In-app notification inbox and notification preferences (require authentication)
+	mux.Handle("/api/notifications", router.authMiddleware.RequireAuth(http.HandlerFunc(router.notificationHandler.GetNotifications)))
+	mux.Handle("/api/notifications/read", router.authMiddleware.RequireAuth(http.HandlerFunc(router.notificationHandler.MarkRead)))
+	mux.Handle("/api/notifications/preferences", router.authMiddleware.RequireAuth(http.HandlerFunc(router.notificationHandler.HandlePreferences)))
+
+	// Any signed-in user can report a review for moderation
+	mux.Handle("/api/reviews/report", router.authMiddleware.RequireAuth(http.HandlerFunc(router.reviewHandler.ReportReview)))
+
+	// Therapist practice endpoints (require authentication)
+	mux.Handle("/api/therapist/profile/accepting-clients", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.SetAcceptingClients)))
+	mux.Handle("/api/therapist/profile/modalities", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.SetModalities)))
+	mux.Handle("/api/therapist/profile/languages", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.SetLanguages)))
+	mux.Handle("/api/therapist/profile/slug", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.UpdateSlug)))
+	mux.Handle("/api/therapist/profile/photo", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.HandleProfilePhoto)))
+	mux.Handle("/api/therapist/locations", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.HandlePracticeLocations)))
+	mux.Handle("/api/therapist/locations/update", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.UpdatePracticeLocation)))
+	mux.Handle("/api/therapist/locations/delete", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.DeletePracticeLocation)))
+	mux.Handle("/api/therapist/waitlist", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.GetTherapistWaitlist)))
+	mux.Handle("/api/therapist/waitlist/offer-next", router.authMiddleware.RequireAuth(http.HandlerFunc(router.waitlistHandler.OfferNextSpot)))
+	mux.Handle("/api/therapist/reviews/reply", router.authMiddleware.RequireAuth(http.HandlerFunc(router.reviewHandler.ReplyToReview)))
+
+	// Therapist intake questionnaire endpoints (require authentication)
+	mux.Handle("/api/therapist/questionnaires", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.HandleTherapistQuestionnaires)))
+	mux.Handle("/api/therapist/questionnaires/update", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.UpdateQuestionnaire)))
+	mux.Handle("/api/therapist/questionnaires/status", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.SetQuestionnaireActive)))
+	mux.Handle("/api/therapist/questionnaires/responses", router.authMiddleware.RequireAuth(http.HandlerFunc(router.questionnaireHandler.GetClientResponses)))
+
+	// Therapist assessment endpoints (require authentication)
+	mux.Handle("/api/therapist/assessments", router.authMiddleware.RequireAuth(http.HandlerFunc(router.assessmentHandler.HandleTherapistAssessments)))
+	mux.Handle("/api/therapist/assessments/cancel", router.authMiddleware.RequireAuth(http.HandlerFunc(router.assessmentHandler.CancelAssessment)))
+	mux.Handle("/api/therapist/assessments/history", router.authMiddleware.RequireAuth(http.HandlerFunc(router.assessmentHandler.GetScoreHistory)))
+
+	// Therapist session note endpoints (require authentication)
+	mux.Handle("/api/therapist/notes", router.authMiddleware.RequireAuth(http.HandlerFunc(router.sessionNoteHandler.HandleTherapistNotes)))
+	mux.Handle("/api/therapist/notes/amend", router.authMiddleware.RequireAuth(http.HandlerFunc(router.sessionNoteHandler.AmendNote)))
+	mux.Handle("/api/therapist/notes/share", router.authMiddleware.RequireAuth(http.HandlerFunc(router.sessionNoteHandler.ShareNote)))
+	mux.Handle("/api/therapist/treatment-plans", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.HandleTherapistPlans)))
+	mux.Handle("/api/therapist/treatment-plans/progress", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.RecordProgress)))
+	mux.Handle("/api/therapist/treatment-plans/review", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.MarkReviewed)))
+	mux.Handle("/api/therapist/treatment-plans/close", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.ClosePlan)))
+	mux.Handle("/api/therapist/treatment-plans/share", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.SharePlan)))
+	mux.Handle("/api/therapist/treatment-plans/reviews-due", router.authMiddleware.RequireAuth(http.HandlerFunc(router.treatmentPlanHandler.GetPlansDueForReview)))
+	mux.Handle("/api/therapist/journal", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.GetClientEntries)))
+	mux.Handle("/api/therapist/journal/trends", router.authMiddleware.RequireAuth(http.HandlerFunc(router.journalHandler.GetClientTrends)))
+	mux.Handle("/api/therapist/homework", router.authMiddleware.RequireAuth(http.HandlerFunc(router.homeworkHandler.HandleTherapistHomework)))
+	mux.Handle("/api/therapist/homework/cancel", router.authMiddleware.RequireAuth(http.HandlerFunc(router.homeworkHandler.CancelAssignment)))
+	mux.Handle("/api/therapist/homework/overdue", router.authMiddleware.RequireAuth(http.HandlerFunc(router.homeworkHandler.GetOverdueAssignments)))
+	mux.Handle("/api/therapist/consents", router.authMiddleware.RequireAuth(http.HandlerFunc(router.consentHandler.GetClientStatus)))
+	mux.Handle("/api/therapist/guardians", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.GetClientGuardianships)))
+	mux.Handle("/api/therapist/guardians/scopes", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.UpdateScopes)))
+	mux.Handle("/api/therapist/guardians/revoke", router.authMiddleware.RequireAuth(http.HandlerFunc(router.guardianHandler.RevokeGuardianship)))
+	mux.Handle("/api/therapist/safety-alerts", router.authMiddleware.RequireAuth(http.HandlerFunc(router.safetyHandler.GetTherapistAlerts)))
+	mux.Handle("/api/therapist/safety-alerts/acknowledge", router.authMiddleware.RequireAuth(http.HandlerFunc(router.safetyHandler.AcknowledgeAlert)))
+
+	// Therapist license verification endpoints (require authentication)
+	mux.Handle("/api/therapist/verification", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.GetVerificationStatus)))
+	mux.Handle("/api/therapist/verification/documents", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.HandleVerificationDocuments)))
+	mux.Handle("/api/therapist/verification/submit", router.authMiddleware.RequireAuth(http.HandlerFunc(router.therapistHandler.SubmitLicenseVerification)))
+
+	// Admin license review endpoints (require admin role)
+	mux.Handle("/api/admin/verifications", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.therapistHandler.GetPendingVerifications)))
+	mux.Handle("/api/admin/verifications/documents", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.therapistHandler.HandleAdminVerificationDocuments)))
+	mux.Handle("/api/admin/verifications/documents/", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.therapistHandler.HandleAdminVerificationDocuments)))
+	mux.Handle("/api/admin/verifications/approve", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.therapistHandler.ApproveVerification)))
+	mux.Handle("/api/admin/verifications/reject", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.therapistHandler.RejectVerification)))
+
+	// Admin review moderation endpoints (require admin role)
+	mux.Handle("/api/admin/reviews", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.reviewHandler.GetModerationQueue)))
+	mux.Handle("/api/admin/reviews/hide", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.reviewHandler.HideReview)))
+	mux.Handle("/api/admin/reviews/restore", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.reviewHandler.RestoreReview)))
+
+	// Admin consent document endpoints (require admin role)
+	mux.Handle("/api/admin/consents", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.consentHandler.HandleAdminDocuments)))
+	mux.Handle("/api/admin/consents/versions", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.consentHandler.HandleAdminVersions)))
+
+	// Admin safety alert escalation endpoints (require admin role)
+	mux.Handle("/api/admin/safety-alerts", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.safetyHandler.GetEscalatedAlerts)))
+	mux.Handle("/api/admin/safety-alerts/acknowledge", router.authMiddleware.RequireAdminRole(http.HandlerFunc(router.safetyHandler.AcknowledgeAlert)))
+
 	// Wrap with CORS middleware
 	return router.corsMiddleware(mux)
 }
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+)
+
+type SafetyHandler struct {
+	safetyService safetyDomain.Service
+}
+
+func NewSafetyHandler(safetyService safetyDomain.Service) *SafetyHandler {
+	return &SafetyHandler{
+		safetyService: safetyService,
+	}
+}
+
+// GetCrisisResources lists crisis services in the language query parameter,
+// falling back to English
+func (h *SafetyHandler) GetCrisisResources(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	resources := h.safetyService.GetCrisisResources(r.URL.Query().Get("language"))
+	h.writeJSONResponse(w, http.StatusOK, CrisisResourceListResponse{Resources: ToCrisisResourceList(resources)})
+}
+
+// GetClientCrisisResources lists crisis services in the client's preferred language
+func (h *SafetyHandler) GetClientCrisisResources(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	resources := h.safetyService.GetClientCrisisResources(r.Context(), userID)
+	h.writeJSONResponse(w, http.StatusOK, CrisisResourceListResponse{Resources: ToCrisisResourceList(resources)})
+}
+
+func (h *SafetyHandler) GetTherapistAlerts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query SafetyAlertsQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alerts, err := h.safetyService.GetTherapistAlerts(r.Context(), userID, query.OpenOnly())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToSafetyAlertListResponse(alerts))
+}
+
+// GetEscalatedAlerts lists the alerts nobody acknowledged in time, for admins
+func (h *SafetyHandler) GetEscalatedAlerts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var query SafetyAlertsQuery
+	if err := query.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alerts, err := h.safetyService.GetEscalatedAlerts(r.Context(), userID, query.OpenOnly())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToSafetyAlertListResponse(alerts))
+}
+
+// AcknowledgeAlert serves both the therapist and the admin acknowledge endpoints
+func (h *SafetyHandler) AcknowledgeAlert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req AcknowledgeSafetyAlertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alert, err := h.safetyService.AcknowledgeAlert(r.Context(), userID, req.AlertID, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := SafetyAlertResponse{
+		Alert:   ToSafetyAlertResponse(alert),
+		Message: "Safety alert acknowledged successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *SafetyHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *SafetyHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *SafetyHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, safetyDomain.ErrAlertNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Safety alert not found")
+	case errors.Is(err, safetyDomain.ErrAlertAlreadyAcknowledged):
+		h.writeErrorResponse(w, http.StatusConflict, "Safety alert has already been acknowledged")
+	case errors.Is(err, safetyDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, safetyDomain.ErrSafetyServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Safety service temporarily unavailable")
+	case errors.Is(err, safetyDomain.ErrInvalidAlertData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled safety service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *SafetyHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
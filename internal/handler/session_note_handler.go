@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
+)
+
+type SessionNoteHandler struct {
+	noteService sessionNoteDomain.Service
+}
+
+func NewSessionNoteHandler(noteService sessionNoteDomain.Service) *SessionNoteHandler {
+	return &SessionNoteHandler{
+		noteService: noteService,
+	}
+}
+
+// HandleTherapistNotes serves GET (a client's notes) and POST (write a note) on /api/therapist/notes
+func (h *SessionNoteHandler) HandleTherapistNotes(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientNotes(w, r)
+	case http.MethodPost:
+		h.CreateNote(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *SessionNoteHandler) GetClientNotes(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	notes, err := h.noteService.GetClientNotes(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToSessionNoteListResponse(notes))
+}
+
+func (h *SessionNoteHandler) CreateNote(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateSessionNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	note, err := h.noteService.CreateNote(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := SessionNoteResponse{
+		Note:    ToSessionNoteResponse(note),
+		Message: "Session note created successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+// AmendNote appends an amendment; notes themselves are never edited
+func (h *SessionNoteHandler) AmendNote(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req AmendSessionNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	note, err := h.noteService.AmendNote(r.Context(), userID, req.NoteID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := SessionNoteResponse{
+		Note:    ToSessionNoteResponse(note),
+		Message: "Session note amended successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *SessionNoteHandler) ShareNote(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ShareSessionNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	note, err := h.noteService.SetNoteShared(r.Context(), userID, req.NoteID, *req.Shared)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := SessionNoteResponse{
+		Note:    ToSessionNoteResponse(note),
+		Message: "Session note sharing updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetSharedNotes lists the notes the client's therapists chose to share
+func (h *SessionNoteHandler) GetSharedNotes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	notes, err := h.noteService.GetSharedNotes(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToSessionNoteListResponse(notes))
+}
+
+// Helper methods
+
+func (h *SessionNoteHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	// Notes hold clinical details that must not linger in shared caches
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *SessionNoteHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *SessionNoteHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sessionNoteDomain.ErrNoteNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Session note not found")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, sessionNoteDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, sessionNoteDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, sessionNoteDomain.ErrNoteServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Session note service temporarily unavailable")
+	case errors.Is(err, sessionNoteDomain.ErrInvalidNoteData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled session note service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *SessionNoteHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
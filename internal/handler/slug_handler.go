@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const therapistSlugPathPrefix = "/api/therapists/by-slug/"
+
+// GetTherapistBySlug serves the public profile behind a slug. Slugs the
+// therapist has since replaced answer with a permanent redirect to the current one.
+func (h *TherapistHandler) GetTherapistBySlug(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	slug := strings.ToLower(strings.TrimPrefix(r.URL.Path, therapistSlugPathPrefix))
+	if slug == "" || strings.Contains(slug, "/") {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingProfileSlug.Error())
+		return
+	}
+
+	profile, err := h.therapistService.GetBySlug(r.Context(), slug)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	if profile.Slug != slug {
+		http.Redirect(w, r, therapistSlugPathPrefix+profile.Slug, http.StatusMovedPermanently)
+		return
+	}
+
+	locations, err := h.therapistService.GetPracticeLocations(r.Context(), profile.UserID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	profileData := ToTherapistProfileResponse(profile)
+	profileData.Locations = ToPracticeLocationListResponse(locations).Locations
+
+	response := TherapistProfileResponse{
+		Profile: profileData,
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) UpdateSlug(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateProfileSlugRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.UpdateSlug(r.Context(), userID, req.Slug)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistProfileResponse{
+		Profile: ToTherapistProfileResponse(profile),
+		Message: "Profile address updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
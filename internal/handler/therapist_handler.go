@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/goran/thappy/internal/domain/media"
+	"github.com/goran/thappy/internal/domain/pagination"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
 )
 
@@ -336,24 +338,20 @@ func (h *TherapistHandler) SetAcceptingClients(w http.ResponseWriter, r *http.Re
 }
 
 func (h *TherapistHandler) GetAcceptingClients(w http.ResponseWriter, r *http.Request) {
-	profiles, err := h.therapistService.GetAcceptingClients(r.Context())
-	if err != nil {
-		h.handleServiceError(w, err)
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	var profilesData []TherapistProfileData
-	for _, profile := range profiles {
-		profilesData = append(profilesData, ToTherapistProfileResponse(profile))
+	page, err := h.therapistService.GetAcceptingClients(r.Context(), pageQuery.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
 	}
 
-	response := struct {
-		Therapists []TherapistProfileData `json:"therapists"`
-		Message    string                 `json:"message,omitempty"`
-	}{
-		Therapists: profilesData,
-		Message:    "Available therapists retrieved successfully",
-	}
+	response := ToTherapistListResponse(page)
+	response.Message = "Available therapists retrieved successfully"
 
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
@@ -371,26 +369,14 @@ func (h *TherapistHandler) SearchTherapists(w http.ResponseWriter, r *http.Reque
 	}
 
 	filters := req.ToTherapistSearchFilters()
-	profiles, err := h.therapistService.SearchTherapists(r.Context(), filters)
+	page, err := h.therapistService.SearchTherapists(r.Context(), filters)
 	if err != nil {
 		h.handleServiceError(w, err)
 		return
 	}
 
-	var profilesData []TherapistProfileData
-	for _, profile := range profiles {
-		profilesData = append(profilesData, ToTherapistProfileResponse(profile))
-	}
-
-	response := struct {
-		Therapists []TherapistProfileData `json:"therapists"`
-		Total      int                    `json:"total"`
-		Message    string                 `json:"message,omitempty"`
-	}{
-		Therapists: profilesData,
-		Total:      len(profilesData),
-		Message:    "Therapists search completed successfully",
-	}
+	response := ToTherapistSearchResponse(page)
+	response.Message = "Therapists search completed successfully"
 
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
@@ -411,8 +397,17 @@ func (h *TherapistHandler) GetTherapistByID(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	locations, err := h.therapistService.GetPracticeLocations(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	profileData := ToTherapistProfileResponse(profile)
+	profileData.Locations = ToPracticeLocationListResponse(locations).Locations
+
 	response := TherapistProfileResponse{
-		Profile: ToTherapistProfileResponse(profile),
+		Profile: profileData,
 	}
 
 	h.writeJSONResponse(w, http.StatusOK, response)
@@ -493,6 +488,44 @@ func (h *TherapistHandler) handleServiceError(w http.ResponseWriter, err error)
 		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Therapist service temporarily unavailable")
 	case errors.Is(err, therapistDomain.ErrLicenseNumberAlreadyExists):
 		h.writeErrorResponse(w, http.StatusConflict, "License number already in use")
+	case errors.Is(err, therapistDomain.ErrSlugAlreadyExists):
+		h.writeErrorResponse(w, http.StatusConflict, "Profile slug already in use")
+	case errors.Is(err, therapistDomain.ErrInvalidSlug):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, therapistDomain.ErrInvalidVerificationTransition):
+		h.writeErrorResponse(w, http.StatusConflict, "License verification cannot be changed in its current state")
+	case errors.Is(err, therapistDomain.ErrLicenseExpired):
+		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "License has expired")
+	case errors.Is(err, therapistDomain.ErrVerificationEvidenceMissing):
+		h.writeErrorResponse(w, http.StatusBadRequest, "Upload at least one verification document before submitting")
+	case errors.Is(err, therapistDomain.ErrVerificationDocumentNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Verification document not found")
+	case errors.Is(err, therapistDomain.ErrVerificationDocumentTooLarge):
+		h.writeErrorResponse(w, http.StatusRequestEntityTooLarge, "Verification document is too large")
+	case errors.Is(err, therapistDomain.ErrUnsupportedDocumentType):
+		h.writeErrorResponse(w, http.StatusUnsupportedMediaType, "Verification document must be a PDF, JPEG or PNG")
+	case errors.Is(err, therapistDomain.ErrInvalidVerificationData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, therapistDomain.ErrPracticeLocationNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Practice location not found")
+	case errors.Is(err, therapistDomain.ErrTooManyPracticeLocations):
+		h.writeErrorResponse(w, http.StatusConflict, "Practice location limit reached")
+	case errors.Is(err, therapistDomain.ErrInvalidLocationData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, therapistDomain.ErrInvalidLanguageData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, therapistDomain.ErrTherapyNotInCatalog):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, therapistDomain.ErrInvalidSearchSort):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, media.ErrImageTooLarge):
+		h.writeErrorResponse(w, http.StatusRequestEntityTooLarge, "Photo is too large")
+	case errors.Is(err, media.ErrUnsupportedImageType):
+		h.writeErrorResponse(w, http.StatusUnsupportedMediaType, "Photo must be a JPEG or PNG")
+	case errors.Is(err, media.ErrInvalidImage):
+		h.writeErrorResponse(w, http.StatusBadRequest, "Photo could not be read")
+	case errors.Is(err, pagination.ErrInvalidCursor):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
 	default:
 		log.Printf("Unhandled therapist service error: %v", err)
 		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
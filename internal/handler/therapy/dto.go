@@ -3,6 +3,7 @@ package therapy
 import (
 	"time"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	"github.com/goran/thappy/internal/domain/therapy"
 )
 
@@ -43,7 +44,8 @@ type CreateTherapyResponse struct {
 }
 
 type TherapyListResponse struct {
-	Therapies []TherapyResponse `json:"therapies"`
+	Therapies  []TherapyResponse `json:"therapies"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 type TherapyDetailResponse struct {
@@ -65,13 +67,14 @@ func ToTherapyResponse(t *therapy.Therapy) TherapyResponse {
 	}
 }
 
-func ToTherapyListResponse(therapies []*therapy.Therapy) TherapyListResponse {
-	responses := make([]TherapyResponse, len(therapies))
-	for i, t := range therapies {
+func ToTherapyListResponse(page *pagination.Page[*therapy.Therapy]) TherapyListResponse {
+	responses := make([]TherapyResponse, len(page.Items))
+	for i, t := range page.Items {
 		responses[i] = ToTherapyResponse(t)
 	}
 	return TherapyListResponse{
-		Therapies: responses,
+		Therapies:  responses,
+		NextCursor: page.NextCursor,
 	}
 }
 
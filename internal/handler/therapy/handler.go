@@ -3,9 +3,11 @@ package therapy
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	"github.com/goran/thappy/internal/domain/therapy"
 	httputil "github.com/goran/thappy/internal/handler/http"
 )
@@ -84,13 +86,17 @@ func (h *Handler) ListTherapies(w http.ResponseWriter, r *http.Request) {
 	// Check if we should filter by active status
 	activeOnly := r.URL.Query().Get("active") == "true"
 
-	var therapies []*therapy.Therapy
-	var err error
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil {
+		limit = 0
+	}
+	page := pagination.PageRequest{Cursor: r.URL.Query().Get("cursor"), Limit: limit}.Normalize()
 
+	var therapies *pagination.Page[*therapy.Therapy]
 	if activeOnly {
-		therapies, err = h.therapyService.ListActiveTherapies(r.Context())
+		therapies, err = h.therapyService.ListActiveTherapies(r.Context(), page)
 	} else {
-		therapies, err = h.therapyService.ListTherapies(r.Context())
+		therapies, err = h.therapyService.ListTherapies(r.Context(), page)
 	}
 
 	if err != nil {
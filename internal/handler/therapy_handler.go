@@ -2,19 +2,24 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/goran/thappy/internal/domain/pagination"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
 	"github.com/goran/thappy/internal/domain/therapy"
 )
 
 type TherapyHandler struct {
-	therapyService therapy.Service
+	therapyService   therapy.Service
+	therapistService therapistDomain.TherapistService
 }
 
-func NewTherapyHandler(therapyService therapy.Service) *TherapyHandler {
+func NewTherapyHandler(therapyService therapy.Service, therapistService therapistDomain.TherapistService) *TherapyHandler {
 	return &TherapyHandler{
-		therapyService: therapyService,
+		therapyService:   therapyService,
+		therapistService: therapistService,
 	}
 }
 
@@ -67,6 +72,8 @@ func (h *TherapyHandler) HandleTherapies(w http.ResponseWriter, r *http.Request)
 			h.listTherapies(w, r)
 		} else if len(pathParts) == 3 && pathParts[2] != "" { // /api/therapies/{id}
 			h.getTherapy(w, r, pathParts[2])
+		} else if len(pathParts) == 4 && pathParts[2] != "" && pathParts[3] == "therapists" { // /api/therapies/{id}/therapists
+			h.listTherapists(w, r, pathParts[2])
 		} else {
 			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid URL path")
 		}
@@ -115,16 +122,27 @@ func (h *TherapyHandler) listTherapies(w http.ResponseWriter, r *http.Request) {
 	// Check if we should filter by active status
 	activeOnly := r.URL.Query().Get("active") == "true"
 
-	var therapies []*therapy.Therapy
+	var pageQuery PageQuery
+	if err := pageQuery.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	page := pageQuery.ToDomain()
+
+	var therapies *pagination.Page[*therapy.Therapy]
 	var err error
 
 	if activeOnly {
-		therapies, err = h.therapyService.ListActiveTherapies(r.Context())
+		therapies, err = h.therapyService.ListActiveTherapies(r.Context(), page)
 	} else {
-		therapies, err = h.therapyService.ListTherapies(r.Context())
+		therapies, err = h.therapyService.ListTherapies(r.Context(), page)
 	}
 
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get therapies")
 		return
 	}
@@ -133,6 +151,42 @@ func (h *TherapyHandler) listTherapies(w http.ResponseWriter, r *http.Request) {
 	h.writeJSONResponse(w, http.StatusOK, response)
 }
 
+// listTherapists pages through the publicly listed therapists who practise a therapy.
+// The search filters (accepting_clients, languages, near, ...) narrow the list further.
+func (h *TherapyHandler) listTherapists(w http.ResponseWriter, r *http.Request, therapyID string) {
+	var req SearchTherapistsRequest
+	if err := req.FromQueryParams(r.URL.Query()); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters := req.ToTherapistSearchFilters()
+	filters.TherapyID = therapyID
+
+	page, err := h.therapistService.SearchTherapists(r.Context(), filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, therapy.ErrTherapyNotFound):
+			h.writeErrorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, pagination.ErrInvalidCursor),
+			errors.Is(err, therapistDomain.ErrInvalidSearchSort),
+			errors.Is(err, therapistDomain.ErrInvalidLocationData),
+			errors.Is(err, therapistDomain.ErrInvalidLanguageData):
+			h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get therapists")
+		}
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToTherapistSearchResponse(page))
+}
+
 func (h *TherapyHandler) updateTherapy(w http.ResponseWriter, r *http.Request, therapyID string) {
 
 	var req UpdateTherapyRequest
@@ -0,0 +1,373 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
+)
+
+type TreatmentPlanHandler struct {
+	planService treatmentPlanDomain.Service
+}
+
+func NewTreatmentPlanHandler(planService treatmentPlanDomain.Service) *TreatmentPlanHandler {
+	return &TreatmentPlanHandler{
+		planService: planService,
+	}
+}
+
+// HandleTherapistPlans serves GET (a client's plans), POST (start a plan) and
+// PUT (revise a plan) on /api/therapist/treatment-plans
+func (h *TreatmentPlanHandler) HandleTherapistPlans(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientPlans(w, r)
+	case http.MethodPost:
+		h.CreatePlan(w, r)
+	case http.MethodPut:
+		h.UpdatePlan(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *TreatmentPlanHandler) GetClientPlans(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	clientID := strings.TrimSpace(r.URL.Query().Get("client_id"))
+	if clientID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingClientID.Error())
+		return
+	}
+
+	plans, err := h.planService.GetClientPlans(r.Context(), userID, clientID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToTreatmentPlanListResponse(plans))
+}
+
+func (h *TreatmentPlanHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CreateTreatmentPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plan, err := h.planService.CreatePlan(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Treatment plan created successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *TreatmentPlanHandler) UpdatePlan(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateTreatmentPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plan, err := h.planService.UpdatePlan(r.Context(), userID, req.PlanID, req.TreatmentPlanContentRequest.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Treatment plan updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TreatmentPlanHandler) RecordProgress(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req RecordPlanProgressRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plan, err := h.planService.RecordProgress(r.Context(), userID, req.PlanID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Progress recorded successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *TreatmentPlanHandler) MarkReviewed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ReviewTreatmentPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plan, err := h.planService.MarkReviewed(r.Context(), userID, req.PlanID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Treatment plan reviewed successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TreatmentPlanHandler) ClosePlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req CloseTreatmentPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status := treatmentPlanDomain.Status(strings.ToLower(strings.TrimSpace(req.Status)))
+	plan, err := h.planService.ClosePlan(r.Context(), userID, req.PlanID, status)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Treatment plan closed successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TreatmentPlanHandler) SharePlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ShareTreatmentPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	plan, err := h.planService.SetPlanShared(r.Context(), userID, req.PlanID, *req.Shared)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TreatmentPlanResponse{
+		Plan:    ToTreatmentPlanResponse(plan),
+		Message: "Treatment plan sharing updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// GetPlansDueForReview lists the therapist's plans that need their periodic review
+func (h *TreatmentPlanHandler) GetPlansDueForReview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	plans, err := h.planService.GetPlansDueForReview(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToTreatmentPlanListResponse(plans))
+}
+
+// GetSharedPlans lists the plans the client's therapists shared with them (read-only)
+func (h *TreatmentPlanHandler) GetSharedPlans(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	plans, err := h.planService.GetSharedPlans(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToTreatmentPlanListResponse(plans))
+}
+
+// Helper methods
+
+func (h *TreatmentPlanHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *TreatmentPlanHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *TreatmentPlanHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, treatmentPlanDomain.ErrPlanNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Treatment plan not found")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Client profile not found")
+	case errors.Is(err, treatmentPlanDomain.ErrClientNotAssigned):
+		h.writeErrorResponse(w, http.StatusForbidden, "Client is not assigned to you")
+	case errors.Is(err, treatmentPlanDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, treatmentPlanDomain.ErrActivePlanExists):
+		h.writeErrorResponse(w, http.StatusConflict, "Client already has an active treatment plan with you")
+	case errors.Is(err, treatmentPlanDomain.ErrPlanClosed):
+		h.writeErrorResponse(w, http.StatusConflict, "Treatment plan is closed")
+	case errors.Is(err, treatmentPlanDomain.ErrPlanServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Treatment plan service temporarily unavailable")
+	case errors.Is(err, treatmentPlanDomain.ErrInvalidPlanData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled treatment plan service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *TreatmentPlanHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+)
+
+// Room for multipart boundaries and headers on top of the file itself
+const verificationUploadOverhead = 1 << 20
+
+// HandleVerificationDocuments serves GET (list own evidence) and POST (upload) on /api/therapist/verification/documents
+func (h *TherapistHandler) HandleVerificationDocuments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetVerificationDocuments(w, r)
+	case http.MethodPost:
+		h.UploadVerificationDocument(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *TherapistHandler) UploadVerificationDocument(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, therapistDomain.MaxVerificationDocumentSize+verificationUploadOverhead)
+	if err := r.ParseMultipartForm(therapistDomain.MaxVerificationDocumentSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.handleServiceError(w, therapistDomain.ErrVerificationDocumentTooLarge)
+			return
+		}
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingDocumentFile.Error())
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingDocumentFile.Error())
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, therapistDomain.MaxVerificationDocumentSize+1))
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingDocumentFile.Error())
+		return
+	}
+
+	// Trust the bytes, not the client-supplied Content-Type
+	uploadReq := therapistDomain.UploadVerificationDocumentRequest{
+		FileName:    header.Filename,
+		ContentType: http.DetectContentType(content),
+		Content:     content,
+	}
+
+	document, err := h.therapistService.UploadVerificationDocument(r.Context(), userID, uploadReq)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := VerificationDocumentResponse{
+		Document: ToVerificationDocumentResponse(document),
+		Message:  "Verification document uploaded successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *TherapistHandler) GetVerificationDocuments(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	documents, err := h.therapistService.GetVerificationDocuments(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToVerificationDocumentListResponse(documents))
+}
+
+func (h *TherapistHandler) GetVerificationStatus(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	profile, err := h.therapistService.GetProfile(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistVerificationResponse{
+		Verification: ToTherapistVerificationResponse(profile),
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) SubmitLicenseVerification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req SubmitLicenseVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.SubmitLicenseVerification(r.Context(), userID, req.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistVerificationResponse{
+		Verification: ToTherapistVerificationResponse(profile),
+		Message:      "License submitted for verification",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Admin review endpoints
+
+func (h *TherapistHandler) GetPendingVerifications(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	profiles, err := h.therapistService.GetPendingVerifications(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToVerificationListResponse(profiles))
+}
+
+// HandleAdminVerificationDocuments lists a therapist's evidence (?therapist_id=) or downloads one document by ID
+func (h *TherapistHandler) HandleAdminVerificationDocuments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	documentID := strings.TrimPrefix(r.URL.Path, "/api/admin/verifications/documents")
+	documentID = strings.Trim(documentID, "/")
+	if documentID != "" {
+		h.downloadVerificationDocument(w, r, userID, documentID)
+		return
+	}
+
+	therapistID := strings.TrimSpace(r.URL.Query().Get("therapist_id"))
+	if therapistID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrMissingTherapistID.Error())
+		return
+	}
+
+	documents, err := h.therapistService.GetTherapistVerificationDocuments(r.Context(), userID, therapistID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToVerificationDocumentListResponse(documents))
+}
+
+func (h *TherapistHandler) ApproveVerification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req ApproveVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.ApproveVerification(r.Context(), userID, req.TherapistID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistVerificationResponse{
+		Verification: ToTherapistVerificationResponse(profile),
+		Message:      "License verified successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) RejectVerification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req RejectVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.therapistService.RejectVerification(r.Context(), userID, req.TherapistID, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := TherapistVerificationResponse{
+		Verification: ToTherapistVerificationResponse(profile),
+		Message:      "License verification rejected",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *TherapistHandler) downloadVerificationDocument(w http.ResponseWriter, r *http.Request, userID, documentID string) {
+	document, err := h.therapistService.GetVerificationDocument(r.Context(), userID, documentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(document.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": document.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(document.Content); err != nil {
+		log.Printf("Error writing verification document: %v", err)
+	}
+}
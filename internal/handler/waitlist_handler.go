@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
+)
+
+type WaitlistHandler struct {
+	waitlistService waitlistDomain.Service
+}
+
+func NewWaitlistHandler(waitlistService waitlistDomain.Service) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistService: waitlistService,
+	}
+}
+
+// HandleClientWaitlist serves GET (list own positions) and POST (join) on /api/client/waitlist
+func (h *WaitlistHandler) HandleClientWaitlist(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.GetClientWaitlists(w, r)
+	case http.MethodPost:
+		h.JoinWaitlist(w, r)
+	default:
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (h *WaitlistHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req JoinWaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	joinReq := waitlistDomain.JoinWaitlistRequest{
+		TherapistID: req.TherapistID,
+		Preferences: req.Preferences.ToDomain(),
+	}
+
+	entry, err := h.waitlistService.JoinWaitlist(r.Context(), userID, joinReq)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := WaitlistEntryResponse{
+		Entry:   ToWaitlistEntryResponse(entry),
+		Message: "Joined waitlist successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (h *WaitlistHandler) GetClientWaitlists(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	entries, err := h.waitlistService.GetClientWaitlists(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToWaitlistListResponse(entries))
+}
+
+func (h *WaitlistHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req UpdateWaitlistPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.waitlistService.UpdatePreferences(r.Context(), userID, req.EntryID, req.Preferences.ToDomain())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := WaitlistEntryResponse{
+		Entry:   ToWaitlistEntryResponse(entry),
+		Message: "Waitlist preferences updated successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *WaitlistHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req WaitlistEntryActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = h.waitlistService.LeaveWaitlist(r.Context(), userID, req.EntryID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := MessageResponse{
+		Message: "Left waitlist successfully",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *WaitlistHandler) RespondToOffer(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	var req RespondToWaitlistOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrInvalidJSON.Error())
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.waitlistService.RespondToOffer(r.Context(), userID, req.EntryID, req.Accept)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	message := "Waitlist offer declined"
+	if req.Accept {
+		message = "Waitlist offer accepted - therapist assigned"
+	}
+
+	response := WaitlistEntryResponse{
+		Entry:   ToWaitlistEntryResponse(entry),
+		Message: message,
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (h *WaitlistHandler) GetTherapistWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	entries, err := h.waitlistService.GetTherapistWaitlist(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, ToWaitlistListResponse(entries))
+}
+
+func (h *WaitlistHandler) OfferNextSpot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	userID, err := h.getUserIDFromContext(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+		return
+	}
+
+	entry, err := h.waitlistService.OfferNextSpot(r.Context(), userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response := WaitlistEntryResponse{
+		Entry:   ToWaitlistEntryResponse(entry),
+		Message: "Spot offered to the next client on the waitlist",
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, response)
+}
+
+// Helper methods
+
+func (h *WaitlistHandler) writeJSONResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func (h *WaitlistHandler) writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	response := ErrorResponse{
+		Error: message,
+	}
+	h.writeJSONResponse(w, status, response)
+}
+
+func (h *WaitlistHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, waitlistDomain.ErrEntryNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Waitlist entry not found")
+	case errors.Is(err, waitlistDomain.ErrAlreadyOnWaitlist):
+		h.writeErrorResponse(w, http.StatusConflict, "Already on this therapist's waitlist")
+	case errors.Is(err, waitlistDomain.ErrTherapistAcceptingClients):
+		h.writeErrorResponse(w, http.StatusConflict, "Therapist is accepting clients - no waitlist needed")
+	case errors.Is(err, waitlistDomain.ErrNoWaitingClients):
+		h.writeErrorResponse(w, http.StatusNotFound, "No clients are waiting")
+	case errors.Is(err, waitlistDomain.ErrInvalidStatusTransition):
+		h.writeErrorResponse(w, http.StatusConflict, "Waitlist entry cannot be changed in its current state")
+	case errors.Is(err, waitlistDomain.ErrEntryExpired):
+		h.writeErrorResponse(w, http.StatusGone, "Waitlist entry has expired")
+	case errors.Is(err, waitlistDomain.ErrUnauthorizedAccess):
+		h.writeErrorResponse(w, http.StatusForbidden, "Access denied")
+	case errors.Is(err, waitlistDomain.ErrWaitlistServiceUnavailable):
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Waitlist service temporarily unavailable")
+	case errors.Is(err, therapistDomain.ErrTherapistProfileNotFound):
+		h.writeErrorResponse(w, http.StatusNotFound, "Therapist profile not found")
+	case errors.Is(err, clientDomain.ErrConsentRequired):
+		h.writeErrorResponse(w, http.StatusConflict, "Required consent forms must be signed before accepting a spot")
+	case errors.Is(err, clientDomain.ErrClientProfileNotFound):
+		h.writeErrorResponse(w, http.StatusBadRequest, "Client profile required before joining a waitlist")
+	case errors.Is(err, waitlistDomain.ErrInvalidWaitlistData):
+		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		log.Printf("Unhandled waitlist service error: %v", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func (h *WaitlistHandler) getUserIDFromContext(r *http.Request) (string, error) {
+	userID := r.Context().Value("userID")
+	if userID == nil {
+		return "", ErrMissingUserID
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		return "", ErrInvalidUserID
+	}
+
+	return userIDStr, nil
+}
@@ -6,11 +6,15 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	RabbitMQ RabbitMQConfig
-	Auth     AuthConfig
-	App      AppConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	RabbitMQ   RabbitMQConfig
+	Auth       AuthConfig
+	Storage    StorageConfig
+	Encryption EncryptionConfig
+	Email      EmailConfig
+	Scheduler  SchedulerConfig
+	App        AppConfig
 }
 
 type ServerConfig struct {
@@ -42,10 +46,46 @@ type RabbitMQConfig struct {
 }
 
 type AuthConfig struct {
-	JWTSecret  string
-	TokenTTL   time.Duration
-	RefreshTTL time.Duration
-	BcryptCost int
+	JWTSecret    string
+	TokenTTL     time.Duration
+	RefreshTTL   time.Duration
+	BcryptCost   int
+	CursorSecret string
+}
+
+// StorageConfig selects where uploaded media is kept. Only the "local" driver
+// exists today; an S3-compatible driver can implement media.Storage later.
+type StorageConfig struct {
+	Driver    string
+	LocalPath string
+}
+
+// EncryptionConfig selects the key provider for data encrypted at rest, such as
+// session notes. The "local" provider reads master keys from MasterKeys, a
+// comma-separated list of "<key-id>:<base64 32-byte key>"; ActiveKeyID picks the
+// one new records use and may be empty when only one key is configured.
+type EncryptionConfig struct {
+	KeyProvider string
+	MasterKeys  string
+	ActiveKeyID string
+}
+
+// EmailConfig configures outgoing notification email. Without an SMTPHost
+// emails are only logged, which is what development uses.
+type EmailConfig struct {
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+}
+
+// SchedulerConfig controls the background job scheduler. ReminderOffsets is a
+// comma-separated list of durations before an appointment at which the
+// client is reminded, such as "24h,1h".
+type SchedulerConfig struct {
+	PollInterval    time.Duration
+	ReminderOffsets string
 }
 
 type AppConfig struct {
@@ -54,6 +94,8 @@ type AppConfig struct {
 	Environment string
 	LogLevel    string
 	Debug       bool
+	// PublicURL is the frontend's address, used for links in notifications
+	PublicURL string
 }
 
 // Load loads configuration using the configuration service
@@ -108,6 +108,28 @@ func (cs *ConfigService) buildConfig() (*Config, error) {
 			TokenTTL:   cs.getDuration("JWT_TOKEN_TTL", 24*time.Hour),
 			RefreshTTL: cs.getDuration("JWT_REFRESH_TTL", 7*24*time.Hour),
 			BcryptCost: cs.getInt("BCRYPT_COST", 12),
+			// Signs pagination cursors; falls back to the JWT secret when unset
+			CursorSecret: cs.getString("CURSOR_SECRET", ""),
+		},
+		Storage: StorageConfig{
+			Driver:    cs.getString("STORAGE_DRIVER", "local"),
+			LocalPath: cs.getString("STORAGE_LOCAL_PATH", "./data/uploads"),
+		},
+		Encryption: EncryptionConfig{
+			KeyProvider: cs.getString("ENCRYPTION_KEY_PROVIDER", "local"),
+			MasterKeys:  cs.getStringRequired("ENCRYPTION_MASTER_KEYS"),
+			ActiveKeyID: cs.getString("ENCRYPTION_ACTIVE_KEY_ID", ""),
+		},
+		Email: EmailConfig{
+			SMTPHost:     cs.getString("SMTP_HOST", ""),
+			SMTPPort:     cs.getInt("SMTP_PORT", 587),
+			SMTPUsername: cs.getString("SMTP_USERNAME", ""),
+			SMTPPassword: cs.getString("SMTP_PASSWORD", ""),
+			From:         cs.getString("EMAIL_FROM", "Thappy <no-reply@thappy.local>"),
+		},
+		Scheduler: SchedulerConfig{
+			PollInterval:    cs.getDuration("SCHEDULER_POLL_INTERVAL", 30*time.Second),
+			ReminderOffsets: cs.getString("REMINDER_OFFSETS", "24h,1h"),
 		},
 		App: AppConfig{
 			Name:        cs.getString("APP_NAME", "thappy"),
@@ -115,6 +137,7 @@ func (cs *ConfigService) buildConfig() (*Config, error) {
 			Environment: cs.getString("APP_ENV", "development"),
 			LogLevel:    cs.getString("LOG_LEVEL", "info"),
 			Debug:       cs.getBool("DEBUG", false),
+			PublicURL:   cs.getString("APP_PUBLIC_URL", "http://localhost:3000"),
 		},
 	}, nil
 }
@@ -144,6 +167,29 @@ func (cs *ConfigService) validateConfig(config *Config) error {
 		errors = append(errors, "bcrypt cost must be between 4 and 31")
 	}
 
+	// Storage validation
+	if config.Storage.Driver != "local" {
+		errors = append(errors, fmt.Sprintf("unsupported storage driver: %s (must be: local)", config.Storage.Driver))
+	}
+
+	// Encryption validation
+	if config.Encryption.KeyProvider != "local" {
+		errors = append(errors, fmt.Sprintf("unsupported encryption key provider: %s (must be: local)", config.Encryption.KeyProvider))
+	}
+	if config.Encryption.MasterKeys == "" {
+		errors = append(errors, "encryption master keys are required")
+	}
+
+	// Email validation
+	if config.Email.SMTPHost != "" && (config.Email.SMTPPort < 1 || config.Email.SMTPPort > 65535) {
+		errors = append(errors, fmt.Sprintf("invalid SMTP port: %d", config.Email.SMTPPort))
+	}
+
+	// Scheduler validation
+	if config.Scheduler.PollInterval <= 0 {
+		errors = append(errors, "scheduler poll interval must be positive")
+	}
+
 	// App validation
 	validEnvs := []string{"development", "staging", "production"}
 	if !slices.Contains(validEnvs, config.App.Environment) {
@@ -6,26 +6,80 @@ import (
 	"time"
 
 	articleDomain "github.com/goran/thappy/internal/domain/article"
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	"github.com/goran/thappy/internal/domain/encryption"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	"github.com/goran/thappy/internal/domain/media"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	reminderDomain "github.com/goran/thappy/internal/domain/reminder"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
 	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
 	"github.com/goran/thappy/internal/domain/user"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
 	"github.com/goran/thappy/internal/handler"
 	userHandler "github.com/goran/thappy/internal/handler/user"
 	"github.com/goran/thappy/internal/infrastructure/config"
 	"github.com/goran/thappy/internal/infrastructure/database"
+	"github.com/goran/thappy/internal/infrastructure/email"
+	"github.com/goran/thappy/internal/infrastructure/keys"
 	"github.com/goran/thappy/internal/infrastructure/messaging"
+	"github.com/goran/thappy/internal/infrastructure/notification"
+	"github.com/goran/thappy/internal/infrastructure/pdf"
+	"github.com/goran/thappy/internal/infrastructure/realtime"
+	"github.com/goran/thappy/internal/infrastructure/storage"
 	articleRepository "github.com/goran/thappy/internal/repository/article/postgres"
+	assessmentRepository "github.com/goran/thappy/internal/repository/assessment/postgres"
 	clientRepository "github.com/goran/thappy/internal/repository/client/postgres"
+	consentRepository "github.com/goran/thappy/internal/repository/consent/postgres"
+	"github.com/goran/thappy/internal/repository/cursor"
+	guardianRepository "github.com/goran/thappy/internal/repository/guardian/postgres"
+	homeworkRepository "github.com/goran/thappy/internal/repository/homework/postgres"
+	journalRepository "github.com/goran/thappy/internal/repository/journal/postgres"
+	messageRepository "github.com/goran/thappy/internal/repository/message/postgres"
+	notificationRepository "github.com/goran/thappy/internal/repository/notification/postgres"
+	questionnaireRepository "github.com/goran/thappy/internal/repository/questionnaire/postgres"
+	reviewRepository "github.com/goran/thappy/internal/repository/review/postgres"
+	safetyRepository "github.com/goran/thappy/internal/repository/safety/postgres"
+	schedulerRepository "github.com/goran/thappy/internal/repository/scheduler/postgres"
+	sessionNoteRepository "github.com/goran/thappy/internal/repository/sessionnote/postgres"
 	therapistRepository "github.com/goran/thappy/internal/repository/therapist/postgres"
 	therapyRepository "github.com/goran/thappy/internal/repository/therapy/postgres"
+	treatmentPlanRepository "github.com/goran/thappy/internal/repository/treatmentplan/postgres"
 	userRepository "github.com/goran/thappy/internal/repository/user/postgres"
+	waitlistRepository "github.com/goran/thappy/internal/repository/waitlist/postgres"
 	articleService "github.com/goran/thappy/internal/service/article"
+	assessmentService "github.com/goran/thappy/internal/service/assessment"
 	authService "github.com/goran/thappy/internal/service/auth"
 	clientService "github.com/goran/thappy/internal/service/client"
+	consentService "github.com/goran/thappy/internal/service/consent"
+	guardianService "github.com/goran/thappy/internal/service/guardian"
+	homeworkService "github.com/goran/thappy/internal/service/homework"
+	journalService "github.com/goran/thappy/internal/service/journal"
+	messageService "github.com/goran/thappy/internal/service/message"
+	notificationService "github.com/goran/thappy/internal/service/notification"
+	questionnaireService "github.com/goran/thappy/internal/service/questionnaire"
+	reminderService "github.com/goran/thappy/internal/service/reminder"
+	reviewService "github.com/goran/thappy/internal/service/review"
+	safetyService "github.com/goran/thappy/internal/service/safety"
+	schedulerService "github.com/goran/thappy/internal/service/scheduler"
+	sessionNoteService "github.com/goran/thappy/internal/service/sessionnote"
 	therapistService "github.com/goran/thappy/internal/service/therapist"
 	therapyService "github.com/goran/thappy/internal/service/therapy"
+	treatmentPlanService "github.com/goran/thappy/internal/service/treatmentplan"
 	userService "github.com/goran/thappy/internal/service/user"
+	waitlistService "github.com/goran/thappy/internal/service/waitlist"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -34,23 +88,64 @@ type Container struct {
 	Config *config.Config
 
 	// Infrastructure
-	DB       *pgxpool.Pool
-	RabbitMQ *messaging.RabbitMQConnection
+	DB           *pgxpool.Pool
+	RabbitMQ     *messaging.RabbitMQConnection
+	MediaStorage media.Storage
+	KeyProvider  encryption.KeyProvider
+
+	// NotificationRenderer writes notifications from the embedded templates;
+	// EmailSender delivers them over SMTP, or logs them when none is configured
+	NotificationRenderer notificationDomain.Renderer
+	EmailSender          notificationDomain.Sender
+
+	// RealtimeHub holds the event streams open on this replica; RealtimeRelay
+	// publishes events to the hubs of all replicas
+	RealtimeHub   *realtime.Hub
+	RealtimeRelay *messaging.RealtimeRelay
 
 	// Services
-	UserService      user.UserService
-	TokenService     user.TokenService
-	ClientService    clientDomain.ClientService
-	TherapistService therapistDomain.TherapistService
-	TherapyService   therapyDomain.Service
-	ArticleService   articleDomain.Service
+	UserService          user.UserService
+	TokenService         user.TokenService
+	ClientService        clientDomain.ClientService
+	TherapistService     therapistDomain.TherapistService
+	TherapyService       therapyDomain.Service
+	ArticleService       articleDomain.Service
+	WaitlistService      waitlistDomain.Service
+	ReviewService        reviewDomain.Service
+	QuestionnaireService questionnaireDomain.Service
+	AssessmentService    assessmentDomain.Service
+	SessionNoteService   sessionNoteDomain.Service
+	TreatmentPlanService treatmentPlanDomain.Service
+	JournalService       journalDomain.Service
+	HomeworkService      homeworkDomain.Service
+	ConsentService       consentDomain.Service
+	GuardianService      guardianDomain.Service
+	SafetyService        safetyDomain.Service
+	MessageService       messageDomain.Service
+	NotificationService  notificationDomain.Service
+	SchedulerService     schedulerDomain.Service
+	ReminderService      reminderDomain.Service
 
 	// Repositories
-	UserRepository      user.UserRepository
-	ClientRepository    clientDomain.ClientRepository
-	TherapistRepository therapistDomain.TherapistRepository
-	TherapyRepository   therapyDomain.Repository
-	ArticleRepository   articleDomain.Repository
+	UserRepository          user.UserRepository
+	ClientRepository        clientDomain.ClientRepository
+	TherapistRepository     therapistDomain.TherapistRepository
+	TherapyRepository       therapyDomain.Repository
+	ArticleRepository       articleDomain.Repository
+	WaitlistRepository      waitlistDomain.Repository
+	ReviewRepository        reviewDomain.Repository
+	QuestionnaireRepository questionnaireDomain.Repository
+	AssessmentRepository    assessmentDomain.Repository
+	SessionNoteRepository   sessionNoteDomain.Repository
+	TreatmentPlanRepository treatmentPlanDomain.Repository
+	JournalRepository       journalDomain.Repository
+	HomeworkRepository      homeworkDomain.Repository
+	ConsentRepository       consentDomain.Repository
+	GuardianRepository      guardianDomain.Repository
+	SafetyRepository        safetyDomain.Repository
+	MessageRepository       messageDomain.Repository
+	NotificationRepository  notificationDomain.Repository
+	SchedulerRepository     schedulerDomain.Repository
 
 	// Handlers
 	UserHandler *userHandler.Handler
@@ -100,6 +195,24 @@ func (c *Container) initInfrastructure() error {
 	}
 	c.DB = db
 
+	// Initialize media storage for uploaded photos
+	mediaStorage, err := storage.NewLocalStorage(c.Config.Storage.LocalPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize media storage: %w", err)
+	}
+	c.MediaStorage = mediaStorage
+
+	// Initialize the key provider for data encrypted at rest
+	masterKeys, err := keys.ParseMasterKeys(c.Config.Encryption.MasterKeys)
+	if err != nil {
+		return fmt.Errorf("failed to read encryption master keys: %w", err)
+	}
+	keyProvider, err := keys.NewLocalKeyProvider(masterKeys, c.Config.Encryption.ActiveKeyID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize key provider: %w", err)
+	}
+	c.KeyProvider = keyProvider
+
 	// Initialize RabbitMQ (optional for now)
 	if c.Config.RabbitMQ.URL != "" {
 		rabbitmq, err := messaging.NewRabbitMQConnection(c.Config)
@@ -111,25 +224,101 @@ func (c *Container) initInfrastructure() error {
 		}
 	}
 
+	// Realtime events fan out through RabbitMQ when it is available
+	c.RealtimeHub = realtime.NewHub()
+	c.RealtimeRelay = messaging.NewRealtimeRelay(c.RabbitMQ, c.RealtimeHub)
+
+	// Initialize notification templates and email delivery
+	renderer, err := notification.NewTemplateRenderer(c.Config.App.PublicURL)
+	if err != nil {
+		return fmt.Errorf("failed to load notification templates: %w", err)
+	}
+	c.NotificationRenderer = renderer
+
+	if c.Config.Email.SMTPHost != "" {
+		sender, err := email.NewSMTPSender(
+			c.Config.Email.SMTPHost,
+			c.Config.Email.SMTPPort,
+			c.Config.Email.SMTPUsername,
+			c.Config.Email.SMTPPassword,
+			c.Config.Email.From,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to initialize email sender: %w", err)
+		}
+		c.EmailSender = sender
+	} else {
+		c.EmailSender = email.NewLogSender()
+	}
+
 	return nil
 }
 
 // initRepositories initializes all repositories
 func (c *Container) initRepositories() error {
+	// Pagination cursors are signed so clients cannot forge list positions
+	cursorSecret := c.Config.Auth.CursorSecret
+	if cursorSecret == "" {
+		cursorSecret = c.Config.Auth.JWTSecret
+	}
+	cursors := cursor.NewCodec(cursorSecret)
+
 	// User repository
 	c.UserRepository = userRepository.NewUserRepository(c.DB)
 
 	// Client repository
-	c.ClientRepository = clientRepository.NewClientRepository(c.DB)
+	c.ClientRepository = clientRepository.NewClientRepository(c.DB, cursors)
 
 	// Therapist repository
-	c.TherapistRepository = therapistRepository.NewTherapistRepository(c.DB)
+	c.TherapistRepository = therapistRepository.NewTherapistRepository(c.DB, cursors)
 
 	// Therapy repository
-	c.TherapyRepository = therapyRepository.NewTherapyRepository(c.DB)
+	c.TherapyRepository = therapyRepository.NewTherapyRepository(c.DB, cursors)
 
 	// Article repository
-	c.ArticleRepository = articleRepository.NewArticleRepository(c.DB)
+	c.ArticleRepository = articleRepository.NewArticleRepository(c.DB, cursors)
+
+	// Waitlist repository
+	c.WaitlistRepository = waitlistRepository.NewWaitlistRepository(c.DB)
+
+	// Review repository
+	c.ReviewRepository = reviewRepository.NewReviewRepository(c.DB, cursors)
+
+	// Questionnaire repository
+	c.QuestionnaireRepository = questionnaireRepository.NewQuestionnaireRepository(c.DB)
+
+	// Assessment repository
+	c.AssessmentRepository = assessmentRepository.NewAssessmentRepository(c.DB)
+
+	// Session note repository (encrypts note content)
+	c.SessionNoteRepository = sessionNoteRepository.NewSessionNoteRepository(c.DB, c.KeyProvider)
+
+	// Treatment plan repository
+	c.TreatmentPlanRepository = treatmentPlanRepository.NewTreatmentPlanRepository(c.DB)
+
+	// Journal repository (encrypts entry text)
+	c.JournalRepository = journalRepository.NewJournalRepository(c.DB, c.KeyProvider)
+
+	// Homework repository
+	c.HomeworkRepository = homeworkRepository.NewHomeworkRepository(c.DB)
+
+	// Consent repository
+	c.ConsentRepository = consentRepository.NewConsentRepository(c.DB)
+
+	// Guardian repository
+	c.GuardianRepository = guardianRepository.NewGuardianRepository(c.DB)
+
+	// Safety alert repository
+	c.SafetyRepository = safetyRepository.NewSafetyRepository(c.DB)
+
+	// Message repository (encrypts message text and attachments)
+	c.MessageRepository = messageRepository.NewMessageRepository(c.DB, c.KeyProvider, cursors)
+
+	// Notification repository (inbox, preferences and email outbox)
+	c.NotificationRepository = notificationRepository.NewNotificationRepository(c.DB, cursors)
+
+	// Scheduled job repository
+	c.SchedulerRepository = schedulerRepository.NewSchedulerRepository(c.DB)
 
 	return nil
 }
@@ -148,16 +337,149 @@ func (c *Container) initServices() error {
 		c.TokenService,
 	)
 
+	// Guardian service (built first: consent and client profiles depend on guardianships)
+	c.GuardianService = guardianService.NewGuardianService(
+		c.GuardianRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		c.HomeworkRepository,
+	)
+
+	// Consent service (built early: assigning a therapist needs signed consents)
+	c.ConsentService = consentService.NewConsentService(
+		c.ConsentRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		c.GuardianService,
+		pdf.NewConsentRecordRenderer(),
+	)
+
 	// Client service
 	c.ClientService = clientService.NewClientService(
 		c.ClientRepository,
 		c.UserRepository,
+		c.ConsentService,
+		c.GuardianService,
+	)
+
+	// Notification service (built before the services whose events it turns into notifications)
+	notifications := notificationService.NewNotificationService(
+		c.NotificationRepository,
+		c.UserRepository,
+		c.ClientRepository,
+		c.NotificationRenderer,
+		c.EmailSender,
 	)
+	c.NotificationService = notifications
+
+	// Scheduler service (job handlers register with it below)
+	scheduler := schedulerService.NewSchedulerService(c.SchedulerRepository)
+	c.SchedulerService = scheduler
+
+	// Appointment reminder service. Booking is to call ScheduleReminders when an
+	// appointment is made or moved and CancelReminders when it is cancelled.
+	reminderOffsets, err := reminderDomain.ParseOffsets(c.Config.Scheduler.ReminderOffsets)
+	if err != nil {
+		return fmt.Errorf("failed to read reminder offsets: %w", err)
+	}
+	reminders := reminderService.NewReminderService(scheduler, notifications, reminderOffsets)
+	scheduler.Register(reminderDomain.JobKind, reminders)
+	c.ReminderService = reminders
+
+	// Events go to open realtime streams and to the notification inbox and email
+	events := realtimeDomain.Publishers{c.RealtimeRelay, notifications}
+
+	// Waitlist service (built before the therapist service, which notifies it)
+	waitlist := waitlistService.NewWaitlistService(
+		c.WaitlistRepository,
+		c.TherapistRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		messaging.NewWaitlistNotifier(c.RabbitMQ),
+		c.ConsentService,
+		events,
+	)
+	c.WaitlistService = waitlist
 
 	// Therapist service
 	c.TherapistService = therapistService.NewTherapistService(
 		c.TherapistRepository,
 		c.UserRepository,
+		c.TherapyRepository,
+		waitlist,
+		c.MediaStorage,
+	)
+
+	// Review service
+	c.ReviewService = reviewService.NewReviewService(
+		c.ReviewRepository,
+		c.TherapistRepository,
+		c.UserRepository,
+	)
+
+	// Questionnaire service
+	c.QuestionnaireService = questionnaireService.NewQuestionnaireService(
+		c.QuestionnaireRepository,
+		c.ClientRepository,
+		c.UserRepository,
+	)
+
+	// Safety service (built before the assessment, journal and message services, which it screens)
+	safety := safetyService.NewSafetyService(
+		c.SafetyRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		safetyDomain.DefaultDetector(),
+		events,
+	)
+	c.SafetyService = safety
+
+	// Assessment service
+	c.AssessmentService = assessmentService.NewAssessmentService(
+		c.AssessmentRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		safety,
+	)
+
+	// Session note service
+	c.SessionNoteService = sessionNoteService.NewSessionNoteService(
+		c.SessionNoteRepository,
+		c.ClientRepository,
+		c.UserRepository,
+	)
+
+	// Treatment plan service
+	c.TreatmentPlanService = treatmentPlanService.NewTreatmentPlanService(
+		c.TreatmentPlanRepository,
+		c.ClientRepository,
+		c.UserRepository,
+	)
+
+	// Journal service
+	c.JournalService = journalService.NewJournalService(
+		c.JournalRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		safety,
+	)
+
+	// Message service
+	c.MessageService = messageService.NewMessageService(
+		c.MessageRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		safety,
+		events,
+	)
+
+	// Homework service (checks references into the article and therapy library)
+	c.HomeworkService = homeworkService.NewHomeworkService(
+		c.HomeworkRepository,
+		c.ClientRepository,
+		c.UserRepository,
+		c.ArticleRepository,
+		c.TherapyRepository,
 	)
 
 	// Therapy service
@@ -170,6 +492,12 @@ func (c *Container) initServices() error {
 		c.ArticleRepository,
 	)
 
+	// Housekeeping runs as recurring jobs, so one instance at a time does it
+	scheduler.Every("therapist.license_expiry", time.Hour, c.TherapistService.ExpireLicenses)
+	scheduler.Every("guardian.majority", time.Hour, c.GuardianService.EndGuardianshipsAtMajority)
+	scheduler.Every("safety.alert_escalation", time.Minute, c.SafetyService.EscalateOverdueAlerts)
+	scheduler.Every("message.retention", time.Hour, c.MessageService.PurgeExpiredMessages)
+
 	return nil
 }
 
@@ -185,7 +513,22 @@ func (c *Container) initHandlers() error {
 		c.TherapistService,
 		c.TherapyService,
 		c.ArticleService,
+		c.WaitlistService,
+		c.ReviewService,
+		c.QuestionnaireService,
+		c.AssessmentService,
+		c.SessionNoteService,
+		c.TreatmentPlanService,
+		c.JournalService,
+		c.HomeworkService,
+		c.ConsentService,
+		c.GuardianService,
+		c.SafetyService,
+		c.MessageService,
+		c.RealtimeHub,
+		c.NotificationService,
 		c.TokenService,
+		c.MediaStorage,
 	)
 
 	return nil
@@ -0,0 +1,21 @@
+package email
+
+import (
+	"context"
+	"log"
+
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+)
+
+// LogSender writes emails to the log instead of sending them, for development
+// and deployments without an SMTP server. Only the subject is logged.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(ctx context.Context, email *notificationDomain.Email) error {
+	log.Printf("Email %s to user %s (SMTP not configured, not sent): %s", email.ID, email.UserID, email.Subject)
+	return nil
+}
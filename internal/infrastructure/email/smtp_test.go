@@ -0,0 +1,244 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+)
+
+// smtpStub is a minimal SMTP server that accepts every message and records it
+type smtpStub struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	rcpt     []string
+	messages []string
+}
+
+func newSMTPStub(t *testing.T) *smtpStub {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start SMTP stub: %v", err)
+	}
+
+	stub := &smtpStub{listener: listener}
+	go stub.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return stub
+}
+
+func (s *smtpStub) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStub) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStub) handle(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+
+	text.PrintfLine("220 localhost ESMTP stub")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			text.PrintfLine("250-localhost")
+			text.PrintfLine("250 AUTH PLAIN")
+		case strings.HasPrefix(command, "AUTH PLAIN"):
+			credentials, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			s.mu.Lock()
+			s.auth = string(credentials)
+			s.mu.Unlock()
+			text.PrintfLine("235 Authenticated")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = line[len("MAIL FROM:"):]
+			s.mu.Unlock()
+			text.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, line[len("RCPT TO:"):])
+			s.mu.Unlock()
+			text.PrintfLine("250 OK")
+		case command == "DATA":
+			text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, string(data))
+			s.mu.Unlock()
+			text.PrintfLine("250 Queued")
+		case command == "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("250 OK")
+		}
+	}
+}
+
+func newTestEmail(t *testing.T) *notificationDomain.Email {
+	t.Helper()
+
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	content := &notificationDomain.Content{
+		Subject: "Imate novu poruku",
+		Text:    "Pozdrav,\n\nImate novu poruku na Thappyju.",
+		HTML:    "<p>Imate novu poruku na Thappyju.</p>",
+	}
+
+	email, err := notificationDomain.NewEmail("user-123", "message.created", "client@example.com", content, now, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return email
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	stub := newSMTPStub(t)
+
+	sender, err := NewSMTPSender("127.0.0.1", stub.port(), "thappy", "secret", "Thappy <no-reply@thappy.test>")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	email := newTestEmail(t)
+	if err := sender.Send(context.Background(), email); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+
+	if stub.auth != "\x00thappy\x00secret" {
+		t.Errorf("Expected plain authentication, got %q", stub.auth)
+	}
+	if stub.from != "<no-reply@thappy.test>" || len(stub.rcpt) != 1 || stub.rcpt[0] != "<client@example.com>" {
+		t.Errorf("Unexpected envelope from %s to %v", stub.from, stub.rcpt)
+	}
+	if len(stub.messages) != 1 {
+		t.Fatalf("Expected one message, got %d", len(stub.messages))
+	}
+
+	message, err := mail.ReadMessage(strings.NewReader(stub.messages[0]))
+	if err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if err != nil || subject != email.Subject {
+		t.Errorf("Expected subject %q, got %q (%v)", email.Subject, subject, err)
+	}
+	if !strings.Contains(message.Header.Get("Message-ID"), email.ID+"@thappy.test") {
+		t.Errorf("Unexpected Message-ID %q", message.Header.Get("Message-ID"))
+	}
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Expected multipart/alternative, got %q (%v)", mediaType, err)
+	}
+
+	bodies := make(map[string]string)
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read part: %v", err)
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("Failed to read part: %v", err)
+		}
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		bodies[partType] = string(content)
+	}
+
+	if bodies["text/plain"] != email.TextBody {
+		t.Errorf("Unexpected text part %q", bodies["text/plain"])
+	}
+	if bodies["text/html"] != email.HTMLBody {
+		t.Errorf("Unexpected HTML part %q", bodies["text/html"])
+	}
+}
+
+func TestSMTPSender_SubjectCannotAddHeaders(t *testing.T) {
+	email := newTestEmail(t)
+	email.Subject = "Hello\r\nBcc: attacker@example.com"
+
+	from := &mail.Address{Name: "Thappy", Address: "no-reply@thappy.test"}
+	raw, err := buildMessage(from, email, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	message, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(raw))))
+	if err != nil {
+		t.Fatalf("Failed to read message: %v", err)
+	}
+	if message.Header.Get("Bcc") != "" {
+		t.Error("Expected the subject to stay a single header")
+	}
+}
+
+func TestSMTPSender_UnreachableServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to reserve a port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	sender, err := NewSMTPSender("127.0.0.1", port, "", "", "no-reply@thappy.test")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := sender.Send(context.Background(), newTestEmail(t)); err == nil {
+		t.Error("Expected an error when the server cannot be reached")
+	}
+}
+
+func TestNewSMTPSender(t *testing.T) {
+	if _, err := NewSMTPSender("", 587, "", "", "no-reply@thappy.test"); err == nil {
+		t.Error("Expected an error without a host")
+	}
+	if _, err := NewSMTPSender("smtp.thappy.test", 587, "", "", "not an address"); err == nil {
+		t.Error("Expected an error for an invalid sender")
+	}
+}
@@ -0,0 +1,134 @@
+package keys
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goran/thappy/internal/domain/encryption"
+)
+
+// LocalKeyProvider wraps data keys with master keys taken from configuration.
+// Several master keys can be configured so old records stay readable after a
+// rotation; new data keys are always wrapped with the active one. A cloud KMS
+// provider can implement encryption.KeyProvider later without changing stored data.
+type LocalKeyProvider struct {
+	masterKeys  map[string]cipher.AEAD
+	activeKeyID string
+}
+
+func NewLocalKeyProvider(masterKeys map[string][]byte, activeKeyID string) (*LocalKeyProvider, error) {
+	if len(masterKeys) == 0 {
+		return nil, errors.New("at least one master key is required")
+	}
+
+	if activeKeyID == "" && len(masterKeys) == 1 {
+		for id := range masterKeys {
+			activeKeyID = id
+		}
+	}
+
+	if _, ok := masterKeys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active master key %q is not configured", activeKeyID)
+	}
+
+	provider := &LocalKeyProvider{
+		masterKeys:  make(map[string]cipher.AEAD, len(masterKeys)),
+		activeKeyID: activeKeyID,
+	}
+
+	for id, key := range masterKeys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("master key %q must be 32 bytes", id)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		provider.masterKeys[id] = aead
+	}
+
+	return provider, nil
+}
+
+// ParseMasterKeys reads a comma-separated list of "<key-id>:<base64 key>" pairs
+func ParseMasterKeys(spec string) (map[string][]byte, error) {
+	masterKeys := make(map[string][]byte)
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(entry, ":")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("master key entry must look like <key-id>:<base64 key>")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("master key %q is not valid base64: %w", id, err)
+		}
+
+		if _, exists := masterKeys[id]; exists {
+			return nil, fmt.Errorf("master key %q is configured twice", id)
+		}
+		masterKeys[id] = key
+	}
+
+	return masterKeys, nil
+}
+
+func (p *LocalKeyProvider) GenerateDataKey(ctx context.Context) (*encryption.DataKey, error) {
+	key := make([]byte, encryption.DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	aead := p.masterKeys[p.activeKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	// The wrapped key is the nonce followed by the sealed data key
+	wrapped := aead.Seal(nonce, nonce, key, []byte(p.activeKeyID))
+
+	return &encryption.DataKey{
+		KeyID:      p.activeKeyID,
+		Plaintext:  key,
+		WrappedKey: wrapped,
+	}, nil
+}
+
+func (p *LocalKeyProvider) DecryptDataKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := p.masterKeys[keyID]
+	if !ok {
+		return nil, encryption.ErrUnknownKey
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, encryption.ErrDecryptionFailed
+	}
+
+	nonce, sealed := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	key, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, encryption.ErrDecryptionFailed
+	}
+
+	return key, nil
+}
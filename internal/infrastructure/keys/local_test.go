@@ -0,0 +1,96 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/goran/thappy/internal/domain/encryption"
+)
+
+func testKey(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, 32)
+}
+
+func TestParseMasterKeys(t *testing.T) {
+	spec := "k1:" + base64.StdEncoding.EncodeToString(testKey(1)) + ", k2:" + base64.StdEncoding.EncodeToString(testKey(2))
+
+	masterKeys, err := ParseMasterKeys(spec)
+	if err != nil {
+		t.Fatalf("ParseMasterKeys() unexpected error = %v", err)
+	}
+	if len(masterKeys) != 2 || !bytes.Equal(masterKeys["k2"], testKey(2)) {
+		t.Errorf("ParseMasterKeys() = %v, want k1 and k2", masterKeys)
+	}
+
+	for _, invalid := range []string{"no-separator", ":" + base64.StdEncoding.EncodeToString(testKey(1)), "k1:not base64!", "k1:AA==,k1:AA=="} {
+		if _, err := ParseMasterKeys(invalid); err == nil {
+			t.Errorf("ParseMasterKeys(%q) expected error", invalid)
+		}
+	}
+}
+
+func TestNewLocalKeyProvider(t *testing.T) {
+	if _, err := NewLocalKeyProvider(nil, ""); err == nil {
+		t.Error("expected error without master keys")
+	}
+
+	if _, err := NewLocalKeyProvider(map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, ""); err == nil {
+		t.Error("expected error when the active key is ambiguous")
+	}
+
+	if _, err := NewLocalKeyProvider(map[string][]byte{"k1": []byte("short")}, "k1"); err == nil {
+		t.Error("expected error for a key that is not 32 bytes")
+	}
+
+	provider, err := NewLocalKeyProvider(map[string][]byte{"k1": testKey(1)}, "")
+	if err != nil {
+		t.Fatalf("NewLocalKeyProvider() unexpected error = %v", err)
+	}
+	if provider.activeKeyID != "k1" {
+		t.Errorf("activeKeyID = %q, want the only configured key", provider.activeKeyID)
+	}
+}
+
+func TestLocalKeyProvider_Rotation(t *testing.T) {
+	ctx := context.Background()
+
+	before, err := NewLocalKeyProvider(map[string][]byte{"k1": testKey(1)}, "k1")
+	if err != nil {
+		t.Fatalf("NewLocalKeyProvider() unexpected error = %v", err)
+	}
+
+	envelope, err := encryption.Seal(ctx, before, []byte("session note"), []byte("note-1"))
+	if err != nil {
+		t.Fatalf("Seal() unexpected error = %v", err)
+	}
+
+	// After rotation new keys are wrapped with k2 and k1 records stay readable
+	after, err := NewLocalKeyProvider(map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, "k2")
+	if err != nil {
+		t.Fatalf("NewLocalKeyProvider() unexpected error = %v", err)
+	}
+
+	plaintext, err := encryption.Open(ctx, after, envelope, []byte("note-1"))
+	if err != nil || string(plaintext) != "session note" {
+		t.Errorf("Open() after rotation = %q, %v", plaintext, err)
+	}
+
+	dataKey, err := after.GenerateDataKey(ctx)
+	if err != nil {
+		t.Fatalf("GenerateDataKey() unexpected error = %v", err)
+	}
+	if dataKey.KeyID != "k2" {
+		t.Errorf("GenerateDataKey() key ID = %q, want k2", dataKey.KeyID)
+	}
+
+	// A wrapped key cannot be unwrapped under a different key ID
+	if _, err := after.DecryptDataKey(ctx, "k1", dataKey.WrappedKey); err != encryption.ErrDecryptionFailed {
+		t.Errorf("DecryptDataKey() with the wrong key ID error = %v, want %v", err, encryption.ErrDecryptionFailed)
+	}
+
+	if _, err := after.DecryptDataKey(ctx, "k3", dataKey.WrappedKey); err != encryption.ErrUnknownKey {
+		t.Errorf("DecryptDataKey() with an unknown key error = %v, want %v", err, encryption.ErrUnknownKey)
+	}
+}
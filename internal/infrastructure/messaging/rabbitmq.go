@@ -1,7 +1,9 @@
 package messaging
 
 import (
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/goran/thappy/internal/infrastructure/config"
@@ -12,6 +14,10 @@ type RabbitMQConnection struct {
 	conn    *amqp091.Connection
 	channel *amqp091.Channel
 	config  *config.RabbitMQConfig
+
+	// broadcastChannel carries this replica's broadcast consumer, replaced on each subscribe
+	broadcastMu      sync.Mutex
+	broadcastChannel *amqp091.Channel
 }
 
 // NewRabbitMQConnection creates a new RabbitMQ connection
@@ -87,9 +93,27 @@ func (r *RabbitMQConnection) setup() error {
 		return fmt.Errorf("failed to bind queue: %w", err)
 	}
 
+	// Declare the fanout exchange every API replica receives broadcasts from
+	err = r.channel.ExchangeDeclare(
+		r.broadcastExchange(), // name
+		"fanout",              // type
+		true,                  // durable
+		false,                 // auto-deleted
+		false,                 // internal
+		false,                 // no-wait
+		nil,                   // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare broadcast exchange: %w", err)
+	}
+
 	return nil
 }
 
+func (r *RabbitMQConnection) broadcastExchange() string {
+	return r.config.ExchangeName + ".broadcast"
+}
+
 // Publish publishes a message to the exchange
 func (r *RabbitMQConnection) Publish(routingKey string, body []byte) error {
 	return r.channel.Publish(
@@ -106,6 +130,27 @@ func (r *RabbitMQConnection) Publish(routingKey string, body []byte) error {
 	)
 }
 
+// Event is the envelope used for domain events published on the application routing key
+type Event struct {
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Payload    interface{} `json:"payload"`
+}
+
+// PublishEvent wraps the payload in an Event and publishes it on the configured routing key
+func (r *RabbitMQConnection) PublishEvent(eventType string, payload interface{}) error {
+	body, err := json.Marshal(Event{
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Payload:    payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return r.Publish(r.config.RoutingKey, body)
+}
+
 // Consume starts consuming messages from the queue
 func (r *RabbitMQConnection) Consume() (<-chan amqp091.Delivery, error) {
 	msgs, err := r.channel.Consume(
@@ -124,8 +169,90 @@ func (r *RabbitMQConnection) Consume() (<-chan amqp091.Delivery, error) {
 	return msgs, nil
 }
 
+// Broadcast publishes a message to every API replica. Broadcasts are transient:
+// replicas that are down miss them.
+func (r *RabbitMQConnection) Broadcast(body []byte) error {
+	return r.channel.Publish(
+		r.broadcastExchange(), // exchange
+		"",                    // routing key
+		false,                 // mandatory
+		false,                 // immediate
+		amqp091.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp091.Transient,
+			Timestamp:    time.Now(),
+			Body:         body,
+		},
+	)
+}
+
+// SubscribeBroadcast starts consuming broadcasts through a queue private to
+// this replica, removed by the broker once the replica disconnects. Any
+// earlier broadcast consumer is closed first.
+func (r *RabbitMQConnection) SubscribeBroadcast() (<-chan amqp091.Delivery, error) {
+	r.broadcastMu.Lock()
+	defer r.broadcastMu.Unlock()
+
+	if r.broadcastChannel != nil {
+		r.broadcastChannel.Close()
+		r.broadcastChannel = nil
+	}
+
+	channel, err := r.conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open broadcast channel: %w", err)
+	}
+
+	queue, err := channel.QueueDeclare(
+		"",    // name, generated by the broker
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to declare broadcast queue: %w", err)
+	}
+
+	err = channel.QueueBind(
+		queue.Name,            // queue name
+		"",                    // routing key
+		r.broadcastExchange(), // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to bind broadcast queue: %w", err)
+	}
+
+	msgs, err := channel.Consume(
+		queue.Name, // queue
+		"",         // consumer
+		true,       // auto-ack
+		true,       // exclusive
+		false,      // no-local
+		false,      // no-wait
+		nil,        // args
+	)
+	if err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to register broadcast consumer: %w", err)
+	}
+
+	r.broadcastChannel = channel
+	return msgs, nil
+}
+
 // Close closes the RabbitMQ connection
 func (r *RabbitMQConnection) Close() {
+	r.broadcastMu.Lock()
+	if r.broadcastChannel != nil {
+		r.broadcastChannel.Close()
+	}
+	r.broadcastMu.Unlock()
 	if r.channel != nil {
 		r.channel.Close()
 	}
@@ -0,0 +1,140 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// LocalDeliverer hands events to the streams open on this replica
+type LocalDeliverer interface {
+	Deliver(event *realtimeDomain.Event)
+}
+
+// RealtimeRelay broadcasts realtime events through RabbitMQ so they reach the
+// user's streams on every API replica, each of which feeds them to its own
+// hub. Without a broker, or until this replica is listening, events go
+// straight to the local hub, which is all a single replica needs.
+type RealtimeRelay struct {
+	rabbitmq  *RabbitMQConnection
+	hub       LocalDeliverer
+	listening atomic.Bool
+}
+
+func NewRealtimeRelay(rabbitmq *RabbitMQConnection, hub LocalDeliverer) *RealtimeRelay {
+	return &RealtimeRelay{
+		rabbitmq: rabbitmq,
+		hub:      hub,
+	}
+}
+
+type realtimeEventMessage struct {
+	ID         string          `json:"id"`
+	UserID     string          `json:"user_id"`
+	Type       string          `json:"type"`
+	Data       json.RawMessage `json:"data"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func (r *RealtimeRelay) Publish(ctx context.Context, event *realtimeDomain.Event) error {
+	if !r.listening.Load() || r.rabbitmq == nil || !r.rabbitmq.IsConnected() {
+		r.hub.Deliver(event)
+		return nil
+	}
+
+	body, err := json.Marshal(realtimeEventMessage{
+		ID:         event.ID,
+		UserID:     event.UserID,
+		Type:       event.Type,
+		Data:       event.Data,
+		OccurredAt: event.OccurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal realtime event: %w", err)
+	}
+
+	if err := r.rabbitmq.Broadcast(body); err != nil {
+		// Streams on this replica still get it
+		r.hub.Deliver(event)
+		return fmt.Errorf("failed to broadcast realtime event: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	// The delay before resubscribing after the broadcast consumer is lost
+	// doubles on each failed attempt, up to the maximum
+	minResubscribeDelay = time.Second
+	maxResubscribeDelay = time.Minute
+)
+
+// Run feeds broadcasts from every replica into the local hub until ctx is
+// cancelled. When the broadcast consumer is lost it subscribes again with
+// backoff; in between, events go to the local hub only. It returns at once
+// when no broker is configured.
+func (r *RealtimeRelay) Run(ctx context.Context) {
+	if r.rabbitmq == nil {
+		return
+	}
+
+	delay := minResubscribeDelay
+	for {
+		deliveries, err := r.rabbitmq.SubscribeBroadcast()
+		if err != nil {
+			log.Printf("Failed to subscribe to realtime broadcasts, retrying in %s: %v", delay, err)
+		} else {
+			delay = minResubscribeDelay
+			r.listening.Store(true)
+			r.consume(ctx, deliveries)
+			r.listening.Store(false)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxResubscribeDelay)
+	}
+}
+
+// consume delivers broadcasts to the local hub until ctx is cancelled or the
+// consumer is closed
+func (r *RealtimeRelay) consume(ctx context.Context, deliveries <-chan amqp091.Delivery) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				log.Printf("Realtime broadcasts stopped: RabbitMQ consumer closed, resubscribing")
+				return
+			}
+
+			var message realtimeEventMessage
+			if err := json.Unmarshal(delivery.Body, &message); err != nil {
+				log.Printf("Failed to read realtime broadcast: %v", err)
+				continue
+			}
+
+			r.hub.Deliver(&realtimeDomain.Event{
+				ID:         message.ID,
+				UserID:     message.UserID,
+				Type:       message.Type,
+				Data:       message.Data,
+				OccurredAt: message.OccurredAt,
+			})
+		}
+	}
+}
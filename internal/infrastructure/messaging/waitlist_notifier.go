@@ -0,0 +1,61 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
+)
+
+const (
+	EventWaitlistTherapistAvailable = "waitlist.therapist_available"
+	EventWaitlistSpotOffered        = "waitlist.spot_offered"
+)
+
+var ErrBrokerUnavailable = errors.New("RabbitMQ unavailable, event not published")
+
+// WaitlistNotifier publishes waitlist events to RabbitMQ for other systems to
+// consume. Clients are told through the notification layer, not through it.
+// Without a broker nothing is published and ErrBrokerUnavailable is returned.
+type WaitlistNotifier struct {
+	rabbitmq *RabbitMQConnection
+}
+
+func NewWaitlistNotifier(rabbitmq *RabbitMQConnection) *WaitlistNotifier {
+	return &WaitlistNotifier{
+		rabbitmq: rabbitmq,
+	}
+}
+
+type waitlistEventPayload struct {
+	EntryID        string     `json:"entry_id"`
+	TherapistID    string     `json:"therapist_id"`
+	ClientID       string     `json:"client_id"`
+	Position       int        `json:"position,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+}
+
+func (n *WaitlistNotifier) NotifyTherapistAvailable(ctx context.Context, entry *waitlistDomain.Entry) error {
+	return n.publish(EventWaitlistTherapistAvailable, entry)
+}
+
+func (n *WaitlistNotifier) NotifySpotOffered(ctx context.Context, entry *waitlistDomain.Entry) error {
+	return n.publish(EventWaitlistSpotOffered, entry)
+}
+
+func (n *WaitlistNotifier) publish(eventType string, entry *waitlistDomain.Entry) error {
+	payload := waitlistEventPayload{
+		EntryID:        entry.ID,
+		TherapistID:    entry.TherapistID,
+		ClientID:       entry.ClientID,
+		Position:       entry.Position,
+		OfferExpiresAt: entry.OfferExpiresAt,
+	}
+
+	if n.rabbitmq == nil || !n.rabbitmq.IsConnected() {
+		return ErrBrokerUnavailable
+	}
+
+	return n.rabbitmq.PublishEvent(eventType, payload)
+}
@@ -0,0 +1,140 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/goran/thappy/internal/domain/language"
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	reminderDomain "github.com/goran/thappy/internal/domain/reminder"
+)
+
+// Templates live in one directory per language. Each event has a .txt file
+// defining its "subject", "summary" and "text" blocks and a .html file
+// defining "content", which layout.html wraps. footer.txt is shared by the
+// text emails.
+//
+//go:embed templates
+var templateFiles embed.FS
+
+// eventTemplates maps the events users are notified of to their templates
+var eventTemplates = map[string]string{
+	realtimeDomain.EventMessageCreated:       "message_created",
+	realtimeDomain.EventConnectionAccepted:   "connection_accepted",
+	realtimeDomain.EventSpotOffered:          "spot_offered",
+	realtimeDomain.EventTherapistAvailable:   "therapist_available",
+	realtimeDomain.EventSafetyAlertRaised:    "safety_alert_raised",
+	realtimeDomain.EventSafetyAlertEscalated: "safety_alert_escalated",
+	reminderDomain.EventAppointmentReminder:  "appointment_reminder",
+}
+
+// TemplateRenderer renders notifications from the embedded templates. Links
+// in them point to appURL, the frontend's address.
+type TemplateRenderer struct {
+	appURL string
+	text   map[string]*texttemplate.Template
+	html   map[string]*htmltemplate.Template
+}
+
+// templateData is what the templates see. Data is the event's payload.
+type templateData struct {
+	AppURL  string
+	Subject string
+	Data    map[string]interface{}
+}
+
+// NewTemplateRenderer parses every template up front, so a language missing
+// one of them fails at startup rather than when someone is notified
+func NewTemplateRenderer(appURL string) (*TemplateRenderer, error) {
+	renderer := &TemplateRenderer{
+		appURL: strings.TrimRight(appURL, "/"),
+		text:   make(map[string]*texttemplate.Template),
+		html:   make(map[string]*htmltemplate.Template),
+	}
+
+	if _, err := fs.Stat(templateFiles, path.Join("templates", notificationDomain.DefaultLanguage)); err != nil {
+		return nil, fmt.Errorf("notification templates are missing the default language %s", notificationDomain.DefaultLanguage)
+	}
+
+	languages, err := fs.ReadDir(templateFiles, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification templates: %w", err)
+	}
+
+	for _, dir := range languages {
+		lang := dir.Name()
+		base := path.Join("templates", lang)
+
+		for _, name := range eventTemplates {
+			text, err := texttemplate.ParseFS(templateFiles, path.Join(base, "footer.txt"), path.Join(base, name+".txt"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s text template: %w", lang, name, err)
+			}
+
+			html, err := htmltemplate.ParseFS(templateFiles, path.Join(base, "layout.html"), path.Join(base, name+".html"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s/%s HTML template: %w", lang, name, err)
+			}
+
+			renderer.text[templateKey(lang, name)] = text
+			renderer.html[templateKey(lang, name)] = html
+		}
+	}
+
+	return renderer, nil
+}
+
+func (r *TemplateRenderer) Render(eventType, lang string, data map[string]interface{}) (*notificationDomain.Content, error) {
+	name, ok := eventTemplates[eventType]
+	if !ok {
+		return nil, notificationDomain.ErrNoTemplate
+	}
+
+	key := templateKey(language.Normalize(lang), name)
+	if _, ok := r.text[key]; !ok {
+		key = templateKey(notificationDomain.DefaultLanguage, name)
+	}
+
+	values := templateData{AppURL: r.appURL, Data: data}
+	text := r.text[key]
+
+	var content notificationDomain.Content
+	var err error
+	if content.Subject, err = executeText(text, "subject", values); err != nil {
+		return nil, err
+	}
+	if content.Summary, err = executeText(text, "summary", values); err != nil {
+		return nil, err
+	}
+	if content.Text, err = executeText(text, "text", values); err != nil {
+		return nil, err
+	}
+
+	values.Subject = content.Subject
+	var html bytes.Buffer
+	if err := r.html[key].ExecuteTemplate(&html, "layout", values); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML: %w", key, err)
+	}
+	content.HTML = html.String()
+
+	return &content, nil
+}
+
+func executeText(template *texttemplate.Template, block string, data templateData) (string, error) {
+	var out bytes.Buffer
+	if err := template.ExecuteTemplate(&out, block, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", block, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+func templateKey(lang, name string) string {
+	return lang + "/" + name
+}
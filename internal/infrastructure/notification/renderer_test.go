@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	reminderDomain "github.com/goran/thappy/internal/domain/reminder"
+)
+
+func TestTemplateRenderer_RendersEveryEventInEveryLanguage(t *testing.T) {
+	renderer, err := NewTemplateRenderer("https://app.thappy.test/")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, lang := range []string{"en", "hr"} {
+		for eventType := range eventTemplates {
+			t.Run(lang+"/"+eventType, func(t *testing.T) {
+				content, err := renderer.Render(eventType, lang, nil)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				if content.Subject == "" || content.Summary == "" || content.Text == "" || content.HTML == "" {
+					t.Fatalf("Expected every part to be rendered, got %+v", content)
+				}
+				if strings.Contains(content.Subject, "\n") {
+					t.Errorf("Expected a single-line subject, got %q", content.Subject)
+				}
+				if !strings.Contains(content.Text, "https://app.thappy.test") || !strings.Contains(content.HTML, `href="https://app.thappy.test"`) {
+					t.Error("Expected both bodies to link to the app")
+				}
+				if !strings.Contains(content.HTML, "<title>"+content.Subject+"</title>") {
+					t.Error("Expected the HTML title to be the subject")
+				}
+			})
+		}
+	}
+}
+
+func TestTemplateRenderer_Languages(t *testing.T) {
+	renderer, err := NewTemplateRenderer("https://app.thappy.test")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	english, err := renderer.Render(realtimeDomain.EventMessageCreated, "en", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	croatian, err := renderer.Render(realtimeDomain.EventMessageCreated, " HR ", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if croatian.Subject == english.Subject {
+		t.Error("Expected a Croatian subject")
+	}
+
+	fallback, err := renderer.Render(realtimeDomain.EventMessageCreated, "de", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fallback.Subject != english.Subject {
+		t.Errorf("Expected English for a language without templates, got %q", fallback.Subject)
+	}
+
+	if _, err := renderer.Render("journal.entry_created", "en", nil); !errors.Is(err, notificationDomain.ErrNoTemplate) {
+		t.Errorf("Expected %v for an event without a template, got %v", notificationDomain.ErrNoTemplate, err)
+	}
+}
+
+func TestTemplateRenderer_AppointmentReminderShowsStartTime(t *testing.T) {
+	renderer, err := NewTemplateRenderer("https://app.thappy.test")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data := map[string]interface{}{"starts_at_local": "2026-04-02 17:00 CEST"}
+	for _, lang := range []string{"en", "hr"} {
+		content, err := renderer.Render(reminderDomain.EventAppointmentReminder, lang, data)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(content.Summary, "2026-04-02 17:00 CEST") || !strings.Contains(content.Text, "2026-04-02 17:00 CEST") || !strings.Contains(content.HTML, "2026-04-02 17:00 CEST") {
+			t.Errorf("Expected the %s reminder to show the start time, got %+v", lang, content)
+		}
+	}
+}
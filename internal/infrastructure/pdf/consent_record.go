@@ -0,0 +1,52 @@
+package pdf
+
+import (
+	"errors"
+	"fmt"
+
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+)
+
+const recordTimeLayout = "2 January 2006, 15:04:05 MST"
+
+// ConsentRecordRenderer writes a signed consent form as a PDF: the text
+// exactly as it was signed, followed by the signature details
+type ConsentRecordRenderer struct{}
+
+func NewConsentRecordRenderer() *ConsentRecordRenderer {
+	return &ConsentRecordRenderer{}
+}
+
+func (r *ConsentRecordRenderer) RenderSignedRecord(record *consentDomain.SignedRecord) ([]byte, error) {
+	if record == nil || record.Version == nil || record.Signature == nil {
+		return nil, errors.New("signed record needs a version and a signature")
+	}
+
+	version := record.Version
+	signature := record.Signature
+
+	doc := NewDocument(version.Title)
+	doc.SetFooter(fmt.Sprintf("Signed consent record %s", signature.ID))
+
+	doc.Heading(version.Title)
+	doc.Field("Version", fmt.Sprintf("%d, published %s", version.Number, version.PublishedAt.UTC().Format(recordTimeLayout)))
+	doc.Rule()
+	doc.Paragraph(version.Body)
+	doc.Rule()
+
+	doc.Heading("Electronic signature")
+	doc.Field("Signed by (typed name)", signature.TypedName)
+	if signature.SignedByGuardian() {
+		doc.Field("Signed on behalf of the client by guardian", record.GuardianEmail)
+		doc.Field("Guardian ID", signature.GuardianID)
+	}
+	doc.Field("Client account email", record.ClientEmail)
+	doc.Field("Client ID", signature.ClientID)
+	doc.Field("Signed at", signature.SignedAt.UTC().Format(recordTimeLayout))
+	doc.Field("IP address", signature.IPAddress)
+	doc.Field("User agent", signature.UserAgent)
+	doc.Field("Document SHA-256", signature.DocumentHash)
+	doc.Field("Signature ID", signature.ID)
+
+	return doc.Bytes(), nil
+}
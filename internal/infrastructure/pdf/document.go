@@ -0,0 +1,271 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/unicode/norm"
+)
+
+// A4 in points, with margins wide enough for binders and hole punches
+const (
+	pageWidth    = 595.28
+	pageHeight   = 841.89
+	marginX      = 56.0
+	marginTop    = 64.0
+	marginBottom = 64.0
+	lineSpacing  = 1.4
+
+	headingSize = 16.0
+	labelSize   = 9.0
+	textSize    = 10.5
+	footerSize  = 8.0
+)
+
+type font struct {
+	resource string
+	name     string
+	widths   *[95]int
+}
+
+var (
+	regular = font{resource: "F1", name: "Helvetica", widths: &helveticaWidths}
+	bold    = font{resource: "F2", name: "Helvetica-Bold", widths: &helveticaBoldWidths}
+)
+
+// Document lays out plain text on A4 pages and writes it as a PDF. It uses
+// the standard Helvetica fonts, which every reader has, so nothing needs to
+// be embedded. Those fonts only cover the Windows-1252 characters; other
+// letters are folded to their base letter, e.g. "č" is written as "c".
+type Document struct {
+	title  string
+	footer string
+	pages  []*bytes.Buffer
+	y      float64
+}
+
+func NewDocument(title string) *Document {
+	return &Document{title: title}
+}
+
+// SetFooter sets text printed at the bottom of every page, next to the page number
+func (d *Document) SetFooter(text string) {
+	d.footer = text
+}
+
+func (d *Document) Heading(text string) {
+	d.write(bold, headingSize, text)
+	d.Space(headingSize * 0.4)
+}
+
+// Paragraph writes wrapped text. Line breaks in the text are kept.
+func (d *Document) Paragraph(text string) {
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			d.Space(textSize * lineSpacing * 0.5)
+			continue
+		}
+		d.write(regular, textSize, line)
+	}
+	d.Space(textSize * 0.6)
+}
+
+// Field writes a small bold label with its value below it
+func (d *Document) Field(label, value string) {
+	d.write(bold, labelSize, label)
+	if value == "" {
+		value = "-"
+	}
+	d.write(regular, textSize, value)
+	d.Space(textSize * 0.4)
+}
+
+// Rule draws a horizontal line across the text area
+func (d *Document) Rule() {
+	page := d.ensureSpace(textSize)
+	fmt.Fprintf(page, "0.5 w %.2f %.2f m %.2f %.2f l S\n", marginX, d.y, pageWidth-marginX, d.y)
+	d.Space(textSize)
+}
+
+// Space moves the next line down by the given number of points
+func (d *Document) Space(points float64) {
+	d.y -= points
+}
+
+func (d *Document) write(f font, size float64, text string) {
+	lineHeight := size * lineSpacing
+	for _, line := range wrap(encode(text), f, size, pageWidth-2*marginX) {
+		page := d.ensureSpace(lineHeight)
+		d.y -= size
+		fmt.Fprintf(page, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", f.resource, size, marginX, d.y, escape(line))
+		d.y -= lineHeight - size
+	}
+}
+
+// ensureSpace starts a new page unless the current one has room for height more points
+func (d *Document) ensureSpace(height float64) *bytes.Buffer {
+	if len(d.pages) == 0 || d.y-height < marginBottom {
+		d.pages = append(d.pages, &bytes.Buffer{})
+		d.y = pageHeight - marginTop
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Bytes writes the document as a PDF file
+func (d *Document) Bytes() []byte {
+	if len(d.pages) == 0 {
+		d.ensureSpace(0)
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are the catalog, page tree, fonts and document info; each
+	// page then takes two objects, the page and its content stream
+	const firstPage = 5
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object(fmt.Sprintf("<< /%s << /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >> /%s << /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >> >>",
+		regular.resource, regular.name, bold.resource, bold.name))
+	object(fmt.Sprintf("<< /Title (%s) /Producer (thappy) >>", escape(encode(d.title))))
+
+	for i, page := range d.pages {
+		content := page.String() + d.footerContent(i+1, len(d.pages))
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font 3 0 R >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+func (d *Document) footerContent(page, pages int) string {
+	y := marginBottom / 2
+	pageLabel := fmt.Sprintf("Page %d of %d", page, pages)
+	x := pageWidth - marginX - textWidth(pageLabel, regular, footerSize)
+
+	content := fmt.Sprintf("BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET", regular.resource, footerSize, x, y, pageLabel)
+	if d.footer != "" {
+		content = fmt.Sprintf("BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", regular.resource, footerSize, marginX, y, escape(encode(d.footer))) + content
+	}
+	return content
+}
+
+// wrap breaks encoded text into lines that fit within maxWidth points.
+// Words longer than a whole line are split.
+func wrap(text string, f font, size, maxWidth float64) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if textWidth(candidate, f, size) <= maxWidth {
+			line = candidate
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+		for textWidth(word, f, size) > maxWidth {
+			cut := 1
+			for cut < len(word) && textWidth(word[:cut+1], f, size) <= maxWidth {
+				cut++
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// textWidth measures encoded text in points
+func textWidth(text string, f font, size float64) float64 {
+	units := 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c >= 32 && c <= 126 {
+			units += f.widths[c-32]
+		} else {
+			units += defaultWidth
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// Letters that do not decompose into a base letter plus combining marks
+var transliterations = map[rune]string{
+	'đ': "d",
+	'Đ': "D",
+	'ł': "l",
+	'Ł': "L",
+	'ı': "i",
+	'ħ': "h",
+	'Ħ': "H",
+}
+
+// encode converts text to Windows-1252 bytes, the encoding the fonts use
+func encode(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			b.WriteString("    ")
+			continue
+		case unicode.IsControl(r):
+			continue
+		}
+
+		if c, ok := charmap.Windows1252.EncodeRune(r); ok {
+			b.WriteByte(c)
+			continue
+		}
+
+		if replacement, ok := transliterations[r]; ok {
+			b.WriteString(replacement)
+			continue
+		}
+
+		// Fold to the base letter when it is encodable, e.g. "č" to "c"
+		base := []rune(norm.NFD.String(string(r)))[0]
+		if c, ok := charmap.Windows1252.EncodeRune(base); ok && base != r {
+			b.WriteByte(c)
+			continue
+		}
+
+		b.WriteByte('?')
+	}
+	return b.String()
+}
+
+// escape makes encoded text safe inside a PDF string literal
+func escape(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(text)
+}
@@ -0,0 +1,115 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+)
+
+var objectOffset = regexp.MustCompile(`(\d{10}) 00000 n `)
+
+func TestDocument_Bytes(t *testing.T) {
+	doc := NewDocument("Informed consent")
+	doc.SetFooter("Record abc")
+	doc.Heading("Informed consent (v2)")
+	doc.Paragraph(strings.Repeat("Therapy involves talking about personal matters. ", 400))
+	out := doc.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("Expected a PDF header and end-of-file marker")
+	}
+
+	// The long paragraph spills over onto more pages
+	if pages := bytes.Count(out, []byte("/Type /Page ")); pages < 2 {
+		t.Errorf("Expected several pages, got %d", pages)
+	}
+	if !bytes.Contains(out, []byte("(Informed consent \\(v2\\)) Tj")) {
+		t.Error("Expected parentheses in text to be escaped")
+	}
+
+	// Every cross-reference entry points at the start of its object
+	startxref := bytes.LastIndex(out, []byte("startxref\n"))
+	xref, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(string(out[startxref+len("startxref\n"):]), "%%EOF\n")))
+	if err != nil || !bytes.HasPrefix(out[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref does not point at the cross-reference table: %v", err)
+	}
+	for i, match := range objectOffset.FindAllStringSubmatch(string(out[xref:]), -1) {
+		offset, _ := strconv.Atoi(match[1])
+		want := strconv.Itoa(i+1) + " 0 obj\n"
+		if !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("Object %d offset %d points at %q", i+1, offset, out[offset:offset+10])
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	maxWidth := 100.0
+	lines := wrap("a few short words and "+strings.Repeat("x", 80), regular, textSize, maxWidth)
+
+	if len(lines) < 3 {
+		t.Fatalf("Expected the text to wrap onto several lines, got %q", lines)
+	}
+	for _, line := range lines {
+		if textWidth(line, regular, textSize) > maxWidth {
+			t.Errorf("Line %q is wider than %v points", line, maxWidth)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := map[string]string{
+		"Ana Marić":    "Ana Maric",
+		"Đorđe Šćepan": "Dorde \x8a" + "cepan",
+		"Café – 5 €":   "Caf\xe9 \x96 5 \x80",
+		"tab\there":    "tab    here",
+		"日本":           "??",
+	}
+
+	for input, want := range tests {
+		if got := encode(input); got != want {
+			t.Errorf("encode(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestConsentRecordRenderer(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	record := &consentDomain.SignedRecord{
+		Version: &consentDomain.Version{
+			ID:          "version-1",
+			Number:      2,
+			Title:       "Privacy notice",
+			Body:        "We keep your records for ten years.",
+			PublishedAt: now,
+		},
+		Signature: &consentDomain.Signature{
+			ID:           "signature-1",
+			ClientID:     "client-1",
+			TypedName:    "Ana Marić",
+			IPAddress:    "203.0.113.7",
+			DocumentHash: strings.Repeat("ab", 32),
+			SignedAt:     now,
+		},
+		ClientEmail: "ana@example.com",
+	}
+
+	out, err := NewConsentRecordRenderer().RenderSignedRecord(record)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"(We keep your records for ten years.) Tj", "(Ana Maric) Tj", "(203.0.113.7) Tj", "(" + strings.Repeat("ab", 32) + ") Tj", "(Signed consent record signature-1) Tj"} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("Expected the record to contain %q", want)
+		}
+	}
+
+	if _, err := NewConsentRecordRenderer().RenderSignedRecord(&consentDomain.SignedRecord{Version: record.Version}); err == nil {
+		t.Error("Expected error without a signature")
+	}
+}
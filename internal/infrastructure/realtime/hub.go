@@ -0,0 +1,128 @@
+package realtime
+
+import (
+	"context"
+	"sync"
+
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+)
+
+const (
+	// streamBuffer is how many events a stream can fall behind before it is dropped
+	streamBuffer = 32
+	// maxStreamsPerUser bounds the open tabs and devices of a single user
+	maxStreamsPerUser = 10
+)
+
+// Hub fans events out to the streams open on this API replica. Publishing to
+// the hub directly only reaches local streams; with several replicas events go
+// through the messaging relay, which feeds every replica's hub.
+type Hub struct {
+	mu      sync.Mutex
+	streams map[string]map[*subscription]struct{}
+	closed  bool
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		streams: make(map[string]map[*subscription]struct{}),
+	}
+}
+
+type subscription struct {
+	hub    *Hub
+	userID string
+	events chan *realtimeDomain.Event
+}
+
+func (s *subscription) Events() <-chan *realtimeDomain.Event {
+	return s.events
+}
+
+func (s *subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s)
+}
+
+func (h *Hub) Subscribe(userID string) (realtimeDomain.Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &subscription{
+		hub:    h,
+		userID: userID,
+		events: make(chan *realtimeDomain.Event, streamBuffer),
+	}
+
+	// A hub that has shut down hands out streams that end straight away
+	if h.closed {
+		close(sub.events)
+		return sub, nil
+	}
+
+	if len(h.streams[userID]) >= maxStreamsPerUser {
+		return nil, realtimeDomain.ErrTooManyStreams
+	}
+
+	if h.streams[userID] == nil {
+		h.streams[userID] = make(map[*subscription]struct{})
+	}
+	h.streams[userID][sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Publish delivers the event to local streams only
+func (h *Hub) Publish(ctx context.Context, event *realtimeDomain.Event) error {
+	h.Deliver(event)
+	return nil
+}
+
+// Deliver hands the event to each of the user's streams without blocking. A
+// stream whose buffer is full is dropped so one stalled client cannot hold up
+// the others; its client reconnects and refetches what it missed.
+func (h *Hub) Deliver(event *realtimeDomain.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.streams[event.UserID] {
+		select {
+		case sub.events <- event:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Close ends every open stream, letting the server shut down without waiting
+// for them
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, subs := range h.streams {
+		for sub := range subs {
+			h.remove(sub)
+		}
+	}
+}
+
+// remove closes the stream once, however it ends. Callers hold the lock.
+func (h *Hub) remove(sub *subscription) {
+	subs, ok := h.streams[sub.userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.streams, sub.userID)
+	}
+	close(sub.events)
+}
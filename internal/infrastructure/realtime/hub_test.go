@@ -0,0 +1,126 @@
+package realtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+)
+
+func newTestEvent(t *testing.T, userID string) *realtimeDomain.Event {
+	t.Helper()
+
+	event, err := realtimeDomain.NewEvent(userID, realtimeDomain.EventMessageCreated, map[string]string{"message_id": "message-123"}, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return event
+}
+
+func TestHub_DeliversToTheUsersStreams(t *testing.T) {
+	hub := NewHub()
+
+	first, err := hub.Subscribe("user-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := hub.Subscribe("user-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	other, err := hub.Subscribe("user-456")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	event := newTestEvent(t, "user-123")
+	if err := hub.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, sub := range []realtimeDomain.Subscription{first, second} {
+		select {
+		case got := <-sub.Events():
+			if got.ID != event.ID {
+				t.Errorf("Expected event %s, got %s", event.ID, got.ID)
+			}
+		default:
+			t.Error("Expected the event on every stream of the user")
+		}
+	}
+
+	select {
+	case got := <-other.Events():
+		t.Errorf("Expected nothing for another user, got %+v", got)
+	default:
+	}
+}
+
+func TestHub_DropsStalledStreams(t *testing.T) {
+	hub := NewHub()
+
+	sub, err := hub.Subscribe("user-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i := 0; i <= streamBuffer; i++ {
+		hub.Deliver(newTestEvent(t, "user-123"))
+	}
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	if received != streamBuffer {
+		t.Errorf("Expected the buffered %d events before the stream ended, got %d", streamBuffer, received)
+	}
+
+	// Closing a dropped stream again is harmless
+	sub.Close()
+}
+
+func TestHub_LimitsStreamsPerUser(t *testing.T) {
+	hub := NewHub()
+
+	var subs []realtimeDomain.Subscription
+	for i := 0; i < maxStreamsPerUser; i++ {
+		sub, err := hub.Subscribe("user-123")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	if _, err := hub.Subscribe("user-123"); err != realtimeDomain.ErrTooManyStreams {
+		t.Fatalf("Expected %v, got %v", realtimeDomain.ErrTooManyStreams, err)
+	}
+
+	subs[0].Close()
+	if _, err := hub.Subscribe("user-123"); err != nil {
+		t.Errorf("Expected a closed stream to free its slot, got %v", err)
+	}
+}
+
+func TestHub_Close(t *testing.T) {
+	hub := NewHub()
+
+	sub, err := hub.Subscribe("user-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	hub.Close()
+	if _, ok := <-sub.Events(); ok {
+		t.Error("Expected open streams to end when the hub closes")
+	}
+
+	late, err := hub.Subscribe("user-123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := <-late.Events(); ok {
+		t.Error("Expected streams opened after closing to end straight away")
+	}
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goran/thappy/internal/domain/media"
+)
+
+func TestLocalStorage_PutGetDelete(t *testing.T) {
+	store, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStorage() unexpected error = %v", err)
+	}
+	ctx := context.Background()
+	key := "photos/therapists/user-1/abc/thumbnail.jpg"
+
+	if _, err := store.Get(ctx, key); err != media.ErrObjectNotFound {
+		t.Errorf("Get() missing object error = %v, want %v", err, media.ErrObjectNotFound)
+	}
+
+	if err := store.Put(ctx, key, &media.Object{Content: []byte("jpeg"), ContentType: "image/jpeg"}); err != nil {
+		t.Fatalf("Put() unexpected error = %v", err)
+	}
+
+	object, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() unexpected error = %v", err)
+	}
+	if string(object.Content) != "jpeg" || object.ContentType != "image/jpeg" {
+		t.Errorf("Get() = %q (%s), want %q (image/jpeg)", object.Content, object.ContentType, "jpeg")
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() missing object error = %v, want nil", err)
+	}
+	if _, err := store.Get(ctx, key); err != media.ErrObjectNotFound {
+		t.Errorf("Get() after delete error = %v, want %v", err, media.ErrObjectNotFound)
+	}
+}
+
+func TestLocalStorage_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStorage() unexpected error = %v", err)
+	}
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "photos/../../secret", "photos//a.jpg", "photos\\a.jpg", "."} {
+		if _, err := store.Get(context.Background(), key); err != media.ErrInvalidKey {
+			t.Errorf("Get(%q) error = %v, want %v", key, err, media.ErrInvalidKey)
+		}
+	}
+}
@@ -3,21 +3,35 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	articleDomain "github.com/goran/thappy/internal/domain/article"
+	"github.com/goran/thappy/internal/domain/pagination"
+	"github.com/goran/thappy/internal/repository/cursor"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Newest first; the ID breaks ties between articles published at the same moment
+var articleKeyset = cursor.Keyset{
+	Scope: "articles",
+	Keys: []cursor.Key{
+		{Column: "published_date", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "id", Cast: "TEXT", Descending: true},
+	},
+}
+
 type ArticleRepository struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
 }
 
-func NewArticleRepository(db *pgxpool.Pool) *ArticleRepository {
+func NewArticleRepository(db *pgxpool.Pool, cursors *cursor.Codec) *ArticleRepository {
 	return &ArticleRepository{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
@@ -120,47 +134,51 @@ func (r *ArticleRepository) GetBySlug(ctx context.Context, slug string) (*articl
 	return &a, nil
 }
 
-func (r *ArticleRepository) GetAll(ctx context.Context) ([]*articleDomain.Article, error) {
-	query := `
-		SELECT id, title, content, author, published_date, category, slug, is_published, created_at, updated_at
-		FROM articles
-		ORDER BY published_date DESC
-	`
+func (r *ArticleRepository) GetAll(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*articleDomain.Article], error) {
+	return r.list(ctx, nil, nil, page)
+}
 
-	return r.scanArticles(ctx, query)
+func (r *ArticleRepository) GetAllPublished(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*articleDomain.Article], error) {
+	return r.list(ctx, []string{"is_published = true"}, nil, page)
 }
 
-func (r *ArticleRepository) GetAllPublished(ctx context.Context) ([]*articleDomain.Article, error) {
-	query := `
-		SELECT id, title, content, author, published_date, category, slug, is_published, created_at, updated_at
-		FROM articles
-		WHERE is_published = true
-		ORDER BY published_date DESC
-	`
+func (r *ArticleRepository) GetByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*articleDomain.Article], error) {
+	return r.list(ctx, []string{"category = $1"}, []interface{}{category}, page)
+}
 
-	return r.scanArticles(ctx, query)
+func (r *ArticleRepository) GetPublishedByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*articleDomain.Article], error) {
+	return r.list(ctx, []string{"category = $1", "is_published = true"}, []interface{}{category}, page)
 }
 
-func (r *ArticleRepository) GetByCategory(ctx context.Context, category string) ([]*articleDomain.Article, error) {
-	query := `
-		SELECT id, title, content, author, published_date, category, slug, is_published, created_at, updated_at
-		FROM articles
-		WHERE category = $1
-		ORDER BY published_date DESC
-	`
+func (r *ArticleRepository) list(ctx context.Context, conditions []string, args []interface{}, page pagination.PageRequest) (*pagination.Page[*articleDomain.Article], error) {
+	page = page.Normalize()
 
-	return r.scanArticles(ctx, query, category)
-}
+	after, cursorArgs, err := r.cursors.Where(articleKeyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		conditions = append(conditions, after)
+		args = append(args, cursorArgs...)
+	}
 
-func (r *ArticleRepository) GetPublishedByCategory(ctx context.Context, category string) ([]*articleDomain.Article, error) {
 	query := `
 		SELECT id, title, content, author, published_date, category, slug, is_published, created_at, updated_at
-		FROM articles
-		WHERE category = $1 AND is_published = true
-		ORDER BY published_date DESC
-	`
+		FROM articles`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += articleKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	articles, err := r.scanArticles(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
 
-	return r.scanArticles(ctx, query, category)
+	return cursor.Paginate(r.cursors, articleKeyset, articles, page.Limit, func(a *articleDomain.Article) []string {
+		return []string{cursor.Time(a.PublishedDate), a.ID}
+	}), nil
 }
 
 func (r *ArticleRepository) Update(ctx context.Context, article *articleDomain.Article) error {
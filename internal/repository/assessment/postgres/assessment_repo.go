@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const assessmentColumns = `id, instrument_code, therapist_id, client_id, status, responses, score,
+			   due_at, assigned_at, completed_at, updated_at`
+
+type AssessmentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAssessmentRepository(db *pgxpool.Pool) *AssessmentRepository {
+	return &AssessmentRepository{
+		db: db,
+	}
+}
+
+func (r *AssessmentRepository) Create(ctx context.Context, assessment *assessmentDomain.Assessment) error {
+	responsesJSON, scoreJSON, err := marshalResult(assessment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO client_assessments (
+			id, instrument_code, therapist_id, client_id, status, responses, score,
+			due_at, assigned_at, completed_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		assessment.ID,
+		assessment.InstrumentCode,
+		assessment.TherapistID,
+		assessment.ClientID,
+		assessment.Status,
+		responsesJSON,
+		scoreJSON,
+		assessment.DueAt,
+		assessment.AssignedAt,
+		assessment.CompletedAt,
+		assessment.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			// Only one open assessment per client and instrument
+			if pgErr.Code == "23505" {
+				return assessmentDomain.ErrAlreadyAssigned
+			}
+			if pgErr.Code == "23503" {
+				return assessmentDomain.ErrInvalidAssessmentData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *AssessmentRepository) GetByID(ctx context.Context, id string) (*assessmentDomain.Assessment, error) {
+	query := `
+		SELECT ` + assessmentColumns + `
+		FROM client_assessments
+		WHERE id = $1
+	`
+
+	assessment, err := scanAssessment(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, assessmentDomain.ErrAssessmentNotFound
+		}
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+func (r *AssessmentRepository) Update(ctx context.Context, assessment *assessmentDomain.Assessment) error {
+	responsesJSON, scoreJSON, err := marshalResult(assessment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE client_assessments
+		SET status = $2, responses = $3, score = $4, completed_at = $5, updated_at = $6
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		assessment.ID,
+		assessment.Status,
+		responsesJSON,
+		scoreJSON,
+		assessment.CompletedAt,
+		assessment.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return assessmentDomain.ErrAssessmentNotFound
+	}
+
+	return nil
+}
+
+func (r *AssessmentRepository) GetByClientID(ctx context.Context, clientID, therapistID string) ([]*assessmentDomain.Assessment, error) {
+	query := `
+		SELECT ` + assessmentColumns + `
+		FROM client_assessments
+		WHERE client_id = $1 AND ($2 = '' OR therapist_id = NULLIF($2, '')::UUID)
+		ORDER BY assigned_at DESC, id
+	`
+
+	return r.list(ctx, query, clientID, therapistID)
+}
+
+func (r *AssessmentRepository) GetScoreHistory(ctx context.Context, clientID, therapistID, instrumentCode string) ([]*assessmentDomain.Assessment, error) {
+	query := `
+		SELECT ` + assessmentColumns + `
+		FROM client_assessments
+		WHERE client_id = $1 AND therapist_id = $2 AND instrument_code = $3 AND status = 'completed'
+		ORDER BY completed_at, id
+	`
+
+	return r.list(ctx, query, clientID, therapistID, instrumentCode)
+}
+
+func (r *AssessmentRepository) list(ctx context.Context, query string, args ...interface{}) ([]*assessmentDomain.Assessment, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assessments []*assessmentDomain.Assessment
+	for rows.Next() {
+		assessment, err := scanAssessment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assessments = append(assessments, assessment)
+	}
+
+	return assessments, rows.Err()
+}
+
+// marshalResult encodes the responses and score, which stay NULL until the assessment is completed
+func marshalResult(assessment *assessmentDomain.Assessment) ([]byte, []byte, error) {
+	if assessment.Score == nil {
+		return nil, nil, nil
+	}
+
+	responsesJSON, err := json.Marshal(assessment.Responses)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal responses: %w", err)
+	}
+
+	scoreJSON, err := json.Marshal(assessment.Score)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal score: %w", err)
+	}
+
+	return responsesJSON, scoreJSON, nil
+}
+
+func scanAssessment(row pgx.Row) (*assessmentDomain.Assessment, error) {
+	var assessment assessmentDomain.Assessment
+	var responsesJSON []byte
+	var scoreJSON []byte
+
+	err := row.Scan(
+		&assessment.ID,
+		&assessment.InstrumentCode,
+		&assessment.TherapistID,
+		&assessment.ClientID,
+		&assessment.Status,
+		&responsesJSON,
+		&scoreJSON,
+		&assessment.DueAt,
+		&assessment.AssignedAt,
+		&assessment.CompletedAt,
+		&assessment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if responsesJSON != nil {
+		if err := json.Unmarshal(responsesJSON, &assessment.Responses); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal responses: %w", err)
+		}
+	}
+
+	if scoreJSON != nil {
+		assessment.Score = &assessmentDomain.Score{}
+		if err := json.Unmarshal(scoreJSON, assessment.Score); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal score: %w", err)
+		}
+	}
+
+	return &assessment, nil
+}
@@ -2,42 +2,63 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	"github.com/goran/thappy/internal/domain/pagination"
+	"github.com/goran/thappy/internal/repository/cursor"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Alphabetical by name; the user ID keeps clients with the same name in a stable order
+var clientKeyset = cursor.Keyset{
+	Scope: "clients",
+	Keys: []cursor.Key{
+		{Column: "cp.first_name", Cast: "TEXT"},
+		{Column: "cp.last_name", Cast: "TEXT"},
+		{Column: "cp.user_id", Cast: "UUID"},
+	},
+}
+
 type ClientRepository struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
 }
 
-func NewClientRepository(db *pgxpool.Pool) *ClientRepository {
+func NewClientRepository(db *pgxpool.Pool, cursors *cursor.Codec) *ClientRepository {
 	return &ClientRepository{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
 func (r *ClientRepository) Create(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	contactsJSON, err := marshalEmergencyContacts(profile.EmergencyContacts)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO client_profiles (
 			user_id, first_name, last_name, date_of_birth, phone,
-			emergency_contact, therapist_id, notes, created_at, updated_at
+			emergency_contacts, therapist_id, preferred_language, created_at, updated_at
 		)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	_, err = r.db.Exec(ctx, query,
 		profile.UserID,
 		profile.FirstName,
 		profile.LastName,
 		profile.DateOfBirth,
 		profile.Phone,
-		profile.EmergencyContact,
+		contactsJSON,
 		profile.TherapistID,
-		profile.Notes,
+		profile.PreferredLanguage,
 		profile.CreatedAt,
 		profile.UpdatedAt,
 	)
@@ -61,21 +82,22 @@ func (r *ClientRepository) Create(ctx context.Context, profile *clientDomain.Cli
 func (r *ClientRepository) GetByUserID(ctx context.Context, userID string) (*clientDomain.ClientProfile, error) {
 	query := `
 		SELECT user_id, first_name, last_name, date_of_birth, phone,
-			   emergency_contact, therapist_id, notes, created_at, updated_at
+			   emergency_contacts, therapist_id, preferred_language, created_at, updated_at
 		FROM client_profiles
 		WHERE user_id = $1
 	`
 
 	var profile clientDomain.ClientProfile
+	var contactsJSON []byte
 	err := r.db.QueryRow(ctx, query, userID).Scan(
 		&profile.UserID,
 		&profile.FirstName,
 		&profile.LastName,
 		&profile.DateOfBirth,
 		&profile.Phone,
-		&profile.EmergencyContact,
+		&contactsJSON,
 		&profile.TherapistID,
-		&profile.Notes,
+		&profile.PreferredLanguage,
 		&profile.CreatedAt,
 		&profile.UpdatedAt,
 	)
@@ -87,14 +109,23 @@ func (r *ClientRepository) GetByUserID(ctx context.Context, userID string) (*cli
 		return nil, err
 	}
 
+	if profile.EmergencyContacts, err = unmarshalEmergencyContacts(contactsJSON); err != nil {
+		return nil, err
+	}
+
 	return &profile, nil
 }
 
 func (r *ClientRepository) Update(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	contactsJSON, err := marshalEmergencyContacts(profile.EmergencyContacts)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE client_profiles
 		SET first_name = $2, last_name = $3, date_of_birth = $4, phone = $5,
-			emergency_contact = $6, therapist_id = $7, notes = $8, updated_at = $9
+			emergency_contacts = $6, therapist_id = $7, preferred_language = $8, updated_at = $9
 		WHERE user_id = $1
 	`
 
@@ -104,9 +135,9 @@ func (r *ClientRepository) Update(ctx context.Context, profile *clientDomain.Cli
 		profile.LastName,
 		profile.DateOfBirth,
 		profile.Phone,
-		profile.EmergencyContact,
+		contactsJSON,
 		profile.TherapistID,
-		profile.Notes,
+		profile.PreferredLanguage,
 		profile.UpdatedAt,
 	)
 
@@ -142,56 +173,46 @@ func (r *ClientRepository) Delete(ctx context.Context, userID string) error {
 	return nil
 }
 
-func (r *ClientRepository) GetByTherapistID(ctx context.Context, therapistID string) ([]*clientDomain.ClientProfile, error) {
+func (r *ClientRepository) GetByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
 	query := `
-		SELECT user_id, first_name, last_name, date_of_birth, phone,
-			   emergency_contact, therapist_id, notes, created_at, updated_at
-		FROM client_profiles
-		WHERE therapist_id = $1
-		ORDER BY first_name, last_name
+		SELECT cp.user_id, cp.first_name, cp.last_name, cp.date_of_birth, cp.phone,
+			   cp.emergency_contacts, cp.therapist_id, cp.preferred_language, cp.created_at, cp.updated_at
+		FROM client_profiles cp
+		WHERE cp.therapist_id = $1
 	`
 
-	rows, err := r.db.Query(ctx, query, therapistID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var profiles []*clientDomain.ClientProfile
-	for rows.Next() {
-		var profile clientDomain.ClientProfile
-		err := rows.Scan(
-			&profile.UserID,
-			&profile.FirstName,
-			&profile.LastName,
-			&profile.DateOfBirth,
-			&profile.Phone,
-			&profile.EmergencyContact,
-			&profile.TherapistID,
-			&profile.Notes,
-			&profile.CreatedAt,
-			&profile.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		profiles = append(profiles, &profile)
-	}
-
-	return profiles, rows.Err()
+	return r.list(ctx, query, []interface{}{therapistID}, page)
 }
 
-func (r *ClientRepository) GetActiveClients(ctx context.Context) ([]*clientDomain.ClientProfile, error) {
+func (r *ClientRepository) GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
 	query := `
 		SELECT cp.user_id, cp.first_name, cp.last_name, cp.date_of_birth, cp.phone,
-			   cp.emergency_contact, cp.therapist_id, cp.notes, cp.created_at, cp.updated_at
+			   cp.emergency_contacts, cp.therapist_id, cp.preferred_language, cp.created_at, cp.updated_at
 		FROM client_profiles cp
 		INNER JOIN users u ON cp.user_id = u.id
 		WHERE u.is_active = true
-		ORDER BY cp.first_name, cp.last_name
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	return r.list(ctx, query, nil, page)
+}
+
+// list runs a client query that already has a WHERE clause, one page at a time
+func (r *ClientRepository) list(ctx context.Context, query string, args []interface{}, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	page = page.Normalize()
+
+	after, cursorArgs, err := r.cursors.Where(clientKeyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += clientKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,25 +221,34 @@ func (r *ClientRepository) GetActiveClients(ctx context.Context) ([]*clientDomai
 	var profiles []*clientDomain.ClientProfile
 	for rows.Next() {
 		var profile clientDomain.ClientProfile
+		var contactsJSON []byte
 		err := rows.Scan(
 			&profile.UserID,
 			&profile.FirstName,
 			&profile.LastName,
 			&profile.DateOfBirth,
 			&profile.Phone,
-			&profile.EmergencyContact,
+			&contactsJSON,
 			&profile.TherapistID,
-			&profile.Notes,
+			&profile.PreferredLanguage,
 			&profile.CreatedAt,
 			&profile.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if profile.EmergencyContacts, err = unmarshalEmergencyContacts(contactsJSON); err != nil {
+			return nil, err
+		}
 		profiles = append(profiles, &profile)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return profiles, rows.Err()
+	return cursor.Paginate(r.cursors, clientKeyset, profiles, page.Limit, func(p *clientDomain.ClientProfile) []string {
+		return []string{p.FirstName, p.LastName, p.UserID}
+	}), nil
 }
 
 func (r *ClientRepository) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
@@ -232,3 +262,23 @@ func (r *ClientRepository) ExistsByUserID(ctx context.Context, userID string) (b
 
 	return exists, nil
 }
+
+func marshalEmergencyContacts(contacts []clientDomain.EmergencyContact) ([]byte, error) {
+	if contacts == nil {
+		contacts = []clientDomain.EmergencyContact{}
+	}
+
+	contactsJSON, err := json.Marshal(contacts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal emergency contacts: %w", err)
+	}
+	return contactsJSON, nil
+}
+
+func unmarshalEmergencyContacts(contactsJSON []byte) ([]clientDomain.EmergencyContact, error) {
+	var contacts []clientDomain.EmergencyContact
+	if err := json.Unmarshal(contactsJSON, &contacts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal emergency contacts: %w", err)
+	}
+	return contacts, nil
+}
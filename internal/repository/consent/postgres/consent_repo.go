@@ -0,0 +1,371 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const versionColumns = `v.id, v.document_id, v.version_number, v.title, v.body, v.change_summary, v.content_hash,
+			   COALESCE(v.published_by::TEXT, ''), v.published_at`
+
+// Documents are always read with their latest version
+const documentQuery = `
+		SELECT d.id, d.title, d.kind, d.required, d.created_at, d.updated_at, ` + versionColumns + `
+		FROM consent_documents d
+		JOIN LATERAL (
+			SELECT *
+			FROM consent_document_versions
+			WHERE document_id = d.id
+			ORDER BY version_number DESC
+			LIMIT 1
+		) v ON TRUE
+	`
+
+const signatureColumns = `id, client_id, COALESCE(signed_by_guardian_id::TEXT, ''), document_id, version_id,
+			   version_number, typed_name, ip_address, user_agent, document_hash, signed_at`
+
+type ConsentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewConsentRepository(db *pgxpool.Pool) *ConsentRepository {
+	return &ConsentRepository{
+		db: db,
+	}
+}
+
+func (r *ConsentRepository) CreateDocument(ctx context.Context, document *consentDomain.Document) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO consent_documents (id, title, kind, required, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		document.ID,
+		document.Title,
+		document.Kind,
+		document.Required,
+		document.CreatedAt,
+		document.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := insertVersion(ctx, tx, document.CurrentVersion); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ConsentRepository) UpdateDocument(ctx context.Context, document *consentDomain.Document) error {
+	query := `
+		UPDATE consent_documents
+		SET title = $2, kind = $3, required = $4, updated_at = $5
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		document.ID,
+		document.Title,
+		document.Kind,
+		document.Required,
+		document.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return consentDomain.ErrDocumentNotFound
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) AddVersion(ctx context.Context, document *consentDomain.Document, version *consentDomain.Version) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `UPDATE consent_documents SET updated_at = $2 WHERE id = $1`, document.ID, document.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return consentDomain.ErrDocumentNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ConsentRepository) GetDocument(ctx context.Context, id string) (*consentDomain.Document, error) {
+	query := documentQuery + `WHERE d.id = $1`
+
+	document, err := scanDocument(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consentDomain.ErrDocumentNotFound
+		}
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (r *ConsentRepository) ListDocuments(ctx context.Context) ([]*consentDomain.Document, error) {
+	query := documentQuery + `ORDER BY d.created_at, d.id`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var documents []*consentDomain.Document
+	for rows.Next() {
+		document, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+
+	return documents, rows.Err()
+}
+
+func (r *ConsentRepository) GetVersion(ctx context.Context, id string) (*consentDomain.Version, error) {
+	query := `
+		SELECT ` + versionColumns + `
+		FROM consent_document_versions v
+		WHERE v.id = $1
+	`
+
+	var version consentDomain.Version
+	err := r.db.QueryRow(ctx, query, id).Scan(versionFields(&version)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consentDomain.ErrVersionNotFound
+		}
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+func (r *ConsentRepository) GetVersions(ctx context.Context, documentID string) ([]*consentDomain.Version, error) {
+	query := `
+		SELECT ` + versionColumns + `
+		FROM consent_document_versions v
+		WHERE v.document_id = $1
+		ORDER BY v.version_number DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, documentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []*consentDomain.Version
+	for rows.Next() {
+		var version consentDomain.Version
+		if err := rows.Scan(versionFields(&version)...); err != nil {
+			return nil, err
+		}
+		versions = append(versions, &version)
+	}
+
+	return versions, rows.Err()
+}
+
+func (r *ConsentRepository) CreateSignature(ctx context.Context, signature *consentDomain.Signature) error {
+	query := `
+		INSERT INTO consent_signatures (
+			id, client_id, signed_by_guardian_id, document_id, version_id, version_number, typed_name,
+			ip_address, user_agent, document_hash, signed_at
+		)
+		VALUES ($1, $2, NULLIF($3, '')::UUID, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		signature.ID,
+		signature.ClientID,
+		signature.GuardianID,
+		signature.DocumentID,
+		signature.VersionID,
+		signature.VersionNumber,
+		signature.TypedName,
+		signature.IPAddress,
+		signature.UserAgent,
+		signature.DocumentHash,
+		signature.SignedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return consentDomain.ErrAlreadySigned
+			case "23503":
+				return consentDomain.ErrInvalidConsentData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) GetSignature(ctx context.Context, id string) (*consentDomain.Signature, error) {
+	query := `
+		SELECT ` + signatureColumns + `
+		FROM consent_signatures
+		WHERE id = $1
+	`
+
+	signature, err := scanSignature(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consentDomain.ErrSignatureNotFound
+		}
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func (r *ConsentRepository) GetSignaturesByClientID(ctx context.Context, clientID string) ([]*consentDomain.Signature, error) {
+	query := `
+		SELECT ` + signatureColumns + `
+		FROM consent_signatures
+		WHERE client_id = $1
+		ORDER BY signed_at DESC, id
+	`
+
+	rows, err := r.db.Query(ctx, query, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var signatures []*consentDomain.Signature
+	for rows.Next() {
+		signature, err := scanSignature(rows)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, rows.Err()
+}
+
+func insertVersion(ctx context.Context, tx pgx.Tx, version *consentDomain.Version) error {
+	query := `
+		INSERT INTO consent_document_versions (
+			id, document_id, version_number, title, body, change_summary, content_hash, published_by, published_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, '')::UUID, $9)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		version.ID,
+		version.DocumentID,
+		version.Number,
+		version.Title,
+		version.Body,
+		version.ChangeSummary,
+		version.ContentHash,
+		version.PublishedBy,
+		version.PublishedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return consentDomain.ErrVersionConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+func scanDocument(row pgx.Row) (*consentDomain.Document, error) {
+	var document consentDomain.Document
+	var version consentDomain.Version
+
+	fields := []any{
+		&document.ID,
+		&document.Title,
+		&document.Kind,
+		&document.Required,
+		&document.CreatedAt,
+		&document.UpdatedAt,
+	}
+
+	if err := row.Scan(append(fields, versionFields(&version)...)...); err != nil {
+		return nil, err
+	}
+
+	document.CurrentVersion = &version
+	return &document, nil
+}
+
+func versionFields(version *consentDomain.Version) []any {
+	return []any{
+		&version.ID,
+		&version.DocumentID,
+		&version.Number,
+		&version.Title,
+		&version.Body,
+		&version.ChangeSummary,
+		&version.ContentHash,
+		&version.PublishedBy,
+		&version.PublishedAt,
+	}
+}
+
+func scanSignature(row pgx.Row) (*consentDomain.Signature, error) {
+	var signature consentDomain.Signature
+
+	err := row.Scan(
+		&signature.ID,
+		&signature.ClientID,
+		&signature.GuardianID,
+		&signature.DocumentID,
+		&signature.VersionID,
+		&signature.VersionNumber,
+		&signature.TypedName,
+		&signature.IPAddress,
+		&signature.UserAgent,
+		&signature.DocumentHash,
+		&signature.SignedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signature, nil
+}
@@ -0,0 +1,156 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+// Key is one ORDER BY term of a keyset-paginated query. Cast is the SQL type
+// the cursor value is converted to before comparing against Column.
+type Key struct {
+	Column     string
+	Cast       string
+	Descending bool
+}
+
+// Keyset describes the ordering of one list query. The last key must be unique
+// (usually the primary key) so that every row has a distinct position.
+type Keyset struct {
+	Scope string
+	Keys  []Key
+}
+
+// Codec signs cursors so clients can pass them back but cannot forge positions
+type Codec struct {
+	secret []byte
+}
+
+func NewCodec(secret string) *Codec {
+	return &Codec{secret: []byte(secret)}
+}
+
+type position struct {
+	Scope  string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+// OrderBy returns the ORDER BY terms matching the keyset
+func (k Keyset) OrderBy() string {
+	terms := make([]string, len(k.Keys))
+	for i, key := range k.Keys {
+		terms[i] = key.Column
+		if key.Descending {
+			terms[i] += " DESC"
+		}
+	}
+	return " ORDER BY " + strings.Join(terms, ", ")
+}
+
+// Encode returns an opaque cursor pointing just past a row with the given key values
+func (c *Codec) Encode(k Keyset, values []string) string {
+	payload, _ := json.Marshal(position{Scope: k.Scope, Values: values})
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Where verifies token and returns a predicate selecting rows after it, with placeholders
+// numbered from argIndex. An empty token yields an empty predicate.
+func (c *Codec) Where(k Keyset, token string, argIndex int) (string, []interface{}, error) {
+	if token == "" {
+		return "", nil, nil
+	}
+
+	values, err := c.decode(k, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := make([]interface{}, len(values))
+	placeholders := make([]string, len(values))
+	for i, value := range values {
+		args[i] = value
+		placeholders[i] = fmt.Sprintf("$%d::%s", argIndex+i, k.Keys[i].Cast)
+	}
+
+	// (a > x) OR (a = x AND b > y) OR ... handles mixed ASC/DESC keys, which a row comparison cannot
+	var branches []string
+	for i, key := range k.Keys {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = %s", k.Keys[j].Column, placeholders[j]))
+		}
+
+		operator := ">"
+		if key.Descending {
+			operator = "<"
+		}
+		terms = append(terms, fmt.Sprintf("%s %s %s", key.Column, operator, placeholders[i]))
+
+		branches = append(branches, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return "(" + strings.Join(branches, " OR ") + ")", args, nil
+}
+
+func (c *Codec) decode(k Keyset, token string) ([]string, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	var pos position
+	if err := json.Unmarshal(payload, &pos); err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	// A cursor from another list or sort order would compare against the wrong columns
+	if pos.Scope != k.Scope || len(pos.Values) != len(k.Keys) {
+		return nil, pagination.ErrInvalidCursor
+	}
+
+	return pos.Values, nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// Paginate trims the extra row fetched to detect a following page and, if there is one,
+// encodes a cursor from the last returned item. Queries should LIMIT to page.Limit+1.
+func Paginate[T any](c *Codec, k Keyset, items []T, limit int, values func(T) []string) *pagination.Page[T] {
+	page := &pagination.Page[T]{Items: items}
+	if limit > 0 && len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = c.Encode(k, values(page.Items[limit-1]))
+	}
+	return page
+}
+
+// Time formats a timestamp key without losing precision
+func Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Float formats a numeric key so it parses back to the identical value
+func Float(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
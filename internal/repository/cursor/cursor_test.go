@@ -0,0 +1,92 @@
+package cursor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+)
+
+var testKeyset = Keyset{
+	Scope: "articles",
+	Keys: []Key{
+		{Column: "published_date", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "id", Cast: "TEXT"},
+	},
+}
+
+func TestCodec_Where(t *testing.T) {
+	codec := NewCodec("test-secret")
+	token := codec.Encode(testKeyset, []string{"2025-09-13T12:00:00Z", "intro-to-cbt"})
+
+	clause, args, err := codec.Where(testKeyset, token, 3)
+	if err != nil {
+		t.Fatalf("Where() unexpected error = %v", err)
+	}
+
+	wantClause := "((published_date < $3::TIMESTAMPTZ) OR (published_date = $3::TIMESTAMPTZ AND id > $4::TEXT))"
+	if clause != wantClause {
+		t.Errorf("Where() clause = %v, want %v", clause, wantClause)
+	}
+
+	if len(args) != 2 || args[0] != "2025-09-13T12:00:00Z" || args[1] != "intro-to-cbt" {
+		t.Errorf("Where() args = %v", args)
+	}
+
+	clause, args, err = codec.Where(testKeyset, "", 1)
+	if err != nil || clause != "" || args != nil {
+		t.Errorf("Where() with empty cursor = %q, %v, %v; want no predicate", clause, args, err)
+	}
+}
+
+func TestCodec_WhereRejectsInvalidCursors(t *testing.T) {
+	codec := NewCodec("test-secret")
+	token := codec.Encode(testKeyset, []string{"2025-09-13T12:00:00Z", "intro-to-cbt"})
+	payload, _, _ := strings.Cut(token, ".")
+
+	otherScope := testKeyset
+	otherScope.Scope = "therapies"
+
+	tests := []struct {
+		name   string
+		keyset Keyset
+		token  string
+	}{
+		{name: "garbage", keyset: testKeyset, token: "not-a-cursor"},
+		{name: "tampered payload", keyset: testKeyset, token: "x" + token},
+		{name: "missing signature", keyset: testKeyset, token: payload + "."},
+		{name: "signed with another secret", keyset: testKeyset, token: NewCodec("other-secret").Encode(testKeyset, []string{"a", "b"})},
+		{name: "different list", keyset: otherScope, token: token},
+		{name: "wrong number of keys", keyset: testKeyset, token: codec.Encode(testKeyset, []string{"only-one"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := codec.Where(tt.keyset, tt.token, 1)
+			if !errors.Is(err, pagination.ErrInvalidCursor) {
+				t.Errorf("Where() error = %v, want %v", err, pagination.ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	codec := NewCodec("test-secret")
+	values := func(id string) []string { return []string{"2025-09-13T12:00:00Z", id} }
+
+	page := Paginate(codec, testKeyset, []string{"a", "b", "c"}, 2, values)
+	if len(page.Items) != 2 || page.NextCursor == "" {
+		t.Fatalf("Paginate() = %d items, next cursor %q; want 2 items and a cursor", len(page.Items), page.NextCursor)
+	}
+
+	_, args, err := codec.Where(testKeyset, page.NextCursor, 1)
+	if err != nil || args[1] != "b" {
+		t.Errorf("Paginate() cursor should point after the last returned item, got %v, %v", args, err)
+	}
+
+	page = Paginate(codec, testKeyset, []string{"a", "b"}, 2, values)
+	if len(page.Items) != 2 || page.NextCursor != "" {
+		t.Errorf("Paginate() on the last page = %d items, next cursor %q; want 2 items and no cursor", len(page.Items), page.NextCursor)
+	}
+}
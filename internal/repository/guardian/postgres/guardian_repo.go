@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const guardianshipColumns = `id, guardian_id, client_id, relationship, scopes, status, COALESCE(end_reason, ''),
+			   created_at, updated_at, ended_at`
+
+type GuardianRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewGuardianRepository(db *pgxpool.Pool) *GuardianRepository {
+	return &GuardianRepository{
+		db: db,
+	}
+}
+
+func (r *GuardianRepository) Create(ctx context.Context, guardianship *guardianDomain.Guardianship) error {
+	query := `
+		INSERT INTO guardianships (id, guardian_id, client_id, relationship, scopes, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		guardianship.ID,
+		guardianship.GuardianID,
+		guardianship.ClientID,
+		guardianship.Relationship,
+		scopeStrings(guardianship.Scopes),
+		guardianship.Status,
+		guardianship.CreatedAt,
+		guardianship.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return guardianDomain.ErrGuardianshipExists
+			case "23503":
+				return guardianDomain.ErrInvalidGuardianData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *GuardianRepository) GetByID(ctx context.Context, id string) (*guardianDomain.Guardianship, error) {
+	query := `
+		SELECT ` + guardianshipColumns + `
+		FROM guardianships
+		WHERE id = $1
+	`
+
+	guardianship, err := scanGuardianship(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, guardianDomain.ErrGuardianshipNotFound
+		}
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+func (r *GuardianRepository) Update(ctx context.Context, guardianship *guardianDomain.Guardianship) error {
+	query := `
+		UPDATE guardianships
+		SET scopes = $2, status = $3, end_reason = NULLIF($4, ''), updated_at = $5, ended_at = $6
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		guardianship.ID,
+		scopeStrings(guardianship.Scopes),
+		guardianship.Status,
+		guardianship.EndReason,
+		guardianship.UpdatedAt,
+		guardianship.EndedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return guardianDomain.ErrGuardianshipNotFound
+	}
+
+	return nil
+}
+
+func (r *GuardianRepository) GetActive(ctx context.Context, guardianID, clientID string) (*guardianDomain.Guardianship, error) {
+	query := `
+		SELECT ` + guardianshipColumns + `
+		FROM guardianships
+		WHERE guardian_id = $1 AND client_id = $2 AND status = 'active'
+	`
+
+	guardianship, err := scanGuardianship(r.db.QueryRow(ctx, query, guardianID, clientID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, guardianDomain.ErrGuardianshipNotFound
+		}
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+func (r *GuardianRepository) GetActiveByGuardianID(ctx context.Context, guardianID string) ([]*guardianDomain.Guardianship, error) {
+	query := `
+		SELECT ` + guardianshipColumns + `
+		FROM guardianships
+		WHERE guardian_id = $1 AND status = 'active'
+		ORDER BY created_at, id
+	`
+
+	return r.query(ctx, query, guardianID)
+}
+
+func (r *GuardianRepository) GetByClientID(ctx context.Context, clientID string) ([]*guardianDomain.Guardianship, error) {
+	query := `
+		SELECT ` + guardianshipColumns + `
+		FROM guardianships
+		WHERE client_id = $1
+		ORDER BY created_at DESC, id
+	`
+
+	return r.query(ctx, query, clientID)
+}
+
+func (r *GuardianRepository) HasActive(ctx context.Context, clientID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM guardianships WHERE client_id = $1 AND status = 'active')`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, clientID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *GuardianRepository) EndAtMajority(ctx context.Context, cutoff, now time.Time) (int64, error) {
+	query := `
+		UPDATE guardianships g
+		SET status = 'ended', end_reason = 'majority', ended_at = $2, updated_at = $2
+		FROM client_profiles c
+		WHERE c.user_id = g.client_id
+		  AND g.status = 'active'
+		  AND c.date_of_birth <= $1
+	`
+
+	result, err := r.db.Exec(ctx, query, cutoff, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *GuardianRepository) query(ctx context.Context, query string, args ...any) ([]*guardianDomain.Guardianship, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var guardianships []*guardianDomain.Guardianship
+	for rows.Next() {
+		guardianship, err := scanGuardianship(rows)
+		if err != nil {
+			return nil, err
+		}
+		guardianships = append(guardianships, guardianship)
+	}
+
+	return guardianships, rows.Err()
+}
+
+func scanGuardianship(row pgx.Row) (*guardianDomain.Guardianship, error) {
+	var guardianship guardianDomain.Guardianship
+	var scopes []string
+
+	err := row.Scan(
+		&guardianship.ID,
+		&guardianship.GuardianID,
+		&guardianship.ClientID,
+		&guardianship.Relationship,
+		&scopes,
+		&guardianship.Status,
+		&guardianship.EndReason,
+		&guardianship.CreatedAt,
+		&guardianship.UpdatedAt,
+		&guardianship.EndedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	guardianship.Scopes = make([]guardianDomain.Scope, len(scopes))
+	for i, scope := range scopes {
+		guardianship.Scopes[i] = guardianDomain.Scope(scope)
+	}
+
+	return &guardianship, nil
+}
+
+func scopeStrings(scopes []guardianDomain.Scope) []string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return values
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const assignmentColumns = `id, therapist_id, client_id, title, instructions, article_id, therapy_id, due_at, status,
+			   response, reflection, helpfulness, completed_at, assigned_at, updated_at`
+
+type HomeworkRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewHomeworkRepository(db *pgxpool.Pool) *HomeworkRepository {
+	return &HomeworkRepository{
+		db: db,
+	}
+}
+
+func (r *HomeworkRepository) Create(ctx context.Context, assignment *homeworkDomain.Assignment) error {
+	articleID, therapyID := resourceColumns(assignment)
+
+	query := `
+		INSERT INTO homework_assignments (
+			id, therapist_id, client_id, title, instructions, article_id, therapy_id, due_at, status,
+			assigned_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		assignment.ID,
+		assignment.TherapistID,
+		assignment.ClientID,
+		assignment.Title,
+		assignment.Instructions,
+		articleID,
+		therapyID,
+		assignment.DueAt,
+		assignment.Status,
+		assignment.AssignedAt,
+		assignment.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			// The article or therapy was removed in the meantime
+			if pgErr.ConstraintName == "homework_assignments_article_id_fkey" || pgErr.ConstraintName == "homework_assignments_therapy_id_fkey" {
+				return homeworkDomain.ErrResourceNotFound
+			}
+			return homeworkDomain.ErrInvalidAssignmentData
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *HomeworkRepository) GetByID(ctx context.Context, id string) (*homeworkDomain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM homework_assignments
+		WHERE id = $1
+	`
+
+	assignment, err := scanAssignment(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, homeworkDomain.ErrAssignmentNotFound
+		}
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (r *HomeworkRepository) Update(ctx context.Context, assignment *homeworkDomain.Assignment) error {
+	var response, reflection *string
+	var helpfulness *int
+	var completedAt *time.Time
+	if submission := assignment.Submission; submission != nil {
+		response = &submission.Response
+		reflection = &submission.Reflection
+		helpfulness = submission.Helpfulness
+		completedAt = &submission.CompletedAt
+	}
+
+	query := `
+		UPDATE homework_assignments
+		SET status = $2, response = $3, reflection = $4, helpfulness = $5, completed_at = $6, updated_at = $7
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		assignment.ID,
+		assignment.Status,
+		response,
+		reflection,
+		helpfulness,
+		completedAt,
+		assignment.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return homeworkDomain.ErrAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (r *HomeworkRepository) GetByClientID(ctx context.Context, clientID, therapistID string) ([]*homeworkDomain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM homework_assignments
+		WHERE client_id = $1 AND ($2 = '' OR therapist_id = NULLIF($2, '')::UUID)
+		ORDER BY assigned_at DESC, id
+	`
+
+	return r.list(ctx, query, clientID, therapistID)
+}
+
+func (r *HomeworkRepository) GetOverdue(ctx context.Context, therapistID string, now time.Time) ([]*homeworkDomain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM homework_assignments
+		WHERE therapist_id = $1 AND status = 'assigned' AND due_at < $2
+		ORDER BY due_at, id
+	`
+
+	return r.list(ctx, query, therapistID, now)
+}
+
+func (r *HomeworkRepository) list(ctx context.Context, query string, args ...interface{}) ([]*homeworkDomain.Assignment, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*homeworkDomain.Assignment
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+// resourceColumns splits the referenced resource into its foreign key column
+func resourceColumns(assignment *homeworkDomain.Assignment) (*string, *string) {
+	switch assignment.ResourceType {
+	case homeworkDomain.ResourceArticle:
+		return &assignment.ResourceID, nil
+	case homeworkDomain.ResourceTherapy:
+		return nil, &assignment.ResourceID
+	}
+	return nil, nil
+}
+
+func scanAssignment(row pgx.Row) (*homeworkDomain.Assignment, error) {
+	var assignment homeworkDomain.Assignment
+	var articleID, therapyID *string
+	var response, reflection *string
+	var helpfulness *int
+	var completedAt *time.Time
+
+	err := row.Scan(
+		&assignment.ID,
+		&assignment.TherapistID,
+		&assignment.ClientID,
+		&assignment.Title,
+		&assignment.Instructions,
+		&articleID,
+		&therapyID,
+		&assignment.DueAt,
+		&assignment.Status,
+		&response,
+		&reflection,
+		&helpfulness,
+		&completedAt,
+		&assignment.AssignedAt,
+		&assignment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// References are cleared when the article or therapy is deleted
+	switch {
+	case articleID != nil:
+		assignment.ResourceType = homeworkDomain.ResourceArticle
+		assignment.ResourceID = *articleID
+	case therapyID != nil:
+		assignment.ResourceType = homeworkDomain.ResourceTherapy
+		assignment.ResourceID = *therapyID
+	}
+
+	if completedAt != nil {
+		assignment.Submission = &homeworkDomain.Submission{
+			Helpfulness: helpfulness,
+			CompletedAt: *completedAt,
+		}
+		if response != nil {
+			assignment.Submission.Response = *response
+		}
+		if reflection != nil {
+			assignment.Submission.Reflection = *reflection
+		}
+	}
+
+	return &assignment, nil
+}
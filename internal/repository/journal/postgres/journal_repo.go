@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/encryption"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const entryColumns = `id, client_id, entry_date, mood_score, emotions, sleep_hours, sleep_quality, activity_minutes,
+			   key_id, wrapped_key, nonce, ciphertext, shared_with_therapist, shared_at, created_at, updated_at`
+
+// JournalRepository keeps the free text of entries encrypted with envelope
+// encryption. Scores and tags stay in plain columns so trends can be queried.
+type JournalRepository struct {
+	db   *pgxpool.Pool
+	keys encryption.KeyProvider
+}
+
+func NewJournalRepository(db *pgxpool.Pool, keys encryption.KeyProvider) *JournalRepository {
+	return &JournalRepository{
+		db:   db,
+		keys: keys,
+	}
+}
+
+func (r *JournalRepository) Create(ctx context.Context, entry *journalDomain.Entry) error {
+	envelope, err := r.seal(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO journal_entries (
+			id, client_id, entry_date, mood_score, emotions, sleep_hours, sleep_quality, activity_minutes,
+			key_id, wrapped_key, nonce, ciphertext, shared_with_therapist, shared_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		entry.ID,
+		entry.ClientID,
+		entry.EntryDate,
+		entry.MoodScore,
+		entry.Emotions,
+		entry.SleepHours,
+		entry.SleepQuality,
+		entry.ActivityMinutes,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		entry.SharedWithTherapist,
+		entry.SharedAt,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			// One entry per client and day
+			if pgErr.Code == "23505" {
+				return journalDomain.ErrEntryExists
+			}
+			if pgErr.Code == "23503" {
+				return journalDomain.ErrInvalidEntryData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *JournalRepository) GetByID(ctx context.Context, id string) (*journalDomain.Entry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE id = $1
+	`
+
+	entry, err := r.scanEntry(ctx, r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, journalDomain.ErrEntryNotFound
+		}
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *JournalRepository) Update(ctx context.Context, entry *journalDomain.Entry) error {
+	envelope, err := r.seal(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE journal_entries
+		SET mood_score = $2, emotions = $3, sleep_hours = $4, sleep_quality = $5, activity_minutes = $6,
+		    key_id = $7, wrapped_key = $8, nonce = $9, ciphertext = $10,
+		    shared_with_therapist = $11, shared_at = $12, updated_at = $13
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		entry.ID,
+		entry.MoodScore,
+		entry.Emotions,
+		entry.SleepHours,
+		entry.SleepQuality,
+		entry.ActivityMinutes,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		entry.SharedWithTherapist,
+		entry.SharedAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return journalDomain.ErrEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *JournalRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM journal_entries WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return journalDomain.ErrEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *JournalRepository) GetByClientID(ctx context.Context, clientID string, from, to time.Time, sharedOnly bool) ([]*journalDomain.Entry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE client_id = $1 AND entry_date BETWEEN $2 AND $3 AND (NOT $4 OR shared_with_therapist)
+		ORDER BY entry_date DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, clientID, from, to, sharedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*journalDomain.Entry
+	for rows.Next() {
+		entry, err := r.scanEntry(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *JournalRepository) GetEntryDates(ctx context.Context, clientID string, sharedOnly bool) ([]time.Time, error) {
+	query := `
+		SELECT entry_date
+		FROM journal_entries
+		WHERE client_id = $1 AND (NOT $2 OR shared_with_therapist)
+		ORDER BY entry_date
+	`
+
+	rows, err := r.db.Query(ctx, query, clientID, sharedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, err
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, rows.Err()
+}
+
+func (r *JournalRepository) scanEntry(ctx context.Context, row pgx.Row) (*journalDomain.Entry, error) {
+	var entry journalDomain.Entry
+	var envelope encryption.Envelope
+	var keyID *string
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.ClientID,
+		&entry.EntryDate,
+		&entry.MoodScore,
+		&entry.Emotions,
+		&entry.SleepHours,
+		&entry.SleepQuality,
+		&entry.ActivityMinutes,
+		&keyID,
+		&envelope.WrappedKey,
+		&envelope.Nonce,
+		&envelope.Ciphertext,
+		&entry.SharedWithTherapist,
+		&entry.SharedAt,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries without text have no envelope
+	if keyID == nil {
+		return &entry, nil
+	}
+	envelope.KeyID = *keyID
+
+	plaintext, err := encryption.Open(ctx, r.keys, &envelope, entryAssociatedData(entry.ID, entry.ClientID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt journal entry: %w", err)
+	}
+	entry.Text = string(plaintext)
+	clear(plaintext)
+
+	return &entry, nil
+}
+
+// seal encrypts the entry's text; an entry without text gets an empty envelope
+// that is stored as NULLs
+func (r *JournalRepository) seal(ctx context.Context, entry *journalDomain.Entry) (*sealedText, error) {
+	if entry.Text == "" {
+		return &sealedText{}, nil
+	}
+
+	plaintext := []byte(entry.Text)
+	defer clear(plaintext)
+
+	envelope, err := encryption.Seal(ctx, r.keys, plaintext, entryAssociatedData(entry.ID, entry.ClientID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt journal entry: %w", err)
+	}
+
+	return &sealedText{
+		KeyID:      &envelope.KeyID,
+		WrappedKey: envelope.WrappedKey,
+		Nonce:      envelope.Nonce,
+		Ciphertext: envelope.Ciphertext,
+	}, nil
+}
+
+type sealedText struct {
+	KeyID      *string
+	WrappedKey []byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// The associated data ties the ciphertext to its entry and client. IDs are
+// normalized because UUID columns read back with hyphens.
+func entryAssociatedData(entryID, clientID string) []byte {
+	return []byte("journal_entry:" + normalizeID(entryID) + ":" + normalizeID(clientID))
+}
+
+func normalizeID(id string) string {
+	return strings.ReplaceAll(strings.ToLower(id), "-", "")
+}
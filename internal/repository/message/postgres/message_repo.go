@@ -0,0 +1,608 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/encryption"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	"github.com/goran/thappy/internal/domain/pagination"
+	"github.com/goran/thappy/internal/repository/cursor"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const conversationColumns = `c.id, c.client_id, c.therapist_id, c.retention_days, c.last_message_at, c.created_at, c.updated_at`
+
+const messageColumns = `m.id, m.conversation_id, m.sender_id, COALESCE(m.thread_id::TEXT, ''),
+			   m.key_id, m.wrapped_key, m.nonce, m.ciphertext, m.read_at, m.created_at`
+
+// Most recently active first
+var conversationKeyset = cursor.Keyset{
+	Scope: "conversations",
+	Keys: []cursor.Key{
+		{Column: "COALESCE(c.last_message_at, c.created_at)", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "c.id", Cast: "UUID", Descending: true},
+	},
+}
+
+// Newest first, both for a conversation's threads and the replies in a thread
+var messageKeyset = cursor.Keyset{
+	Scope: "messages",
+	Keys: []cursor.Key{
+		{Column: "m.created_at", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "m.id", Cast: "UUID", Descending: true},
+	},
+}
+
+// MessageRepository encrypts message text and attachments with envelope
+// encryption. Attachment file names are sealed together with the message
+// text, so only sizes and content types are stored in plain columns.
+type MessageRepository struct {
+	db      *pgxpool.Pool
+	keys    encryption.KeyProvider
+	cursors *cursor.Codec
+}
+
+func NewMessageRepository(db *pgxpool.Pool, keys encryption.KeyProvider, cursors *cursor.Codec) *MessageRepository {
+	return &MessageRepository{
+		db:      db,
+		keys:    keys,
+		cursors: cursors,
+	}
+}
+
+// messagePayload is the plaintext sealed for each message
+type messagePayload struct {
+	Body      string            `json:"body"`
+	FileNames map[string]string `json:"file_names,omitempty"`
+}
+
+func (r *MessageRepository) CreateConversation(ctx context.Context, conversation *messageDomain.Conversation) error {
+	query := `
+		INSERT INTO conversations (id, client_id, therapist_id, retention_days, last_message_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		conversation.ID,
+		conversation.ClientID,
+		conversation.TherapistID,
+		conversation.RetentionDays,
+		conversation.LastMessageAt,
+		conversation.CreatedAt,
+		conversation.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			// One conversation per client and therapist
+			if pgErr.Code == "23505" {
+				return messageDomain.ErrConversationExists
+			}
+			if pgErr.Code == "23503" {
+				return messageDomain.ErrInvalidMessageData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *MessageRepository) GetConversationByID(ctx context.Context, id string) (*messageDomain.Conversation, error) {
+	query := `
+		SELECT ` + conversationColumns + `
+		FROM conversations c
+		WHERE c.id = $1
+	`
+
+	return r.getConversation(ctx, query, id)
+}
+
+func (r *MessageRepository) GetConversationByParticipants(ctx context.Context, clientID, therapistID string) (*messageDomain.Conversation, error) {
+	query := `
+		SELECT ` + conversationColumns + `
+		FROM conversations c
+		WHERE c.client_id = $1 AND c.therapist_id = $2
+	`
+
+	return r.getConversation(ctx, query, clientID, therapistID)
+}
+
+func (r *MessageRepository) UpdateConversation(ctx context.Context, conversation *messageDomain.Conversation) error {
+	query := `
+		UPDATE conversations
+		SET retention_days = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, conversation.ID, conversation.RetentionDays, conversation.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return messageDomain.ErrConversationNotFound
+	}
+
+	return nil
+}
+
+func (r *MessageRepository) ListConversations(ctx context.Context, userID string, page pagination.PageRequest) (*pagination.Page[*messageDomain.ConversationSummary], error) {
+	page = page.Normalize()
+
+	query := `
+		SELECT ` + conversationColumns + `,
+			   (SELECT COUNT(*) FROM messages m
+			    WHERE m.conversation_id = c.id AND m.sender_id <> $1 AND m.read_at IS NULL),
+			   EXISTS(SELECT 1 FROM client_profiles cp
+			          WHERE cp.user_id = c.client_id AND cp.therapist_id = c.therapist_id)
+		FROM conversations c
+		WHERE (c.client_id = $1 OR c.therapist_id = $1)
+	`
+	args := []interface{}{userID}
+
+	after, cursorArgs, err := r.cursors.Where(conversationKeyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += conversationKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []*messageDomain.ConversationSummary
+	for rows.Next() {
+		var conversation messageDomain.Conversation
+		summary := &messageDomain.ConversationSummary{Conversation: &conversation}
+		err := rows.Scan(
+			&conversation.ID,
+			&conversation.ClientID,
+			&conversation.TherapistID,
+			&conversation.RetentionDays,
+			&conversation.LastMessageAt,
+			&conversation.CreatedAt,
+			&conversation.UpdatedAt,
+			&summary.UnreadCount,
+			&summary.Active,
+		)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, conversationKeyset, summaries, page.Limit, func(summary *messageDomain.ConversationSummary) []string {
+		return []string{cursor.Time(summary.Conversation.LastActivityAt()), summary.Conversation.ID}
+	}), nil
+}
+
+func (r *MessageRepository) CreateMessage(ctx context.Context, message *messageDomain.Message) error {
+	envelope, err := r.sealMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO messages (
+			id, conversation_id, sender_id, thread_id, key_id, wrapped_key, nonce, ciphertext, read_at, created_at
+		)
+		VALUES ($1, $2, $3, NULLIF($4, '')::UUID, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		message.ID,
+		message.ConversationID,
+		message.SenderID,
+		message.ThreadID,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		message.ReadAt,
+		message.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return messageDomain.ErrInvalidMessageData
+		}
+		return err
+	}
+
+	for _, attachment := range message.Attachments {
+		if err := r.insertAttachment(ctx, tx, attachment); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE conversations SET last_message_at = $2 WHERE id = $1`, message.ConversationID, message.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *MessageRepository) GetMessageByID(ctx context.Context, id string) (*messageDomain.Message, error) {
+	query := `
+		SELECT ` + messageColumns + `, 0
+		FROM messages m
+		WHERE m.id = $1
+	`
+
+	messages, err := r.queryMessages(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, messageDomain.ErrMessageNotFound
+	}
+
+	return messages[0], nil
+}
+
+func (r *MessageRepository) ListMessages(ctx context.Context, conversationID, threadID string, page pagination.PageRequest) (*pagination.Page[*messageDomain.Message], error) {
+	page = page.Normalize()
+
+	var query string
+	args := []interface{}{conversationID}
+	if threadID == "" {
+		query = `
+			SELECT ` + messageColumns + `,
+				   (SELECT COUNT(*) FROM messages r WHERE r.thread_id = m.id)
+			FROM messages m
+			WHERE m.conversation_id = $1 AND m.thread_id IS NULL
+		`
+	} else {
+		query = `
+			SELECT ` + messageColumns + `, 0
+			FROM messages m
+			WHERE m.conversation_id = $1 AND m.thread_id = $2
+		`
+		args = append(args, threadID)
+	}
+
+	after, cursorArgs, err := r.cursors.Where(messageKeyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += messageKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	messages, err := r.queryMessages(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, messageKeyset, messages, page.Limit, func(message *messageDomain.Message) []string {
+		return []string{cursor.Time(message.CreatedAt), message.ID}
+	}), nil
+}
+
+func (r *MessageRepository) GetAttachment(ctx context.Context, id string) (*messageDomain.Attachment, error) {
+	query := `
+		SELECT a.id, a.message_id, a.content_type, a.size_bytes, a.key_id, a.wrapped_key, a.nonce, a.ciphertext, a.created_at,
+			   m.conversation_id, m.key_id, m.wrapped_key, m.nonce, m.ciphertext
+		FROM message_attachments a
+		JOIN messages m ON m.id = a.message_id
+		WHERE a.id = $1
+	`
+
+	var attachment messageDomain.Attachment
+	var content, messageEnvelope encryption.Envelope
+	var conversationID string
+
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&attachment.ID,
+		&attachment.MessageID,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&content.KeyID,
+		&content.WrappedKey,
+		&content.Nonce,
+		&content.Ciphertext,
+		&attachment.CreatedAt,
+		&conversationID,
+		&messageEnvelope.KeyID,
+		&messageEnvelope.WrappedKey,
+		&messageEnvelope.Nonce,
+		&messageEnvelope.Ciphertext,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, messageDomain.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	payload, err := r.openMessage(ctx, &messageEnvelope, attachment.MessageID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	attachment.FileName = payload.FileNames[normalizeID(attachment.ID)]
+
+	attachment.Content, err = encryption.Open(ctx, r.keys, &content, attachmentAssociatedData(attachment.ID, attachment.MessageID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt message attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+func (r *MessageRepository) MarkRead(ctx context.Context, conversationID, readerID string, now time.Time) (int64, error) {
+	query := `
+		UPDATE messages
+		SET read_at = $3
+		WHERE conversation_id = $1 AND sender_id <> $2 AND read_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, conversationID, readerID, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *MessageRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	// Replies go first; a thread's first message then only outlives the
+	// retention period while one of its replies is still kept
+	query := `
+		DELETE FROM messages m
+		USING conversations c
+		WHERE m.conversation_id = c.id
+		  AND m.created_at < $1::TIMESTAMPTZ - make_interval(days => c.retention_days)
+		  AND NOT EXISTS (
+			  SELECT 1 FROM messages r
+			  WHERE r.thread_id = m.id
+			    AND r.created_at >= $1::TIMESTAMPTZ - make_interval(days => c.retention_days)
+		  )
+	`
+
+	var deleted int64
+	for _, threadFilter := range []string{" AND m.thread_id IS NOT NULL", " AND m.thread_id IS NULL"} {
+		result, err := r.db.Exec(ctx, query+threadFilter, now)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += result.RowsAffected()
+	}
+
+	return deleted, nil
+}
+
+func (r *MessageRepository) getConversation(ctx context.Context, query string, args ...interface{}) (*messageDomain.Conversation, error) {
+	var conversation messageDomain.Conversation
+	err := r.db.QueryRow(ctx, query, args...).Scan(
+		&conversation.ID,
+		&conversation.ClientID,
+		&conversation.TherapistID,
+		&conversation.RetentionDays,
+		&conversation.LastMessageAt,
+		&conversation.CreatedAt,
+		&conversation.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, messageDomain.ErrConversationNotFound
+		}
+		return nil, err
+	}
+
+	return &conversation, nil
+}
+
+// queryMessages runs a query selecting messageColumns followed by the reply
+// count, decrypts each message and loads its attachments' details
+func (r *MessageRepository) queryMessages(ctx context.Context, query string, args ...interface{}) ([]*messageDomain.Message, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*messageDomain.Message
+	var envelopes []*encryption.Envelope
+	for rows.Next() {
+		var message messageDomain.Message
+		var envelope encryption.Envelope
+		err := rows.Scan(
+			&message.ID,
+			&message.ConversationID,
+			&message.SenderID,
+			&message.ThreadID,
+			&envelope.KeyID,
+			&envelope.WrappedKey,
+			&envelope.Nonce,
+			&envelope.Ciphertext,
+			&message.ReadAt,
+			&message.CreatedAt,
+			&message.ReplyCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &message)
+		envelopes = append(envelopes, &envelope)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if len(messages) == 0 {
+		return messages, nil
+	}
+
+	attachments, err := r.getAttachmentDetails(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, message := range messages {
+		payload, err := r.openMessage(ctx, envelopes[i], message.ID, message.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+
+		message.Body = payload.Body
+		for _, attachment := range attachments[message.ID] {
+			attachment.FileName = payload.FileNames[normalizeID(attachment.ID)]
+			message.Attachments = append(message.Attachments, attachment)
+		}
+	}
+
+	return messages, nil
+}
+
+// getAttachmentDetails loads the attachments of the given messages without
+// their content, keyed by message ID
+func (r *MessageRepository) getAttachmentDetails(ctx context.Context, messages []*messageDomain.Message) (map[string][]*messageDomain.Attachment, error) {
+	ids := make([]string, len(messages))
+	for i, message := range messages {
+		ids[i] = message.ID
+	}
+
+	query := `
+		SELECT id, message_id, content_type, size_bytes, created_at
+		FROM message_attachments
+		WHERE message_id = ANY($1::UUID[])
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := make(map[string][]*messageDomain.Attachment)
+	for rows.Next() {
+		var attachment messageDomain.Attachment
+		err := rows.Scan(
+			&attachment.ID,
+			&attachment.MessageID,
+			&attachment.ContentType,
+			&attachment.SizeBytes,
+			&attachment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		attachments[attachment.MessageID] = append(attachments[attachment.MessageID], &attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+func (r *MessageRepository) insertAttachment(ctx context.Context, tx pgx.Tx, attachment *messageDomain.Attachment) error {
+	envelope, err := encryption.Seal(ctx, r.keys, attachment.Content, attachmentAssociatedData(attachment.ID, attachment.MessageID))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt message attachment: %w", err)
+	}
+
+	query := `
+		INSERT INTO message_attachments (
+			id, message_id, content_type, size_bytes, key_id, wrapped_key, nonce, ciphertext, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		attachment.ID,
+		attachment.MessageID,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		attachment.CreatedAt,
+	)
+	return err
+}
+
+func (r *MessageRepository) sealMessage(ctx context.Context, message *messageDomain.Message) (*encryption.Envelope, error) {
+	payload := messagePayload{Body: message.Body}
+	if len(message.Attachments) > 0 {
+		payload.FileNames = make(map[string]string, len(message.Attachments))
+		for _, attachment := range message.Attachments {
+			payload.FileNames[normalizeID(attachment.ID)] = attachment.FileName
+		}
+	}
+
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(plaintext)
+
+	envelope, err := encryption.Seal(ctx, r.keys, plaintext, messageAssociatedData(message.ID, message.ConversationID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt message: %w", err)
+	}
+
+	return envelope, nil
+}
+
+func (r *MessageRepository) openMessage(ctx context.Context, envelope *encryption.Envelope, messageID, conversationID string) (*messagePayload, error) {
+	plaintext, err := encryption.Open(ctx, r.keys, envelope, messageAssociatedData(messageID, conversationID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt message: %w", err)
+	}
+	defer clear(plaintext)
+
+	var payload messagePayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return nil, fmt.Errorf("failed to read message: %w", err)
+	}
+
+	return &payload, nil
+}
+
+// The associated data ties message ciphertext to its conversation and
+// attachment ciphertext to its message. IDs are normalized because UUID
+// columns read back with hyphens.
+func messageAssociatedData(messageID, conversationID string) []byte {
+	return []byte("message:" + normalizeID(messageID) + ":" + normalizeID(conversationID))
+}
+
+func attachmentAssociatedData(attachmentID, messageID string) []byte {
+	return []byte("message_attachment:" + normalizeID(attachmentID) + ":" + normalizeID(messageID))
+}
+
+func normalizeID(id string) string {
+	return strings.ReplaceAll(strings.ToLower(id), "-", "")
+}
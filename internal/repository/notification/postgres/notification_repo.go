@@ -0,0 +1,350 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	"github.com/goran/thappy/internal/domain/pagination"
+	"github.com/goran/thappy/internal/repository/cursor"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const notificationColumns = `n.id, n.user_id, n.type, n.title, n.body, n.read_at, n.created_at`
+
+const emailColumns = `id, user_id, type, recipient, subject, text_body, html_body, status, attempts,
+			   send_after, COALESCE(last_error, ''), sent_at, claimed_until, created_at, updated_at`
+
+// Newest first
+var notificationKeyset = cursor.Keyset{
+	Scope: "notifications",
+	Keys: []cursor.Key{
+		{Column: "n.created_at", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "n.id", Cast: "UUID", Descending: true},
+	},
+}
+
+type NotificationRepository struct {
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
+}
+
+func NewNotificationRepository(db *pgxpool.Pool, cursors *cursor.Codec) *NotificationRepository {
+	return &NotificationRepository{
+		db:      db,
+		cursors: cursors,
+	}
+}
+
+func (r *NotificationRepository) CreateNotification(ctx context.Context, notification *notificationDomain.Notification) error {
+	query := `
+		INSERT INTO notifications (id, user_id, type, title, body, read_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		notification.ID,
+		notification.UserID,
+		notification.Type,
+		notification.Title,
+		notification.Body,
+		notification.ReadAt,
+		notification.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *NotificationRepository) ListNotifications(ctx context.Context, userID string, unreadOnly bool, page pagination.PageRequest) (*pagination.Page[*notificationDomain.Notification], error) {
+	page = page.Normalize()
+
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications n
+		WHERE n.user_id = $1
+	`
+	if unreadOnly {
+		query += " AND n.read_at IS NULL"
+	}
+	args := []interface{}{userID}
+
+	after, cursorArgs, err := r.cursors.Where(notificationKeyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += notificationKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*notificationDomain.Notification
+	for rows.Next() {
+		var notification notificationDomain.Notification
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.Title,
+			&notification.Body,
+			&notification.ReadAt,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, notificationKeyset, notifications, page.Limit, func(notification *notificationDomain.Notification) []string {
+		return []string{cursor.Time(notification.CreatedAt), notification.ID}
+	}), nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&count)
+	return count, err
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID, notificationID string, now time.Time) error {
+	query := `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, $3)
+		WHERE id = $1 AND user_id = $2
+	`
+
+	result, err := r.db.Exec(ctx, query, notificationID, userID, now)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return notificationDomain.ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID string, now time.Time) (int64, error) {
+	result, err := r.db.Exec(ctx, `UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND read_at IS NULL`, userID, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID string) (*notificationDomain.Preferences, error) {
+	query := `
+		SELECT user_id, email_enabled, in_app_enabled, language,
+			   quiet_hours_start, quiet_hours_end, quiet_hours_time_zone, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	var preferences notificationDomain.Preferences
+	var quietStart, quietEnd *int
+	var quietTimeZone *string
+
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&preferences.UserID,
+		&preferences.EmailEnabled,
+		&preferences.InAppEnabled,
+		&preferences.Language,
+		&quietStart,
+		&quietEnd,
+		&quietTimeZone,
+		&preferences.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, notificationDomain.ErrPreferencesNotFound
+		}
+		return nil, err
+	}
+
+	if quietStart != nil && quietEnd != nil && quietTimeZone != nil {
+		preferences.QuietHours = &notificationDomain.QuietHours{
+			Start:    *quietStart,
+			End:      *quietEnd,
+			TimeZone: *quietTimeZone,
+		}
+	}
+
+	return &preferences, nil
+}
+
+func (r *NotificationRepository) SavePreferences(ctx context.Context, preferences *notificationDomain.Preferences) error {
+	query := `
+		INSERT INTO notification_preferences (
+			user_id, email_enabled, in_app_enabled, language,
+			quiet_hours_start, quiet_hours_end, quiet_hours_time_zone, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE SET
+			email_enabled = EXCLUDED.email_enabled,
+			in_app_enabled = EXCLUDED.in_app_enabled,
+			language = EXCLUDED.language,
+			quiet_hours_start = EXCLUDED.quiet_hours_start,
+			quiet_hours_end = EXCLUDED.quiet_hours_end,
+			quiet_hours_time_zone = EXCLUDED.quiet_hours_time_zone,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	var quietStart, quietEnd *int
+	var quietTimeZone *string
+	if quietHours := preferences.QuietHours; quietHours != nil {
+		quietStart = &quietHours.Start
+		quietEnd = &quietHours.End
+		quietTimeZone = &quietHours.TimeZone
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		preferences.UserID,
+		preferences.EmailEnabled,
+		preferences.InAppEnabled,
+		preferences.Language,
+		quietStart,
+		quietEnd,
+		quietTimeZone,
+		preferences.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && (pgErr.Code == "23503" || pgErr.Code == "23514") {
+			return notificationDomain.ErrInvalidPreferences
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) CreateEmail(ctx context.Context, email *notificationDomain.Email) error {
+	query := `
+		INSERT INTO notification_emails (
+			id, user_id, type, recipient, subject, text_body, html_body, status, attempts,
+			send_after, last_error, sent_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), $12, $13, $14)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		email.ID,
+		email.UserID,
+		email.Type,
+		email.To,
+		email.Subject,
+		email.TextBody,
+		email.HTMLBody,
+		email.Status,
+		email.Attempts,
+		email.SendAfter,
+		email.LastError,
+		email.SentAt,
+		email.CreatedAt,
+		email.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *NotificationRepository) ClaimDueEmails(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*notificationDomain.Email, error) {
+	// SKIP LOCKED lets every API instance claim a different batch at once.
+	// Emails still marked as sending after their lease belong to an instance
+	// that stopped mid-send and are claimed again.
+	query := `
+		UPDATE notification_emails
+		SET status = 'sending', claimed_until = $2, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM notification_emails
+			WHERE (status = 'pending' AND send_after <= $1)
+			   OR (status = 'sending' AND claimed_until <= $1)
+			ORDER BY send_after
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + emailColumns
+
+	rows, err := r.db.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []*notificationDomain.Email
+	for rows.Next() {
+		var email notificationDomain.Email
+		err := rows.Scan(
+			&email.ID,
+			&email.UserID,
+			&email.Type,
+			&email.To,
+			&email.Subject,
+			&email.TextBody,
+			&email.HTMLBody,
+			&email.Status,
+			&email.Attempts,
+			&email.SendAfter,
+			&email.LastError,
+			&email.SentAt,
+			&email.ClaimedUntil,
+			&email.CreatedAt,
+			&email.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, &email)
+	}
+
+	return emails, rows.Err()
+}
+
+func (r *NotificationRepository) UpdateEmail(ctx context.Context, email *notificationDomain.Email) error {
+	// Only the instance holding the claim may record the outcome; once the
+	// lease ran out the email belongs to whichever instance claimed it next
+	query := `
+		UPDATE notification_emails
+		SET status = $2, attempts = $3, send_after = $4, last_error = NULLIF($5, ''),
+			sent_at = $6, claimed_until = NULL, updated_at = $7
+		WHERE id = $1 AND status = 'sending' AND claimed_until = $8
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		email.ID,
+		email.Status,
+		email.Attempts,
+		email.SendAfter,
+		email.LastError,
+		email.SentAt,
+		email.UpdatedAt,
+		email.ClaimedUntil,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return notificationDomain.ErrEmailClaimLost
+	}
+
+	return nil
+}
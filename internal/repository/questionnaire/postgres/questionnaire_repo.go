@@ -0,0 +1,295 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Questionnaire content lives in questionnaire_versions; join on the version wanted
+const questionnaireColumns = `q.id, q.therapist_id, v.title, v.description, v.version, v.questions,
+			   q.is_active, q.created_at, q.updated_at`
+
+const responseColumns = `r.id, r.questionnaire_id, r.version, r.client_id, r.answers, r.submitted_at`
+
+type QuestionnaireRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewQuestionnaireRepository(db *pgxpool.Pool) *QuestionnaireRepository {
+	return &QuestionnaireRepository{
+		db: db,
+	}
+}
+
+func (r *QuestionnaireRepository) Create(ctx context.Context, questionnaire *questionnaireDomain.Questionnaire) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO questionnaires (id, therapist_id, current_version, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		questionnaire.ID,
+		questionnaire.TherapistID,
+		questionnaire.Version,
+		questionnaire.IsActive,
+		questionnaire.CreatedAt,
+		questionnaire.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return questionnaireDomain.ErrInvalidQuestionnaireData
+		}
+		return err
+	}
+
+	if err := insertVersion(ctx, tx, questionnaire); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *QuestionnaireRepository) GetByID(ctx context.Context, id string) (*questionnaireDomain.Questionnaire, error) {
+	query := `
+		SELECT ` + questionnaireColumns + `
+		FROM questionnaires q
+		JOIN questionnaire_versions v ON v.questionnaire_id = q.id AND v.version = q.current_version
+		WHERE q.id = $1
+	`
+
+	questionnaire, err := scanQuestionnaire(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, questionnaireDomain.ErrQuestionnaireNotFound
+		}
+		return nil, err
+	}
+
+	return questionnaire, nil
+}
+
+func (r *QuestionnaireRepository) GetVersion(ctx context.Context, id string, version int) (*questionnaireDomain.Questionnaire, error) {
+	query := `
+		SELECT ` + questionnaireColumns + `
+		FROM questionnaires q
+		JOIN questionnaire_versions v ON v.questionnaire_id = q.id
+		WHERE q.id = $1 AND v.version = $2
+	`
+
+	questionnaire, err := scanQuestionnaire(r.db.QueryRow(ctx, query, id, version))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, questionnaireDomain.ErrQuestionnaireNotFound
+		}
+		return nil, err
+	}
+
+	return questionnaire, nil
+}
+
+func (r *QuestionnaireRepository) Update(ctx context.Context, questionnaire *questionnaireDomain.Questionnaire) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Versions are immutable; an existing one is left as it is
+	if err := insertVersion(ctx, tx, questionnaire); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE questionnaires
+		SET current_version = $2, is_active = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	result, err := tx.Exec(ctx, query,
+		questionnaire.ID,
+		questionnaire.Version,
+		questionnaire.IsActive,
+		questionnaire.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return questionnaireDomain.ErrQuestionnaireNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *QuestionnaireRepository) GetByTherapistID(ctx context.Context, therapistID string, activeOnly bool) ([]*questionnaireDomain.Questionnaire, error) {
+	query := `
+		SELECT ` + questionnaireColumns + `
+		FROM questionnaires q
+		JOIN questionnaire_versions v ON v.questionnaire_id = q.id AND v.version = q.current_version
+		WHERE q.therapist_id = $1 AND (q.is_active OR NOT $2)
+		ORDER BY q.created_at DESC, q.id
+	`
+
+	rows, err := r.db.Query(ctx, query, therapistID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questionnaires []*questionnaireDomain.Questionnaire
+	for rows.Next() {
+		questionnaire, err := scanQuestionnaire(rows)
+		if err != nil {
+			return nil, err
+		}
+		questionnaires = append(questionnaires, questionnaire)
+	}
+
+	return questionnaires, rows.Err()
+}
+
+func (r *QuestionnaireRepository) CreateResponse(ctx context.Context, response *questionnaireDomain.Response) error {
+	answersJSON, err := json.Marshal(response.Answers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal answers: %w", err)
+	}
+
+	query := `
+		INSERT INTO questionnaire_responses (id, questionnaire_id, version, client_id, answers, submitted_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		response.ID,
+		response.QuestionnaireID,
+		response.Version,
+		response.ClientID,
+		answersJSON,
+		response.SubmittedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return questionnaireDomain.ErrInvalidResponseData
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *QuestionnaireRepository) GetResponses(ctx context.Context, clientID, therapistID string) ([]*questionnaireDomain.AnsweredQuestionnaire, error) {
+	query := `
+		SELECT ` + questionnaireColumns + `, ` + responseColumns + `
+		FROM questionnaire_responses r
+		JOIN questionnaires q ON q.id = r.questionnaire_id
+		JOIN questionnaire_versions v ON v.questionnaire_id = r.questionnaire_id AND v.version = r.version
+		WHERE r.client_id = $1 AND ($2 = '' OR q.therapist_id = NULLIF($2, '')::UUID)
+		ORDER BY r.submitted_at DESC, r.id
+	`
+
+	rows, err := r.db.Query(ctx, query, clientID, therapistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var answered []*questionnaireDomain.AnsweredQuestionnaire
+	for rows.Next() {
+		var response questionnaireDomain.Response
+		var answersJSON []byte
+
+		questionnaire, err := scanQuestionnaire(rows,
+			&response.ID,
+			&response.QuestionnaireID,
+			&response.Version,
+			&response.ClientID,
+			&answersJSON,
+			&response.SubmittedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(answersJSON, &response.Answers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal answers: %w", err)
+		}
+		response.TherapistID = questionnaire.TherapistID
+
+		answered = append(answered, &questionnaireDomain.AnsweredQuestionnaire{
+			Questionnaire: questionnaire,
+			Response:      &response,
+		})
+	}
+
+	return answered, rows.Err()
+}
+
+func insertVersion(ctx context.Context, tx pgx.Tx, questionnaire *questionnaireDomain.Questionnaire) error {
+	questionsJSON, err := json.Marshal(questionnaire.Questions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal questions: %w", err)
+	}
+
+	query := `
+		INSERT INTO questionnaire_versions (questionnaire_id, version, title, description, questions, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (questionnaire_id, version) DO NOTHING
+	`
+
+	_, err = tx.Exec(ctx, query,
+		questionnaire.ID,
+		questionnaire.Version,
+		questionnaire.Title,
+		questionnaire.Description,
+		questionsJSON,
+		questionnaire.UpdatedAt,
+	)
+	return err
+}
+
+// scanQuestionnaire reads the questionnaireColumns, followed by any extra
+// per-query columns into the supplied destinations
+func scanQuestionnaire(row pgx.Row, extra ...interface{}) (*questionnaireDomain.Questionnaire, error) {
+	var questionnaire questionnaireDomain.Questionnaire
+	var questionsJSON []byte
+
+	dest := []interface{}{
+		&questionnaire.ID,
+		&questionnaire.TherapistID,
+		&questionnaire.Title,
+		&questionnaire.Description,
+		&questionnaire.Version,
+		&questionsJSON,
+		&questionnaire.IsActive,
+		&questionnaire.CreatedAt,
+		&questionnaire.UpdatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(questionsJSON, &questionnaire.Questions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal questions: %w", err)
+	}
+
+	return &questionnaire, nil
+}
@@ -0,0 +1,397 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	"github.com/goran/thappy/internal/repository/cursor"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const reviewColumns = `r.id, r.therapist_id, r.client_id, r.rating, r.body, r.status, r.reply, r.replied_at,
+			   (SELECT COUNT(*) FROM therapist_review_reports rr WHERE rr.review_id = r.id AND rr.resolved_at IS NULL),
+			   r.moderated_by, r.moderated_at, r.moderation_reason, r.created_at, r.updated_at`
+
+// Newest first on therapist pages
+var therapistReviewKeyset = cursor.Keyset{
+	Scope: "reviews",
+	Keys: []cursor.Key{
+		{Column: "r.created_at", Cast: "TIMESTAMPTZ", Descending: true},
+		{Column: "r.id", Cast: "UUID", Descending: true},
+	},
+}
+
+// Oldest first so the moderation queue is worked through in order
+var flaggedReviewKeyset = cursor.Keyset{
+	Scope: "reviews:flagged",
+	Keys: []cursor.Key{
+		{Column: "r.created_at", Cast: "TIMESTAMPTZ"},
+		{Column: "r.id", Cast: "UUID"},
+	},
+}
+
+type ReviewRepository struct {
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
+}
+
+func NewReviewRepository(db *pgxpool.Pool, cursors *cursor.Codec) *ReviewRepository {
+	return &ReviewRepository{
+		db:      db,
+		cursors: cursors,
+	}
+}
+
+func (r *ReviewRepository) Create(ctx context.Context, review *reviewDomain.Review) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO therapist_reviews (
+			id, therapist_id, client_id, rating, body, status, reply, replied_at,
+			moderated_by, moderated_at, moderation_reason, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		review.ID,
+		review.TherapistID,
+		review.ClientID,
+		review.Rating,
+		review.Body,
+		review.Status,
+		review.Reply,
+		review.RepliedAt,
+		review.ModeratedBy,
+		review.ModeratedAt,
+		review.ModerationReason,
+		review.CreatedAt,
+		review.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return reviewDomain.ErrReviewAlreadyExists
+			}
+			if pgErr.Code == "23503" {
+				return reviewDomain.ErrInvalidReviewData
+			}
+		}
+		return err
+	}
+
+	if err := refreshRating(ctx, tx, review.TherapistID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ReviewRepository) GetByID(ctx context.Context, id string) (*reviewDomain.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM therapist_reviews r
+		WHERE r.id = $1
+	`
+
+	review, err := scanReview(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, reviewDomain.ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// Update saves the review, resolves its reports once it leaves the moderation queue
+// and refreshes the therapist's rating summary
+func (r *ReviewRepository) Update(ctx context.Context, review *reviewDomain.Review) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE therapist_reviews
+		SET rating = $2, body = $3, status = $4, reply = $5, replied_at = $6,
+			moderated_by = $7, moderated_at = $8, moderation_reason = $9, updated_at = $10
+		WHERE id = $1
+	`
+
+	result, err := tx.Exec(ctx, query,
+		review.ID,
+		review.Rating,
+		review.Body,
+		review.Status,
+		review.Reply,
+		review.RepliedAt,
+		review.ModeratedBy,
+		review.ModeratedAt,
+		review.ModerationReason,
+		review.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return reviewDomain.ErrReviewNotFound
+	}
+
+	if review.Status != reviewDomain.StatusFlagged {
+		_, err = tx.Exec(ctx, `
+			UPDATE therapist_review_reports
+			SET resolved_at = $2
+			WHERE review_id = $1 AND resolved_at IS NULL
+		`, review.ID, review.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := refreshRating(ctx, tx, review.TherapistID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ReviewRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var therapistID string
+	err = tx.QueryRow(ctx, `DELETE FROM therapist_reviews WHERE id = $1 RETURNING therapist_id`, id).Scan(&therapistID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return reviewDomain.ErrReviewNotFound
+		}
+		return err
+	}
+
+	if err := refreshRating(ctx, tx, therapistID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ReviewRepository) GetVisibleByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*reviewDomain.Review], error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM therapist_reviews r
+		WHERE r.therapist_id = $1 AND r.status <> 'hidden'
+	`
+
+	return r.list(ctx, therapistReviewKeyset, query, []interface{}{therapistID}, page)
+}
+
+func (r *ReviewRepository) GetByClientID(ctx context.Context, clientID string) ([]*reviewDomain.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM therapist_reviews r
+		WHERE r.client_id = $1
+		ORDER BY r.created_at DESC
+	`
+
+	return r.scanReviews(ctx, query, clientID)
+}
+
+func (r *ReviewRepository) GetFlagged(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*reviewDomain.Review], error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM therapist_reviews r
+		WHERE r.status = 'flagged'
+	`
+
+	return r.list(ctx, flaggedReviewKeyset, query, nil, page)
+}
+
+func (r *ReviewRepository) AddReport(ctx context.Context, report *reviewDomain.Report) error {
+	query := `
+		INSERT INTO therapist_review_reports (id, review_id, reporter_id, reason, resolved_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		report.ID,
+		report.ReviewID,
+		report.ReporterID,
+		report.Reason,
+		report.ResolvedAt,
+		report.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return reviewDomain.ErrAlreadyReported
+			}
+			if pgErr.Code == "23503" {
+				return reviewDomain.ErrReviewNotFound
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) GetOpenReports(ctx context.Context, reviewID string) ([]*reviewDomain.Report, error) {
+	query := `
+		SELECT id, review_id, reporter_id, reason, resolved_at, created_at
+		FROM therapist_review_reports
+		WHERE review_id = $1 AND resolved_at IS NULL
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*reviewDomain.Report
+	for rows.Next() {
+		var report reviewDomain.Report
+		err := rows.Scan(
+			&report.ID,
+			&report.ReviewID,
+			&report.ReporterID,
+			&report.Reason,
+			&report.ResolvedAt,
+			&report.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &report)
+	}
+
+	return reports, rows.Err()
+}
+
+func (r *ReviewRepository) HasRelationship(ctx context.Context, clientID, therapistID string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM client_therapist_relationships
+			WHERE client_id = $1 AND therapist_id = $2
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, clientID, therapistID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// list runs a review query that already has a WHERE clause, one page at a time
+func (r *ReviewRepository) list(ctx context.Context, keyset cursor.Keyset, query string, args []interface{}, page pagination.PageRequest) (*pagination.Page[*reviewDomain.Review], error) {
+	page = page.Normalize()
+
+	after, cursorArgs, err := r.cursors.Where(keyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += keyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	reviews, err := r.scanReviews(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, keyset, reviews, page.Limit, func(review *reviewDomain.Review) []string {
+		return []string{cursor.Time(review.CreatedAt), review.ID}
+	}), nil
+}
+
+func (r *ReviewRepository) scanReviews(ctx context.Context, query string, args ...interface{}) ([]*reviewDomain.Review, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []*reviewDomain.Review
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+// refreshRating recomputes the rating summary on the therapist profile from its visible reviews
+func refreshRating(ctx context.Context, tx pgx.Tx, therapistID string) error {
+	query := `
+		UPDATE therapist_profiles tp
+		SET rating_count = summary.count, rating_average = summary.average
+		FROM (
+			SELECT COUNT(*) AS count, ROUND(AVG(rating), 2) AS average
+			FROM therapist_reviews
+			WHERE therapist_id = $1 AND status <> 'hidden'
+		) summary
+		WHERE tp.user_id = $1
+	`
+
+	_, err := tx.Exec(ctx, query, therapistID)
+	return err
+}
+
+func scanReview(row pgx.Row) (*reviewDomain.Review, error) {
+	var review reviewDomain.Review
+	var openReports int64
+
+	err := row.Scan(
+		&review.ID,
+		&review.TherapistID,
+		&review.ClientID,
+		&review.Rating,
+		&review.Body,
+		&review.Status,
+		&review.Reply,
+		&review.RepliedAt,
+		&openReports,
+		&review.ModeratedBy,
+		&review.ModeratedAt,
+		&review.ModerationReason,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	review.OpenReports = int(openReports)
+	return &review, nil
+}
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const alertColumns = `id, client_id, COALESCE(therapist_id::TEXT, ''), source, source_id, severity, signals, status,
+			   escalation_due_at, escalated_at, COALESCE(acknowledged_by::TEXT, ''), acknowledged_at, note,
+			   created_at, updated_at`
+
+type SafetyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSafetyRepository(db *pgxpool.Pool) *SafetyRepository {
+	return &SafetyRepository{
+		db: db,
+	}
+}
+
+func (r *SafetyRepository) Create(ctx context.Context, alert *safetyDomain.Alert) error {
+	signalsJSON, err := json.Marshal(alert.Signals)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signals: %w", err)
+	}
+
+	query := `
+		INSERT INTO safety_alerts (id, client_id, therapist_id, source, source_id, severity, signals, status,
+								   escalation_due_at, escalated_at, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, '')::UUID, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		alert.ID,
+		alert.ClientID,
+		alert.TherapistID,
+		alert.Source,
+		alert.SourceID,
+		alert.Severity,
+		signalsJSON,
+		alert.Status,
+		alert.EscalationDueAt,
+		alert.EscalatedAt,
+		alert.CreatedAt,
+		alert.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return safetyDomain.ErrInvalidAlertData
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *SafetyRepository) GetByID(ctx context.Context, id string) (*safetyDomain.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM safety_alerts
+		WHERE id = $1
+	`
+
+	alert, err := scanAlert(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, safetyDomain.ErrAlertNotFound
+		}
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+func (r *SafetyRepository) Update(ctx context.Context, alert *safetyDomain.Alert) error {
+	query := `
+		UPDATE safety_alerts
+		SET status = $2, escalated_at = $3, acknowledged_by = NULLIF($4, '')::UUID, acknowledged_at = $5,
+			note = $6, updated_at = $7
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		alert.ID,
+		alert.Status,
+		alert.EscalatedAt,
+		alert.AcknowledgedBy,
+		alert.AcknowledgedAt,
+		alert.Note,
+		alert.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return safetyDomain.ErrAlertNotFound
+	}
+
+	return nil
+}
+
+func (r *SafetyRepository) HasOpenForSource(ctx context.Context, source safetyDomain.Source, sourceID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM safety_alerts WHERE source = $1 AND source_id = $2 AND status = 'open')`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, source, sourceID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *SafetyRepository) GetByTherapistID(ctx context.Context, therapistID string, openOnly bool) ([]*safetyDomain.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM safety_alerts
+		WHERE therapist_id = $1 AND (NOT $2 OR status = 'open')
+		ORDER BY created_at DESC, id
+	`
+
+	return r.query(ctx, query, therapistID, openOnly)
+}
+
+func (r *SafetyRepository) GetEscalated(ctx context.Context, openOnly bool) ([]*safetyDomain.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM safety_alerts
+		WHERE escalated_at IS NOT NULL AND (NOT $1 OR status = 'open')
+		ORDER BY escalated_at DESC, id
+	`
+
+	return r.query(ctx, query, openOnly)
+}
+
+func (r *SafetyRepository) EscalateOverdue(ctx context.Context, now time.Time) ([]*safetyDomain.Alert, error) {
+	query := `
+		UPDATE safety_alerts
+		SET escalated_at = $1, updated_at = $1
+		WHERE status = 'open' AND escalated_at IS NULL AND escalation_due_at <= $1
+		RETURNING ` + alertColumns
+
+	return r.query(ctx, query, now)
+}
+
+func (r *SafetyRepository) query(ctx context.Context, query string, args ...any) ([]*safetyDomain.Alert, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []*safetyDomain.Alert
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, rows.Err()
+}
+
+func scanAlert(row pgx.Row) (*safetyDomain.Alert, error) {
+	var alert safetyDomain.Alert
+	var signalsJSON []byte
+
+	err := row.Scan(
+		&alert.ID,
+		&alert.ClientID,
+		&alert.TherapistID,
+		&alert.Source,
+		&alert.SourceID,
+		&alert.Severity,
+		&signalsJSON,
+		&alert.Status,
+		&alert.EscalationDueAt,
+		&alert.EscalatedAt,
+		&alert.AcknowledgedBy,
+		&alert.AcknowledgedAt,
+		&alert.Note,
+		&alert.CreatedAt,
+		&alert.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(signalsJSON, &alert.Signals); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signals: %w", err)
+	}
+
+	return &alert, nil
+}
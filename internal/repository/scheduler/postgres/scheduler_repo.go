@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SchedulerRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSchedulerRepository(db *pgxpool.Pool) *SchedulerRepository {
+	return &SchedulerRepository{
+		db: db,
+	}
+}
+
+func (r *SchedulerRepository) ReplaceJobs(ctx context.Context, key string, jobs []*schedulerDomain.Job, now time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = 'cancelled', updated_at = $2
+		WHERE key = $1 AND status IN ('pending', 'running')
+	`, key, now)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO scheduled_jobs (id, kind, key, payload, run_at, status, attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	for _, job := range jobs {
+		_, err := tx.Exec(ctx, query,
+			job.ID,
+			job.Kind,
+			job.Key,
+			job.Payload,
+			job.RunAt,
+			job.Status,
+			job.Attempts,
+			job.CreatedAt,
+			job.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *SchedulerRepository) CancelJobs(ctx context.Context, key string, now time.Time) (int64, error) {
+	result, err := r.db.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = 'cancelled', updated_at = $2
+		WHERE key = $1 AND status IN ('pending', 'running')
+	`, key, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *SchedulerRepository) AddRecurringJob(ctx context.Context, job *schedulerDomain.Job) error {
+	// Recurring keys are unique among unfinished jobs, so an instance that
+	// comes second adds nothing
+	query := `
+		INSERT INTO scheduled_jobs (id, kind, key, payload, run_at, status, attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		job.ID,
+		job.Kind,
+		job.Key,
+		job.Payload,
+		job.RunAt,
+		job.Status,
+		job.Attempts,
+		job.CreatedAt,
+		job.UpdatedAt,
+	)
+	return err
+}
+
+func (r *SchedulerRepository) ClaimDueJobs(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*schedulerDomain.Job, error) {
+	// SKIP LOCKED lets every API instance claim a different batch at once.
+	// Jobs still marked as running after their lease belong to an instance
+	// that stopped mid-run and are claimed again.
+	query := `
+		UPDATE scheduled_jobs
+		SET status = 'running', claimed_until = $2, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM scheduled_jobs
+			WHERE (status = 'pending' AND run_at <= $1)
+			   OR (status = 'running' AND claimed_until <= $1)
+			ORDER BY run_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, kind, key, payload, run_at, status, attempts, COALESCE(last_error, ''), claimed_until, created_at, updated_at
+	`
+
+	rows, err := r.db.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*schedulerDomain.Job
+	for rows.Next() {
+		var job schedulerDomain.Job
+		err := rows.Scan(
+			&job.ID,
+			&job.Kind,
+			&job.Key,
+			&job.Payload,
+			&job.RunAt,
+			&job.Status,
+			&job.Attempts,
+			&job.LastError,
+			&job.ClaimedUntil,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, rows.Err()
+}
+
+func (r *SchedulerRepository) UpdateJob(ctx context.Context, job *schedulerDomain.Job) error {
+	// Only the runner holding the claim may record the outcome. A job
+	// cancelled while it ran stays cancelled, and one whose lease ran out
+	// belongs to whichever instance claimed it next.
+	query := `
+		UPDATE scheduled_jobs
+		SET status = $2, attempts = $3, run_at = $4, last_error = NULLIF($5, ''),
+			claimed_until = NULL, updated_at = $6
+		WHERE id = $1 AND status = 'running' AND claimed_until = $7
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		job.ID,
+		job.Status,
+		job.Attempts,
+		job.RunAt,
+		job.LastError,
+		job.UpdatedAt,
+		job.ClaimedUntil,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return schedulerDomain.ErrJobClaimLost
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goran/thappy/internal/domain/encryption"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const noteColumns = `id, client_id, therapist_id, template, session_date, key_id, wrapped_key, nonce, ciphertext,
+			   shared_with_client, shared_at, created_at`
+
+// SessionNoteRepository keeps note content encrypted with envelope encryption:
+// every note and amendment has its own data key, wrapped by the key provider
+type SessionNoteRepository struct {
+	db   *pgxpool.Pool
+	keys encryption.KeyProvider
+}
+
+// amendmentPayload is the encrypted part of an amendment
+type amendmentPayload struct {
+	Reason  string `json:"reason"`
+	Content string `json:"content"`
+}
+
+func NewSessionNoteRepository(db *pgxpool.Pool, keys encryption.KeyProvider) *SessionNoteRepository {
+	return &SessionNoteRepository{
+		db:   db,
+		keys: keys,
+	}
+}
+
+func (r *SessionNoteRepository) Create(ctx context.Context, note *sessionNoteDomain.Note) error {
+	envelope, err := r.seal(ctx, note.Sections, noteAssociatedData(note.ID, note.ClientID, note.TherapistID))
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO session_notes (
+			id, client_id, therapist_id, template, session_date, key_id, wrapped_key, nonce, ciphertext,
+			shared_with_client, shared_at, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		note.ID,
+		note.ClientID,
+		note.TherapistID,
+		note.Template,
+		note.SessionDate,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		note.SharedWithClient,
+		note.SharedAt,
+		note.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return sessionNoteDomain.ErrInvalidNoteData
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *SessionNoteRepository) GetByID(ctx context.Context, id string) (*sessionNoteDomain.Note, error) {
+	query := `
+		SELECT ` + noteColumns + `
+		FROM session_notes
+		WHERE id = $1
+	`
+
+	note, err := r.scanNote(ctx, r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sessionNoteDomain.ErrNoteNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadAmendments(ctx, []*sessionNoteDomain.Note{note}); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (r *SessionNoteRepository) AddAmendment(ctx context.Context, amendment *sessionNoteDomain.Amendment) error {
+	payload := amendmentPayload{Reason: amendment.Reason, Content: amendment.Content}
+	envelope, err := r.seal(ctx, payload, amendmentAssociatedData(amendment.ID, amendment.NoteID))
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO session_note_amendments (
+			id, note_id, therapist_id, key_id, wrapped_key, nonce, ciphertext, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		amendment.ID,
+		amendment.NoteID,
+		amendment.TherapistID,
+		envelope.KeyID,
+		envelope.WrappedKey,
+		envelope.Nonce,
+		envelope.Ciphertext,
+		amendment.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return sessionNoteDomain.ErrNoteNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *SessionNoteRepository) UpdateSharing(ctx context.Context, note *sessionNoteDomain.Note) error {
+	query := `
+		UPDATE session_notes
+		SET shared_with_client = $2, shared_at = $3
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, note.ID, note.SharedWithClient, note.SharedAt)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sessionNoteDomain.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+func (r *SessionNoteRepository) GetByClientID(ctx context.Context, clientID, therapistID string, sharedOnly bool) ([]*sessionNoteDomain.Note, error) {
+	query := `
+		SELECT ` + noteColumns + `
+		FROM session_notes
+		WHERE client_id = $1
+		  AND ($2 = '' OR therapist_id = NULLIF($2, '')::UUID)
+		  AND (NOT $3 OR shared_with_client)
+		ORDER BY session_date DESC, created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, clientID, therapistID, sharedOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []*sessionNoteDomain.Note
+	for rows.Next() {
+		note, err := r.scanNote(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadAmendments(ctx, notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func (r *SessionNoteRepository) loadAmendments(ctx context.Context, notes []*sessionNoteDomain.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*sessionNoteDomain.Note, len(notes))
+	noteIDs := make([]string, len(notes))
+	for i, note := range notes {
+		byID[note.ID] = note
+		noteIDs[i] = note.ID
+	}
+
+	query := `
+		SELECT id, note_id, therapist_id, key_id, wrapped_key, nonce, ciphertext, created_at
+		FROM session_note_amendments
+		WHERE note_id = ANY($1::UUID[])
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, noteIDs)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var amendment sessionNoteDomain.Amendment
+		var envelope encryption.Envelope
+
+		err := rows.Scan(
+			&amendment.ID,
+			&amendment.NoteID,
+			&amendment.TherapistID,
+			&envelope.KeyID,
+			&envelope.WrappedKey,
+			&envelope.Nonce,
+			&envelope.Ciphertext,
+			&amendment.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		var payload amendmentPayload
+		if err := r.open(ctx, &envelope, amendmentAssociatedData(amendment.ID, amendment.NoteID), &payload); err != nil {
+			return err
+		}
+		amendment.Reason = payload.Reason
+		amendment.Content = payload.Content
+
+		if note, ok := byID[amendment.NoteID]; ok {
+			note.Amendments = append(note.Amendments, &amendment)
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *SessionNoteRepository) scanNote(ctx context.Context, row pgx.Row) (*sessionNoteDomain.Note, error) {
+	var note sessionNoteDomain.Note
+	var envelope encryption.Envelope
+
+	err := row.Scan(
+		&note.ID,
+		&note.ClientID,
+		&note.TherapistID,
+		&note.Template,
+		&note.SessionDate,
+		&envelope.KeyID,
+		&envelope.WrappedKey,
+		&envelope.Nonce,
+		&envelope.Ciphertext,
+		&note.SharedWithClient,
+		&note.SharedAt,
+		&note.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.open(ctx, &envelope, noteAssociatedData(note.ID, note.ClientID, note.TherapistID), &note.Sections); err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+func (r *SessionNoteRepository) seal(ctx context.Context, content interface{}, associatedData []byte) (*encryption.Envelope, error) {
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal note content: %w", err)
+	}
+	defer clear(plaintext)
+
+	envelope, err := encryption.Seal(ctx, r.keys, plaintext, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt note content: %w", err)
+	}
+
+	return envelope, nil
+}
+
+func (r *SessionNoteRepository) open(ctx context.Context, envelope *encryption.Envelope, associatedData []byte, content interface{}) error {
+	plaintext, err := encryption.Open(ctx, r.keys, envelope, associatedData)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt note content: %w", err)
+	}
+	defer clear(plaintext)
+
+	if err := json.Unmarshal(plaintext, content); err != nil {
+		return fmt.Errorf("failed to unmarshal note content: %w", err)
+	}
+
+	return nil
+}
+
+// The associated data ties ciphertext to its row, so content copied onto another
+// note, client or therapist fails to decrypt. IDs are normalized because UUID
+// columns read back with hyphens.
+func noteAssociatedData(noteID, clientID, therapistID string) []byte {
+	return []byte("session_note:" + normalizeID(noteID) + ":" + normalizeID(clientID) + ":" + normalizeID(therapistID))
+}
+
+func amendmentAssociatedData(amendmentID, noteID string) []byte {
+	return []byte("session_note_amendment:" + normalizeID(amendmentID) + ":" + normalizeID(noteID))
+}
+
+func normalizeID(id string) string {
+	return strings.ReplaceAll(strings.ToLower(id), "-", "")
+}
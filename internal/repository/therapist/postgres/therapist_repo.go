@@ -5,21 +5,42 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	"github.com/goran/thappy/internal/repository/cursor"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const therapistProfileColumns = `tp.user_id, tp.first_name, tp.last_name, tp.slug, tp.license_number, tp.specializations, tp.languages,
+			   tp.phone, tp.bio, tp.is_accepting_clients, tp.offers_in_person, tp.offers_remote, tp.verification_status, tp.license_jurisdiction,
+			   tp.license_type, tp.license_expires_at, tp.verification_submitted_at, tp.verification_reviewed_at,
+			   tp.verification_reviewed_by, tp.verification_rejection_reason, tp.rating_average::DOUBLE PRECISION,
+			   tp.rating_count, tp.photo_key, tp.photo_uploaded_at, tp.created_at, tp.updated_at`
+
+// Only therapists with a reviewed, unexpired license appear in public listings
+const publiclyListedCondition = `tp.verification_status = 'verified' AND tp.license_expires_at > CURRENT_DATE`
+
+// Length of one degree of latitude, used to bound location searches before computing exact distances
+const kmPerDegreeLatitude = 111.045
+
+// ts_headline options for search snippets: a couple of short bio fragments with matches wrapped in <mark>
+const searchSnippetOptions = `StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "`
+
 type TherapistRepository struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
 }
 
-func NewTherapistRepository(db *pgxpool.Pool) *TherapistRepository {
+func NewTherapistRepository(db *pgxpool.Pool, cursors *cursor.Codec) *TherapistRepository {
 	return &TherapistRepository{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
@@ -29,23 +50,49 @@ func (r *TherapistRepository) Create(ctx context.Context, profile *therapistDoma
 		return fmt.Errorf("failed to marshal specializations: %w", err)
 	}
 
+	languagesJSON, err := json.Marshal(profile.Languages)
+	if err != nil {
+		return fmt.Errorf("failed to marshal languages: %w", err)
+	}
+
 	query := `
 		INSERT INTO therapist_profiles (
-			user_id, first_name, last_name, license_number, specializations,
-			phone, bio, is_accepting_clients, created_at, updated_at
+			user_id, first_name, last_name, license_number, specializations, languages,
+			phone, bio, is_accepting_clients, offers_in_person, offers_remote, verification_status,
+			license_jurisdiction, license_type, license_expires_at, verification_submitted_at,
+			verification_reviewed_at, verification_reviewed_by, verification_rejection_reason,
+			slug, created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 	`
 
-	_, err = r.db.Exec(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, query,
 		profile.UserID,
 		profile.FirstName,
 		profile.LastName,
 		profile.LicenseNumber,
 		specializationsJSON,
+		languagesJSON,
 		profile.Phone,
 		profile.Bio,
 		profile.IsAcceptingClients,
+		profile.OffersInPerson,
+		profile.OffersRemote,
+		profile.Verification.Status,
+		profile.Verification.Jurisdiction,
+		profile.Verification.LicenseType,
+		profile.Verification.LicenseExpiresAt,
+		profile.Verification.SubmittedAt,
+		profile.Verification.ReviewedAt,
+		profile.Verification.ReviewedBy,
+		profile.Verification.RejectionReason,
+		profile.Slug,
 		profile.CreatedAt,
 		profile.UpdatedAt,
 	)
@@ -57,6 +104,9 @@ func (r *TherapistRepository) Create(ctx context.Context, profile *therapistDoma
 				if strings.Contains(pgErr.Detail, "license_number") {
 					return therapistDomain.ErrLicenseNumberAlreadyExists
 				}
+				if strings.Contains(pgErr.Detail, "slug") {
+					return therapistDomain.ErrSlugAlreadyExists
+				}
 				return therapistDomain.ErrTherapistProfileAlreadyExists
 			}
 			if pgErr.Code == "23503" {
@@ -66,33 +116,21 @@ func (r *TherapistRepository) Create(ctx context.Context, profile *therapistDoma
 		return err
 	}
 
-	return nil
+	if err := syncTherapies(ctx, tx, profile.UserID, profile.Specializations); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *TherapistRepository) GetByUserID(ctx context.Context, userID string) (*therapistDomain.TherapistProfile, error) {
 	query := `
-		SELECT user_id, first_name, last_name, license_number, specializations,
-			   phone, bio, is_accepting_clients, created_at, updated_at
-		FROM therapist_profiles
-		WHERE user_id = $1
+		SELECT ` + therapistProfileColumns + `
+		FROM therapist_profiles tp
+		WHERE tp.user_id = $1
 	`
 
-	var profile therapistDomain.TherapistProfile
-	var specializationsJSON []byte
-
-	err := r.db.QueryRow(ctx, query, userID).Scan(
-		&profile.UserID,
-		&profile.FirstName,
-		&profile.LastName,
-		&profile.LicenseNumber,
-		&specializationsJSON,
-		&profile.Phone,
-		&profile.Bio,
-		&profile.IsAcceptingClients,
-		&profile.CreatedAt,
-		&profile.UpdatedAt,
-	)
-
+	profile, err := scanTherapistProfile(r.db.QueryRow(ctx, query, userID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, therapistDomain.ErrTherapistProfileNotFound
@@ -100,38 +138,38 @@ func (r *TherapistRepository) GetByUserID(ctx context.Context, userID string) (*
 		return nil, err
 	}
 
-	err = json.Unmarshal(specializationsJSON, &profile.Specializations)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal specializations: %w", err)
-	}
-
-	return &profile, nil
+	return profile, nil
 }
 
 func (r *TherapistRepository) GetByLicenseNumber(ctx context.Context, licenseNumber string) (*therapistDomain.TherapistProfile, error) {
 	query := `
-		SELECT user_id, first_name, last_name, license_number, specializations,
-			   phone, bio, is_accepting_clients, created_at, updated_at
-		FROM therapist_profiles
-		WHERE license_number = $1
+		SELECT ` + therapistProfileColumns + `
+		FROM therapist_profiles tp
+		WHERE tp.license_number = $1
 	`
 
-	var profile therapistDomain.TherapistProfile
-	var specializationsJSON []byte
+	profile, err := scanTherapistProfile(r.db.QueryRow(ctx, query, licenseNumber))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, therapistDomain.ErrTherapistProfileNotFound
+		}
+		return nil, err
+	}
 
-	err := r.db.QueryRow(ctx, query, licenseNumber).Scan(
-		&profile.UserID,
-		&profile.FirstName,
-		&profile.LastName,
-		&profile.LicenseNumber,
-		&specializationsJSON,
-		&profile.Phone,
-		&profile.Bio,
-		&profile.IsAcceptingClients,
-		&profile.CreatedAt,
-		&profile.UpdatedAt,
-	)
+	return profile, nil
+}
 
+// GetBySlug resolves retired slugs through therapist_slug_history, which the
+// database fills whenever a profile's slug changes
+func (r *TherapistRepository) GetBySlug(ctx context.Context, slug string) (*therapistDomain.TherapistProfile, error) {
+	query := `
+		SELECT ` + therapistProfileColumns + `
+		FROM therapist_profiles tp
+		WHERE tp.slug = $1
+		   OR tp.user_id = (SELECT therapist_id FROM therapist_slug_history WHERE slug = $1)
+	`
+
+	profile, err := scanTherapistProfile(r.db.QueryRow(ctx, query, slug))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, therapistDomain.ErrTherapistProfileNotFound
@@ -139,12 +177,34 @@ func (r *TherapistRepository) GetByLicenseNumber(ctx context.Context, licenseNum
 		return nil, err
 	}
 
-	err = json.Unmarshal(specializationsJSON, &profile.Specializations)
+	return profile, nil
+}
+
+func (r *TherapistRepository) GetTakenSlugs(ctx context.Context, base, exceptTherapistID string) ([]string, error) {
+	query := `
+		SELECT slug FROM therapist_profiles
+		WHERE (slug = $1 OR slug LIKE $1 || '-%') AND user_id <> $2
+		UNION
+		SELECT slug FROM therapist_slug_history
+		WHERE (slug = $1 OR slug LIKE $1 || '-%') AND therapist_id <> $2
+	`
+
+	rows, err := r.db.Query(ctx, query, base, exceptTherapistID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal specializations: %w", err)
+		return nil, err
 	}
+	defer rows.Close()
 
-	return &profile, nil
+	var slugs []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		slugs = append(slugs, slug)
+	}
+
+	return slugs, rows.Err()
 }
 
 func (r *TherapistRepository) Update(ctx context.Context, profile *therapistDomain.TherapistProfile) error {
@@ -153,22 +213,58 @@ func (r *TherapistRepository) Update(ctx context.Context, profile *therapistDoma
 		return fmt.Errorf("failed to marshal specializations: %w", err)
 	}
 
+	languagesJSON, err := json.Marshal(profile.Languages)
+	if err != nil {
+		return fmt.Errorf("failed to marshal languages: %w", err)
+	}
+
 	query := `
 		UPDATE therapist_profiles
-		SET first_name = $2, last_name = $3, license_number = $4, specializations = $5,
-			phone = $6, bio = $7, is_accepting_clients = $8, updated_at = $9
+		SET first_name = $2, last_name = $3, license_number = $4, specializations = $5, languages = $6,
+			phone = $7, bio = $8, is_accepting_clients = $9, offers_in_person = $10, offers_remote = $11,
+			verification_status = $12, license_jurisdiction = $13, license_type = $14,
+			license_expires_at = $15, verification_submitted_at = $16, verification_reviewed_at = $17,
+			verification_reviewed_by = $18, verification_rejection_reason = $19, photo_key = $20,
+			photo_uploaded_at = $21, slug = $22, updated_at = $23
 		WHERE user_id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query,
+	var photoKey *string
+	var photoUploadedAt *time.Time
+	if profile.Photo != nil {
+		photoKey = &profile.Photo.Key
+		photoUploadedAt = &profile.Photo.UploadedAt
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query,
 		profile.UserID,
 		profile.FirstName,
 		profile.LastName,
 		profile.LicenseNumber,
 		specializationsJSON,
+		languagesJSON,
 		profile.Phone,
 		profile.Bio,
 		profile.IsAcceptingClients,
+		profile.OffersInPerson,
+		profile.OffersRemote,
+		profile.Verification.Status,
+		profile.Verification.Jurisdiction,
+		profile.Verification.LicenseType,
+		profile.Verification.LicenseExpiresAt,
+		profile.Verification.SubmittedAt,
+		profile.Verification.ReviewedAt,
+		profile.Verification.ReviewedBy,
+		profile.Verification.RejectionReason,
+		photoKey,
+		photoUploadedAt,
+		profile.Slug,
 		profile.UpdatedAt,
 	)
 
@@ -178,6 +274,9 @@ func (r *TherapistRepository) Update(ctx context.Context, profile *therapistDoma
 			if pgErr.Code == "23505" && strings.Contains(pgErr.Detail, "license_number") {
 				return therapistDomain.ErrLicenseNumberAlreadyExists
 			}
+			if pgErr.Code == "23505" && strings.Contains(pgErr.Detail, "slug") {
+				return therapistDomain.ErrSlugAlreadyExists
+			}
 			if pgErr.Code == "23503" {
 				return therapistDomain.ErrInvalidTherapistData
 			}
@@ -189,6 +288,41 @@ func (r *TherapistRepository) Update(ctx context.Context, profile *therapistDoma
 		return therapistDomain.ErrTherapistProfileNotFound
 	}
 
+	if err := syncTherapies(ctx, tx, profile.UserID, profile.Specializations); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// syncTherapies makes the therapist_therapies links match the profile's specializations.
+// The specializations column keeps a copy of the same therapy IDs for full-text search and facets.
+func syncTherapies(ctx context.Context, tx pgx.Tx, therapistID string, therapyIDs []string) error {
+	if therapyIDs == nil {
+		therapyIDs = []string{}
+	}
+
+	_, err := tx.Exec(ctx, `
+		DELETE FROM therapist_therapies
+		WHERE therapist_id = $1 AND NOT (therapy_id = ANY($2::TEXT[]))
+	`, therapistID, therapyIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO therapist_therapies (therapist_id, therapy_id)
+		SELECT $1, therapy_id FROM UNNEST($2::TEXT[]) AS therapy_id
+		ON CONFLICT DO NOTHING
+	`, therapistID, therapyIDs)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return therapistDomain.ErrTherapyNotInCatalog
+		}
+		return err
+	}
+
 	return nil
 }
 
@@ -207,49 +341,122 @@ func (r *TherapistRepository) Delete(ctx context.Context, userID string) error {
 	return nil
 }
 
-func (r *TherapistRepository) GetAcceptingClients(ctx context.Context) ([]*therapistDomain.TherapistProfile, error) {
+// Public lists are ordered by name, then user_id so that therapists who share a name keep a stable order between pages
+var (
+	acceptingKeyset      = nameKeyset("therapists:accepting")
+	specializationKeyset = nameKeyset("therapists:specialization")
+)
+
+func nameKeyset(scope string) cursor.Keyset {
+	keyset := cursor.Keyset{Scope: scope, Keys: make([]cursor.Key, len(nameSortKeys))}
+	for i, key := range nameSortKeys {
+		keyset.Keys[i] = key.key
+	}
+	return keyset
+}
+
+func (r *TherapistRepository) GetAcceptingClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
 	query := `
-		SELECT tp.user_id, tp.first_name, tp.last_name, tp.license_number, tp.specializations,
-			   tp.phone, tp.bio, tp.is_accepting_clients, tp.created_at, tp.updated_at
+		SELECT ` + therapistProfileColumns + `
 		FROM therapist_profiles tp
 		INNER JOIN users u ON tp.user_id = u.id
-		WHERE tp.is_accepting_clients = true AND u.is_active = true
-		ORDER BY tp.first_name, tp.last_name
-	`
+		WHERE tp.is_accepting_clients = true AND u.is_active = true AND ` + publiclyListedCondition
 
-	return r.scanTherapistProfiles(ctx, query)
+	return r.listByName(ctx, acceptingKeyset, query, nil, page)
 }
 
-func (r *TherapistRepository) GetBySpecialization(ctx context.Context, specialization string) ([]*therapistDomain.TherapistProfile, error) {
+func (r *TherapistRepository) GetBySpecialization(ctx context.Context, specialization string, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
 	query := `
-		SELECT tp.user_id, tp.first_name, tp.last_name, tp.license_number, tp.specializations,
-			   tp.phone, tp.bio, tp.is_accepting_clients, tp.created_at, tp.updated_at
+		SELECT ` + therapistProfileColumns + `
 		FROM therapist_profiles tp
 		INNER JOIN users u ON tp.user_id = u.id
-		WHERE tp.specializations @> $1 AND u.is_active = true
-		ORDER BY tp.first_name, tp.last_name
-	`
+		WHERE tp.specializations @> $1 AND u.is_active = true AND ` + publiclyListedCondition
 
 	specializationJSON, err := json.Marshal([]string{specialization})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal specialization: %w", err)
 	}
 
-	return r.scanTherapistProfiles(ctx, query, specializationJSON)
+	return r.listByName(ctx, specializationKeyset, query, []interface{}{specializationJSON}, page)
+}
+
+// listByName runs a profile query, which must end in its WHERE clause, one page at a time in name order
+func (r *TherapistRepository) listByName(ctx context.Context, keyset cursor.Keyset, query string, args []interface{}, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
+	page = page.Normalize()
+
+	after, cursorArgs, err := r.cursors.Where(keyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		query += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query += keyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	profiles, err := r.scanTherapistProfiles(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, keyset, profiles, page.Limit, func(profile *therapistDomain.TherapistProfile) []string {
+		return []string{profile.FirstName, profile.LastName, profile.UserID}
+	}), nil
 }
 
-func (r *TherapistRepository) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) ([]*therapistDomain.TherapistProfile, error) {
+// therapistSearchQuery holds the FROM/WHERE shared by the result page and facet queries of one search
+type therapistSearchQuery struct {
+	columns  string
+	from     string
+	where    string
+	args     []interface{}
+	near     bool
+	fullText bool
+}
+
+func buildTherapistSearchQuery(filters therapistDomain.TherapistSearchFilters) (*therapistSearchQuery, error) {
 	var queryParts []string
 	var args []interface{}
 	argIndex := 1
 
-	baseQuery := `
-		SELECT tp.user_id, tp.first_name, tp.last_name, tp.license_number, tp.specializations,
-			   tp.phone, tp.bio, tp.is_accepting_clients, tp.created_at, tp.updated_at
-		FROM therapist_profiles tp
-		INNER JOIN users u ON tp.user_id = u.id
-		WHERE u.is_active = true
-	`
+	// Distance to the closest office is only computed for location-anchored searches
+	distanceColumn := "NULL::DOUBLE PRECISION"
+	var locationJoin string
+	if filters.Near != nil {
+		distanceColumn = "nearest.distance_km"
+		// The latitude band lets the coordinates index discard far-away offices before haversine runs
+		locationJoin = fmt.Sprintf(`
+		INNER JOIN LATERAL (
+			SELECT MIN(haversine_km($%d, $%d, pl.latitude, pl.longitude)) AS distance_km
+			FROM therapist_practice_locations pl
+			WHERE pl.therapist_id = tp.user_id AND pl.latitude BETWEEN $%d AND $%d
+		) nearest ON true`, argIndex, argIndex+1, argIndex+2, argIndex+3)
+
+		latitudeDelta := filters.RadiusKm / kmPerDegreeLatitude
+		args = append(args, filters.Near.Latitude, filters.Near.Longitude, filters.Near.Latitude-latitudeDelta, filters.Near.Latitude+latitudeDelta)
+		argIndex += 4
+
+		queryParts = append(queryParts, "tp.offers_in_person = true", fmt.Sprintf("nearest.distance_km <= $%d", argIndex))
+		args = append(args, filters.RadiusKm)
+		argIndex++
+	}
+
+	// Free text is matched against the generated search_vector; rank and snippet are only computed when it is present
+	rankColumn := "NULL::DOUBLE PRECISION"
+	snippetColumn := "''"
+	var searchJoin string
+	if terms := therapistDomain.ParseSearchTerms(filters.SearchText); len(terms) > 0 {
+		rankColumn = "ts_rank_cd(tp.search_vector, search.query)::DOUBLE PRECISION"
+		snippetColumn = "ts_headline('english', COALESCE(tp.bio, ''), search.query, '" + searchSnippetOptions + "')"
+		searchJoin = fmt.Sprintf(`
+		CROSS JOIN to_tsquery('english', $%d) AS search(query)`, argIndex)
+		args = append(args, prefixTSQuery(terms))
+		argIndex++
+
+		queryParts = append(queryParts, "tp.search_vector @@ search.query")
+	}
 
 	if filters.AcceptingClients != nil {
 		queryParts = append(queryParts, fmt.Sprintf("tp.is_accepting_clients = $%d", argIndex))
@@ -257,47 +464,289 @@ func (r *TherapistRepository) SearchTherapists(ctx context.Context, filters ther
 		argIndex++
 	}
 
-	if len(filters.Specializations) > 0 {
-		for _, spec := range filters.Specializations {
-			specializationJSON, err := json.Marshal([]string{spec})
+	switch filters.Modality {
+	case therapistDomain.ModalityRemote:
+		queryParts = append(queryParts, "tp.offers_remote = true")
+	case therapistDomain.ModalityInPerson:
+		queryParts = append(queryParts, "tp.offers_in_person = true")
+	}
+
+	for _, spec := range filters.Specializations {
+		specializationJSON, err := json.Marshal([]string{spec})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal specialization: %w", err)
+		}
+		queryParts = append(queryParts, fmt.Sprintf("tp.specializations @> $%d", argIndex))
+		args = append(args, specializationJSON)
+		argIndex++
+	}
+
+	// Any one of the requested languages is enough
+	if len(filters.Languages) > 0 {
+		languageParts := make([]string, 0, len(filters.Languages))
+		for _, code := range filters.Languages {
+			languageJSON, err := json.Marshal([]map[string]string{{"code": code}})
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal specialization: %w", err)
+				return nil, fmt.Errorf("failed to marshal language: %w", err)
 			}
-			queryParts = append(queryParts, fmt.Sprintf("tp.specializations @> $%d", argIndex))
-			args = append(args, specializationJSON)
+			languageParts = append(languageParts, fmt.Sprintf("tp.languages @> $%d", argIndex))
+			args = append(args, languageJSON)
 			argIndex++
 		}
+		queryParts = append(queryParts, "("+strings.Join(languageParts, " OR ")+")")
 	}
 
-	if filters.SearchText != "" {
-		searchPattern := "%" + strings.ToLower(filters.SearchText) + "%"
-		queryParts = append(queryParts, fmt.Sprintf("(LOWER(tp.first_name) LIKE $%d OR LOWER(tp.last_name) LIKE $%d OR LOWER(tp.bio) LIKE $%d)", argIndex, argIndex, argIndex))
-		args = append(args, searchPattern)
+	if filters.TherapyID != "" {
+		queryParts = append(queryParts, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM therapist_therapies tt WHERE tt.therapist_id = tp.user_id AND tt.therapy_id = $%d)", argIndex))
+		args = append(args, filters.TherapyID)
 		argIndex++
 	}
 
-	var whereClause string
+	where := "u.is_active = true AND " + publiclyListedCondition
 	if len(queryParts) > 0 {
-		whereClause = " AND " + strings.Join(queryParts, " AND ")
+		where += " AND " + strings.Join(queryParts, " AND ")
 	}
 
-	orderClause := " ORDER BY tp.first_name, tp.last_name"
+	return &therapistSearchQuery{
+		columns: therapistProfileColumns + `, ` + distanceColumn + `, ` + rankColumn + `, ` + snippetColumn,
+		from: `therapist_profiles tp
+		INNER JOIN users u ON tp.user_id = u.id` + locationJoin + searchJoin,
+		where:    where,
+		args:     args,
+		near:     filters.Near != nil,
+		fullText: searchJoin != "",
+	}, nil
+}
 
-	var limitClause string
-	if filters.Limit > 0 {
-		limitClause = fmt.Sprintf(" LIMIT $%d", argIndex)
-		args = append(args, filters.Limit)
-		argIndex++
+// searchSortKey pairs a keyset column with how to read its value back off a result for the next cursor
+type searchSortKey struct {
+	name  string
+	key   cursor.Key
+	value func(*therapistDomain.TherapistSearchResult) string
+}
 
-		if filters.Offset > 0 {
-			limitClause += fmt.Sprintf(" OFFSET $%d", argIndex)
-			args = append(args, filters.Offset)
+var (
+	distanceSortKey = searchSortKey{
+		name:  "distance",
+		key:   cursor.Key{Column: "nearest.distance_km", Cast: "DOUBLE PRECISION"},
+		value: func(r *therapistDomain.TherapistSearchResult) string { return cursor.Float(*r.DistanceKm) },
+	}
+	relevanceSortKey = searchSortKey{
+		name:  "relevance",
+		key:   cursor.Key{Column: "ts_rank_cd(tp.search_vector, search.query)::DOUBLE PRECISION", Cast: "DOUBLE PRECISION", Descending: true},
+		value: func(r *therapistDomain.TherapistSearchResult) string { return cursor.Float(*r.Rank) },
+	}
+	newestSortKey = searchSortKey{
+		name:  "newest",
+		key:   cursor.Key{Column: "tp.created_at", Cast: "TIMESTAMPTZ", Descending: true},
+		value: func(r *therapistDomain.TherapistSearchResult) string { return cursor.Time(r.Profile.CreatedAt) },
+	}
+	// Highest average first, more reviews winning ties; unrated therapists sort last
+	ratingSortKeys = []searchSortKey{
+		{
+			name:  "rating",
+			key:   cursor.Key{Column: "COALESCE(tp.rating_average, 0)::DOUBLE PRECISION", Cast: "DOUBLE PRECISION", Descending: true},
+			value: func(r *therapistDomain.TherapistSearchResult) string { return cursor.Float(ratingAverage(r.Profile)) },
+		},
+		{
+			name:  "rating_count",
+			key:   cursor.Key{Column: "tp.rating_count", Cast: "INTEGER", Descending: true},
+			value: func(r *therapistDomain.TherapistSearchResult) string { return strconv.Itoa(r.Profile.Rating.Count) },
+		},
+	}
+	// Name, then user_id so that therapists who share a name keep a stable order between pages
+	nameSortKeys = []searchSortKey{
+		{
+			name:  "first_name",
+			key:   cursor.Key{Column: "tp.first_name", Cast: "TEXT"},
+			value: func(r *therapistDomain.TherapistSearchResult) string { return r.Profile.FirstName },
+		},
+		{
+			name:  "last_name",
+			key:   cursor.Key{Column: "tp.last_name", Cast: "TEXT"},
+			value: func(r *therapistDomain.TherapistSearchResult) string { return r.Profile.LastName },
+		},
+		{
+			name:  "user_id",
+			key:   cursor.Key{Column: "tp.user_id", Cast: "UUID"},
+			value: func(r *therapistDomain.TherapistSearchResult) string { return r.Profile.UserID },
+		},
+	}
+)
+
+func (q *therapistSearchQuery) sortKeys(sort therapistDomain.SearchSort) []searchSortKey {
+	var keys []searchSortKey
+	switch {
+	case sort == therapistDomain.SortByName:
+	case sort == therapistDomain.SortByNewest:
+		keys = append(keys, newestSortKey)
+	case sort == therapistDomain.SortByRating:
+		keys = append(keys, ratingSortKeys...)
+	case sort == therapistDomain.SortByRelevance && q.fullText:
+		keys = append(keys, relevanceSortKey)
+	default:
+		// Location-anchored searches stay nearest first; relevance breaks ties between equally close offices
+		if q.near {
+			keys = append(keys, distanceSortKey)
+		}
+		if q.fullText {
+			keys = append(keys, relevanceSortKey)
 		}
 	}
 
-	finalQuery := baseQuery + whereClause + orderClause + limitClause
+	return append(keys, nameSortKeys...)
+}
+
+func ratingAverage(profile *therapistDomain.TherapistProfile) float64 {
+	if profile.Rating.Average == nil {
+		return 0
+	}
+	return *profile.Rating.Average
+}
+
+func searchKeyset(keys []searchSortKey) cursor.Keyset {
+	names := make([]string, len(keys))
+	keyset := cursor.Keyset{Keys: make([]cursor.Key, len(keys))}
+	for i, key := range keys {
+		names[i] = key.name
+		keyset.Keys[i] = key.key
+	}
+	keyset.Scope = "therapists:" + strings.Join(names, ",")
+	return keyset
+}
+
+func (r *TherapistRepository) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) (*therapistDomain.TherapistSearchPage, error) {
+	search, err := buildTherapistSearchQuery(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	page := filters.Page.Normalize()
+	sortKeys := search.sortKeys(filters.Sort)
+	keyset := searchKeyset(sortKeys)
+
+	where := search.where
+	args := search.args
+	after, cursorArgs, err := r.cursors.Where(keyset, page.Cursor, len(args)+1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		where += " AND " + after
+		args = append(args, cursorArgs...)
+	}
+
+	query := `SELECT ` + search.columns + ` FROM ` + search.from + ` WHERE ` + where + keyset.OrderBy() +
+		fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*therapistDomain.TherapistSearchResult
+	for rows.Next() {
+		var distanceKm, rank *float64
+		var snippet string
+		profile, err := scanTherapistProfile(rows, &distanceKm, &rank, &snippet)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &therapistDomain.TherapistSearchResult{
+			Profile:    profile,
+			DistanceKm: distanceKm,
+			Rank:       rank,
+			Snippet:    snippet,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	paged := cursor.Paginate(r.cursors, keyset, results, page.Limit, func(result *therapistDomain.TherapistSearchResult) []string {
+		values := make([]string, len(sortKeys))
+		for i, key := range sortKeys {
+			values[i] = key.value(result)
+		}
+		return values
+	})
+
+	searchPage := &therapistDomain.TherapistSearchPage{
+		Results:    paged.Items,
+		NextCursor: paged.NextCursor,
+	}
+
+	// Facets count every match, so they ignore the cursor
+	if err := r.countSearchFacets(ctx, search, searchPage); err != nil {
+		return nil, err
+	}
+
+	return searchPage, nil
+}
+
+// countSearchFacets fills in the total and per-value counts across every match, not just the current page
+func (r *TherapistRepository) countSearchFacets(ctx context.Context, search *therapistSearchQuery, page *therapistDomain.TherapistSearchPage) error {
+	query := `
+		WITH matched AS (
+			SELECT tp.specializations, tp.languages, COALESCE(tp.is_accepting_clients, false) AS is_accepting_clients, tp.offers_in_person, tp.offers_remote
+			FROM ` + search.from + `
+			WHERE ` + search.where + `
+		)
+		SELECT 'total', '', COUNT(*) FROM matched
+		UNION ALL
+		SELECT 'specialization', spec, COUNT(*) FROM matched, jsonb_array_elements_text(matched.specializations) AS spec GROUP BY spec
+		UNION ALL
+		SELECT 'language', spoken->>'code', COUNT(*) FROM matched, jsonb_array_elements(matched.languages) AS spoken GROUP BY spoken->>'code'
+		UNION ALL
+		SELECT 'accepting_clients', is_accepting_clients::TEXT, COUNT(*) FROM matched GROUP BY is_accepting_clients
+		UNION ALL
+		SELECT 'modality', 'in_person', COUNT(*) FILTER (WHERE offers_in_person) FROM matched
+		UNION ALL
+		SELECT 'modality', 'remote', COUNT(*) FILTER (WHERE offers_remote) FROM matched
+	`
+
+	rows, err := r.db.Query(ctx, query, search.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	page.Facets = therapistDomain.TherapistSearchFacets{
+		Specializations: make(map[string]int),
+		Modalities:      make(map[therapistDomain.SessionModality]int),
+		Languages:       make(map[string]int),
+	}
 
-	return r.scanTherapistProfiles(ctx, finalQuery, args...)
+	for rows.Next() {
+		var facet, value string
+		var count int
+		if err := rows.Scan(&facet, &value, &count); err != nil {
+			return err
+		}
+
+		switch facet {
+		case "total":
+			page.Total = count
+		case "specialization":
+			page.Facets.Specializations[value] = count
+		case "language":
+			page.Facets.Languages[value] = count
+		case "accepting_clients":
+			if value == "true" {
+				page.Facets.Accepting = count
+			} else {
+				page.Facets.NotAccepting = count
+			}
+		case "modality":
+			page.Facets.Modalities[therapistDomain.SessionModality(value)] = count
+		}
+	}
+
+	return rows.Err()
 }
 
 func (r *TherapistRepository) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
@@ -324,41 +773,371 @@ func (r *TherapistRepository) ExistsByLicenseNumber(ctx context.Context, license
 	return exists, nil
 }
 
-func (r *TherapistRepository) scanTherapistProfiles(ctx context.Context, query string, args ...interface{}) ([]*therapistDomain.TherapistProfile, error) {
-	rows, err := r.db.Query(ctx, query, args...)
+func (r *TherapistRepository) GetByVerificationStatus(ctx context.Context, status therapistDomain.VerificationStatus) ([]*therapistDomain.TherapistProfile, error) {
+	query := `
+		SELECT ` + therapistProfileColumns + `
+		FROM therapist_profiles tp
+		WHERE tp.verification_status = $1
+		ORDER BY tp.verification_submitted_at, tp.user_id
+	`
+
+	return r.scanTherapistProfiles(ctx, query, status)
+}
+
+// ExpireLicenses moves every verified profile whose license has lapsed to expired
+func (r *TherapistRepository) ExpireLicenses(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		UPDATE therapist_profiles
+		SET verification_status = 'expired', updated_at = $1
+		WHERE verification_status = 'verified' AND license_expires_at <= $1::date
+	`
+
+	result, err := r.db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *TherapistRepository) AddVerificationDocument(ctx context.Context, document *therapistDomain.VerificationDocument) error {
+	query := `
+		INSERT INTO license_verification_documents (
+			id, therapist_id, file_name, content_type, size_bytes, content, uploaded_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		document.ID,
+		document.TherapistID,
+		document.FileName,
+		document.ContentType,
+		document.SizeBytes,
+		document.Content,
+		document.UploadedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return therapistDomain.ErrTherapistProfileNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetVerificationDocuments lists document metadata without loading file contents
+func (r *TherapistRepository) GetVerificationDocuments(ctx context.Context, therapistID string) ([]*therapistDomain.VerificationDocument, error) {
+	query := `
+		SELECT id, therapist_id, file_name, content_type, size_bytes, uploaded_at
+		FROM license_verification_documents
+		WHERE therapist_id = $1
+		ORDER BY uploaded_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, therapistID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var profiles []*therapistDomain.TherapistProfile
+	var documents []*therapistDomain.VerificationDocument
 	for rows.Next() {
-		var profile therapistDomain.TherapistProfile
-		var specializationsJSON []byte
-
+		var document therapistDomain.VerificationDocument
 		err := rows.Scan(
-			&profile.UserID,
-			&profile.FirstName,
-			&profile.LastName,
-			&profile.LicenseNumber,
-			&specializationsJSON,
-			&profile.Phone,
-			&profile.Bio,
-			&profile.IsAcceptingClients,
-			&profile.CreatedAt,
-			&profile.UpdatedAt,
+			&document.ID,
+			&document.TherapistID,
+			&document.FileName,
+			&document.ContentType,
+			&document.SizeBytes,
+			&document.UploadedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		err = json.Unmarshal(specializationsJSON, &profile.Specializations)
+		documents = append(documents, &document)
+	}
+
+	return documents, rows.Err()
+}
+
+func (r *TherapistRepository) GetVerificationDocument(ctx context.Context, id string) (*therapistDomain.VerificationDocument, error) {
+	query := `
+		SELECT id, therapist_id, file_name, content_type, size_bytes, content, uploaded_at
+		FROM license_verification_documents
+		WHERE id = $1
+	`
+
+	var document therapistDomain.VerificationDocument
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&document.ID,
+		&document.TherapistID,
+		&document.FileName,
+		&document.ContentType,
+		&document.SizeBytes,
+		&document.Content,
+		&document.UploadedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, therapistDomain.ErrVerificationDocumentNotFound
+		}
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+func (r *TherapistRepository) AddPracticeLocation(ctx context.Context, location *therapistDomain.PracticeLocation) error {
+	query := `
+		INSERT INTO therapist_practice_locations (
+			id, therapist_id, label, address_line1, address_line2, city, region,
+			postal_code, country, latitude, longitude, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		location.ID,
+		location.TherapistID,
+		location.Label,
+		location.AddressLine1,
+		location.AddressLine2,
+		location.City,
+		location.Region,
+		location.PostalCode,
+		location.Country,
+		location.Coordinates.Latitude,
+		location.Coordinates.Longitude,
+		location.CreatedAt,
+		location.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return therapistDomain.ErrTherapistProfileNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *TherapistRepository) UpdatePracticeLocation(ctx context.Context, location *therapistDomain.PracticeLocation) error {
+	query := `
+		UPDATE therapist_practice_locations
+		SET label = $2, address_line1 = $3, address_line2 = $4, city = $5, region = $6,
+			postal_code = $7, country = $8, latitude = $9, longitude = $10, updated_at = $11
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		location.ID,
+		location.Label,
+		location.AddressLine1,
+		location.AddressLine2,
+		location.City,
+		location.Region,
+		location.PostalCode,
+		location.Country,
+		location.Coordinates.Latitude,
+		location.Coordinates.Longitude,
+		location.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return therapistDomain.ErrPracticeLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *TherapistRepository) DeletePracticeLocation(ctx context.Context, id string) error {
+	query := `DELETE FROM therapist_practice_locations WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return therapistDomain.ErrPracticeLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *TherapistRepository) GetPracticeLocation(ctx context.Context, id string) (*therapistDomain.PracticeLocation, error) {
+	query := `
+		SELECT ` + practiceLocationColumns + `
+		FROM therapist_practice_locations
+		WHERE id = $1
+	`
+
+	location, err := scanPracticeLocation(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, therapistDomain.ErrPracticeLocationNotFound
+		}
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (r *TherapistRepository) GetPracticeLocations(ctx context.Context, therapistID string) ([]*therapistDomain.PracticeLocation, error) {
+	query := `
+		SELECT ` + practiceLocationColumns + `
+		FROM therapist_practice_locations
+		WHERE therapist_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, therapistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []*therapistDomain.PracticeLocation
+	for rows.Next() {
+		location, err := scanPracticeLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations, rows.Err()
+}
+
+func (r *TherapistRepository) scanTherapistProfiles(ctx context.Context, query string, args ...interface{}) ([]*therapistDomain.TherapistProfile, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*therapistDomain.TherapistProfile
+	for rows.Next() {
+		profile, err := scanTherapistProfile(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal specializations: %w", err)
+			return nil, err
 		}
 
-		profiles = append(profiles, &profile)
+		profiles = append(profiles, profile)
 	}
 
 	return profiles, rows.Err()
 }
+
+// scanTherapistProfile reads the therapistProfileColumns, followed by any extra
+// per-query columns into the supplied destinations
+func scanTherapistProfile(row pgx.Row, extra ...interface{}) (*therapistDomain.TherapistProfile, error) {
+	var profile therapistDomain.TherapistProfile
+	var specializationsJSON []byte
+	var photoKey *string
+	var photoUploadedAt *time.Time
+	var languagesJSON []byte
+
+	dest := []interface{}{
+		&profile.UserID,
+		&profile.FirstName,
+		&profile.LastName,
+		&profile.Slug,
+		&profile.LicenseNumber,
+		&specializationsJSON,
+		&languagesJSON,
+		&profile.Phone,
+		&profile.Bio,
+		&profile.IsAcceptingClients,
+		&profile.OffersInPerson,
+		&profile.OffersRemote,
+		&profile.Verification.Status,
+		&profile.Verification.Jurisdiction,
+		&profile.Verification.LicenseType,
+		&profile.Verification.LicenseExpiresAt,
+		&profile.Verification.SubmittedAt,
+		&profile.Verification.ReviewedAt,
+		&profile.Verification.ReviewedBy,
+		&profile.Verification.RejectionReason,
+		&profile.Rating.Average,
+		&profile.Rating.Count,
+		&photoKey,
+		&photoUploadedAt,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(specializationsJSON, &profile.Specializations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal specializations: %w", err)
+	}
+
+	err = json.Unmarshal(languagesJSON, &profile.Languages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal languages: %w", err)
+	}
+
+	if photoKey != nil && photoUploadedAt != nil {
+		profile.Photo = &therapistDomain.ProfilePhoto{
+			Key:        *photoKey,
+			UploadedAt: *photoUploadedAt,
+		}
+	}
+
+	return &profile, nil
+}
+
+const practiceLocationColumns = `id, therapist_id, label, address_line1, address_line2, city, region,
+			   postal_code, country, latitude, longitude, created_at, updated_at`
+
+func scanPracticeLocation(row pgx.Row) (*therapistDomain.PracticeLocation, error) {
+	var location therapistDomain.PracticeLocation
+
+	err := row.Scan(
+		&location.ID,
+		&location.TherapistID,
+		&location.Label,
+		&location.AddressLine1,
+		&location.AddressLine2,
+		&location.City,
+		&location.Region,
+		&location.PostalCode,
+		&location.Country,
+		&location.Coordinates.Latitude,
+		&location.Coordinates.Longitude,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+// prefixTSQuery ANDs every term as a prefix match, so "anx depress" finds "anxiety" and "depression".
+// Terms come from ParseSearchTerms and contain only letters and digits.
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
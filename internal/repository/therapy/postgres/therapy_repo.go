@@ -3,21 +3,35 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
+	"github.com/goran/thappy/internal/repository/cursor"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Oldest first, matching the order therapies were curated in
+var therapyKeyset = cursor.Keyset{
+	Scope: "therapies",
+	Keys: []cursor.Key{
+		{Column: "created_at", Cast: "TIMESTAMPTZ"},
+		{Column: "id", Cast: "TEXT"},
+	},
+}
+
 type TherapyRepository struct {
-	db *pgxpool.Pool
+	db      *pgxpool.Pool
+	cursors *cursor.Codec
 }
 
-func NewTherapyRepository(db *pgxpool.Pool) *TherapyRepository {
+func NewTherapyRepository(db *pgxpool.Pool, cursors *cursor.Codec) *TherapyRepository {
 	return &TherapyRepository{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
@@ -82,25 +96,42 @@ func (r *TherapyRepository) GetByID(ctx context.Context, id string) (*therapyDom
 	return &t, nil
 }
 
-func (r *TherapyRepository) GetAll(ctx context.Context) ([]*therapyDomain.Therapy, error) {
-	query := `
-		SELECT id, title, short_description, icon, detailed_info, when_needed, is_active, created_at, updated_at
-		FROM therapies
-		ORDER BY created_at ASC
-	`
+func (r *TherapyRepository) GetAll(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapyDomain.Therapy], error) {
+	return r.list(ctx, nil, page)
+}
 
-	return r.scanTherapies(ctx, query)
+func (r *TherapyRepository) GetAllActive(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapyDomain.Therapy], error) {
+	return r.list(ctx, []string{"is_active = true"}, page)
 }
 
-func (r *TherapyRepository) GetAllActive(ctx context.Context) ([]*therapyDomain.Therapy, error) {
+func (r *TherapyRepository) list(ctx context.Context, conditions []string, page pagination.PageRequest) (*pagination.Page[*therapyDomain.Therapy], error) {
+	page = page.Normalize()
+
+	after, args, err := r.cursors.Where(therapyKeyset, page.Cursor, 1)
+	if err != nil {
+		return nil, err
+	}
+	if after != "" {
+		conditions = append(conditions, after)
+	}
+
 	query := `
 		SELECT id, title, short_description, icon, detailed_info, when_needed, is_active, created_at, updated_at
-		FROM therapies
-		WHERE is_active = true
-		ORDER BY created_at ASC
-	`
+		FROM therapies`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += therapyKeyset.OrderBy() + fmt.Sprintf(" LIMIT $%d", len(args)+1)
+	args = append(args, page.Limit+1)
 
-	return r.scanTherapies(ctx, query)
+	therapies, err := r.scanTherapies(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor.Paginate(r.cursors, therapyKeyset, therapies, page.Limit, func(t *therapyDomain.Therapy) []string {
+		return []string{cursor.Time(t.CreatedAt), t.ID}
+	}), nil
 }
 
 func (r *TherapyRepository) Update(ctx context.Context, therapy *therapyDomain.Therapy) error {
@@ -133,9 +164,15 @@ func (r *TherapyRepository) Update(ctx context.Context, therapy *therapyDomain.T
 }
 
 func (r *TherapyRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `DELETE FROM therapies WHERE id = $1`
 
-	result, err := r.db.Exec(ctx, query, id)
+	result, err := tx.Exec(ctx, query, id)
 	if err != nil {
 		return err
 	}
@@ -144,7 +181,17 @@ func (r *TherapyRepository) Delete(ctx context.Context, id string) error {
 		return therapyDomain.ErrTherapyNotFound
 	}
 
-	return nil
+	// The therapist_therapies links cascade; the search copy on therapist profiles has to be trimmed here
+	_, err = tx.Exec(ctx, `
+		UPDATE therapist_profiles
+		SET specializations = specializations - $1::TEXT
+		WHERE specializations ? $1::TEXT
+	`, id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *TherapyRepository) scanTherapies(ctx context.Context, query string, args ...interface{}) ([]*therapyDomain.Therapy, error) {
@@ -0,0 +1,331 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const planColumns = `id, client_id, therapist_id, title, status, problems, goals, review_interval_days,
+			   last_reviewed_at, next_review_at, shared_with_client, created_at, updated_at, closed_at`
+
+type TreatmentPlanRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTreatmentPlanRepository(db *pgxpool.Pool) *TreatmentPlanRepository {
+	return &TreatmentPlanRepository{
+		db: db,
+	}
+}
+
+func (r *TreatmentPlanRepository) Create(ctx context.Context, plan *treatmentPlanDomain.Plan) error {
+	problemsJSON, goalsJSON, err := marshalContent(plan)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO treatment_plans (
+			id, client_id, therapist_id, title, status, problems, goals, review_interval_days,
+			last_reviewed_at, next_review_at, shared_with_client, created_at, updated_at, closed_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		plan.ID,
+		plan.ClientID,
+		plan.TherapistID,
+		plan.Title,
+		plan.Status,
+		problemsJSON,
+		goalsJSON,
+		plan.ReviewIntervalDays,
+		plan.LastReviewedAt,
+		plan.NextReviewAt,
+		plan.SharedWithClient,
+		plan.CreatedAt,
+		plan.UpdatedAt,
+		plan.ClosedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			// Only one active plan per client and therapist
+			if pgErr.Code == "23505" {
+				return treatmentPlanDomain.ErrActivePlanExists
+			}
+			if pgErr.Code == "23503" {
+				return treatmentPlanDomain.ErrInvalidPlanData
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *TreatmentPlanRepository) GetByID(ctx context.Context, id string) (*treatmentPlanDomain.Plan, error) {
+	query := `
+		SELECT ` + planColumns + `
+		FROM treatment_plans
+		WHERE id = $1
+	`
+
+	plan, err := scanPlan(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, treatmentPlanDomain.ErrPlanNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadProgress(ctx, []*treatmentPlanDomain.Plan{plan}); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (r *TreatmentPlanRepository) Update(ctx context.Context, plan *treatmentPlanDomain.Plan) error {
+	result, err := r.update(ctx, r.db, plan)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return treatmentPlanDomain.ErrPlanNotFound
+	}
+
+	return nil
+}
+
+func (r *TreatmentPlanRepository) AddProgress(ctx context.Context, plan *treatmentPlanDomain.Plan, update *treatmentPlanDomain.ProgressUpdate) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO treatment_plan_progress (id, plan_id, goal_id, therapist_id, note, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		update.ID,
+		update.PlanID,
+		update.GoalID,
+		update.TherapistID,
+		update.Note,
+		update.Status,
+		update.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The goal's new status lives in the plan's goals
+	result, err := r.update(ctx, tx, plan)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return treatmentPlanDomain.ErrPlanNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *TreatmentPlanRepository) GetByClientID(ctx context.Context, clientID, therapistID string, sharedOnly bool) ([]*treatmentPlanDomain.Plan, error) {
+	query := `
+		SELECT ` + planColumns + `
+		FROM treatment_plans
+		WHERE client_id = $1
+		  AND ($2 = '' OR therapist_id = NULLIF($2, '')::UUID)
+		  AND (NOT $3 OR shared_with_client)
+		ORDER BY status = 'active' DESC, created_at DESC, id
+	`
+
+	return r.list(ctx, query, clientID, therapistID, sharedOnly)
+}
+
+func (r *TreatmentPlanRepository) GetDueForReview(ctx context.Context, therapistID string, by time.Time) ([]*treatmentPlanDomain.Plan, error) {
+	query := `
+		SELECT ` + planColumns + `
+		FROM treatment_plans
+		WHERE therapist_id = $1 AND status = 'active' AND next_review_at <= $2
+		ORDER BY next_review_at, id
+	`
+
+	return r.list(ctx, query, therapistID, by)
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+func (r *TreatmentPlanRepository) update(ctx context.Context, db execer, plan *treatmentPlanDomain.Plan) (pgconn.CommandTag, error) {
+	problemsJSON, goalsJSON, err := marshalContent(plan)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	query := `
+		UPDATE treatment_plans
+		SET title = $2, status = $3, problems = $4, goals = $5, review_interval_days = $6,
+		    last_reviewed_at = $7, next_review_at = $8, shared_with_client = $9,
+		    updated_at = $10, closed_at = $11
+		WHERE id = $1
+	`
+
+	return db.Exec(ctx, query,
+		plan.ID,
+		plan.Title,
+		plan.Status,
+		problemsJSON,
+		goalsJSON,
+		plan.ReviewIntervalDays,
+		plan.LastReviewedAt,
+		plan.NextReviewAt,
+		plan.SharedWithClient,
+		plan.UpdatedAt,
+		plan.ClosedAt,
+	)
+}
+
+func (r *TreatmentPlanRepository) list(ctx context.Context, query string, args ...interface{}) ([]*treatmentPlanDomain.Plan, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []*treatmentPlanDomain.Plan
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadProgress(ctx, plans); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+func (r *TreatmentPlanRepository) loadProgress(ctx context.Context, plans []*treatmentPlanDomain.Plan) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*treatmentPlanDomain.Plan, len(plans))
+	planIDs := make([]string, len(plans))
+	for i, plan := range plans {
+		byID[plan.ID] = plan
+		planIDs[i] = plan.ID
+	}
+
+	query := `
+		SELECT id, plan_id, goal_id, therapist_id, note, status, created_at
+		FROM treatment_plan_progress
+		WHERE plan_id = ANY($1::UUID[])
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, planIDs)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var update treatmentPlanDomain.ProgressUpdate
+
+		err := rows.Scan(
+			&update.ID,
+			&update.PlanID,
+			&update.GoalID,
+			&update.TherapistID,
+			&update.Note,
+			&update.Status,
+			&update.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if plan, ok := byID[update.PlanID]; ok {
+			plan.Progress = append(plan.Progress, &update)
+		}
+	}
+
+	return rows.Err()
+}
+
+func marshalContent(plan *treatmentPlanDomain.Plan) ([]byte, []byte, error) {
+	problemsJSON, err := json.Marshal(plan.Problems)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal problems: %w", err)
+	}
+
+	goalsJSON, err := json.Marshal(plan.Goals)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal goals: %w", err)
+	}
+
+	return problemsJSON, goalsJSON, nil
+}
+
+func scanPlan(row pgx.Row) (*treatmentPlanDomain.Plan, error) {
+	var plan treatmentPlanDomain.Plan
+	var problemsJSON []byte
+	var goalsJSON []byte
+
+	err := row.Scan(
+		&plan.ID,
+		&plan.ClientID,
+		&plan.TherapistID,
+		&plan.Title,
+		&plan.Status,
+		&problemsJSON,
+		&goalsJSON,
+		&plan.ReviewIntervalDays,
+		&plan.LastReviewedAt,
+		&plan.NextReviewAt,
+		&plan.SharedWithClient,
+		&plan.CreatedAt,
+		&plan.UpdatedAt,
+		&plan.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(problemsJSON, &plan.Problems); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal problems: %w", err)
+	}
+
+	if err := json.Unmarshal(goalsJSON, &plan.Goals); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal goals: %w", err)
+	}
+
+	return &plan, nil
+}
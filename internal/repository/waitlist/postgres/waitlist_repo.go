@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WaitlistRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWaitlistRepository(db *pgxpool.Pool) *WaitlistRepository {
+	return &WaitlistRepository{
+		db: db,
+	}
+}
+
+// rankedEntriesQuery numbers every active entry within its therapist's queue so that
+// positions are always derived from join order rather than stored and kept in sync.
+const rankedEntriesQuery = `
+	WITH ranked AS (
+		SELECT id, therapist_id, client_id, preferences, status, notified_at, offered_at,
+			   offer_expires_at, expires_at, created_at, updated_at,
+			   ROW_NUMBER() OVER (PARTITION BY therapist_id ORDER BY created_at, id) AS position
+		FROM therapist_waitlist_entries
+		WHERE status IN ('waiting', 'offered') AND expires_at > NOW()
+	)
+	SELECT id, therapist_id, client_id, position, preferences, status, notified_at, offered_at,
+		   offer_expires_at, expires_at, created_at, updated_at
+	FROM ranked
+`
+
+func (r *WaitlistRepository) Create(ctx context.Context, entry *waitlistDomain.Entry) error {
+	preferencesJSON, err := json.Marshal(entry.Preferences)
+	if err != nil {
+		return fmt.Errorf("failed to marshal preferences: %w", err)
+	}
+
+	query := `
+		INSERT INTO therapist_waitlist_entries (
+			id, therapist_id, client_id, preferences, status, notified_at, offered_at,
+			offer_expires_at, expires_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		entry.ID,
+		entry.TherapistID,
+		entry.ClientID,
+		preferencesJSON,
+		entry.Status,
+		entry.NotifiedAt,
+		entry.OfferedAt,
+		entry.OfferExpiresAt,
+		entry.ExpiresAt,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				return waitlistDomain.ErrAlreadyOnWaitlist
+			}
+			if pgErr.Code == "23503" {
+				return waitlistDomain.ErrEntryNotFound
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *WaitlistRepository) GetByID(ctx context.Context, id string) (*waitlistDomain.Entry, error) {
+	query := `
+		SELECT e.id, e.therapist_id, e.client_id, COALESCE(ranked.position, 0), e.preferences, e.status,
+			   e.notified_at, e.offered_at, e.offer_expires_at, e.expires_at, e.created_at, e.updated_at
+		FROM therapist_waitlist_entries e
+		LEFT JOIN (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY therapist_id ORDER BY created_at, id) AS position
+			FROM therapist_waitlist_entries
+			WHERE status IN ('waiting', 'offered') AND expires_at > NOW()
+		) ranked ON ranked.id = e.id
+		WHERE e.id = $1
+	`
+
+	entry, err := scanEntry(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, waitlistDomain.ErrEntryNotFound
+		}
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *WaitlistRepository) Update(ctx context.Context, entry *waitlistDomain.Entry) error {
+	preferencesJSON, err := json.Marshal(entry.Preferences)
+	if err != nil {
+		return fmt.Errorf("failed to marshal preferences: %w", err)
+	}
+
+	query := `
+		UPDATE therapist_waitlist_entries
+		SET preferences = $2, status = $3, notified_at = $4, offered_at = $5,
+			offer_expires_at = $6, expires_at = $7, updated_at = $8
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		entry.ID,
+		preferencesJSON,
+		entry.Status,
+		entry.NotifiedAt,
+		entry.OfferedAt,
+		entry.OfferExpiresAt,
+		entry.ExpiresAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return waitlistDomain.ErrEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *WaitlistRepository) GetActiveByClientID(ctx context.Context, clientID string) ([]*waitlistDomain.Entry, error) {
+	query := rankedEntriesQuery + `
+		WHERE client_id = $1
+		ORDER BY created_at
+	`
+
+	return r.scanEntries(ctx, query, clientID)
+}
+
+func (r *WaitlistRepository) GetActiveByTherapistID(ctx context.Context, therapistID string) ([]*waitlistDomain.Entry, error) {
+	query := rankedEntriesQuery + `
+		WHERE therapist_id = $1
+		ORDER BY position
+	`
+
+	return r.scanEntries(ctx, query, therapistID)
+}
+
+func (r *WaitlistRepository) GetNextWaiting(ctx context.Context, therapistID string) (*waitlistDomain.Entry, error) {
+	query := rankedEntriesQuery + `
+		WHERE therapist_id = $1 AND status = 'waiting'
+		ORDER BY position
+		LIMIT 1
+	`
+
+	entry, err := scanEntry(r.db.QueryRow(ctx, query, therapistID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, waitlistDomain.ErrEntryNotFound
+		}
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *WaitlistRepository) ExpireStale(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		UPDATE therapist_waitlist_entries
+		SET status = 'expired', updated_at = $1
+		WHERE (status = 'waiting' AND expires_at <= $1)
+		   OR (status = 'offered' AND (offer_expires_at <= $1 OR expires_at <= $1))
+	`
+
+	result, err := r.db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *WaitlistRepository) scanEntries(ctx context.Context, query string, args ...interface{}) ([]*waitlistDomain.Entry, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*waitlistDomain.Entry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func scanEntry(row pgx.Row) (*waitlistDomain.Entry, error) {
+	var entry waitlistDomain.Entry
+	var position int64
+	var preferencesJSON []byte
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.TherapistID,
+		&entry.ClientID,
+		&position,
+		&preferencesJSON,
+		&entry.Status,
+		&entry.NotifiedAt,
+		&entry.OfferedAt,
+		&entry.OfferExpiresAt,
+		&entry.ExpiresAt,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Position = int(position)
+
+	err = json.Unmarshal(preferencesJSON, &entry.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal preferences: %w", err)
+	}
+
+	return &entry, nil
+}
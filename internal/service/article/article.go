@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/goran/thappy/internal/domain/article"
+	"github.com/goran/thappy/internal/domain/pagination"
 )
 
 type ArticleService struct {
@@ -64,22 +65,22 @@ func (s *ArticleService) GetArticleBySlug(ctx context.Context, slug string) (*ar
 	return s.repo.GetBySlug(ctx, slug)
 }
 
-func (s *ArticleService) ListArticles(ctx context.Context) ([]*article.Article, error) {
-	return s.repo.GetAll(ctx)
+func (s *ArticleService) ListArticles(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*article.Article], error) {
+	return s.repo.GetAll(ctx, page)
 }
 
-func (s *ArticleService) ListPublishedArticles(ctx context.Context) ([]*article.Article, error) {
-	return s.repo.GetAllPublished(ctx)
+func (s *ArticleService) ListPublishedArticles(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*article.Article], error) {
+	return s.repo.GetAllPublished(ctx, page)
 }
 
-func (s *ArticleService) ListArticlesByCategory(ctx context.Context, category string) ([]*article.Article, error) {
+func (s *ArticleService) ListArticlesByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*article.Article], error) {
 	category = strings.ToLower(strings.TrimSpace(category))
-	return s.repo.GetByCategory(ctx, category)
+	return s.repo.GetByCategory(ctx, category, page)
 }
 
-func (s *ArticleService) ListPublishedArticlesByCategory(ctx context.Context, category string) ([]*article.Article, error) {
+func (s *ArticleService) ListPublishedArticlesByCategory(ctx context.Context, category string, page pagination.PageRequest) (*pagination.Page[*article.Article], error) {
 	category = strings.ToLower(strings.TrimSpace(category))
-	return s.repo.GetPublishedByCategory(ctx, category)
+	return s.repo.GetPublishedByCategory(ctx, category, page)
 }
 
 func (s *ArticleService) UpdateArticle(ctx context.Context, articleEntity *article.Article) error {
@@ -0,0 +1,221 @@
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type AssessmentService struct {
+	assessmentRepo assessmentDomain.Repository
+	clientRepo     clientDomain.ClientRepository
+	userRepo       userDomain.UserRepository
+	riskScreener   assessmentDomain.RiskScreener
+}
+
+func NewAssessmentService(
+	assessmentRepo assessmentDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	riskScreener assessmentDomain.RiskScreener,
+) *AssessmentService {
+	return &AssessmentService{
+		assessmentRepo: assessmentRepo,
+		clientRepo:     clientRepo,
+		userRepo:       userRepo,
+		riskScreener:   riskScreener,
+	}
+}
+
+func (s *AssessmentService) GetInstruments() []*assessmentDomain.Instrument {
+	return assessmentDomain.Instruments()
+}
+
+// AssignAssessment asks a client currently assigned to the therapist to
+// complete one of the built-in instruments
+func (s *AssessmentService) AssignAssessment(ctx context.Context, therapistUserID string, req assessmentDomain.AssignRequest) (*assessmentDomain.Assessment, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, req.ClientID); err != nil {
+		return nil, err
+	}
+
+	assessment, err := assessmentDomain.NewAssessment(req.InstrumentCode, therapistUserID, req.ClientID, req.DueAt, time.Now())
+	if err != nil {
+		if err == assessmentDomain.ErrUnknownInstrument {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", assessmentDomain.ErrInvalidAssessmentData, err)
+	}
+
+	err = s.assessmentRepo.Create(ctx, assessment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+func (s *AssessmentService) CancelAssessment(ctx context.Context, therapistUserID, assessmentID string) (*assessmentDomain.Assessment, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.getAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !assessment.AssignedBy(therapistUserID) {
+		return nil, assessmentDomain.ErrUnauthorizedAccess
+	}
+
+	err = assessment.Cancel(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.assessmentRepo.Update(ctx, assessment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assessment, nil
+}
+
+// GetClientAssessments lists the assessments a therapist gave to a client
+// currently assigned to them
+func (s *AssessmentService) GetClientAssessments(ctx context.Context, therapistUserID, clientUserID string) ([]*assessmentDomain.Assessment, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	assessments, err := s.assessmentRepo.GetByClientID(ctx, clientUserID, therapistUserID)
+	if err != nil {
+		return nil, assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	return assessments, nil
+}
+
+// GetScoreHistory returns a client's completed scores on one instrument, oldest
+// first, so the therapist can follow symptom change over treatment
+func (s *AssessmentService) GetScoreHistory(ctx context.Context, therapistUserID, clientUserID, instrumentCode string) ([]*assessmentDomain.Assessment, error) {
+	instrument, ok := assessmentDomain.GetInstrument(instrumentCode)
+	if !ok {
+		return nil, assessmentDomain.ErrUnknownInstrument
+	}
+
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	assessments, err := s.assessmentRepo.GetScoreHistory(ctx, clientUserID, therapistUserID, instrument.Code)
+	if err != nil {
+		return nil, assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	return assessments, nil
+}
+
+func (s *AssessmentService) GetOwnAssessments(ctx context.Context, clientUserID string) ([]*assessmentDomain.Assessment, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	assessments, err := s.assessmentRepo.GetByClientID(ctx, clientUserID, "")
+	if err != nil {
+		return nil, assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	return assessments, nil
+}
+
+func (s *AssessmentService) CompleteAssessment(ctx context.Context, clientUserID, assessmentID string, responses []int) (*assessmentDomain.Assessment, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.getAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assessments of other clients are treated as missing
+	if !assessment.AssignedTo(clientUserID) {
+		return nil, assessmentDomain.ErrAssessmentNotFound
+	}
+
+	err = assessment.Complete(responses, time.Now())
+	if err != nil {
+		if err == assessmentDomain.ErrInvalidStatusTransition {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", assessmentDomain.ErrInvalidAssessmentData, err)
+	}
+
+	err = s.assessmentRepo.Update(ctx, assessment)
+	if err != nil {
+		return nil, err
+	}
+
+	// The assessment is saved, so a failed alert is logged rather than returned
+	if s.riskScreener != nil {
+		if _, err := s.riskScreener.ScreenAssessment(ctx, assessment); err != nil {
+			log.Printf("Failed to raise safety alert for assessment %s: %v", assessment.ID, err)
+		}
+	}
+
+	return assessment, nil
+}
+
+func (s *AssessmentService) getAssessment(ctx context.Context, assessmentID string) (*assessmentDomain.Assessment, error) {
+	assessment, err := s.assessmentRepo.GetByID(ctx, assessmentID)
+	if err != nil {
+		if err == assessmentDomain.ErrAssessmentNotFound {
+			return nil, err
+		}
+		return nil, assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	return assessment, nil
+}
+
+func (s *AssessmentService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return assessmentDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+func (s *AssessmentService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return assessmentDomain.ErrUnauthorizedAccess
+		}
+		return assessmentDomain.ErrAssessmentServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return assessmentDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
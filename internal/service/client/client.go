@@ -2,22 +2,28 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	"github.com/goran/thappy/internal/domain/pagination"
 	userDomain "github.com/goran/thappy/internal/domain/user"
 )
 
 type ClientService struct {
-	clientRepo clientDomain.ClientRepository
-	userRepo   userDomain.UserRepository
+	clientRepo      clientDomain.ClientRepository
+	userRepo        userDomain.UserRepository
+	consentChecker  clientDomain.ConsentChecker
+	guardianChecker clientDomain.GuardianChecker
 }
 
-func NewClientService(clientRepo clientDomain.ClientRepository, userRepo userDomain.UserRepository) *ClientService {
+func NewClientService(clientRepo clientDomain.ClientRepository, userRepo userDomain.UserRepository, consentChecker clientDomain.ConsentChecker, guardianChecker clientDomain.GuardianChecker) *ClientService {
 	return &ClientService{
-		clientRepo: clientRepo,
-		userRepo:   userRepo,
+		clientRepo:      clientRepo,
+		userRepo:        userRepo,
+		consentChecker:  consentChecker,
+		guardianChecker: guardianChecker,
 	}
 }
 
@@ -51,10 +57,17 @@ func (s *ClientService) CreateProfile(ctx context.Context, userID string, req cl
 	}
 
 	// Update contact info if provided
-	if req.Phone != "" || req.EmergencyContact != "" {
-		err = profile.UpdateContactInfo(req.Phone, req.EmergencyContact)
+	if req.Phone != "" {
+		err = profile.UpdateContactInfo(req.Phone)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: %v", clientDomain.ErrInvalidClientData, err)
+		}
+	}
+
+	if len(req.EmergencyContacts) > 0 {
+		err = profile.SetEmergencyContacts(req.EmergencyContacts)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", clientDomain.ErrInvalidClientData, err)
 		}
 	}
 
@@ -114,9 +127,16 @@ func (s *ClientService) UpdateContactInfo(ctx context.Context, userID string, re
 		return nil, err
 	}
 
-	err = profile.UpdateContactInfo(req.Phone, req.EmergencyContact)
+	err = profile.UpdateContactInfo(req.Phone)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", clientDomain.ErrInvalidClientData, err)
+	}
+
+	if req.EmergencyContacts != nil {
+		err = profile.SetEmergencyContacts(*req.EmergencyContacts)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", clientDomain.ErrInvalidClientData, err)
+		}
 	}
 
 	err = s.clientRepo.Update(ctx, profile)
@@ -133,6 +153,17 @@ func (s *ClientService) SetDateOfBirth(ctx context.Context, userID string, req c
 		return nil, err
 	}
 
+	// The date of birth decides when guardianship ends, so a minor cannot change it
+	if s.guardianChecker != nil {
+		managed, err := s.guardianChecker.HasActiveGuardian(ctx, userID)
+		if err != nil {
+			return nil, clientDomain.ErrClientServiceUnavailable
+		}
+		if managed {
+			return nil, clientDomain.ErrManagedByGuardian
+		}
+	}
+
 	var birthDate *time.Time
 	if req.DateOfBirth != nil {
 		parsed, err := time.Parse("2006-01-02", *req.DateOfBirth)
@@ -155,6 +186,25 @@ func (s *ClientService) SetDateOfBirth(ctx context.Context, userID string, req c
 	return profile, nil
 }
 
+func (s *ClientService) SetPreferredLanguage(ctx context.Context, userID string, code *string) (*clientDomain.ClientProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = profile.SetPreferredLanguage(code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", clientDomain.ErrInvalidClientData, err)
+	}
+
+	err = s.clientRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
 func (s *ClientService) AssignTherapist(ctx context.Context, clientUserID, therapistUserID string) error {
 	// Verify therapist exists and is active
 	therapist, err := s.userRepo.GetByID(ctx, therapistUserID)
@@ -175,6 +225,17 @@ func (s *ClientService) AssignTherapist(ctx context.Context, clientUserID, thera
 		return err
 	}
 
+	// Treatment starts with the assignment, so consent must come first
+	if s.consentChecker != nil {
+		signed, err := s.consentChecker.HasSignedRequiredConsents(ctx, clientUserID)
+		if err != nil {
+			return clientDomain.ErrClientServiceUnavailable
+		}
+		if !signed {
+			return clientDomain.ErrConsentRequired
+		}
+	}
+
 	// Assign therapist
 	profile.AssignTherapist(&therapistUserID)
 
@@ -203,23 +264,7 @@ func (s *ClientService) UnassignTherapist(ctx context.Context, clientUserID stri
 	return nil
 }
 
-func (s *ClientService) UpdateNotes(ctx context.Context, clientUserID string, notes string) error {
-	profile, err := s.GetProfile(ctx, clientUserID)
-	if err != nil {
-		return err
-	}
-
-	profile.UpdateNotes(notes)
-
-	err = s.clientRepo.Update(ctx, profile)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (s *ClientService) GetClientsByTherapist(ctx context.Context, therapistUserID string) ([]*clientDomain.ClientProfile, error) {
+func (s *ClientService) GetClientsByTherapist(ctx context.Context, therapistUserID string, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
 	// Verify therapist exists and is active
 	therapist, err := s.userRepo.GetByID(ctx, therapistUserID)
 	if err != nil {
@@ -233,17 +278,23 @@ func (s *ClientService) GetClientsByTherapist(ctx context.Context, therapistUser
 		return nil, clientDomain.ErrUnauthorizedAccess
 	}
 
-	profiles, err := s.clientRepo.GetByTherapistID(ctx, therapistUserID)
+	profiles, err := s.clientRepo.GetByTherapistID(ctx, therapistUserID, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
 		return nil, clientDomain.ErrClientServiceUnavailable
 	}
 
 	return profiles, nil
 }
 
-func (s *ClientService) GetActiveClients(ctx context.Context) ([]*clientDomain.ClientProfile, error) {
-	profiles, err := s.clientRepo.GetActiveClients(ctx)
+func (s *ClientService) GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	profiles, err := s.clientRepo.GetActiveClients(ctx, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
 		return nil, clientDomain.ErrClientServiceUnavailable
 	}
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	clientDomain "github.com/goran/thappy/internal/domain/client"
+	"github.com/goran/thappy/internal/domain/pagination"
 	userDomain "github.com/goran/thappy/internal/domain/user"
 )
 
@@ -76,7 +77,7 @@ func (m *MockClientRepository) Delete(ctx context.Context, userID string) error
 	return nil
 }
 
-func (m *MockClientRepository) GetByTherapistID(ctx context.Context, therapistID string) ([]*clientDomain.ClientProfile, error) {
+func (m *MockClientRepository) GetByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
 	if m.shouldFailNext {
 		m.shouldFailNext = false
 		return nil, m.failError
@@ -88,10 +89,10 @@ func (m *MockClientRepository) GetByTherapistID(ctx context.Context, therapistID
 			profiles = append(profiles, profile)
 		}
 	}
-	return profiles, nil
+	return &pagination.Page[*clientDomain.ClientProfile]{Items: profiles}, nil
 }
 
-func (m *MockClientRepository) GetActiveClients(ctx context.Context) ([]*clientDomain.ClientProfile, error) {
+func (m *MockClientRepository) GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
 	if m.shouldFailNext {
 		m.shouldFailNext = false
 		return nil, m.failError
@@ -101,7 +102,7 @@ func (m *MockClientRepository) GetActiveClients(ctx context.Context) ([]*clientD
 	for _, profile := range m.profiles {
 		profiles = append(profiles, profile)
 	}
-	return profiles, nil
+	return &pagination.Page[*clientDomain.ClientProfile]{Items: profiles}, nil
 }
 
 func (m *MockClientRepository) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
@@ -227,7 +228,7 @@ func TestClientService_CreateProfile(t *testing.T) {
 			clientRepo := NewMockClientRepository()
 			tt.setup(userRepo, clientRepo)
 
-			service := NewClientService(clientRepo, userRepo)
+			service := NewClientService(clientRepo, userRepo, nil, nil)
 
 			profile, err := service.CreateProfile(context.Background(), tt.userID, tt.request)
 
@@ -266,7 +267,7 @@ func TestClientService_GetProfile(t *testing.T) {
 	profile, _ := clientDomain.NewClientProfile("user-123", "John", "Doe")
 	clientRepo.profiles[profile.UserID] = profile
 
-	service := NewClientService(clientRepo, userRepo)
+	service := NewClientService(clientRepo, userRepo, nil, nil)
 
 	t.Run("successful get profile", func(t *testing.T) {
 		result, err := service.GetProfile(context.Background(), "user-123")
@@ -289,3 +290,86 @@ func TestClientService_GetProfile(t *testing.T) {
 		}
 	})
 }
+
+type stubConsentChecker struct {
+	signed bool
+}
+
+func (c *stubConsentChecker) HasSignedRequiredConsents(ctx context.Context, clientUserID string) (bool, error) {
+	return c.signed, nil
+}
+
+func TestClientService_AssignTherapist_RequiresConsent(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	clientRepo := NewMockClientRepository()
+
+	therapist, _ := userDomain.NewUserWithRole("therapist@example.com", "password123", userDomain.RoleTherapist)
+	therapist.ID = "therapist-123"
+	userRepo.users[therapist.ID] = therapist
+
+	user, _ := userDomain.NewUserWithRole("john@example.com", "password123", userDomain.RoleClient)
+	user.ID = "user-123"
+	userRepo.users[user.ID] = user
+
+	profile, _ := clientDomain.NewClientProfile("user-123", "John", "Doe")
+	clientRepo.profiles[profile.UserID] = profile
+
+	consents := &stubConsentChecker{}
+	service := NewClientService(clientRepo, userRepo, consents, nil)
+
+	err := service.AssignTherapist(context.Background(), "user-123", "therapist-123")
+	if err != clientDomain.ErrConsentRequired {
+		t.Fatalf("AssignTherapist() error = %v, want %v", err, clientDomain.ErrConsentRequired)
+	}
+	if profile.TherapistID != nil {
+		t.Error("AssignTherapist() must not assign a therapist before consent")
+	}
+
+	consents.signed = true
+	if err := service.AssignTherapist(context.Background(), "user-123", "therapist-123"); err != nil {
+		t.Fatalf("AssignTherapist() unexpected error = %v", err)
+	}
+	if profile.TherapistID == nil || *profile.TherapistID != "therapist-123" {
+		t.Errorf("AssignTherapist() TherapistID = %v, want therapist-123", profile.TherapistID)
+	}
+}
+
+type stubGuardianChecker struct {
+	active bool
+}
+
+func (c *stubGuardianChecker) HasActiveGuardian(ctx context.Context, clientUserID string) (bool, error) {
+	return c.active, nil
+}
+
+func TestClientService_SetDateOfBirth_ManagedByGuardian(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	clientRepo := NewMockClientRepository()
+
+	user, _ := userDomain.NewUserWithRole("teen@example.com", "password123", userDomain.RoleClient)
+	user.ID = "user-123"
+	userRepo.users[user.ID] = user
+
+	profile, _ := clientDomain.NewClientProfile("user-123", "Ana", "Horvat")
+	clientRepo.profiles[profile.UserID] = profile
+
+	guardians := &stubGuardianChecker{active: true}
+	service := NewClientService(clientRepo, userRepo, nil, guardians)
+
+	dateOfBirth := "1990-01-01"
+	_, err := service.SetDateOfBirth(context.Background(), "user-123", clientDomain.SetDateOfBirthRequest{DateOfBirth: &dateOfBirth})
+	if err != clientDomain.ErrManagedByGuardian {
+		t.Fatalf("SetDateOfBirth() error = %v, want %v", err, clientDomain.ErrManagedByGuardian)
+	}
+	if profile.DateOfBirth != nil {
+		t.Error("SetDateOfBirth() must not change the date of birth of a managed client")
+	}
+
+	guardians.active = false
+	if _, err := service.SetDateOfBirth(context.Background(), "user-123", clientDomain.SetDateOfBirthRequest{DateOfBirth: &dateOfBirth}); err != nil {
+		t.Fatalf("SetDateOfBirth() unexpected error = %v", err)
+	}
+	if profile.DateOfBirth == nil {
+		t.Error("SetDateOfBirth() should set the date of birth once no guardian manages the account")
+	}
+}
@@ -0,0 +1,442 @@
+package consent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	consentDomain "github.com/goran/thappy/internal/domain/consent"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type ConsentService struct {
+	consentRepo consentDomain.Repository
+	clientRepo  clientDomain.ClientRepository
+	userRepo    userDomain.UserRepository
+	guardians   consentDomain.GuardianAuthorizer
+	renderer    consentDomain.RecordRenderer
+}
+
+func NewConsentService(
+	consentRepo consentDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	guardians consentDomain.GuardianAuthorizer,
+	renderer consentDomain.RecordRenderer,
+) *ConsentService {
+	return &ConsentService{
+		consentRepo: consentRepo,
+		clientRepo:  clientRepo,
+		userRepo:    userRepo,
+		guardians:   guardians,
+		renderer:    renderer,
+	}
+}
+
+// CreateDocument adds a consent document and publishes its first version
+func (s *ConsentService) CreateDocument(ctx context.Context, adminUserID string, req consentDomain.CreateDocumentRequest) (*consentDomain.Document, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	document, err := consentDomain.NewDocument(req.DocumentDetails, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", consentDomain.ErrInvalidConsentData, err)
+	}
+
+	_, err = document.PublishVersion(req.Body, "", adminUserID, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", consentDomain.ErrInvalidConsentData, err)
+	}
+
+	err = s.consentRepo.CreateDocument(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// UpdateDocument changes a document's title, kind and whether it is required.
+// A new title shows on the next published version.
+func (s *ConsentService) UpdateDocument(ctx context.Context, adminUserID, documentID string, details consentDomain.DocumentDetails) (*consentDomain.Document, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	document, err := s.getDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = document.Update(details, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", consentDomain.ErrInvalidConsentData, err)
+	}
+
+	err = s.consentRepo.UpdateDocument(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// PublishVersion makes new text current. Clients who signed an earlier
+// version have to sign again before the document counts as signed.
+func (s *ConsentService) PublishVersion(ctx context.Context, adminUserID, documentID string, req consentDomain.PublishVersionRequest) (*consentDomain.Document, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	document, err := s.getDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := document.PublishVersion(req.Body, req.ChangeSummary, adminUserID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", consentDomain.ErrInvalidConsentData, err)
+	}
+
+	err = s.consentRepo.AddVersion(ctx, document, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (s *ConsentService) ListDocuments(ctx context.Context, adminUserID string) ([]*consentDomain.Document, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	documents, err := s.consentRepo.ListDocuments(ctx)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return documents, nil
+}
+
+func (s *ConsentService) GetVersions(ctx context.Context, adminUserID, documentID string) ([]*consentDomain.Version, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getDocument(ctx, documentID); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.consentRepo.GetVersions(ctx, documentID)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return versions, nil
+}
+
+// GetOwnStatus lists every consent document with the client's signing state
+func (s *ConsentService) GetOwnStatus(ctx context.Context, clientUserID string) ([]consentDomain.DocumentStatus, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	return s.statuses(ctx, clientUserID)
+}
+
+// Sign records the client's signature of a document's current version. Minors
+// cannot consent for themselves; their guardian signs instead.
+func (s *ConsentService) Sign(ctx context.Context, clientUserID string, req consentDomain.SignRequest) (*consentDomain.Signature, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	// Signatures belong to the client profile
+	profile, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return nil, err
+		}
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	now := time.Now()
+	if profile.IsMinorAt(now) {
+		return nil, consentDomain.ErrGuardianConsentRequired
+	}
+
+	document, err := s.getVersionDocument(ctx, req.VersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := document.Sign(clientUserID, req.VersionID, req.DocumentHash, req.TypedName, req.IPAddress, req.UserAgent, now)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	err = s.consentRepo.CreateSignature(ctx, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func (s *ConsentService) GetOwnSignatures(ctx context.Context, clientUserID string) ([]*consentDomain.Signature, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	signatures, err := s.consentRepo.GetSignaturesByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return signatures, nil
+}
+
+// GetSignedRecord renders one of the client's signatures as a printable record
+func (s *ConsentService) GetSignedRecord(ctx context.Context, clientUserID, signatureID string) ([]byte, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	signature, err := s.getClientSignature(ctx, clientUserID, signatureID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.renderRecord(ctx, signature)
+}
+
+// GetMinorStatus lists every consent document with the minor's signing state
+func (s *ConsentService) GetMinorStatus(ctx context.Context, guardianUserID, clientUserID string) ([]consentDomain.DocumentStatus, error) {
+	if err := s.verifyGuardian(ctx, guardianUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	return s.statuses(ctx, clientUserID)
+}
+
+// SignForMinor records a guardian's signature of a document's current version
+// on behalf of the minor they look after
+func (s *ConsentService) SignForMinor(ctx context.Context, guardianUserID, clientUserID string, req consentDomain.SignRequest) (*consentDomain.Signature, error) {
+	if err := s.verifyGuardian(ctx, guardianUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	document, err := s.getVersionDocument(ctx, req.VersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := document.SignOnBehalf(guardianUserID, clientUserID, req.VersionID, req.DocumentHash, req.TypedName, req.IPAddress, req.UserAgent, time.Now())
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	err = s.consentRepo.CreateSignature(ctx, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// GetMinorSignedRecord renders one of the minor's signatures, including those
+// signed by another guardian, as a printable record
+func (s *ConsentService) GetMinorSignedRecord(ctx context.Context, guardianUserID, clientUserID, signatureID string) ([]byte, error) {
+	if err := s.verifyGuardian(ctx, guardianUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	signature, err := s.getClientSignature(ctx, clientUserID, signatureID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.renderRecord(ctx, signature)
+}
+
+// GetClientStatus shows a therapist which consents an assigned client has signed
+func (s *ConsentService) GetClientStatus(ctx context.Context, therapistUserID, clientUserID string) ([]consentDomain.DocumentStatus, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	return s.statuses(ctx, clientUserID)
+}
+
+// HasSignedRequiredConsents reports whether the client signed the current
+// version of every required document. Other services use it to hold back the
+// start of treatment.
+func (s *ConsentService) HasSignedRequiredConsents(ctx context.Context, clientUserID string) (bool, error) {
+	statuses, err := s.statuses(ctx, clientUserID)
+	if err != nil {
+		return false, err
+	}
+
+	return !consentDomain.HasOutstanding(statuses), nil
+}
+
+// statuses works out the client's signing state. For a minor only their
+// guardians' signatures count, for an adult only their own.
+func (s *ConsentService) statuses(ctx context.Context, clientUserID string) ([]consentDomain.DocumentStatus, error) {
+	minor := false
+	profile, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	switch {
+	case err == nil:
+		minor = profile.IsMinorAt(time.Now())
+	case err != clientDomain.ErrClientProfileNotFound:
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	documents, err := s.consentRepo.ListDocuments(ctx)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	signatures, err := s.consentRepo.GetSignaturesByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return consentDomain.BuildStatuses(documents, consentDomain.CountingSignatures(signatures, minor)), nil
+}
+
+func (s *ConsentService) renderRecord(ctx context.Context, signature *consentDomain.Signature) ([]byte, error) {
+	version, err := s.consentRepo.GetVersion(ctx, signature.VersionID)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	record := &consentDomain.SignedRecord{
+		Version:   version,
+		Signature: signature,
+	}
+
+	client, err := s.userRepo.GetByID(ctx, signature.ClientID)
+	if err != nil {
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+	record.ClientEmail = client.Email
+
+	// The guardian's account may be gone; the signature still names their ID
+	if signature.SignedByGuardian() {
+		guardian, err := s.userRepo.GetByID(ctx, signature.GuardianID)
+		switch {
+		case err == nil:
+			record.GuardianEmail = guardian.Email
+		case err != userDomain.ErrUserNotFound:
+			return nil, consentDomain.ErrConsentServiceUnavailable
+		}
+	}
+
+	return s.renderer.RenderSignedRecord(record)
+}
+
+// getClientSignature loads a signature of the client. Signatures of other
+// clients are treated as missing.
+func (s *ConsentService) getClientSignature(ctx context.Context, clientUserID, signatureID string) (*consentDomain.Signature, error) {
+	signature, err := s.consentRepo.GetSignature(ctx, signatureID)
+	if err != nil {
+		if err == consentDomain.ErrSignatureNotFound {
+			return nil, err
+		}
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	if signature.ClientID != clientUserID {
+		return nil, consentDomain.ErrSignatureNotFound
+	}
+
+	return signature, nil
+}
+
+// getVersionDocument loads the document a version belongs to
+func (s *ConsentService) getVersionDocument(ctx context.Context, versionID string) (*consentDomain.Document, error) {
+	version, err := s.consentRepo.GetVersion(ctx, versionID)
+	if err != nil {
+		if err == consentDomain.ErrVersionNotFound {
+			return nil, err
+		}
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return s.getDocument(ctx, version.DocumentID)
+}
+
+func signError(err error) error {
+	switch err {
+	case consentDomain.ErrVersionNotFound, consentDomain.ErrVersionSuperseded, consentDomain.ErrDocumentHashMismatch:
+		return err
+	}
+	return fmt.Errorf("%w: %v", consentDomain.ErrInvalidConsentData, err)
+}
+
+func (s *ConsentService) getDocument(ctx context.Context, documentID string) (*consentDomain.Document, error) {
+	document, err := s.consentRepo.GetDocument(ctx, documentID)
+	if err != nil {
+		if err == consentDomain.ErrDocumentNotFound {
+			return nil, err
+		}
+		return nil, consentDomain.ErrConsentServiceUnavailable
+	}
+
+	return document, nil
+}
+
+func (s *ConsentService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return consentDomain.ErrConsentServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return consentDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+// verifyGuardian checks that the user is a guardian allowed to handle the
+// client's consent forms
+func (s *ConsentService) verifyGuardian(ctx context.Context, guardianUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, guardianUserID, userDomain.RoleGuardian); err != nil {
+		return err
+	}
+
+	if s.guardians == nil {
+		return consentDomain.ErrUnauthorizedAccess
+	}
+
+	return s.guardians.AuthorizeConsent(ctx, guardianUserID, clientUserID)
+}
+
+func (s *ConsentService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return consentDomain.ErrUnauthorizedAccess
+		}
+		return consentDomain.ErrConsentServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return consentDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
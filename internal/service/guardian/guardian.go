@@ -0,0 +1,439 @@
+package guardian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	guardianDomain "github.com/goran/thappy/internal/domain/guardian"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type GuardianService struct {
+	guardianRepo guardianDomain.Repository
+	clientRepo   clientDomain.ClientRepository
+	userRepo     userDomain.UserRepository
+	homeworkRepo homeworkDomain.Repository
+}
+
+func NewGuardianService(
+	guardianRepo guardianDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	homeworkRepo homeworkDomain.Repository,
+) *GuardianService {
+	return &GuardianService{
+		guardianRepo: guardianRepo,
+		clientRepo:   clientRepo,
+		userRepo:     userRepo,
+		homeworkRepo: homeworkRepo,
+	}
+}
+
+// RegisterMinor creates a client account and profile for a minor and makes the
+// registering guardian responsible for it
+func (s *GuardianService) RegisterMinor(ctx context.Context, guardianUserID string, req guardianDomain.RegisterMinorRequest) (*guardianDomain.Minor, error) {
+	if err := s.verifyRole(ctx, guardianUserID, userDomain.RoleGuardian); err != nil {
+		return nil, err
+	}
+
+	if !guardianDomain.IsValidRelationship(req.Relationship) {
+		return nil, fmt.Errorf("%w: unknown relationship %q", guardianDomain.ErrInvalidGuardianData, req.Relationship)
+	}
+
+	dateOfBirth, err := parseDateOfBirth(req.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.userRepo.ExistsByEmail(ctx, strings.ToLower(strings.TrimSpace(req.Email)))
+	if err != nil {
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+	if exists {
+		return nil, userDomain.ErrUserAlreadyExists
+	}
+
+	user, err := userDomain.NewUserWithRole(req.Email, req.Password, userDomain.RoleClient)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	profile, err := clientDomain.NewClientProfile(user.ID, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	if err := profile.SetDateOfBirth(&dateOfBirth); err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	now := time.Now()
+	guardianship, err := guardianDomain.NewGuardianship(guardianUserID, user.ID, req.Relationship, profile.IsMinorAt(now), now)
+	if err != nil {
+		if err == guardianDomain.ErrClientNotMinor {
+			return nil, fmt.Errorf("%w: only clients under %d can be registered by a guardian", guardianDomain.ErrInvalidGuardianData, clientDomain.AgeOfMajority)
+		}
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// Deleting the user also removes the profile, so a failed registration
+	// leaves nothing behind
+	if err := s.clientRepo.Create(ctx, profile); err != nil {
+		s.userRepo.Delete(ctx, user.ID)
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if err := s.guardianRepo.Create(ctx, guardianship); err != nil {
+		s.userRepo.Delete(ctx, user.ID)
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	return newMinor(guardianship, profile), nil
+}
+
+// AddGuardian links another guardian account to a minor, e.g. the second
+// parent. The new guardian gets the default scopes.
+func (s *GuardianService) AddGuardian(ctx context.Context, guardianUserID, clientUserID string, req guardianDomain.AddGuardianRequest) (*guardianDomain.Guardianship, error) {
+	_, profile, err := s.verifyGuardianship(ctx, guardianUserID, clientUserID, guardianDomain.ScopeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	other, err := s.userRepo.GetByEmail(ctx, strings.ToLower(strings.TrimSpace(req.Email)))
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return nil, guardianDomain.ErrGuardianNotFound
+		}
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if !other.IsGuardian() || !other.IsActive {
+		return nil, guardianDomain.ErrGuardianNotFound
+	}
+
+	now := time.Now()
+	guardianship, err := guardianDomain.NewGuardianship(other.ID, clientUserID, req.Relationship, profile.IsMinorAt(now), now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	err = s.guardianRepo.Create(ctx, guardianship)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+// GetMinors lists the minors the guardian currently looks after
+func (s *GuardianService) GetMinors(ctx context.Context, guardianUserID string) ([]*guardianDomain.Minor, error) {
+	if err := s.verifyRole(ctx, guardianUserID, userDomain.RoleGuardian); err != nil {
+		return nil, err
+	}
+
+	guardianships, err := s.guardianRepo.GetActiveByGuardianID(ctx, guardianUserID)
+	if err != nil {
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	now := time.Now()
+	minors := make([]*guardianDomain.Minor, 0, len(guardianships))
+	for _, guardianship := range guardianships {
+		profile, err := s.clientRepo.GetByUserID(ctx, guardianship.ClientID)
+		if err != nil {
+			return nil, guardianDomain.ErrGuardianServiceUnavailable
+		}
+
+		// Clients who came of age since the last majority run are left out
+		if !profile.IsMinorAt(now) {
+			continue
+		}
+		minors = append(minors, newMinor(guardianship, profile))
+	}
+
+	return minors, nil
+}
+
+func (s *GuardianService) GetMinor(ctx context.Context, guardianUserID, clientUserID string) (*guardianDomain.Minor, error) {
+	guardianship, profile, err := s.verifyGuardianship(ctx, guardianUserID, clientUserID, guardianDomain.ScopeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMinor(guardianship, profile), nil
+}
+
+// UpdateMinor corrects the minor's name and date of birth. The date of birth
+// cannot make the client an adult; that is for the client and the practice.
+func (s *GuardianService) UpdateMinor(ctx context.Context, guardianUserID, clientUserID string, req guardianDomain.UpdateMinorRequest) (*guardianDomain.Minor, error) {
+	guardianship, profile, err := s.verifyGuardianship(ctx, guardianUserID, clientUserID, guardianDomain.ScopeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	dateOfBirth, err := parseDateOfBirth(req.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := profile.UpdatePersonalInfo(req.FirstName, req.LastName); err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	if err := profile.SetDateOfBirth(&dateOfBirth); err != nil {
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	if !profile.IsMinorAt(time.Now()) {
+		return nil, fmt.Errorf("%w: the date of birth must be of a client under %d", guardianDomain.ErrInvalidGuardianData, clientDomain.AgeOfMajority)
+	}
+
+	err = s.clientRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMinor(guardianship, profile), nil
+}
+
+// GetMinorHomework lists the minor's homework without their own answers
+func (s *GuardianService) GetMinorHomework(ctx context.Context, guardianUserID, clientUserID string) ([]*guardianDomain.HomeworkItem, error) {
+	if _, _, err := s.verifyGuardianship(ctx, guardianUserID, clientUserID, guardianDomain.ScopeHomework); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.homeworkRepo.GetByClientID(ctx, clientUserID, "")
+	if err != nil {
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	items := make([]*guardianDomain.HomeworkItem, 0, len(assignments))
+	for _, assignment := range assignments {
+		item := &guardianDomain.HomeworkItem{
+			ID:           assignment.ID,
+			Title:        assignment.Title,
+			Instructions: assignment.Instructions,
+			Status:       string(assignment.Status),
+			DueAt:        assignment.DueAt,
+			AssignedAt:   assignment.AssignedAt,
+		}
+		if assignment.Submission != nil {
+			completedAt := assignment.Submission.CompletedAt
+			item.CompletedAt = &completedAt
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// GetClientGuardianships shows a therapist who looks after an assigned client,
+// including guardianships that have ended
+func (s *GuardianService) GetClientGuardianships(ctx context.Context, therapistUserID, clientUserID string) ([]*guardianDomain.Guardianship, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	guardianships, err := s.guardianRepo.GetByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	return guardianships, nil
+}
+
+// UpdateScopes lets the minor's therapist narrow or widen what a guardian can
+// access, e.g. to keep homework private for an older teenager
+func (s *GuardianService) UpdateScopes(ctx context.Context, therapistUserID, guardianshipID string, scopes []guardianDomain.Scope) (*guardianDomain.Guardianship, error) {
+	guardianship, err := s.getTherapistGuardianship(ctx, therapistUserID, guardianshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = guardianship.SetScopes(scopes, time.Now())
+	if err != nil {
+		if err == guardianDomain.ErrGuardianshipEnded {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", guardianDomain.ErrInvalidGuardianData, err)
+	}
+
+	err = s.guardianRepo.Update(ctx, guardianship)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+// RevokeGuardianship removes a guardian's access to the minor
+func (s *GuardianService) RevokeGuardianship(ctx context.Context, therapistUserID, guardianshipID string) (*guardianDomain.Guardianship, error) {
+	guardianship, err := s.getTherapistGuardianship(ctx, therapistUserID, guardianshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = guardianship.End(guardianDomain.EndReasonRevoked, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.guardianRepo.Update(ctx, guardianship)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+// GetOwnGuardianships shows clients who manages or managed their account
+func (s *GuardianService) GetOwnGuardianships(ctx context.Context, clientUserID string) ([]*guardianDomain.Guardianship, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	guardianships, err := s.guardianRepo.GetByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	return guardianships, nil
+}
+
+func (s *GuardianService) AuthorizeConsent(ctx context.Context, guardianUserID, clientUserID string) error {
+	_, _, err := s.verifyGuardianship(ctx, guardianUserID, clientUserID, guardianDomain.ScopeConsents)
+	return err
+}
+
+func (s *GuardianService) HasActiveGuardian(ctx context.Context, clientUserID string) (bool, error) {
+	active, err := s.guardianRepo.HasActive(ctx, clientUserID)
+	if err != nil {
+		return false, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	return active, nil
+}
+
+// EndGuardianshipsAtMajority hands accounts over to clients who came of age.
+// Guardians lose access on the birthday itself either way; this records it.
+func (s *GuardianService) EndGuardianshipsAtMajority(ctx context.Context) (int64, error) {
+	now := time.Now()
+	return s.guardianRepo.EndAtMajority(ctx, clientDomain.MajorityCutoff(now), now)
+}
+
+// verifyGuardianship checks that the user is an active guardian of the client
+// with access to the given scope
+func (s *GuardianService) verifyGuardianship(ctx context.Context, guardianUserID, clientUserID string, scope guardianDomain.Scope) (*guardianDomain.Guardianship, *clientDomain.ClientProfile, error) {
+	if err := s.verifyRole(ctx, guardianUserID, userDomain.RoleGuardian); err != nil {
+		return nil, nil, err
+	}
+
+	guardianship, err := s.guardianRepo.GetActive(ctx, guardianUserID, clientUserID)
+	if err != nil {
+		if err == guardianDomain.ErrGuardianshipNotFound {
+			return nil, nil, err
+		}
+		return nil, nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	profile, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return nil, nil, guardianDomain.ErrGuardianshipNotFound
+		}
+		return nil, nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if err := guardianship.Authorize(scope, profile.IsMinorAt(time.Now())); err != nil {
+		return nil, nil, err
+	}
+
+	return guardianship, profile, nil
+}
+
+func (s *GuardianService) getTherapistGuardianship(ctx context.Context, therapistUserID, guardianshipID string) (*guardianDomain.Guardianship, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	guardianship, err := s.guardianRepo.GetByID(ctx, guardianshipID)
+	if err != nil {
+		if err == guardianDomain.ErrGuardianshipNotFound {
+			return nil, err
+		}
+		return nil, guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if err := s.verifyAssignedClient(ctx, therapistUserID, guardianship.ClientID); err != nil {
+		return nil, err
+	}
+
+	return guardianship, nil
+}
+
+func (s *GuardianService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return guardianDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+func (s *GuardianService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return guardianDomain.ErrUnauthorizedAccess
+		}
+		return guardianDomain.ErrGuardianServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return guardianDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
+
+func newMinor(guardianship *guardianDomain.Guardianship, profile *clientDomain.ClientProfile) *guardianDomain.Minor {
+	return &guardianDomain.Minor{
+		Guardianship: guardianship,
+		FirstName:    profile.FirstName,
+		LastName:     profile.LastName,
+		DateOfBirth:  profile.DateOfBirth,
+		MajorityDate: profile.MajorityDate(),
+	}
+}
+
+func parseDateOfBirth(value string) (time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, fmt.Errorf("%w: date of birth is required", guardianDomain.ErrInvalidGuardianData)
+	}
+
+	dateOfBirth, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date format, use YYYY-MM-DD", guardianDomain.ErrInvalidGuardianData)
+	}
+
+	return dateOfBirth, nil
+}
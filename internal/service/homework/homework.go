@@ -0,0 +1,253 @@
+package homework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	articleDomain "github.com/goran/thappy/internal/domain/article"
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	homeworkDomain "github.com/goran/thappy/internal/domain/homework"
+	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type HomeworkService struct {
+	homeworkRepo homeworkDomain.Repository
+	clientRepo   clientDomain.ClientRepository
+	userRepo     userDomain.UserRepository
+	articleRepo  articleDomain.Repository
+	therapyRepo  therapyDomain.Repository
+}
+
+func NewHomeworkService(
+	homeworkRepo homeworkDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	articleRepo articleDomain.Repository,
+	therapyRepo therapyDomain.Repository,
+) *HomeworkService {
+	return &HomeworkService{
+		homeworkRepo: homeworkRepo,
+		clientRepo:   clientRepo,
+		userRepo:     userRepo,
+		articleRepo:  articleRepo,
+		therapyRepo:  therapyRepo,
+	}
+}
+
+// CreateAssignment gives homework to a client currently assigned to the therapist
+func (s *HomeworkService) CreateAssignment(ctx context.Context, therapistUserID string, req homeworkDomain.CreateAssignmentRequest) (*homeworkDomain.Assignment, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, req.ClientID); err != nil {
+		return nil, err
+	}
+
+	assignment, err := homeworkDomain.NewAssignment(therapistUserID, req.ClientID, req.AssignmentDetails, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", homeworkDomain.ErrInvalidAssignmentData, err)
+	}
+
+	if err := s.verifyResource(ctx, assignment.ResourceType, assignment.ResourceID); err != nil {
+		return nil, err
+	}
+
+	err = s.homeworkRepo.Create(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (s *HomeworkService) CancelAssignment(ctx context.Context, therapistUserID, assignmentID string) (*homeworkDomain.Assignment, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.getAssignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !assignment.AssignedBy(therapistUserID) {
+		return nil, homeworkDomain.ErrUnauthorizedAccess
+	}
+
+	err = assignment.Cancel(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.homeworkRepo.Update(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// GetClientAssignments lists the homework a therapist gave to a client
+// currently assigned to them
+func (s *HomeworkService) GetClientAssignments(ctx context.Context, therapistUserID, clientUserID string, filter homeworkDomain.StatusFilter) ([]*homeworkDomain.Assignment, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.homeworkRepo.GetByClientID(ctx, clientUserID, therapistUserID)
+	if err != nil {
+		return nil, homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	return filterAssignments(assignments, filter), nil
+}
+
+// GetOverdueAssignments lists the therapist's open homework past its due date, across all clients
+func (s *HomeworkService) GetOverdueAssignments(ctx context.Context, therapistUserID string) ([]*homeworkDomain.Assignment, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.homeworkRepo.GetOverdue(ctx, therapistUserID, time.Now())
+	if err != nil {
+		return nil, homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	return assignments, nil
+}
+
+func (s *HomeworkService) GetOwnAssignments(ctx context.Context, clientUserID string, filter homeworkDomain.StatusFilter) ([]*homeworkDomain.Assignment, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.homeworkRepo.GetByClientID(ctx, clientUserID, "")
+	if err != nil {
+		return nil, homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	return filterAssignments(assignments, filter), nil
+}
+
+func (s *HomeworkService) CompleteAssignment(ctx context.Context, clientUserID, assignmentID string, req homeworkDomain.CompleteAssignmentRequest) (*homeworkDomain.Assignment, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.getAssignment(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assignments of other clients are treated as missing
+	if !assignment.AssignedTo(clientUserID) {
+		return nil, homeworkDomain.ErrAssignmentNotFound
+	}
+
+	err = assignment.Complete(req.Response, req.Reflection, req.Helpfulness, time.Now())
+	if err != nil {
+		if err == homeworkDomain.ErrInvalidStatusTransition {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", homeworkDomain.ErrInvalidAssignmentData, err)
+	}
+
+	err = s.homeworkRepo.Update(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func filterAssignments(assignments []*homeworkDomain.Assignment, filter homeworkDomain.StatusFilter) []*homeworkDomain.Assignment {
+	if filter == "" {
+		return assignments
+	}
+
+	now := time.Now()
+	filtered := make([]*homeworkDomain.Assignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.Matches(filter, now) {
+			filtered = append(filtered, assignment)
+		}
+	}
+	return filtered
+}
+
+// verifyResource checks that a referenced article is published and a referenced therapy is active
+func (s *HomeworkService) verifyResource(ctx context.Context, resourceType homeworkDomain.ResourceType, resourceID string) error {
+	switch resourceType {
+	case homeworkDomain.ResourceArticle:
+		article, err := s.articleRepo.GetByID(ctx, resourceID)
+		if err != nil {
+			if err == articleDomain.ErrArticleNotFound {
+				return homeworkDomain.ErrResourceNotFound
+			}
+			return homeworkDomain.ErrHomeworkServiceUnavailable
+		}
+		if !article.IsPublished {
+			return homeworkDomain.ErrResourceNotFound
+		}
+	case homeworkDomain.ResourceTherapy:
+		therapy, err := s.therapyRepo.GetByID(ctx, resourceID)
+		if err != nil {
+			if err == therapyDomain.ErrTherapyNotFound {
+				return homeworkDomain.ErrResourceNotFound
+			}
+			return homeworkDomain.ErrHomeworkServiceUnavailable
+		}
+		if !therapy.IsActive {
+			return homeworkDomain.ErrResourceNotFound
+		}
+	}
+
+	return nil
+}
+
+func (s *HomeworkService) getAssignment(ctx context.Context, assignmentID string) (*homeworkDomain.Assignment, error) {
+	assignment, err := s.homeworkRepo.GetByID(ctx, assignmentID)
+	if err != nil {
+		if err == homeworkDomain.ErrAssignmentNotFound {
+			return nil, err
+		}
+		return nil, homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	return assignment, nil
+}
+
+func (s *HomeworkService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return homeworkDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+func (s *HomeworkService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return homeworkDomain.ErrUnauthorizedAccess
+		}
+		return homeworkDomain.ErrHomeworkServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return homeworkDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
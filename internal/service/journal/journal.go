@@ -0,0 +1,258 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+const (
+	defaultListDays = 30
+	maxListDays     = 366
+)
+
+type JournalService struct {
+	journalRepo  journalDomain.Repository
+	clientRepo   clientDomain.ClientRepository
+	userRepo     userDomain.UserRepository
+	riskScreener journalDomain.RiskScreener
+}
+
+func NewJournalService(
+	journalRepo journalDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	riskScreener journalDomain.RiskScreener,
+) *JournalService {
+	return &JournalService{
+		journalRepo:  journalRepo,
+		clientRepo:   clientRepo,
+		userRepo:     userRepo,
+		riskScreener: riskScreener,
+	}
+}
+
+func (s *JournalService) CreateEntry(ctx context.Context, clientUserID string, req journalDomain.CreateEntryRequest) (*journalDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	entry, err := journalDomain.NewEntry(clientUserID, req.EntryDate, req.EntryContent, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", journalDomain.ErrInvalidEntryData, err)
+	}
+
+	err = s.journalRepo.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	s.screen(ctx, entry)
+	return entry, nil
+}
+
+func (s *JournalService) UpdateEntry(ctx context.Context, clientUserID, entryID string, content journalDomain.EntryContent) (*journalDomain.Entry, error) {
+	entry, err := s.getOwnEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = entry.Update(content, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", journalDomain.ErrInvalidEntryData, err)
+	}
+
+	err = s.journalRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	s.screen(ctx, entry)
+	return entry, nil
+}
+
+func (s *JournalService) DeleteEntry(ctx context.Context, clientUserID, entryID string) error {
+	entry, err := s.getOwnEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return err
+	}
+
+	return s.journalRepo.Delete(ctx, entry.ID)
+}
+
+// SetEntryShared lets the client's assigned therapist read the entry, or stops them
+func (s *JournalService) SetEntryShared(ctx context.Context, clientUserID, entryID string, shared bool) (*journalDomain.Entry, error) {
+	entry, err := s.getOwnEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.SetShared(shared, time.Now())
+
+	err = s.journalRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *JournalService) GetOwnEntries(ctx context.Context, clientUserID string, period journalDomain.Period) ([]*journalDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	return s.listEntries(ctx, clientUserID, period, false)
+}
+
+func (s *JournalService) GetOwnTrends(ctx context.Context, clientUserID string, weeks int) (*journalDomain.Trends, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	return s.buildTrends(ctx, clientUserID, weeks, false)
+}
+
+// GetClientEntries lists the entries an assigned client shared with the therapist
+func (s *JournalService) GetClientEntries(ctx context.Context, therapistUserID, clientUserID string, period journalDomain.Period) ([]*journalDomain.Entry, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	return s.listEntries(ctx, clientUserID, period, true)
+}
+
+// GetClientTrends summarizes only the entries the client shared
+func (s *JournalService) GetClientTrends(ctx context.Context, therapistUserID, clientUserID string, weeks int) (*journalDomain.Trends, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	return s.buildTrends(ctx, clientUserID, weeks, true)
+}
+
+func (s *JournalService) listEntries(ctx context.Context, clientUserID string, period journalDomain.Period, sharedOnly bool) ([]*journalDomain.Entry, error) {
+	to := period.To
+	if to.IsZero() {
+		// Clients may be a day ahead of the server's clock
+		to = time.Now().AddDate(0, 0, 1)
+	}
+
+	from := period.From
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -defaultListDays)
+	}
+
+	if from.After(to) || to.Sub(from) > maxListDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: period must be at most %d days and end after it starts", journalDomain.ErrInvalidEntryData, maxListDays)
+	}
+
+	entries, err := s.journalRepo.GetByClientID(ctx, clientUserID, from, to, sharedOnly)
+	if err != nil {
+		return nil, journalDomain.ErrJournalServiceUnavailable
+	}
+
+	return entries, nil
+}
+
+func (s *JournalService) buildTrends(ctx context.Context, clientUserID string, weeks int, sharedOnly bool) (*journalDomain.Trends, error) {
+	if weeks == 0 {
+		weeks = journalDomain.DefaultTrendWeeks
+	}
+
+	if weeks < 1 || weeks > journalDomain.MaxTrendWeeks {
+		return nil, fmt.Errorf("%w: weeks must be between 1 and %d", journalDomain.ErrInvalidEntryData, journalDomain.MaxTrendWeeks)
+	}
+
+	today := time.Now()
+	from, to := journalDomain.TrendPeriod(today, weeks)
+
+	entries, err := s.journalRepo.GetByClientID(ctx, clientUserID, from, to, sharedOnly)
+	if err != nil {
+		return nil, journalDomain.ErrJournalServiceUnavailable
+	}
+
+	dates, err := s.journalRepo.GetEntryDates(ctx, clientUserID, sharedOnly)
+	if err != nil {
+		return nil, journalDomain.ErrJournalServiceUnavailable
+	}
+
+	return journalDomain.BuildTrends(entries, dates, today, weeks), nil
+}
+
+// screen flags entries that suggest acute risk. Failing to raise the alert is
+// logged rather than returned: the entry is saved and the client must still
+// be shown crisis resources.
+func (s *JournalService) screen(ctx context.Context, entry *journalDomain.Entry) {
+	if s.riskScreener == nil {
+		return
+	}
+
+	flagged, err := s.riskScreener.ScreenJournalEntry(ctx, entry)
+	if err != nil {
+		log.Printf("Failed to raise safety alert for journal entry %s: %v", entry.ID, err)
+	}
+	entry.RiskFlagged = flagged
+}
+
+// getOwnEntry loads an entry for changes. Other clients' entries are treated as missing.
+func (s *JournalService) getOwnEntry(ctx context.Context, clientUserID, entryID string) (*journalDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.journalRepo.GetByID(ctx, entryID)
+	if err != nil {
+		if err == journalDomain.ErrEntryNotFound {
+			return nil, err
+		}
+		return nil, journalDomain.ErrJournalServiceUnavailable
+	}
+
+	if !entry.BelongsTo(clientUserID) {
+		return nil, journalDomain.ErrEntryNotFound
+	}
+
+	return entry, nil
+}
+
+func (s *JournalService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return journalDomain.ErrJournalServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return journalDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+func (s *JournalService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return journalDomain.ErrUnauthorizedAccess
+		}
+		return journalDomain.ErrJournalServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return journalDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	"github.com/goran/thappy/internal/domain/pagination"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type MessageService struct {
+	messageRepo  messageDomain.Repository
+	clientRepo   clientDomain.ClientRepository
+	userRepo     userDomain.UserRepository
+	riskScreener messageDomain.RiskScreener
+	events       realtimeDomain.Publisher
+}
+
+func NewMessageService(
+	messageRepo messageDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	riskScreener messageDomain.RiskScreener,
+	events realtimeDomain.Publisher,
+) *MessageService {
+	return &MessageService{
+		messageRepo:  messageRepo,
+		clientRepo:   clientRepo,
+		userRepo:     userRepo,
+		riskScreener: riskScreener,
+		events:       events,
+	}
+}
+
+// StartConversation lets a client open a conversation with their assigned
+// therapist, or a therapist with an assigned client. If the two already have
+// one it is returned instead.
+func (s *MessageService) StartConversation(ctx context.Context, userID, participantID string) (*messageDomain.Conversation, error) {
+	user, err := s.getActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientID, therapistID string
+	switch {
+	case user.IsClient():
+		clientID, therapistID = userID, participantID
+	case user.IsTherapist():
+		clientID, therapistID = participantID, userID
+	default:
+		return nil, messageDomain.ErrUnauthorizedAccess
+	}
+
+	if err := s.verifyActiveRelationship(ctx, clientID, therapistID); err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.messageRepo.GetConversationByParticipants(ctx, clientID, therapistID)
+	if err == nil {
+		return conversation, nil
+	}
+	if err != messageDomain.ErrConversationNotFound {
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	conversation, err = messageDomain.NewConversation(clientID, therapistID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", messageDomain.ErrInvalidMessageData, err)
+	}
+
+	err = s.messageRepo.CreateConversation(ctx, conversation)
+	if err == messageDomain.ErrConversationExists {
+		// Both participants started it at the same time
+		return s.messageRepo.GetConversationByParticipants(ctx, clientID, therapistID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+func (s *MessageService) ListConversations(ctx context.Context, userID string, page pagination.PageRequest) (*pagination.Page[*messageDomain.ConversationSummary], error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	conversations, err := s.messageRepo.ListConversations(ctx, userID, page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	return conversations, nil
+}
+
+// SendMessage posts a message, optionally as a reply, while the client is
+// still assigned to the therapist. Client messages are screened for signs of
+// acute risk.
+func (s *MessageService) SendMessage(ctx context.Context, senderID string, req messageDomain.SendMessageRequest) (*messageDomain.Message, error) {
+	conversation, err := s.getOwnConversation(ctx, senderID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyActiveRelationship(ctx, conversation.ClientID, conversation.TherapistID); err != nil {
+		return nil, err
+	}
+
+	var replyTo *messageDomain.Message
+	if req.ReplyToID != "" {
+		replyTo, err = s.messageRepo.GetMessageByID(ctx, req.ReplyToID)
+		if err != nil {
+			if err == messageDomain.ErrMessageNotFound {
+				return nil, err
+			}
+			return nil, messageDomain.ErrMessageServiceUnavailable
+		}
+
+		// Messages elsewhere are treated as missing
+		if replyTo.ConversationID != conversation.ID {
+			return nil, messageDomain.ErrMessageNotFound
+		}
+	}
+
+	message, err := messageDomain.NewMessage(conversation, senderID, replyTo, req.Body, req.Attachments, time.Now())
+	if err != nil {
+		if errors.Is(err, messageDomain.ErrAttachmentTooLarge) || errors.Is(err, messageDomain.ErrUnsupportedAttachmentType) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", messageDomain.ErrInvalidMessageData, err)
+	}
+
+	err = s.messageRepo.CreateMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	if senderID == conversation.ClientID {
+		s.screen(ctx, conversation.ClientID, message)
+	}
+
+	s.notifyRecipient(ctx, conversation, message)
+
+	return message, nil
+}
+
+func (s *MessageService) GetMessages(ctx context.Context, userID string, query messageDomain.MessagesQuery) (*pagination.Page[*messageDomain.Message], error) {
+	conversation, err := s.getOwnConversation(ctx, userID, query.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.messageRepo.ListMessages(ctx, conversation.ID, query.ThreadID, query.Page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	return messages, nil
+}
+
+// MarkConversationRead records read receipts for the other participant's
+// messages. It works on past conversations too, so nothing stays unread.
+func (s *MessageService) MarkConversationRead(ctx context.Context, userID, conversationID string) (int64, error) {
+	conversation, err := s.getOwnConversation(ctx, userID, conversationID)
+	if err != nil {
+		return 0, err
+	}
+
+	marked, err := s.messageRepo.MarkRead(ctx, conversation.ID, userID, time.Now())
+	if err != nil {
+		return 0, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	return marked, nil
+}
+
+func (s *MessageService) GetAttachment(ctx context.Context, userID, attachmentID string) (*messageDomain.Attachment, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.messageRepo.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		if err == messageDomain.ErrAttachmentNotFound {
+			return nil, err
+		}
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	message, err := s.messageRepo.GetMessageByID(ctx, attachment.MessageID)
+	if err != nil {
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	// Attachments in other people's conversations are treated as missing
+	if _, err := s.getOwnConversation(ctx, userID, message.ConversationID); err != nil {
+		if err == messageDomain.ErrConversationNotFound {
+			return nil, messageDomain.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// SetRetention lets the conversation's therapist choose how long its messages
+// are kept. Shortening it removes older messages on the next purge.
+func (s *MessageService) SetRetention(ctx context.Context, therapistUserID, conversationID string, days int) (*messageDomain.Conversation, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.getOwnConversation(ctx, therapistUserID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if conversation.TherapistID != therapistUserID {
+		return nil, messageDomain.ErrUnauthorizedAccess
+	}
+
+	err = conversation.SetRetention(days, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", messageDomain.ErrInvalidMessageData, err)
+	}
+
+	err = s.messageRepo.UpdateConversation(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+func (s *MessageService) PurgeExpiredMessages(ctx context.Context) (int64, error) {
+	return s.messageRepo.DeleteExpired(ctx, time.Now())
+}
+
+// screen flags messages that suggest acute risk. Failing to raise the alert
+// is logged rather than returned: the message is sent and the client must
+// still be shown crisis resources.
+func (s *MessageService) screen(ctx context.Context, clientUserID string, message *messageDomain.Message) {
+	if s.riskScreener == nil {
+		return
+	}
+
+	flagged, err := s.riskScreener.ScreenMessage(ctx, clientUserID, message)
+	if err != nil {
+		log.Printf("Failed to raise safety alert for message %s: %v", message.ID, err)
+	}
+	message.RiskFlagged = flagged
+}
+
+// notifyRecipient pushes the new message to the other participant's open
+// streams. Failing to is logged: they still see it on their next fetch.
+func (s *MessageService) notifyRecipient(ctx context.Context, conversation *messageDomain.Conversation, message *messageDomain.Message) {
+	if s.events == nil {
+		return
+	}
+
+	event, err := realtimeDomain.NewEvent(conversation.OtherParticipant(message.SenderID), realtimeDomain.EventMessageCreated, realtimeDomain.MessageCreatedData{
+		ConversationID: conversation.ID,
+		MessageID:      message.ID,
+		ThreadID:       message.ThreadID,
+		SenderID:       message.SenderID,
+	}, message.CreatedAt)
+	if err == nil {
+		err = s.events.Publish(ctx, event)
+	}
+	if err != nil {
+		log.Printf("Failed to push message %s to its recipient: %v", message.ID, err)
+	}
+}
+
+// getOwnConversation loads a conversation the user takes part in. Other
+// people's conversations are treated as missing.
+func (s *MessageService) getOwnConversation(ctx context.Context, userID, conversationID string) (*messageDomain.Conversation, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.messageRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		if err == messageDomain.ErrConversationNotFound {
+			return nil, err
+		}
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	if !conversation.HasParticipant(userID) {
+		return nil, messageDomain.ErrConversationNotFound
+	}
+
+	return conversation, nil
+}
+
+// verifyActiveRelationship checks that the client is currently assigned to the therapist
+func (s *MessageService) verifyActiveRelationship(ctx context.Context, clientUserID, therapistUserID string) error {
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return messageDomain.ErrNoActiveRelationship
+		}
+		return messageDomain.ErrMessageServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return messageDomain.ErrNoActiveRelationship
+	}
+
+	return nil
+}
+
+func (s *MessageService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.getActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasRole(role) {
+		return messageDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
+
+func (s *MessageService) getActiveUser(ctx context.Context, userID string) (*userDomain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return nil, messageDomain.ErrUnauthorizedAccess
+		}
+		return nil, messageDomain.ErrMessageServiceUnavailable
+	}
+
+	if !user.IsActive {
+		return nil, messageDomain.ErrUnauthorizedAccess
+	}
+
+	return user, nil
+}
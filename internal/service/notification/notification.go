@@ -0,0 +1,303 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	notificationDomain "github.com/goran/thappy/internal/domain/notification"
+	"github.com/goran/thappy/internal/domain/pagination"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+const (
+	// emailBatchSize is how many due emails are claimed at a time
+	emailBatchSize = 50
+	// emailLease is how long a claimed email is left to its instance before
+	// another one may send it
+	emailLease = 5 * time.Minute
+)
+
+type NotificationService struct {
+	notificationRepo notificationDomain.Repository
+	userRepo         userDomain.UserRepository
+	clientRepo       clientDomain.ClientRepository
+	renderer         notificationDomain.Renderer
+	sender           notificationDomain.Sender
+}
+
+func NewNotificationService(
+	notificationRepo notificationDomain.Repository,
+	userRepo userDomain.UserRepository,
+	clientRepo clientDomain.ClientRepository,
+	renderer notificationDomain.Renderer,
+	sender notificationDomain.Sender,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		clientRepo:       clientRepo,
+		renderer:         renderer,
+		sender:           sender,
+	}
+}
+
+// Publish notifies the event's user, so the service can sit next to the
+// realtime stream as a publisher of domain events
+func (s *NotificationService) Publish(ctx context.Context, event *realtimeDomain.Event) error {
+	var data map[string]interface{}
+	if len(event.Data) > 0 {
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("failed to decode %s event: %w", event.Type, err)
+		}
+	}
+
+	return s.Notify(ctx, event.UserID, event.Type, data)
+}
+
+// Notify writes the notification in the user's language and puts it in their
+// inbox and the email outbox, as their preferences allow. Emails that would
+// arrive during quiet hours wait until the quiet hours end.
+func (s *NotificationService) Notify(ctx context.Context, userID, eventType string, data map[string]interface{}) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return nil
+		}
+		return notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	// Deactivated accounts are not contacted
+	if !user.IsActive {
+		return nil
+	}
+
+	preferences, err := s.getPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !preferences.InAppEnabled && !preferences.EmailEnabled {
+		return nil
+	}
+
+	content, err := s.renderer.Render(eventType, s.languageFor(ctx, user, preferences), data)
+	if err != nil {
+		if errors.Is(err, notificationDomain.ErrNoTemplate) {
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+
+	if preferences.InAppEnabled {
+		notification, err := notificationDomain.NewNotification(userID, eventType, content, now)
+		if err != nil {
+			return err
+		}
+
+		if err := s.notificationRepo.CreateNotification(ctx, notification); err != nil {
+			return notificationDomain.ErrNotificationServiceUnavailable
+		}
+	}
+
+	if preferences.EmailEnabled {
+		email, err := notificationDomain.NewEmail(userID, eventType, user.Email, content, preferences.EmailSendTime(now), now)
+		if err != nil {
+			return err
+		}
+
+		if err := s.notificationRepo.CreateEmail(ctx, email); err != nil {
+			return notificationDomain.ErrNotificationServiceUnavailable
+		}
+	}
+
+	return nil
+}
+
+func (s *NotificationService) GetNotifications(ctx context.Context, userID string, query notificationDomain.InboxQuery) (*pagination.Page[*notificationDomain.Notification], error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.notificationRepo.ListNotifications(ctx, userID, query.UnreadOnly, query.Page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return notifications, nil
+}
+
+func (s *NotificationService) CountUnread(ctx context.Context, userID string) (int, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	count, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return 0, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return count, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, userID, notificationID string) error {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return err
+	}
+
+	err := s.notificationRepo.MarkRead(ctx, userID, notificationID, time.Now())
+	if err != nil {
+		if err == notificationDomain.ErrNotificationNotFound {
+			return err
+		}
+		return notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	count, err := s.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return 0, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return count, nil
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, userID string) (*notificationDomain.Preferences, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.getPreferences(ctx, userID)
+}
+
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID string, req notificationDomain.UpdatePreferencesRequest) (*notificationDomain.Preferences, error) {
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	var quietHours *notificationDomain.QuietHours
+	if req.QuietHours != nil {
+		var err error
+		quietHours, err = notificationDomain.ParseQuietHours(req.QuietHours.Start, req.QuietHours.End, req.QuietHours.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", notificationDomain.ErrInvalidPreferences, err)
+		}
+	}
+
+	preferences, err := s.getPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := preferences.Update(req.EmailEnabled, req.InAppEnabled, req.Language, quietHours, time.Now()); err != nil {
+		return nil, fmt.Errorf("%w: %v", notificationDomain.ErrInvalidPreferences, err)
+	}
+
+	if err := s.notificationRepo.SavePreferences(ctx, preferences); err != nil {
+		if err == notificationDomain.ErrInvalidPreferences {
+			return nil, err
+		}
+		return nil, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return preferences, nil
+}
+
+// DeliverPendingEmails sends due emails batch by batch until none are left.
+// Failed emails are put back with a delay, so one bad address does not hold
+// up the rest.
+func (s *NotificationService) DeliverPendingEmails(ctx context.Context) (int, error) {
+	sent := 0
+
+	for {
+		emails, err := s.notificationRepo.ClaimDueEmails(ctx, time.Now(), emailLease, emailBatchSize)
+		if err != nil {
+			return sent, notificationDomain.ErrNotificationServiceUnavailable
+		}
+
+		for _, email := range emails {
+			if err := s.sender.Send(ctx, email); err != nil {
+				log.Printf("Failed to send email %s (attempt %d): %v", email.ID, email.Attempts+1, err)
+				email.MarkFailed(err, time.Now())
+			} else {
+				email.MarkSent(time.Now())
+				sent++
+			}
+
+			if err := s.notificationRepo.UpdateEmail(ctx, email); err != nil {
+				if errors.Is(err, notificationDomain.ErrEmailClaimLost) {
+					log.Printf("Email %s was claimed again while it was being sent, its outcome is not recorded", email.ID)
+				} else {
+					log.Printf("Failed to record delivery of email %s: %v", email.ID, err)
+				}
+			}
+		}
+
+		if len(emails) < emailBatchSize || ctx.Err() != nil {
+			return sent, nil
+		}
+	}
+}
+
+// getPreferences returns the user's saved preferences or the defaults
+func (s *NotificationService) getPreferences(ctx context.Context, userID string) (*notificationDomain.Preferences, error) {
+	preferences, err := s.notificationRepo.GetPreferences(ctx, userID)
+	if err == notificationDomain.ErrPreferencesNotFound {
+		return notificationDomain.DefaultPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	return preferences, nil
+}
+
+// languageFor picks the language chosen for notifications, then the client's
+// preferred session language, then the default
+func (s *NotificationService) languageFor(ctx context.Context, user *userDomain.User, preferences *notificationDomain.Preferences) string {
+	if preferences.Language != nil {
+		return *preferences.Language
+	}
+
+	if user.IsClient() {
+		profile, err := s.clientRepo.GetByUserID(ctx, user.ID)
+		if err == nil && profile.PreferredLanguage != nil {
+			return *profile.PreferredLanguage
+		}
+	}
+
+	return notificationDomain.DefaultLanguage
+}
+
+func (s *NotificationService) getActiveUser(ctx context.Context, userID string) (*userDomain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return nil, notificationDomain.ErrUnauthorizedAccess
+		}
+		return nil, notificationDomain.ErrNotificationServiceUnavailable
+	}
+
+	if !user.IsActive {
+		return nil, notificationDomain.ErrUnauthorizedAccess
+	}
+
+	return user, nil
+}
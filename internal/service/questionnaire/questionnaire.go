@@ -0,0 +1,249 @@
+package questionnaire
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	questionnaireDomain "github.com/goran/thappy/internal/domain/questionnaire"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type QuestionnaireService struct {
+	questionnaireRepo questionnaireDomain.Repository
+	clientRepo        clientDomain.ClientRepository
+	userRepo          userDomain.UserRepository
+}
+
+func NewQuestionnaireService(
+	questionnaireRepo questionnaireDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+) *QuestionnaireService {
+	return &QuestionnaireService{
+		questionnaireRepo: questionnaireRepo,
+		clientRepo:        clientRepo,
+		userRepo:          userRepo,
+	}
+}
+
+func (s *QuestionnaireService) CreateQuestionnaire(ctx context.Context, therapistUserID string, req questionnaireDomain.QuestionnaireRequest) (*questionnaireDomain.Questionnaire, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	questionnaire, err := questionnaireDomain.NewQuestionnaire(therapistUserID, req.Title, req.Description, req.Questions)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", questionnaireDomain.ErrInvalidQuestionnaireData, err)
+	}
+
+	err = s.questionnaireRepo.Create(ctx, questionnaire)
+	if err != nil {
+		return nil, err
+	}
+
+	return questionnaire, nil
+}
+
+// UpdateQuestionnaire publishes a new version of the form. Responses to earlier
+// versions keep their questions.
+func (s *QuestionnaireService) UpdateQuestionnaire(ctx context.Context, therapistUserID, questionnaireID string, req questionnaireDomain.QuestionnaireRequest) (*questionnaireDomain.Questionnaire, error) {
+	questionnaire, err := s.getOwnQuestionnaire(ctx, therapistUserID, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = questionnaire.Revise(req.Title, req.Description, req.Questions, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", questionnaireDomain.ErrInvalidQuestionnaireData, err)
+	}
+
+	err = s.questionnaireRepo.Update(ctx, questionnaire)
+	if err != nil {
+		return nil, err
+	}
+
+	return questionnaire, nil
+}
+
+func (s *QuestionnaireService) SetQuestionnaireActive(ctx context.Context, therapistUserID, questionnaireID string, active bool) (*questionnaireDomain.Questionnaire, error) {
+	questionnaire, err := s.getOwnQuestionnaire(ctx, therapistUserID, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+
+	questionnaire.SetActive(active, time.Now())
+
+	err = s.questionnaireRepo.Update(ctx, questionnaire)
+	if err != nil {
+		return nil, err
+	}
+
+	return questionnaire, nil
+}
+
+func (s *QuestionnaireService) GetTherapistQuestionnaires(ctx context.Context, therapistUserID string) ([]*questionnaireDomain.Questionnaire, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	questionnaires, err := s.questionnaireRepo.GetByTherapistID(ctx, therapistUserID, false)
+	if err != nil {
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	return questionnaires, nil
+}
+
+// GetClientResponses shows a therapist what a client currently assigned to them
+// submitted to the therapist's own questionnaires
+func (s *QuestionnaireService) GetClientResponses(ctx context.Context, therapistUserID, clientUserID string) ([]*questionnaireDomain.AnsweredQuestionnaire, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	client, err := s.getClientProfile(ctx, clientUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return nil, questionnaireDomain.ErrClientNotAssigned
+	}
+
+	responses, err := s.questionnaireRepo.GetResponses(ctx, clientUserID, therapistUserID)
+	if err != nil {
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	return responses, nil
+}
+
+// GetAssignedQuestionnaires lists the active forms of the client's therapist
+func (s *QuestionnaireService) GetAssignedQuestionnaires(ctx context.Context, clientUserID string) ([]*questionnaireDomain.Questionnaire, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	client, err := s.getClientProfile(ctx, clientUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.TherapistID == nil {
+		return []*questionnaireDomain.Questionnaire{}, nil
+	}
+
+	questionnaires, err := s.questionnaireRepo.GetByTherapistID(ctx, *client.TherapistID, true)
+	if err != nil {
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	return questionnaires, nil
+}
+
+func (s *QuestionnaireService) SubmitResponse(ctx context.Context, clientUserID string, req questionnaireDomain.SubmitResponseRequest) (*questionnaireDomain.Response, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	client, err := s.getClientProfile(ctx, clientUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	questionnaire, err := s.questionnaireRepo.GetByID(ctx, req.QuestionnaireID)
+	if err != nil {
+		if err == questionnaireDomain.ErrQuestionnaireNotFound {
+			return nil, err
+		}
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	// Forms of other therapists are treated as missing
+	if client.TherapistID == nil || !questionnaire.OwnedBy(*client.TherapistID) {
+		return nil, questionnaireDomain.ErrQuestionnaireNotFound
+	}
+
+	if !questionnaire.IsActive {
+		return nil, questionnaireDomain.ErrQuestionnaireInactive
+	}
+
+	if req.Version != questionnaire.Version {
+		return nil, questionnaireDomain.ErrOutdatedVersion
+	}
+
+	response, err := questionnaireDomain.NewResponse(questionnaire, clientUserID, req.Answers)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", questionnaireDomain.ErrInvalidResponseData, err)
+	}
+
+	err = s.questionnaireRepo.CreateResponse(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *QuestionnaireService) GetOwnResponses(ctx context.Context, clientUserID string) ([]*questionnaireDomain.AnsweredQuestionnaire, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	responses, err := s.questionnaireRepo.GetResponses(ctx, clientUserID, "")
+	if err != nil {
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	return responses, nil
+}
+
+func (s *QuestionnaireService) getOwnQuestionnaire(ctx context.Context, therapistUserID, questionnaireID string) (*questionnaireDomain.Questionnaire, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	questionnaire, err := s.questionnaireRepo.GetByID(ctx, questionnaireID)
+	if err != nil {
+		if err == questionnaireDomain.ErrQuestionnaireNotFound {
+			return nil, err
+		}
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	if !questionnaire.OwnedBy(therapistUserID) {
+		return nil, questionnaireDomain.ErrUnauthorizedAccess
+	}
+
+	return questionnaire, nil
+}
+
+func (s *QuestionnaireService) getClientProfile(ctx context.Context, clientUserID string) (*clientDomain.ClientProfile, error) {
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return nil, err
+		}
+		return nil, questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	return client, nil
+}
+
+func (s *QuestionnaireService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return questionnaireDomain.ErrUnauthorizedAccess
+		}
+		return questionnaireDomain.ErrQuestionnaireServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return questionnaireDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	reminderDomain "github.com/goran/thappy/internal/domain/reminder"
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+)
+
+type ReminderService struct {
+	scheduler schedulerDomain.Service
+	notifier  reminderDomain.Notifier
+	offsets   []time.Duration
+}
+
+func NewReminderService(
+	scheduler schedulerDomain.Service,
+	notifier reminderDomain.Notifier,
+	offsets []time.Duration,
+) *ReminderService {
+	return &ReminderService{
+		scheduler: scheduler,
+		notifier:  notifier,
+		offsets:   offsets,
+	}
+}
+
+// ScheduleReminders schedules a reminder for each configured offset before
+// the appointment, replacing any not sent yet. Calling it again after the
+// appointment moved reschedules them.
+func (s *ReminderService) ScheduleReminders(ctx context.Context, appointment reminderDomain.Appointment) error {
+	if err := appointment.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", reminderDomain.ErrInvalidAppointment, err)
+	}
+
+	now := time.Now()
+	key := reminderDomain.JobKey(appointment.ID)
+
+	var jobs []*schedulerDomain.Job
+	for _, reminder := range reminderDomain.Plan(appointment, s.offsets, now) {
+		job, err := schedulerDomain.NewJob(reminderDomain.JobKind, key, reminder, reminder.SendAt, now)
+		if err != nil {
+			return fmt.Errorf("%w: %v", reminderDomain.ErrInvalidAppointment, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := s.scheduler.Schedule(ctx, key, jobs); err != nil {
+		return reminderDomain.ErrReminderServiceUnavailable
+	}
+
+	return nil
+}
+
+// CancelReminders drops the reminders of a cancelled appointment
+func (s *ReminderService) CancelReminders(ctx context.Context, appointmentID string) error {
+	if strings.TrimSpace(appointmentID) == "" {
+		return fmt.Errorf("%w: appointment ID is required", reminderDomain.ErrInvalidAppointment)
+	}
+
+	if _, err := s.scheduler.Cancel(ctx, reminderDomain.JobKey(appointmentID)); err != nil {
+		return reminderDomain.ErrReminderServiceUnavailable
+	}
+
+	return nil
+}
+
+// Handle sends one reminder when its job comes due. Reminders for
+// appointments that have already started are dropped, since a job can run
+// late after an outage.
+func (s *ReminderService) Handle(ctx context.Context, job *schedulerDomain.Job) error {
+	var reminder reminderDomain.Reminder
+	if err := job.DecodePayload(&reminder); err != nil {
+		return fmt.Errorf("%w: %v", schedulerDomain.ErrPermanent, err)
+	}
+
+	if !time.Now().Before(reminder.StartsAt) {
+		return nil
+	}
+
+	return s.notifier.Notify(ctx, reminder.UserID, reminderDomain.EventAppointmentReminder, reminder.TemplateData())
+}
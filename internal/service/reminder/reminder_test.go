@@ -0,0 +1,121 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	reminderDomain "github.com/goran/thappy/internal/domain/reminder"
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+)
+
+// MockScheduler is a mock implementation of schedulerDomain.Service
+type MockScheduler struct {
+	jobs      map[string][]*schedulerDomain.Job
+	cancelled []string
+}
+
+func NewMockScheduler() *MockScheduler {
+	return &MockScheduler{
+		jobs: make(map[string][]*schedulerDomain.Job),
+	}
+}
+
+func (m *MockScheduler) Schedule(ctx context.Context, key string, jobs []*schedulerDomain.Job) error {
+	m.jobs[key] = jobs
+	return nil
+}
+
+func (m *MockScheduler) Cancel(ctx context.Context, key string) (int64, error) {
+	cancelled := int64(len(m.jobs[key]))
+	delete(m.jobs, key)
+	m.cancelled = append(m.cancelled, key)
+	return cancelled, nil
+}
+
+func (m *MockScheduler) RunDueJobs(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+// MockNotifier records the notifications it is asked to send
+type MockNotifier struct {
+	userIDs    []string
+	eventTypes []string
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, userID, eventType string, data map[string]interface{}) error {
+	m.userIDs = append(m.userIDs, userID)
+	m.eventTypes = append(m.eventTypes, eventType)
+	return nil
+}
+
+func TestReminderService_ScheduleReminders(t *testing.T) {
+	scheduler := NewMockScheduler()
+	service := NewReminderService(scheduler, &MockNotifier{}, reminderDomain.DefaultOffsets)
+
+	startsAt := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	appointment := reminderDomain.Appointment{ID: "appointment-123", ClientID: "client-123", StartsAt: startsAt}
+
+	if err := service.ScheduleReminders(context.Background(), appointment); err != nil {
+		t.Fatalf("ScheduleReminders() unexpected error = %v", err)
+	}
+
+	jobs := scheduler.jobs[reminderDomain.JobKey("appointment-123")]
+	if len(jobs) != len(reminderDomain.DefaultOffsets) {
+		t.Fatalf("ScheduleReminders() scheduled %d jobs, want one per offset", len(jobs))
+	}
+	for i, offset := range reminderDomain.DefaultOffsets {
+		if jobs[i].Kind != reminderDomain.JobKind || !jobs[i].RunAt.Equal(startsAt.Add(-offset)) {
+			t.Errorf("Unexpected job %d: kind %s at %v", i, jobs[i].Kind, jobs[i].RunAt)
+		}
+	}
+
+	// Moving the appointment replaces the reminders under the same key
+	appointment.StartsAt = startsAt.Add(24 * time.Hour)
+	if err := service.ScheduleReminders(context.Background(), appointment); err != nil {
+		t.Fatalf("ScheduleReminders() unexpected error = %v", err)
+	}
+	if jobs := scheduler.jobs[reminderDomain.JobKey("appointment-123")]; !jobs[0].RunAt.Equal(startsAt) {
+		t.Errorf("Expected the rescheduled first reminder at %v, got %v", startsAt, jobs[0].RunAt)
+	}
+
+	if err := service.CancelReminders(context.Background(), "appointment-123"); err != nil {
+		t.Fatalf("CancelReminders() unexpected error = %v", err)
+	}
+	if len(scheduler.cancelled) != 1 || scheduler.cancelled[0] != reminderDomain.JobKey("appointment-123") {
+		t.Errorf("CancelReminders() cancelled %v", scheduler.cancelled)
+	}
+
+	invalid := reminderDomain.Appointment{ID: "appointment-123", StartsAt: startsAt}
+	if err := service.ScheduleReminders(context.Background(), invalid); !errors.Is(err, reminderDomain.ErrInvalidAppointment) {
+		t.Errorf("ScheduleReminders() error = %v, want %v", err, reminderDomain.ErrInvalidAppointment)
+	}
+}
+
+func TestReminderService_Handle(t *testing.T) {
+	notifier := &MockNotifier{}
+	service := NewReminderService(NewMockScheduler(), notifier, reminderDomain.DefaultOffsets)
+
+	now := time.Now()
+	upcoming := reminderDomain.Reminder{AppointmentID: "appointment-123", UserID: "client-123", StartsAt: now.Add(time.Hour), SendAt: now}
+	job, _ := schedulerDomain.NewJob(reminderDomain.JobKind, reminderDomain.JobKey("appointment-123"), upcoming, now, now)
+
+	if err := service.Handle(context.Background(), job); err != nil {
+		t.Fatalf("Handle() unexpected error = %v", err)
+	}
+	if len(notifier.userIDs) != 1 || notifier.userIDs[0] != "client-123" || notifier.eventTypes[0] != reminderDomain.EventAppointmentReminder {
+		t.Errorf("Handle() notified %v with %v", notifier.userIDs, notifier.eventTypes)
+	}
+
+	// A reminder that runs late, after the appointment started, is dropped
+	started := upcoming
+	started.StartsAt = now.Add(-time.Minute)
+	late, _ := schedulerDomain.NewJob(reminderDomain.JobKind, reminderDomain.JobKey("appointment-123"), started, now, now)
+	if err := service.Handle(context.Background(), late); err != nil {
+		t.Fatalf("Handle() unexpected error = %v", err)
+	}
+	if len(notifier.userIDs) != 1 {
+		t.Error("Handle() must not remind of an appointment that already started")
+	}
+}
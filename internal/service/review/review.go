@@ -0,0 +1,327 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goran/thappy/internal/domain/pagination"
+	reviewDomain "github.com/goran/thappy/internal/domain/review"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type ReviewService struct {
+	reviewRepo    reviewDomain.Repository
+	therapistRepo therapistDomain.TherapistRepository
+	userRepo      userDomain.UserRepository
+}
+
+func NewReviewService(
+	reviewRepo reviewDomain.Repository,
+	therapistRepo therapistDomain.TherapistRepository,
+	userRepo userDomain.UserRepository,
+) *ReviewService {
+	return &ReviewService{
+		reviewRepo:    reviewRepo,
+		therapistRepo: therapistRepo,
+		userRepo:      userRepo,
+	}
+}
+
+func (s *ReviewService) CreateReview(ctx context.Context, clientUserID string, req reviewDomain.CreateReviewRequest) (*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.therapistRepo.ExistsByUserID(ctx, req.TherapistID)
+	if err != nil {
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+	if !exists {
+		return nil, therapistDomain.ErrTherapistProfileNotFound
+	}
+
+	// Only clients who are or were assigned to the therapist can review them
+	related, err := s.reviewRepo.HasRelationship(ctx, clientUserID, req.TherapistID)
+	if err != nil {
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+	if !related {
+		return nil, reviewDomain.ErrNoRelationship
+	}
+
+	review, err := reviewDomain.NewReview(req.TherapistID, clientUserID, req.Rating, req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", reviewDomain.ErrInvalidReviewData, err)
+	}
+
+	err = s.reviewRepo.Create(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *ReviewService) UpdateReview(ctx context.Context, clientUserID, reviewID string, req reviewDomain.UpdateReviewRequest) (*reviewDomain.Review, error) {
+	review, err := s.getClientReview(ctx, clientUserID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = review.Edit(req.Rating, req.Body)
+	if err != nil {
+		if err == reviewDomain.ErrInvalidStatusTransition {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", reviewDomain.ErrInvalidReviewData, err)
+	}
+
+	err = s.reviewRepo.Update(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *ReviewService) DeleteReview(ctx context.Context, clientUserID, reviewID string) error {
+	review, err := s.getClientReview(ctx, clientUserID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	return s.reviewRepo.Delete(ctx, review.ID)
+}
+
+func (s *ReviewService) GetClientReviews(ctx context.Context, clientUserID string) ([]*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.reviewRepo.GetByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+
+	return reviews, nil
+}
+
+func (s *ReviewService) GetTherapistReviews(ctx context.Context, therapistUserID string, page pagination.PageRequest) (*pagination.Page[*reviewDomain.Review], error) {
+	exists, err := s.therapistRepo.ExistsByUserID(ctx, therapistUserID)
+	if err != nil {
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+	if !exists {
+		return nil, therapistDomain.ErrTherapistProfileNotFound
+	}
+
+	reviews, err := s.reviewRepo.GetVisibleByTherapistID(ctx, therapistUserID, page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+
+	return reviews, nil
+}
+
+// ReportReview records a complaint and moves the review into the moderation queue.
+// Reviews stay visible while flagged; only an admin can hide them.
+func (s *ReviewService) ReportReview(ctx context.Context, reporterUserID, reviewID, reason string) (*reviewDomain.Review, error) {
+	if err := s.verifyActive(ctx, reporterUserID); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !review.IsVisible() {
+		return nil, reviewDomain.ErrReviewNotFound
+	}
+
+	if review.WrittenBy(reporterUserID) {
+		return nil, fmt.Errorf("%w: you cannot report your own review", reviewDomain.ErrInvalidReviewData)
+	}
+
+	report, err := reviewDomain.NewReport(review.ID, reporterUserID, reason)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", reviewDomain.ErrInvalidReviewData, err)
+	}
+
+	err = s.reviewRepo.AddReport(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.Status == reviewDomain.StatusPublished {
+		review.Flag(time.Now())
+		err = s.reviewRepo.Update(ctx, review)
+		if err != nil {
+			return nil, err
+		}
+	}
+	review.OpenReports++
+
+	return review, nil
+}
+
+func (s *ReviewService) ReplyToReview(ctx context.Context, therapistUserID, reviewID, reply string) (*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !review.IsAbout(therapistUserID) {
+		return nil, reviewDomain.ErrReviewNotFound
+	}
+
+	err = review.SetReply(reply, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", reviewDomain.ErrInvalidReviewData, err)
+	}
+
+	err = s.reviewRepo.Update(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *ReviewService) GetModerationQueue(ctx context.Context, adminUserID string, page pagination.PageRequest) (*pagination.Page[*reviewDomain.ModerationItem], error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	flagged, err := s.reviewRepo.GetFlagged(ctx, page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, reviewDomain.ErrReviewServiceUnavailable
+	}
+
+	items := make([]*reviewDomain.ModerationItem, 0, len(flagged.Items))
+	for _, review := range flagged.Items {
+		reports, err := s.reviewRepo.GetOpenReports(ctx, review.ID)
+		if err != nil {
+			return nil, reviewDomain.ErrReviewServiceUnavailable
+		}
+		items = append(items, &reviewDomain.ModerationItem{Review: review, Reports: reports})
+	}
+
+	return &pagination.Page[*reviewDomain.ModerationItem]{
+		Items:      items,
+		NextCursor: flagged.NextCursor,
+	}, nil
+}
+
+func (s *ReviewService) HideReview(ctx context.Context, adminUserID, reviewID, reason string) (*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = review.Hide(adminUserID, reason, time.Now())
+	if err != nil {
+		if err == reviewDomain.ErrInvalidStatusTransition {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", reviewDomain.ErrInvalidReviewData, err)
+	}
+
+	err = s.reviewRepo.Update(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	review.OpenReports = 0
+
+	return review, nil
+}
+
+func (s *ReviewService) RestoreReview(ctx context.Context, adminUserID, reviewID string) (*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = review.Restore(adminUserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reviewRepo.Update(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	review.OpenReports = 0
+
+	return review, nil
+}
+
+func (s *ReviewService) getClientReview(ctx context.Context, clientUserID, reviewID string) (*reviewDomain.Review, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !review.WrittenBy(clientUserID) {
+		return nil, reviewDomain.ErrReviewNotFound
+	}
+
+	return review, nil
+}
+
+func (s *ReviewService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return reviewDomain.ErrUnauthorizedAccess
+		}
+		return reviewDomain.ErrReviewServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return reviewDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
+
+func (s *ReviewService) verifyActive(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return reviewDomain.ErrUnauthorizedAccess
+		}
+		return reviewDomain.ErrReviewServiceUnavailable
+	}
+
+	if !user.IsActive {
+		return reviewDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
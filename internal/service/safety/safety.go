@@ -0,0 +1,294 @@
+package safety
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	assessmentDomain "github.com/goran/thappy/internal/domain/assessment"
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	journalDomain "github.com/goran/thappy/internal/domain/journal"
+	messageDomain "github.com/goran/thappy/internal/domain/message"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type SafetyService struct {
+	safetyRepo safetyDomain.Repository
+	clientRepo clientDomain.ClientRepository
+	userRepo   userDomain.UserRepository
+	detector   *safetyDomain.Detector
+	events     realtimeDomain.Publisher
+}
+
+func NewSafetyService(
+	safetyRepo safetyDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	detector *safetyDomain.Detector,
+	events realtimeDomain.Publisher,
+) *SafetyService {
+	return &SafetyService{
+		safetyRepo: safetyRepo,
+		clientRepo: clientRepo,
+		userRepo:   userRepo,
+		detector:   detector,
+		events:     events,
+	}
+}
+
+// ScreenJournalEntry raises an alert when a client's entry suggests acute
+// risk. It reports the risk even when the alert could not be stored, so the
+// client is still shown crisis resources.
+func (s *SafetyService) ScreenJournalEntry(ctx context.Context, entry *journalDomain.Entry) (bool, error) {
+	signals := s.detector.ScanText(entry.Text)
+	if len(signals) == 0 {
+		return false, nil
+	}
+
+	return true, s.raiseAlert(ctx, entry.ClientID, safetyDomain.SourceJournalEntry, entry.ID, signals)
+}
+
+// ScreenMessage raises an alert when a message the client sent their
+// therapist suggests acute risk
+func (s *SafetyService) ScreenMessage(ctx context.Context, clientUserID string, message *messageDomain.Message) (bool, error) {
+	signals := s.detector.ScanText(message.Body)
+	if len(signals) == 0 {
+		return false, nil
+	}
+
+	return true, s.raiseAlert(ctx, clientUserID, safetyDomain.SourceMessage, message.ID, signals)
+}
+
+// ScreenAssessment raises an alert when a completed assessment endorses one of
+// the instrument's critical items
+func (s *SafetyService) ScreenAssessment(ctx context.Context, assessment *assessmentDomain.Assessment) (bool, error) {
+	if assessment.Score == nil || !assessment.Score.HasCriticalItems() {
+		return false, nil
+	}
+
+	instrument, ok := assessmentDomain.GetInstrument(assessment.InstrumentCode)
+	if !ok {
+		return false, assessmentDomain.ErrUnknownInstrument
+	}
+
+	var signals []safetyDomain.Signal
+	for _, critical := range instrument.CriticalItems {
+		response := assessment.Responses[critical.Item-1]
+		if response >= critical.MinResponse {
+			signals = append(signals, safetyDomain.CriticalItemSignal(instrument.Code, critical.Item, response, critical.MinResponse, critical.Reason))
+		}
+	}
+
+	if len(signals) == 0 {
+		return false, nil
+	}
+
+	return true, s.raiseAlert(ctx, assessment.ClientID, safetyDomain.SourceAssessment, assessment.ID, signals)
+}
+
+func (s *SafetyService) GetCrisisResources(language string) []safetyDomain.CrisisResource {
+	return safetyDomain.CrisisResourcesFor(language)
+}
+
+// GetClientCrisisResources never fails: a client in crisis gets the default
+// resources if their profile cannot be loaded
+func (s *SafetyService) GetClientCrisisResources(ctx context.Context, clientUserID string) []safetyDomain.CrisisResource {
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil || client.PreferredLanguage == nil {
+		return safetyDomain.CrisisResourcesFor(safetyDomain.DefaultResourceLanguage)
+	}
+
+	return safetyDomain.CrisisResourcesFor(*client.PreferredLanguage)
+}
+
+func (s *SafetyService) GetTherapistAlerts(ctx context.Context, therapistUserID string, openOnly bool) ([]*safetyDomain.Alert, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.safetyRepo.GetByTherapistID(ctx, therapistUserID, openOnly)
+	if err != nil {
+		return nil, safetyDomain.ErrSafetyServiceUnavailable
+	}
+
+	return alerts, nil
+}
+
+// AcknowledgeAlert records that the therapist the alert went to, or an admin,
+// has taken responsibility for it. Other therapists' alerts are treated as missing.
+func (s *SafetyService) AcknowledgeAlert(ctx context.Context, userID, alertID, note string) (*safetyDomain.Alert, error) {
+	user, err := s.getActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsTherapist() && !user.IsAdmin() {
+		return nil, safetyDomain.ErrUnauthorizedAccess
+	}
+
+	alert, err := s.safetyRepo.GetByID(ctx, alertID)
+	if err != nil {
+		if err == safetyDomain.ErrAlertNotFound {
+			return nil, err
+		}
+		return nil, safetyDomain.ErrSafetyServiceUnavailable
+	}
+
+	if !user.IsAdmin() && !alert.AssignedTo(userID) {
+		return nil, safetyDomain.ErrAlertNotFound
+	}
+
+	err = alert.Acknowledge(userID, note, time.Now())
+	if err != nil {
+		if err == safetyDomain.ErrAlertAlreadyAcknowledged {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", safetyDomain.ErrInvalidAlertData, err)
+	}
+
+	err = s.safetyRepo.Update(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+func (s *SafetyService) GetEscalatedAlerts(ctx context.Context, adminUserID string, openOnly bool) ([]*safetyDomain.Alert, error) {
+	if err := s.verifyRole(ctx, adminUserID, userDomain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.safetyRepo.GetEscalated(ctx, openOnly)
+	if err != nil {
+		return nil, safetyDomain.ErrSafetyServiceUnavailable
+	}
+
+	return alerts, nil
+}
+
+func (s *SafetyService) EscalateOverdueAlerts(ctx context.Context) (int64, error) {
+	alerts, err := s.safetyRepo.EscalateOverdue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, alert := range alerts {
+		s.notifyAdmins(ctx, alert)
+	}
+
+	return int64(len(alerts)), nil
+}
+
+// raiseAlert creates an alert for the client's current therapist, unless one
+// is still open for the same source, e.g. when a flagged entry is edited
+func (s *SafetyService) raiseAlert(ctx context.Context, clientUserID string, source safetyDomain.Source, sourceID string, signals []safetyDomain.Signal) error {
+	open, err := s.safetyRepo.HasOpenForSource(ctx, source, sourceID)
+	if err != nil {
+		return err
+	}
+	if open {
+		return nil
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		return err
+	}
+
+	therapistID := ""
+	if client.TherapistID != nil {
+		therapistID = *client.TherapistID
+	}
+
+	alert, err := safetyDomain.NewAlert(clientUserID, therapistID, source, sourceID, signals, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %v", safetyDomain.ErrInvalidAlertData, err)
+	}
+
+	if err := s.safetyRepo.Create(ctx, alert); err != nil {
+		return err
+	}
+
+	if alert.TherapistID != "" {
+		s.pushEvent(ctx, alert.TherapistID, realtimeDomain.EventSafetyAlertRaised, alert)
+	}
+
+	// Clients without a therapist are escalated straight away
+	if alert.IsEscalated() {
+		s.notifyAdmins(ctx, alert)
+	}
+
+	return nil
+}
+
+// notifyAdmins tells every active admin that the alert was escalated to them
+func (s *SafetyService) notifyAdmins(ctx context.Context, alert *safetyDomain.Alert) {
+	if s.events == nil {
+		return
+	}
+
+	admins, err := s.userRepo.GetActiveUsersByRole(ctx, userDomain.RoleAdmin)
+	if err != nil {
+		log.Printf("Failed to load admins to notify of escalated alert %s: %v", alert.ID, err)
+		return
+	}
+
+	for _, admin := range admins {
+		s.pushEvent(ctx, admin.ID, realtimeDomain.EventSafetyAlertEscalated, alert)
+	}
+}
+
+// pushEvent tells the user about the alert through their open streams and
+// notifications. Failing to is logged: the alert stands either way and is
+// listed in the alert endpoints.
+func (s *SafetyService) pushEvent(ctx context.Context, userID, eventType string, alert *safetyDomain.Alert) {
+	if s.events == nil {
+		return
+	}
+
+	event, err := realtimeDomain.NewEvent(userID, eventType, realtimeDomain.SafetyAlertData{
+		AlertID:  alert.ID,
+		ClientID: alert.ClientID,
+		Severity: string(alert.Severity),
+		Source:   string(alert.Source),
+	}, time.Now())
+	if err == nil {
+		err = s.events.Publish(ctx, event)
+	}
+	if err != nil {
+		log.Printf("Failed to push %s event to user %s: %v", eventType, userID, err)
+	}
+}
+
+func (s *SafetyService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.getActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasRole(role) {
+		return safetyDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
+
+func (s *SafetyService) getActiveUser(ctx context.Context, userID string) (*userDomain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return nil, safetyDomain.ErrUnauthorizedAccess
+		}
+		return nil, safetyDomain.ErrSafetyServiceUnavailable
+	}
+
+	if !user.IsActive {
+		return nil, safetyDomain.ErrUnauthorizedAccess
+	}
+
+	return user, nil
+}
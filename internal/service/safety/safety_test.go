@@ -0,0 +1,211 @@
+package safety
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	"github.com/goran/thappy/internal/domain/pagination"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	safetyDomain "github.com/goran/thappy/internal/domain/safety"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+// MockSafetyRepository is a mock implementation of safetyDomain.Repository
+type MockSafetyRepository struct {
+	alerts  map[string]*safetyDomain.Alert
+	updated []string
+}
+
+func NewMockSafetyRepository() *MockSafetyRepository {
+	return &MockSafetyRepository{
+		alerts: make(map[string]*safetyDomain.Alert),
+	}
+}
+
+func (m *MockSafetyRepository) Create(ctx context.Context, alert *safetyDomain.Alert) error {
+	m.alerts[alert.ID] = alert
+	return nil
+}
+
+func (m *MockSafetyRepository) GetByID(ctx context.Context, id string) (*safetyDomain.Alert, error) {
+	alert, exists := m.alerts[id]
+	if !exists {
+		return nil, safetyDomain.ErrAlertNotFound
+	}
+	return alert, nil
+}
+
+func (m *MockSafetyRepository) Update(ctx context.Context, alert *safetyDomain.Alert) error {
+	m.alerts[alert.ID] = alert
+	m.updated = append(m.updated, alert.ID)
+	return nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockSafetyRepository) HasOpenForSource(ctx context.Context, source safetyDomain.Source, sourceID string) (bool, error) {
+	return false, nil
+}
+func (m *MockSafetyRepository) GetByTherapistID(ctx context.Context, therapistID string, openOnly bool) ([]*safetyDomain.Alert, error) {
+	return nil, nil
+}
+func (m *MockSafetyRepository) GetEscalated(ctx context.Context, openOnly bool) ([]*safetyDomain.Alert, error) {
+	return nil, nil
+}
+func (m *MockSafetyRepository) EscalateOverdue(ctx context.Context, now time.Time) ([]*safetyDomain.Alert, error) {
+	return nil, nil
+}
+
+// MockClientRepository is a simplified mock of clientDomain.ClientRepository
+type MockClientRepository struct {
+	profiles map[string]*clientDomain.ClientProfile
+}
+
+func NewMockClientRepository() *MockClientRepository {
+	return &MockClientRepository{
+		profiles: make(map[string]*clientDomain.ClientProfile),
+	}
+}
+
+func (m *MockClientRepository) GetByUserID(ctx context.Context, userID string) (*clientDomain.ClientProfile, error) {
+	profile, exists := m.profiles[userID]
+	if !exists {
+		return nil, clientDomain.ErrClientProfileNotFound
+	}
+	return profile, nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockClientRepository) Create(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	return nil
+}
+func (m *MockClientRepository) Update(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	return nil
+}
+func (m *MockClientRepository) Delete(ctx context.Context, userID string) error { return nil }
+func (m *MockClientRepository) GetByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	return &pagination.Page[*clientDomain.ClientProfile]{}, nil
+}
+func (m *MockClientRepository) GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	return &pagination.Page[*clientDomain.ClientProfile]{}, nil
+}
+func (m *MockClientRepository) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
+	_, exists := m.profiles[userID]
+	return exists, nil
+}
+
+// MockUserRepository is a simplified mock for testing
+type MockUserRepository struct {
+	users map[string]*userDomain.User
+}
+
+func NewMockUserRepository() *MockUserRepository {
+	return &MockUserRepository{
+		users: make(map[string]*userDomain.User),
+	}
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id string) (*userDomain.User, error) {
+	user, exists := m.users[id]
+	if !exists {
+		return nil, userDomain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockUserRepository) Create(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) Update(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepository) Delete(ctx context.Context, id string) error             { return nil }
+func (m *MockUserRepository) GetByRole(ctx context.Context, role userDomain.UserRole) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) GetActiveUsers(ctx context.Context) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) GetActiveUsersByRole(ctx context.Context, role userDomain.UserRole) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+// MockPublisher records the events it is asked to publish
+type MockPublisher struct {
+	events []*realtimeDomain.Event
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, event *realtimeDomain.Event) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+// setupSafetyService registers two therapists and a client assigned to therapist-123
+func setupSafetyService(t *testing.T) (*SafetyService, *MockSafetyRepository, *MockPublisher) {
+	t.Helper()
+
+	userRepo := NewMockUserRepository()
+	clientRepo := NewMockClientRepository()
+	safetyRepo := NewMockSafetyRepository()
+	events := &MockPublisher{}
+
+	for _, id := range []string{"therapist-123", "therapist-456"} {
+		therapist, _ := userDomain.NewUserWithRole(id+"@example.com", "password123", userDomain.RoleTherapist)
+		therapist.ID = id
+		userRepo.users[therapist.ID] = therapist
+	}
+
+	profile, _ := clientDomain.NewClientProfile("client-123", "John", "Doe")
+	therapistID := "therapist-123"
+	profile.TherapistID = &therapistID
+	clientRepo.profiles[profile.UserID] = profile
+
+	return NewSafetyService(safetyRepo, clientRepo, userRepo, safetyDomain.DefaultDetector(), events), safetyRepo, events
+}
+
+func TestSafetyService_AcknowledgeAlert(t *testing.T) {
+	service, safetyRepo, events := setupSafetyService(t)
+
+	signals := []safetyDomain.Signal{{Category: safetyDomain.CategorySuicide, Severity: safetyDomain.SeverityCritical, Indicator: "kill myself"}}
+	if err := service.raiseAlert(context.Background(), "client-123", safetyDomain.SourceJournalEntry, "entry-123", signals); err != nil {
+		t.Fatalf("raiseAlert() unexpected error = %v", err)
+	}
+
+	if len(safetyRepo.alerts) != 1 {
+		t.Fatalf("raiseAlert() stored %d alerts, want 1", len(safetyRepo.alerts))
+	}
+	var alert *safetyDomain.Alert
+	for _, stored := range safetyRepo.alerts {
+		alert = stored
+	}
+
+	if len(events.events) != 1 || events.events[0].Type != realtimeDomain.EventSafetyAlertRaised || events.events[0].UserID != "therapist-123" {
+		t.Fatalf("Expected one %s event for therapist-123, got %+v", realtimeDomain.EventSafetyAlertRaised, events.events)
+	}
+
+	t.Run("another therapist", func(t *testing.T) {
+		// Alerts for other therapists' clients are treated as missing
+		_, err := service.AcknowledgeAlert(context.Background(), "therapist-456", alert.ID, "Called the client")
+		if err != safetyDomain.ErrAlertNotFound {
+			t.Fatalf("AcknowledgeAlert() error = %v, want %v", err, safetyDomain.ErrAlertNotFound)
+		}
+
+		if alert.Status != safetyDomain.StatusOpen || len(safetyRepo.updated) != 0 {
+			t.Error("AcknowledgeAlert() must not acknowledge an alert for another therapist")
+		}
+	})
+
+	t.Run("assigned therapist", func(t *testing.T) {
+		if _, err := service.AcknowledgeAlert(context.Background(), "therapist-123", alert.ID, "Called the client"); err != nil {
+			t.Fatalf("AcknowledgeAlert() unexpected error = %v", err)
+		}
+
+		if alert.Status != safetyDomain.StatusAcknowledged || alert.AcknowledgedBy != "therapist-123" {
+			t.Errorf("AcknowledgeAlert() left status %s acknowledged by %q", alert.Status, alert.AcknowledgedBy)
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+)
+
+const (
+	// jobBatchSize is how many due jobs are claimed at a time
+	jobBatchSize = 50
+	// jobLease is how long a claimed job is left to its instance before
+	// another one may run it
+	jobLease = 5 * time.Minute
+)
+
+type SchedulerService struct {
+	schedulerRepo schedulerDomain.Repository
+
+	mu        sync.RWMutex
+	handlers  map[string]schedulerDomain.Handler
+	recurring map[string]recurringTask
+}
+
+// recurringTask is a task run as a job that reschedules itself
+type recurringTask struct {
+	interval time.Duration
+	task     schedulerDomain.Task
+}
+
+func NewSchedulerService(schedulerRepo schedulerDomain.Repository) *SchedulerService {
+	return &SchedulerService{
+		schedulerRepo: schedulerRepo,
+		handlers:      make(map[string]schedulerDomain.Handler),
+		recurring:     make(map[string]recurringTask),
+	}
+}
+
+// Register sets the handler for a kind of job. Services that schedule jobs
+// register themselves after the scheduler is built.
+func (s *SchedulerService) Register(kind string, handler schedulerDomain.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[kind] = handler
+}
+
+// Every runs task once per interval as a job of the given kind. The job is
+// rescheduled after each run, so one instance at a time runs it however many
+// there are. Its first run is due as soon as the job is added.
+func (s *SchedulerService) Every(kind string, interval time.Duration, task schedulerDomain.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recurring[kind] = recurringTask{interval: interval, task: task}
+}
+
+func (s *SchedulerService) Schedule(ctx context.Context, key string, jobs []*schedulerDomain.Job) error {
+	for _, job := range jobs {
+		if job.Key != key {
+			return fmt.Errorf("%w: job %s belongs to %s, not %s", schedulerDomain.ErrInvalidJob, job.ID, job.Key, key)
+		}
+	}
+
+	if err := s.schedulerRepo.ReplaceJobs(ctx, key, jobs, time.Now()); err != nil {
+		return schedulerDomain.ErrSchedulerUnavailable
+	}
+
+	return nil
+}
+
+func (s *SchedulerService) Cancel(ctx context.Context, key string) (int64, error) {
+	cancelled, err := s.schedulerRepo.CancelJobs(ctx, key, time.Now())
+	if err != nil {
+		return 0, schedulerDomain.ErrSchedulerUnavailable
+	}
+
+	return cancelled, nil
+}
+
+// RunDueJobs runs due jobs batch by batch until none are left. Failed jobs
+// are put back with a delay, so one failing job does not hold up the rest.
+// Recurring jobs are put back for their next run either way.
+func (s *SchedulerService) RunDueJobs(ctx context.Context) (int, error) {
+	done := 0
+
+	if err := s.addRecurringJobs(ctx); err != nil {
+		return done, schedulerDomain.ErrSchedulerUnavailable
+	}
+
+	for {
+		jobs, err := s.schedulerRepo.ClaimDueJobs(ctx, time.Now(), jobLease, jobBatchSize)
+		if err != nil {
+			return done, schedulerDomain.ErrSchedulerUnavailable
+		}
+
+		for _, job := range jobs {
+			if recurring, ok := s.getRecurring(job.Kind); ok {
+				if s.runRecurring(ctx, job, recurring) == nil {
+					done++
+				}
+			} else if err := s.run(ctx, job); err != nil {
+				log.Printf("Failed to run %s job %s (attempt %d): %v", job.Kind, job.ID, job.Attempts+1, err)
+				retry := !errors.Is(err, schedulerDomain.ErrUnknownJobKind) && !errors.Is(err, schedulerDomain.ErrPermanent)
+				job.MarkFailed(err, retry, time.Now())
+			} else {
+				job.MarkDone(time.Now())
+				done++
+			}
+
+			if err := s.schedulerRepo.UpdateJob(ctx, job); err != nil {
+				if errors.Is(err, schedulerDomain.ErrJobClaimLost) {
+					log.Printf("Job %s was cancelled or claimed again while it ran, its outcome is not recorded", job.ID)
+				} else {
+					log.Printf("Failed to record run of job %s: %v", job.ID, err)
+				}
+			}
+		}
+
+		if len(jobs) < jobBatchSize || ctx.Err() != nil {
+			return done, nil
+		}
+	}
+}
+
+func (s *SchedulerService) run(ctx context.Context, job *schedulerDomain.Job) error {
+	s.mu.RLock()
+	handler, ok := s.handlers[job.Kind]
+	s.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", schedulerDomain.ErrUnknownJobKind, job.Kind)
+	}
+
+	return handler.Handle(ctx, job)
+}
+
+// runRecurring runs a recurring job's task and puts the job back for its next run
+func (s *SchedulerService) runRecurring(ctx context.Context, job *schedulerDomain.Job, recurring recurringTask) error {
+	handled, err := recurring.task(ctx)
+	if err != nil {
+		log.Printf("Failed to run %s job: %v", job.Kind, err)
+	} else if handled > 0 {
+		log.Printf("Ran %s job, %d item(s) handled", job.Kind, handled)
+	}
+
+	now := time.Now()
+	job.Reschedule(err, now.Add(recurring.interval), now)
+	return err
+}
+
+// addRecurringJobs adds the recurring jobs that are not scheduled yet. Doing
+// it on every run brings back a job that an older instance without its task
+// gave up on.
+func (s *SchedulerService) addRecurringJobs(ctx context.Context) error {
+	s.mu.RLock()
+	kinds := make([]string, 0, len(s.recurring))
+	for kind := range s.recurring {
+		kinds = append(kinds, kind)
+	}
+	s.mu.RUnlock()
+
+	now := time.Now()
+	for _, kind := range kinds {
+		job, err := schedulerDomain.NewJob(kind, schedulerDomain.RecurringKey(kind), struct{}{}, now, now)
+		if err != nil {
+			return err
+		}
+
+		if err := s.schedulerRepo.AddRecurringJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SchedulerService) getRecurring(kind string) (recurringTask, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	recurring, ok := s.recurring[kind]
+	return recurring, ok
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	schedulerDomain "github.com/goran/thappy/internal/domain/scheduler"
+)
+
+// MockSchedulerRepository is a mock implementation of schedulerDomain.Repository
+type MockSchedulerRepository struct {
+	jobs map[string]*schedulerDomain.Job
+}
+
+func NewMockSchedulerRepository() *MockSchedulerRepository {
+	return &MockSchedulerRepository{
+		jobs: make(map[string]*schedulerDomain.Job),
+	}
+}
+
+func (m *MockSchedulerRepository) AddRecurringJob(ctx context.Context, job *schedulerDomain.Job) error {
+	for _, existing := range m.jobs {
+		if existing.Key == job.Key && (existing.Status == schedulerDomain.JobPending || existing.Status == schedulerDomain.JobRunning) {
+			return nil
+		}
+	}
+	m.jobs[job.ID] = job
+	return nil
+}
+
+func (m *MockSchedulerRepository) ClaimDueJobs(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*schedulerDomain.Job, error) {
+	var jobs []*schedulerDomain.Job
+	for _, job := range m.jobs {
+		if job.Status == schedulerDomain.JobPending && !job.RunAt.After(now) && len(jobs) < limit {
+			claimedUntil := now.Add(lease)
+			job.Status = schedulerDomain.JobRunning
+			job.ClaimedUntil = &claimedUntil
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *MockSchedulerRepository) UpdateJob(ctx context.Context, job *schedulerDomain.Job) error {
+	job.ClaimedUntil = nil
+	m.jobs[job.ID] = job
+	return nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockSchedulerRepository) ReplaceJobs(ctx context.Context, key string, jobs []*schedulerDomain.Job, now time.Time) error {
+	return nil
+}
+func (m *MockSchedulerRepository) CancelJobs(ctx context.Context, key string, now time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestSchedulerService_Every(t *testing.T) {
+	schedulerRepo := NewMockSchedulerRepository()
+	service := NewSchedulerService(schedulerRepo)
+
+	runs := 0
+	var taskErr error
+	service.Every("message.retention", time.Hour, func(ctx context.Context) (int64, error) {
+		runs++
+		return 3, taskErr
+	})
+
+	done, err := service.RunDueJobs(context.Background())
+	if err != nil {
+		t.Fatalf("RunDueJobs() unexpected error = %v", err)
+	}
+	if done != 1 || runs != 1 {
+		t.Fatalf("RunDueJobs() ran the task %d times and reported %d, want 1", runs, done)
+	}
+
+	if len(schedulerRepo.jobs) != 1 {
+		t.Fatalf("Expected one recurring job, got %d", len(schedulerRepo.jobs))
+	}
+	var job *schedulerDomain.Job
+	for _, stored := range schedulerRepo.jobs {
+		job = stored
+	}
+	if job.Key != schedulerDomain.RecurringKey("message.retention") || job.Status != schedulerDomain.JobPending {
+		t.Fatalf("Expected the job to be put back for its next run, got %+v", job)
+	}
+	if wait := time.Until(job.RunAt); wait < 59*time.Minute || wait > time.Hour {
+		t.Errorf("Expected the next run in an hour, got %v", wait)
+	}
+
+	// Not due yet: the job is neither run nor added again
+	if _, err := service.RunDueJobs(context.Background()); err != nil {
+		t.Fatalf("RunDueJobs() unexpected error = %v", err)
+	}
+	if runs != 1 || len(schedulerRepo.jobs) != 1 {
+		t.Errorf("Expected no run before the interval passed, got %d runs and %d jobs", runs, len(schedulerRepo.jobs))
+	}
+
+	// A failed run waits for the next interval instead of giving the job up
+	job.RunAt = time.Now().Add(-time.Second)
+	taskErr = errors.New("database unavailable")
+	done, err = service.RunDueJobs(context.Background())
+	if err != nil {
+		t.Fatalf("RunDueJobs() unexpected error = %v", err)
+	}
+	if done != 0 || runs != 2 {
+		t.Errorf("RunDueJobs() ran the task %d times and reported %d done, want 2 and 0", runs, done)
+	}
+	if job.Status != schedulerDomain.JobPending || job.LastError != "database unavailable" || time.Until(job.RunAt) < 59*time.Minute {
+		t.Errorf("Expected the failed job to wait for its next run, got %+v", job)
+	}
+}
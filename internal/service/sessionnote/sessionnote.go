@@ -0,0 +1,162 @@
+package sessionnote
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type SessionNoteService struct {
+	noteRepo   sessionNoteDomain.Repository
+	clientRepo clientDomain.ClientRepository
+	userRepo   userDomain.UserRepository
+}
+
+func NewSessionNoteService(
+	noteRepo sessionNoteDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+) *SessionNoteService {
+	return &SessionNoteService{
+		noteRepo:   noteRepo,
+		clientRepo: clientRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreateNote records a session note. Only the client's currently assigned
+// therapist can write notes for them.
+func (s *SessionNoteService) CreateNote(ctx context.Context, therapistUserID string, req sessionNoteDomain.CreateNoteRequest) (*sessionNoteDomain.Note, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	client, err := s.clientRepo.GetByUserID(ctx, req.ClientID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return nil, err
+		}
+		return nil, sessionNoteDomain.ErrNoteServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return nil, sessionNoteDomain.ErrClientNotAssigned
+	}
+
+	note, err := sessionNoteDomain.NewNote(therapistUserID, req.ClientID, req.Template, req.SessionDate, req.Sections, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", sessionNoteDomain.ErrInvalidNoteData, err)
+	}
+
+	err = s.noteRepo.Create(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// AmendNote appends a correction; the original content is never changed
+func (s *SessionNoteService) AmendNote(ctx context.Context, therapistUserID, noteID string, req sessionNoteDomain.AmendNoteRequest) (*sessionNoteDomain.Note, error) {
+	note, err := s.getOwnNote(ctx, therapistUserID, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	amendment, err := note.Amend(therapistUserID, req.Reason, req.Content, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", sessionNoteDomain.ErrInvalidNoteData, err)
+	}
+
+	err = s.noteRepo.AddAmendment(ctx, amendment)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (s *SessionNoteService) SetNoteShared(ctx context.Context, therapistUserID, noteID string, shared bool) (*sessionNoteDomain.Note, error) {
+	note, err := s.getOwnNote(ctx, therapistUserID, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	note.SetShared(shared, time.Now())
+
+	err = s.noteRepo.UpdateSharing(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// GetClientNotes lists the notes the therapist wrote about a client. Authors keep
+// access to their own notes after the client moves to another therapist.
+func (s *SessionNoteService) GetClientNotes(ctx context.Context, therapistUserID, clientUserID string) ([]*sessionNoteDomain.Note, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.noteRepo.GetByClientID(ctx, clientUserID, therapistUserID, false)
+	if err != nil {
+		return nil, sessionNoteDomain.ErrNoteServiceUnavailable
+	}
+
+	return notes, nil
+}
+
+// GetSharedNotes lists the notes therapists have shared with the client
+func (s *SessionNoteService) GetSharedNotes(ctx context.Context, clientUserID string) ([]*sessionNoteDomain.Note, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.noteRepo.GetByClientID(ctx, clientUserID, "", true)
+	if err != nil {
+		return nil, sessionNoteDomain.ErrNoteServiceUnavailable
+	}
+
+	return notes, nil
+}
+
+func (s *SessionNoteService) getOwnNote(ctx context.Context, therapistUserID, noteID string) (*sessionNoteDomain.Note, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	note, err := s.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		if err == sessionNoteDomain.ErrNoteNotFound {
+			return nil, err
+		}
+		return nil, sessionNoteDomain.ErrNoteServiceUnavailable
+	}
+
+	if !note.AuthoredBy(therapistUserID) {
+		return nil, sessionNoteDomain.ErrUnauthorizedAccess
+	}
+
+	return note, nil
+}
+
+func (s *SessionNoteService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return sessionNoteDomain.ErrUnauthorizedAccess
+		}
+		return sessionNoteDomain.ErrNoteServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return sessionNoteDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package sessionnote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	"github.com/goran/thappy/internal/domain/pagination"
+	sessionNoteDomain "github.com/goran/thappy/internal/domain/sessionnote"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+// MockNoteRepository is a mock implementation of sessionNoteDomain.Repository
+type MockNoteRepository struct {
+	notes      map[string]*sessionNoteDomain.Note
+	amendments []*sessionNoteDomain.Amendment
+
+	// Arguments of the last GetByClientID call
+	listedClientID    string
+	listedTherapistID string
+	listedSharedOnly  bool
+}
+
+func NewMockNoteRepository() *MockNoteRepository {
+	return &MockNoteRepository{
+		notes: make(map[string]*sessionNoteDomain.Note),
+	}
+}
+
+func (m *MockNoteRepository) Create(ctx context.Context, note *sessionNoteDomain.Note) error {
+	m.notes[note.ID] = note
+	return nil
+}
+
+func (m *MockNoteRepository) GetByID(ctx context.Context, id string) (*sessionNoteDomain.Note, error) {
+	note, exists := m.notes[id]
+	if !exists {
+		return nil, sessionNoteDomain.ErrNoteNotFound
+	}
+	return note, nil
+}
+
+func (m *MockNoteRepository) AddAmendment(ctx context.Context, amendment *sessionNoteDomain.Amendment) error {
+	m.amendments = append(m.amendments, amendment)
+	return nil
+}
+
+func (m *MockNoteRepository) UpdateSharing(ctx context.Context, note *sessionNoteDomain.Note) error {
+	if _, exists := m.notes[note.ID]; !exists {
+		return sessionNoteDomain.ErrNoteNotFound
+	}
+	m.notes[note.ID] = note
+	return nil
+}
+
+func (m *MockNoteRepository) GetByClientID(ctx context.Context, clientID, therapistID string, sharedOnly bool) ([]*sessionNoteDomain.Note, error) {
+	m.listedClientID = clientID
+	m.listedTherapistID = therapistID
+	m.listedSharedOnly = sharedOnly
+
+	var notes []*sessionNoteDomain.Note
+	for _, note := range m.notes {
+		if note.ClientID != clientID {
+			continue
+		}
+		if therapistID != "" && note.TherapistID != therapistID {
+			continue
+		}
+		if sharedOnly && !note.SharedWithClient {
+			continue
+		}
+		notes = append(notes, note)
+	}
+	return notes, nil
+}
+
+// MockClientRepository is a simplified mock of clientDomain.ClientRepository
+type MockClientRepository struct {
+	profiles map[string]*clientDomain.ClientProfile
+}
+
+func NewMockClientRepository() *MockClientRepository {
+	return &MockClientRepository{
+		profiles: make(map[string]*clientDomain.ClientProfile),
+	}
+}
+
+func (m *MockClientRepository) GetByUserID(ctx context.Context, userID string) (*clientDomain.ClientProfile, error) {
+	profile, exists := m.profiles[userID]
+	if !exists {
+		return nil, clientDomain.ErrClientProfileNotFound
+	}
+	return profile, nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockClientRepository) Create(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	return nil
+}
+func (m *MockClientRepository) Update(ctx context.Context, profile *clientDomain.ClientProfile) error {
+	return nil
+}
+func (m *MockClientRepository) Delete(ctx context.Context, userID string) error { return nil }
+func (m *MockClientRepository) GetByTherapistID(ctx context.Context, therapistID string, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	return &pagination.Page[*clientDomain.ClientProfile]{}, nil
+}
+func (m *MockClientRepository) GetActiveClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*clientDomain.ClientProfile], error) {
+	return &pagination.Page[*clientDomain.ClientProfile]{}, nil
+}
+func (m *MockClientRepository) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
+	_, exists := m.profiles[userID]
+	return exists, nil
+}
+
+// MockUserRepository is a simplified mock for testing
+type MockUserRepository struct {
+	users map[string]*userDomain.User
+}
+
+func NewMockUserRepository() *MockUserRepository {
+	return &MockUserRepository{
+		users: make(map[string]*userDomain.User),
+	}
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id string) (*userDomain.User, error) {
+	user, exists := m.users[id]
+	if !exists {
+		return nil, userDomain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockUserRepository) Create(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) Update(ctx context.Context, user *userDomain.User) error { return nil }
+func (m *MockUserRepository) Delete(ctx context.Context, id string) error             { return nil }
+func (m *MockUserRepository) GetByRole(ctx context.Context, role userDomain.UserRole) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) GetActiveUsers(ctx context.Context) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) GetActiveUsersByRole(ctx context.Context, role userDomain.UserRole) ([]*userDomain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
+
+// setupNoteService registers two therapists and a client assigned to therapist-123
+func setupNoteService(t *testing.T) (*SessionNoteService, *MockNoteRepository) {
+	t.Helper()
+
+	userRepo := NewMockUserRepository()
+	clientRepo := NewMockClientRepository()
+	noteRepo := NewMockNoteRepository()
+
+	for _, id := range []string{"therapist-123", "therapist-456"} {
+		therapist, _ := userDomain.NewUserWithRole(id+"@example.com", "password123", userDomain.RoleTherapist)
+		therapist.ID = id
+		userRepo.users[therapist.ID] = therapist
+	}
+
+	client, _ := userDomain.NewUserWithRole("john@example.com", "password123", userDomain.RoleClient)
+	client.ID = "client-123"
+	userRepo.users[client.ID] = client
+
+	profile, _ := clientDomain.NewClientProfile("client-123", "John", "Doe")
+	therapistID := "therapist-123"
+	profile.TherapistID = &therapistID
+	clientRepo.profiles[profile.UserID] = profile
+
+	return NewSessionNoteService(noteRepo, clientRepo, userRepo), noteRepo
+}
+
+func soapNoteRequest() sessionNoteDomain.CreateNoteRequest {
+	return sessionNoteDomain.CreateNoteRequest{
+		ClientID:    "client-123",
+		Template:    sessionNoteDomain.TemplateSOAP,
+		SessionDate: time.Now().AddDate(0, 0, -1),
+		Sections:    map[string]string{"subjective": "Reports sleeping better"},
+	}
+}
+
+func TestSessionNoteService_CreateNote(t *testing.T) {
+	t.Run("assigned therapist", func(t *testing.T) {
+		service, noteRepo := setupNoteService(t)
+
+		note, err := service.CreateNote(context.Background(), "therapist-123", soapNoteRequest())
+		if err != nil {
+			t.Fatalf("CreateNote() unexpected error = %v", err)
+		}
+
+		if _, exists := noteRepo.notes[note.ID]; !exists {
+			t.Error("CreateNote() did not store the note")
+		}
+	})
+
+	t.Run("unassigned therapist", func(t *testing.T) {
+		service, noteRepo := setupNoteService(t)
+
+		_, err := service.CreateNote(context.Background(), "therapist-456", soapNoteRequest())
+		if err != sessionNoteDomain.ErrClientNotAssigned {
+			t.Fatalf("CreateNote() error = %v, want %v", err, sessionNoteDomain.ErrClientNotAssigned)
+		}
+
+		if len(noteRepo.notes) != 0 {
+			t.Error("CreateNote() must not store a note for an unassigned client")
+		}
+	})
+}
+
+func TestSessionNoteService_OnlyAuthorChangesNote(t *testing.T) {
+	service, noteRepo := setupNoteService(t)
+
+	note, err := service.CreateNote(context.Background(), "therapist-123", soapNoteRequest())
+	if err != nil {
+		t.Fatalf("CreateNote() unexpected error = %v", err)
+	}
+
+	t.Run("amend by another therapist", func(t *testing.T) {
+		req := sessionNoteDomain.AmendNoteRequest{Reason: "Typo", Content: "Reports sleeping worse"}
+
+		_, err := service.AmendNote(context.Background(), "therapist-456", note.ID, req)
+		if err != sessionNoteDomain.ErrUnauthorizedAccess {
+			t.Fatalf("AmendNote() error = %v, want %v", err, sessionNoteDomain.ErrUnauthorizedAccess)
+		}
+
+		if len(noteRepo.amendments) != 0 {
+			t.Error("AmendNote() must not store an amendment by another therapist")
+		}
+	})
+
+	t.Run("share by another therapist", func(t *testing.T) {
+		_, err := service.SetNoteShared(context.Background(), "therapist-456", note.ID, true)
+		if err != sessionNoteDomain.ErrUnauthorizedAccess {
+			t.Fatalf("SetNoteShared() error = %v, want %v", err, sessionNoteDomain.ErrUnauthorizedAccess)
+		}
+
+		if note.SharedWithClient {
+			t.Error("SetNoteShared() must not share a note for another therapist")
+		}
+	})
+
+	t.Run("amend by author", func(t *testing.T) {
+		req := sessionNoteDomain.AmendNoteRequest{Reason: "Typo", Content: "Reports sleeping worse"}
+
+		if _, err := service.AmendNote(context.Background(), "therapist-123", note.ID, req); err != nil {
+			t.Fatalf("AmendNote() unexpected error = %v", err)
+		}
+
+		if len(noteRepo.amendments) != 1 {
+			t.Errorf("AmendNote() stored %d amendments, want 1", len(noteRepo.amendments))
+		}
+	})
+}
+
+func TestSessionNoteService_GetSharedNotes(t *testing.T) {
+	service, noteRepo := setupNoteService(t)
+
+	shared, err := service.CreateNote(context.Background(), "therapist-123", soapNoteRequest())
+	if err != nil {
+		t.Fatalf("CreateNote() unexpected error = %v", err)
+	}
+	if _, err := service.SetNoteShared(context.Background(), "therapist-123", shared.ID, true); err != nil {
+		t.Fatalf("SetNoteShared() unexpected error = %v", err)
+	}
+
+	if _, err := service.CreateNote(context.Background(), "therapist-123", soapNoteRequest()); err != nil {
+		t.Fatalf("CreateNote() unexpected error = %v", err)
+	}
+
+	notes, err := service.GetSharedNotes(context.Background(), "client-123")
+	if err != nil {
+		t.Fatalf("GetSharedNotes() unexpected error = %v", err)
+	}
+
+	if !noteRepo.listedSharedOnly {
+		t.Error("GetSharedNotes() must ask the repository for shared notes only")
+	}
+	if noteRepo.listedClientID != "client-123" || noteRepo.listedTherapistID != "" {
+		t.Errorf("GetSharedNotes() listed client %q therapist %q, want client-123 and any therapist", noteRepo.listedClientID, noteRepo.listedTherapistID)
+	}
+
+	if len(notes) != 1 || notes[0].ID != shared.ID {
+		t.Errorf("GetSharedNotes() returned %d notes, want only the shared one", len(notes))
+	}
+
+	t.Run("therapists cannot read the client view", func(t *testing.T) {
+		_, err := service.GetSharedNotes(context.Background(), "therapist-123")
+		if err != sessionNoteDomain.ErrUnauthorizedAccess {
+			t.Errorf("GetSharedNotes() error = %v, want %v", err, sessionNoteDomain.ErrUnauthorizedAccess)
+		}
+	})
+}
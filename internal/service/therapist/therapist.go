@@ -2,21 +2,36 @@ package therapist
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/goran/thappy/internal/domain/language"
+	"github.com/goran/thappy/internal/domain/media"
+	"github.com/goran/thappy/internal/domain/pagination"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
 	userDomain "github.com/goran/thappy/internal/domain/user"
 )
 
 type TherapistService struct {
-	therapistRepo therapistDomain.TherapistRepository
-	userRepo      userDomain.UserRepository
+	therapistRepo        therapistDomain.TherapistRepository
+	userRepo             userDomain.UserRepository
+	therapyRepo          therapyDomain.Repository
+	availabilityNotifier therapistDomain.AvailabilityNotifier
+	mediaStorage         media.Storage
 }
 
-func NewTherapistService(therapistRepo therapistDomain.TherapistRepository, userRepo userDomain.UserRepository) *TherapistService {
+func NewTherapistService(therapistRepo therapistDomain.TherapistRepository, userRepo userDomain.UserRepository, therapyRepo therapyDomain.Repository, availabilityNotifier therapistDomain.AvailabilityNotifier, mediaStorage media.Storage) *TherapistService {
 	return &TherapistService{
-		therapistRepo: therapistRepo,
-		userRepo:      userRepo,
+		therapistRepo:        therapistRepo,
+		userRepo:             userRepo,
+		therapyRepo:          therapyRepo,
+		availabilityNotifier: availabilityNotifier,
+		mediaStorage:         mediaStorage,
 	}
 }
 
@@ -70,6 +85,11 @@ func (s *TherapistService) CreateProfile(ctx context.Context, userID string, req
 		profile.UpdateBio(req.Bio)
 	}
 
+	profile.Slug, err = s.uniqueSlug(ctx, profile.Slug, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Save to repository
 	err = s.therapistRepo.Create(ctx, profile)
 	if err != nil {
@@ -110,6 +130,65 @@ func (s *TherapistService) GetByLicenseNumber(ctx context.Context, licenseNumber
 	return profile, nil
 }
 
+// GetBySlug looks a profile up by its public slug. Retired slugs still resolve;
+// callers compare the returned profile's Slug to spot them and redirect.
+func (s *TherapistService) GetBySlug(ctx context.Context, slug string) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.therapistRepo.GetBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *TherapistService) UpdateSlug(ctx context.Context, userID, slug string) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := profile.Slug
+	err = profile.SetSlug(slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidSlug, err)
+	}
+
+	if profile.Slug == previous {
+		return profile, nil
+	}
+
+	// Slugs another therapist used before stay reserved so their old links keep working
+	taken, err := s.therapistRepo.GetTakenSlugs(ctx, profile.Slug, userID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+	if slices.Contains(taken, profile.Slug) {
+		return nil, therapistDomain.ErrSlugAlreadyExists
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// uniqueSlug returns the first numbered variant of base that no other therapist uses or used
+func (s *TherapistService) uniqueSlug(ctx context.Context, base, userID string) (string, error) {
+	taken, err := s.therapistRepo.GetTakenSlugs(ctx, base, userID)
+	if err != nil {
+		return "", therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	for n := 1; ; n++ {
+		candidate := therapistDomain.SlugCandidate(base, n)
+		if !slices.Contains(taken, candidate) {
+			return candidate, nil
+		}
+	}
+}
+
 func (s *TherapistService) UpdatePersonalInfo(ctx context.Context, userID string, req therapistDomain.UpdatePersonalInfoRequest) (*therapistDomain.TherapistProfile, error) {
 	profile, err := s.GetProfile(ctx, userID)
 	if err != nil {
@@ -200,13 +279,19 @@ func (s *TherapistService) UpdateSpecializations(ctx context.Context, userID str
 		return nil, err
 	}
 
-	// Clean and validate specializations
+	// Clean and validate specializations against the therapies catalog
 	cleanedSpecs := make([]string, 0, len(specializations))
+	seen := make(map[string]bool, len(specializations))
 	for _, spec := range specializations {
-		clean := strings.TrimSpace(spec)
-		if clean != "" {
-			cleanedSpecs = append(cleanedSpecs, clean)
+		clean := normalizeTherapyID(spec)
+		if clean == "" || seen[clean] {
+			continue
 		}
+		if err := s.verifyCatalogTherapy(ctx, clean); err != nil {
+			return nil, err
+		}
+		seen[clean] = true
+		cleanedSpecs = append(cleanedSpecs, clean)
 	}
 
 	profile.UpdateSpecializations(cleanedSpecs)
@@ -225,6 +310,13 @@ func (s *TherapistService) AddSpecialization(ctx context.Context, userID, specia
 		return nil, err
 	}
 
+	specialization = normalizeTherapyID(specialization)
+	if specialization != "" {
+		if err := s.verifyCatalogTherapy(ctx, specialization); err != nil {
+			return nil, err
+		}
+	}
+
 	err = profile.AddSpecialization(specialization)
 	if err != nil {
 		return nil, err
@@ -244,7 +336,7 @@ func (s *TherapistService) RemoveSpecialization(ctx context.Context, userID, spe
 		return nil, err
 	}
 
-	err = profile.RemoveSpecialization(specialization)
+	err = profile.RemoveSpecialization(normalizeTherapyID(specialization))
 	if err != nil {
 		return nil, err
 	}
@@ -257,12 +349,35 @@ func (s *TherapistService) RemoveSpecialization(ctx context.Context, userID, spe
 	return profile, nil
 }
 
+// verifyCatalogTherapy checks that a specialization names an active therapy
+func (s *TherapistService) verifyCatalogTherapy(ctx context.Context, therapyID string) error {
+	therapy, err := s.therapyRepo.GetByID(ctx, therapyID)
+	if err != nil {
+		if errors.Is(err, therapyDomain.ErrTherapyNotFound) {
+			return fmt.Errorf("%w: %s", therapistDomain.ErrTherapyNotInCatalog, therapyID)
+		}
+		return therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	if !therapy.IsActive {
+		return fmt.Errorf("%w: %s", therapistDomain.ErrTherapyNotInCatalog, therapyID)
+	}
+
+	return nil
+}
+
+// normalizeTherapyID matches the lowercase slug format of therapy IDs
+func normalizeTherapyID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
 func (s *TherapistService) SetAcceptingClients(ctx context.Context, userID string, accepting bool) (*therapistDomain.TherapistProfile, error) {
 	profile, err := s.GetProfile(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	wasAccepting := profile.IsAcceptingClients
 	profile.SetAcceptingClients(accepting)
 
 	err = s.therapistRepo.Update(ctx, profile)
@@ -270,34 +385,90 @@ func (s *TherapistService) SetAcceptingClients(ctx context.Context, userID strin
 		return nil, err
 	}
 
+	// Let waitlisted clients know the practice has reopened
+	if accepting && !wasAccepting && s.availabilityNotifier != nil {
+		if _, err := s.availabilityNotifier.NotifyTherapistAvailable(ctx, userID); err != nil {
+			log.Printf("Failed to notify waitlist for therapist %s: %v", userID, err)
+		}
+	}
+
 	return profile, nil
 }
 
-func (s *TherapistService) GetAcceptingClients(ctx context.Context) ([]*therapistDomain.TherapistProfile, error) {
-	profiles, err := s.therapistRepo.GetAcceptingClients(ctx)
+func (s *TherapistService) GetAcceptingClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
+	profiles, err := s.therapistRepo.GetAcceptingClients(ctx, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
 		return nil, therapistDomain.ErrTherapistServiceUnavailable
 	}
 
 	return profiles, nil
 }
 
-func (s *TherapistService) GetBySpecialization(ctx context.Context, specialization string) ([]*therapistDomain.TherapistProfile, error) {
-	profiles, err := s.therapistRepo.GetBySpecialization(ctx, specialization)
+func (s *TherapistService) GetBySpecialization(ctx context.Context, specialization string, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
+	profiles, err := s.therapistRepo.GetBySpecialization(ctx, specialization, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
 		return nil, therapistDomain.ErrTherapistServiceUnavailable
 	}
 
 	return profiles, nil
 }
 
-func (s *TherapistService) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) ([]*therapistDomain.TherapistProfile, error) {
-	profiles, err := s.therapistRepo.SearchTherapists(ctx, filters)
+func (s *TherapistService) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) (*therapistDomain.TherapistSearchPage, error) {
+	if filters.Sort != "" && !therapistDomain.IsValidSearchSort(filters.Sort) {
+		return nil, therapistDomain.ErrInvalidSearchSort
+	}
+
+	// Relevance needs something to be relevant to
+	if filters.Sort == therapistDomain.SortByRelevance && len(therapistDomain.ParseSearchTerms(filters.SearchText)) == 0 {
+		return nil, fmt.Errorf("%w: relevance sort requires search text", therapistDomain.ErrInvalidSearchSort)
+	}
+
+	if len(filters.Languages) > 0 {
+		codes := make([]string, 0, len(filters.Languages))
+		for _, code := range filters.Languages {
+			normalized := language.Normalize(code)
+			if !language.IsValid(normalized) {
+				return nil, fmt.Errorf("%w: unsupported language code: %s", therapistDomain.ErrInvalidLanguageData, code)
+			}
+			codes = append(codes, normalized)
+		}
+		filters.Languages = codes
+	}
+
+	if filters.TherapyID != "" {
+		filters.TherapyID = normalizeTherapyID(filters.TherapyID)
+		if _, err := s.therapyRepo.GetByID(ctx, filters.TherapyID); err != nil {
+			if errors.Is(err, therapyDomain.ErrTherapyNotFound) {
+				return nil, err
+			}
+			return nil, therapistDomain.ErrTherapistServiceUnavailable
+		}
+	}
+
+	if filters.Near != nil {
+		if err := filters.Near.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidLocationData, err)
+		}
+		if filters.RadiusKm <= 0 {
+			return nil, fmt.Errorf("%w: radius must be greater than zero", therapistDomain.ErrInvalidLocationData)
+		}
+	}
+
+	page, err := s.therapistRepo.SearchTherapists(ctx, filters)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, err
+		}
 		return nil, therapistDomain.ErrTherapistServiceUnavailable
 	}
 
-	return profiles, nil
+	return page, nil
 }
 
 func (s *TherapistService) DeleteProfile(ctx context.Context, userID string) error {
@@ -327,3 +498,380 @@ func (s *TherapistService) ValidateLicenseNumber(ctx context.Context, licenseNum
 
 	return nil
 }
+
+func (s *TherapistService) UploadVerificationDocument(ctx context.Context, userID string, req therapistDomain.UploadVerificationDocumentRequest) (*therapistDomain.VerificationDocument, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Evidence is frozen while an admin is reviewing it
+	if profile.Verification.Status == therapistDomain.VerificationSubmitted {
+		return nil, therapistDomain.ErrInvalidVerificationTransition
+	}
+
+	document, err := therapistDomain.NewVerificationDocument(userID, req.FileName, req.ContentType, req.Content)
+	if err != nil {
+		if errors.Is(err, therapistDomain.ErrVerificationDocumentTooLarge) || errors.Is(err, therapistDomain.ErrUnsupportedDocumentType) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidVerificationData, err)
+	}
+
+	err = s.therapistRepo.AddVerificationDocument(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (s *TherapistService) GetVerificationDocuments(ctx context.Context, userID string) ([]*therapistDomain.VerificationDocument, error) {
+	_, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := s.therapistRepo.GetVerificationDocuments(ctx, userID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	return documents, nil
+}
+
+func (s *TherapistService) SubmitLicenseVerification(ctx context.Context, userID string, req therapistDomain.SubmitVerificationRequest) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := s.therapistRepo.GetVerificationDocuments(ctx, userID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+	if len(documents) == 0 {
+		return nil, therapistDomain.ErrVerificationEvidenceMissing
+	}
+
+	err = profile.SubmitForVerification(req.Jurisdiction, req.LicenseType, req.LicenseExpiresAt, time.Now())
+	if err != nil {
+		if errors.Is(err, therapistDomain.ErrInvalidVerificationTransition) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidVerificationData, err)
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *TherapistService) GetPendingVerifications(ctx context.Context, adminUserID string) ([]*therapistDomain.TherapistProfile, error) {
+	if err := s.verifyAdmin(ctx, adminUserID); err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.therapistRepo.GetByVerificationStatus(ctx, therapistDomain.VerificationSubmitted)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	return profiles, nil
+}
+
+func (s *TherapistService) GetTherapistVerificationDocuments(ctx context.Context, adminUserID, therapistUserID string) ([]*therapistDomain.VerificationDocument, error) {
+	if err := s.verifyAdmin(ctx, adminUserID); err != nil {
+		return nil, err
+	}
+
+	documents, err := s.therapistRepo.GetVerificationDocuments(ctx, therapistUserID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	return documents, nil
+}
+
+func (s *TherapistService) GetVerificationDocument(ctx context.Context, adminUserID, documentID string) (*therapistDomain.VerificationDocument, error) {
+	if err := s.verifyAdmin(ctx, adminUserID); err != nil {
+		return nil, err
+	}
+
+	return s.therapistRepo.GetVerificationDocument(ctx, documentID)
+}
+
+func (s *TherapistService) ApproveVerification(ctx context.Context, adminUserID, therapistUserID string) (*therapistDomain.TherapistProfile, error) {
+	if err := s.verifyAdmin(ctx, adminUserID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.therapistRepo.GetByUserID(ctx, therapistUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = profile.ApproveVerification(adminUserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *TherapistService) RejectVerification(ctx context.Context, adminUserID, therapistUserID, reason string) (*therapistDomain.TherapistProfile, error) {
+	if err := s.verifyAdmin(ctx, adminUserID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.therapistRepo.GetByUserID(ctx, therapistUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = profile.RejectVerification(adminUserID, reason, time.Now())
+	if err != nil {
+		if errors.Is(err, therapistDomain.ErrInvalidVerificationTransition) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidVerificationData, err)
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// ExpireLicenses marks verified therapists whose license has lapsed as expired.
+// It returns the number of profiles that were expired.
+func (s *TherapistService) ExpireLicenses(ctx context.Context) (int64, error) {
+	expired, err := s.therapistRepo.ExpireLicenses(ctx, time.Now())
+	if err != nil {
+		return 0, therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	return expired, nil
+}
+
+func (s *TherapistService) SetModalities(ctx context.Context, userID string, inPerson, remote bool) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = profile.SetModalities(inPerson, remote)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidTherapistData, err)
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *TherapistService) SetLanguages(ctx context.Context, userID string, languages []therapistDomain.TherapistLanguage) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = profile.SetLanguages(languages)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidLanguageData, err)
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// UploadPhoto resizes the image into the profile photo variants, stores them and
+// points the profile at the new photo. The previous photo's files are removed.
+func (s *TherapistService) UploadPhoto(ctx context.Context, userID string, content []byte) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	renditions, err := media.ProcessImage(content, media.ProfilePhotoVariants)
+	if err != nil {
+		return nil, err
+	}
+
+	key := media.NewKey(therapistDomain.PhotoKeyPrefix + "/" + userID)
+	now := time.Now()
+	for _, rendition := range renditions {
+		object := &media.Object{
+			Content:     rendition.Content,
+			ContentType: "image/jpeg",
+			ModifiedAt:  now,
+		}
+		if err := s.mediaStorage.Put(ctx, media.VariantKey(key, rendition.Variant), object); err != nil {
+			s.deletePhotoFiles(ctx, key)
+			return nil, therapistDomain.ErrTherapistServiceUnavailable
+		}
+	}
+
+	previous, err := profile.SetPhoto(key, now)
+	if err != nil {
+		s.deletePhotoFiles(ctx, key)
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidTherapistData, err)
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		s.deletePhotoFiles(ctx, key)
+		return nil, err
+	}
+
+	if previous != nil {
+		s.deletePhotoFiles(ctx, previous.Key)
+	}
+
+	return profile, nil
+}
+
+func (s *TherapistService) DeletePhoto(ctx context.Context, userID string) (*therapistDomain.TherapistProfile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := profile.RemovePhoto(time.Now())
+	if removed == nil {
+		return profile, nil
+	}
+
+	err = s.therapistRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.deletePhotoFiles(ctx, removed.Key)
+	return profile, nil
+}
+
+// deletePhotoFiles removes every variant stored under key. Failures only leave
+// unreferenced files behind, so they are logged rather than returned.
+func (s *TherapistService) deletePhotoFiles(ctx context.Context, key string) {
+	for _, variant := range media.ProfilePhotoVariants {
+		if err := s.mediaStorage.Delete(ctx, media.VariantKey(key, variant.Name)); err != nil {
+			log.Printf("Failed to delete photo file %s: %v", media.VariantKey(key, variant.Name), err)
+		}
+	}
+}
+
+func (s *TherapistService) AddPracticeLocation(ctx context.Context, userID string, req therapistDomain.PracticeLocationRequest) (*therapistDomain.PracticeLocation, error) {
+	_, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.therapistRepo.GetPracticeLocations(ctx, userID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+	if len(existing) >= therapistDomain.MaxPracticeLocations {
+		return nil, therapistDomain.ErrTooManyPracticeLocations
+	}
+
+	location, err := therapistDomain.NewPracticeLocation(userID, req.Address, req.Coordinates)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidLocationData, err)
+	}
+
+	err = s.therapistRepo.AddPracticeLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (s *TherapistService) UpdatePracticeLocation(ctx context.Context, userID, locationID string, req therapistDomain.PracticeLocationRequest) (*therapistDomain.PracticeLocation, error) {
+	location, err := s.getOwnPracticeLocation(ctx, userID, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = location.Update(req.Address, req.Coordinates)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", therapistDomain.ErrInvalidLocationData, err)
+	}
+
+	err = s.therapistRepo.UpdatePracticeLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (s *TherapistService) DeletePracticeLocation(ctx context.Context, userID, locationID string) error {
+	_, err := s.getOwnPracticeLocation(ctx, userID, locationID)
+	if err != nil {
+		return err
+	}
+
+	return s.therapistRepo.DeletePracticeLocation(ctx, locationID)
+}
+
+func (s *TherapistService) GetPracticeLocations(ctx context.Context, therapistUserID string) ([]*therapistDomain.PracticeLocation, error) {
+	locations, err := s.therapistRepo.GetPracticeLocations(ctx, therapistUserID)
+	if err != nil {
+		return nil, therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	return locations, nil
+}
+
+func (s *TherapistService) getOwnPracticeLocation(ctx context.Context, userID, locationID string) (*therapistDomain.PracticeLocation, error) {
+	_, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := s.therapistRepo.GetPracticeLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !location.BelongsToTherapist(userID) {
+		return nil, therapistDomain.ErrPracticeLocationNotFound
+	}
+
+	return location, nil
+}
+
+func (s *TherapistService) verifyAdmin(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return therapistDomain.ErrUnauthorizedAccess
+		}
+		return therapistDomain.ErrTherapistServiceUnavailable
+	}
+
+	if !user.IsAdmin() || !user.IsActive {
+		return therapistDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
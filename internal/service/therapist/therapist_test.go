@@ -1,10 +1,19 @@
 package therapist
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/goran/thappy/internal/domain/media"
+	"github.com/goran/thappy/internal/domain/pagination"
 	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	therapyDomain "github.com/goran/thappy/internal/domain/therapy"
 	userDomain "github.com/goran/thappy/internal/domain/user"
 )
 
@@ -12,6 +21,8 @@ import (
 type MockTherapistRepository struct {
 	profiles       map[string]*therapistDomain.TherapistProfile
 	licenseIndex   map[string]string
+	documents      map[string][]*therapistDomain.VerificationDocument
+	locations      map[string]*therapistDomain.PracticeLocation
 	shouldFailNext bool
 	failError      error
 }
@@ -20,6 +31,8 @@ func NewMockTherapistRepository() *MockTherapistRepository {
 	return &MockTherapistRepository{
 		profiles:     make(map[string]*therapistDomain.TherapistProfile),
 		licenseIndex: make(map[string]string),
+		documents:    make(map[string][]*therapistDomain.VerificationDocument),
+		locations:    make(map[string]*therapistDomain.PracticeLocation),
 	}
 }
 
@@ -84,19 +97,141 @@ func (m *MockTherapistRepository) SetNextError(err error) {
 func (m *MockTherapistRepository) GetByLicenseNumber(ctx context.Context, licenseNumber string) (*therapistDomain.TherapistProfile, error) {
 	return nil, nil
 }
+func (m *MockTherapistRepository) GetBySlug(ctx context.Context, slug string) (*therapistDomain.TherapistProfile, error) {
+	for _, profile := range m.profiles {
+		if profile.Slug == slug {
+			return profile, nil
+		}
+	}
+	return nil, therapistDomain.ErrTherapistProfileNotFound
+}
+func (m *MockTherapistRepository) GetTakenSlugs(ctx context.Context, base, exceptTherapistID string) ([]string, error) {
+	var slugs []string
+	for _, profile := range m.profiles {
+		if profile.UserID != exceptTherapistID && (profile.Slug == base || strings.HasPrefix(profile.Slug, base+"-")) {
+			slugs = append(slugs, profile.Slug)
+		}
+	}
+	return slugs, nil
+}
 func (m *MockTherapistRepository) Update(ctx context.Context, profile *therapistDomain.TherapistProfile) error {
 	return nil
 }
 func (m *MockTherapistRepository) Delete(ctx context.Context, userID string) error { return nil }
-func (m *MockTherapistRepository) GetAcceptingClients(ctx context.Context) ([]*therapistDomain.TherapistProfile, error) {
-	return nil, nil
+func (m *MockTherapistRepository) GetAcceptingClients(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
+	return &pagination.Page[*therapistDomain.TherapistProfile]{}, nil
 }
-func (m *MockTherapistRepository) GetBySpecialization(ctx context.Context, specialization string) ([]*therapistDomain.TherapistProfile, error) {
-	return nil, nil
+func (m *MockTherapistRepository) GetBySpecialization(ctx context.Context, specialization string, page pagination.PageRequest) (*pagination.Page[*therapistDomain.TherapistProfile], error) {
+	return &pagination.Page[*therapistDomain.TherapistProfile]{}, nil
+}
+func (m *MockTherapistRepository) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) (*therapistDomain.TherapistSearchPage, error) {
+	return &therapistDomain.TherapistSearchPage{}, nil
 }
-func (m *MockTherapistRepository) SearchTherapists(ctx context.Context, filters therapistDomain.TherapistSearchFilters) ([]*therapistDomain.TherapistProfile, error) {
+func (m *MockTherapistRepository) GetByVerificationStatus(ctx context.Context, status therapistDomain.VerificationStatus) ([]*therapistDomain.TherapistProfile, error) {
 	return nil, nil
 }
+func (m *MockTherapistRepository) ExpireLicenses(ctx context.Context, now time.Time) (int64, error) {
+	return 0, nil
+}
+func (m *MockTherapistRepository) AddVerificationDocument(ctx context.Context, document *therapistDomain.VerificationDocument) error {
+	m.documents[document.TherapistID] = append(m.documents[document.TherapistID], document)
+	return nil
+}
+func (m *MockTherapistRepository) GetVerificationDocuments(ctx context.Context, therapistID string) ([]*therapistDomain.VerificationDocument, error) {
+	return m.documents[therapistID], nil
+}
+func (m *MockTherapistRepository) GetVerificationDocument(ctx context.Context, id string) (*therapistDomain.VerificationDocument, error) {
+	return nil, therapistDomain.ErrVerificationDocumentNotFound
+}
+func (m *MockTherapistRepository) AddPracticeLocation(ctx context.Context, location *therapistDomain.PracticeLocation) error {
+	m.locations[location.ID] = location
+	return nil
+}
+func (m *MockTherapistRepository) UpdatePracticeLocation(ctx context.Context, location *therapistDomain.PracticeLocation) error {
+	return nil
+}
+func (m *MockTherapistRepository) DeletePracticeLocation(ctx context.Context, id string) error {
+	delete(m.locations, id)
+	return nil
+}
+func (m *MockTherapistRepository) GetPracticeLocation(ctx context.Context, id string) (*therapistDomain.PracticeLocation, error) {
+	location, exists := m.locations[id]
+	if !exists {
+		return nil, therapistDomain.ErrPracticeLocationNotFound
+	}
+	return location, nil
+}
+func (m *MockTherapistRepository) GetPracticeLocations(ctx context.Context, therapistID string) ([]*therapistDomain.PracticeLocation, error) {
+	var locations []*therapistDomain.PracticeLocation
+	for _, location := range m.locations {
+		if location.TherapistID == therapistID {
+			locations = append(locations, location)
+		}
+	}
+	return locations, nil
+}
+
+// MockTherapyRepository serves a fixed therapies catalog
+type MockTherapyRepository struct {
+	therapies map[string]*therapyDomain.Therapy
+}
+
+func NewMockTherapyRepository() *MockTherapyRepository {
+	return &MockTherapyRepository{
+		therapies: make(map[string]*therapyDomain.Therapy),
+	}
+}
+
+func (m *MockTherapyRepository) GetByID(ctx context.Context, id string) (*therapyDomain.Therapy, error) {
+	therapy, exists := m.therapies[id]
+	if !exists {
+		return nil, therapyDomain.ErrTherapyNotFound
+	}
+	return therapy, nil
+}
+
+func (m *MockTherapyRepository) Create(ctx context.Context, therapy *therapyDomain.Therapy) error {
+	return nil
+}
+func (m *MockTherapyRepository) GetAll(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapyDomain.Therapy], error) {
+	return &pagination.Page[*therapyDomain.Therapy]{}, nil
+}
+func (m *MockTherapyRepository) GetAllActive(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapyDomain.Therapy], error) {
+	return &pagination.Page[*therapyDomain.Therapy]{}, nil
+}
+func (m *MockTherapyRepository) Update(ctx context.Context, therapy *therapyDomain.Therapy) error {
+	return nil
+}
+func (m *MockTherapyRepository) Delete(ctx context.Context, id string) error { return nil }
+
+// MockMediaStorage keeps objects in memory
+type MockMediaStorage struct {
+	objects map[string]*media.Object
+}
+
+func NewMockMediaStorage() *MockMediaStorage {
+	return &MockMediaStorage{
+		objects: make(map[string]*media.Object),
+	}
+}
+
+func (m *MockMediaStorage) Put(ctx context.Context, key string, object *media.Object) error {
+	m.objects[key] = object
+	return nil
+}
+
+func (m *MockMediaStorage) Get(ctx context.Context, key string) (*media.Object, error) {
+	object, exists := m.objects[key]
+	if !exists {
+		return nil, media.ErrObjectNotFound
+	}
+	return object, nil
+}
+
+func (m *MockMediaStorage) Delete(ctx context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
 
 // MockUserRepository for therapist service testing
 type MockUserRepository struct {
@@ -228,7 +363,7 @@ func TestTherapistService_CreateProfile(t *testing.T) {
 			therapistRepo := NewMockTherapistRepository()
 			tt.setup(userRepo, therapistRepo)
 
-			service := NewTherapistService(therapistRepo, userRepo)
+			service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, nil)
 
 			profile, err := service.CreateProfile(context.Background(), tt.userID, tt.request)
 
@@ -262,7 +397,7 @@ func TestTherapistService_ValidateLicenseNumber(t *testing.T) {
 	// Setup existing license
 	therapistRepo.licenseIndex["LIC-EXISTING"] = "existing-user"
 
-	service := NewTherapistService(therapistRepo, userRepo)
+	service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, nil)
 
 	t.Run("license number available", func(t *testing.T) {
 		err := service.ValidateLicenseNumber(context.Background(), "LIC-NEW")
@@ -284,3 +419,364 @@ func TestTherapistService_ValidateLicenseNumber(t *testing.T) {
 		}
 	})
 }
+
+func TestTherapistService_LicenseVerification(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	therapistRepo := NewMockTherapistRepository()
+
+	therapist, _ := userDomain.NewUserWithRole("jane@example.com", "password123", userDomain.RoleTherapist)
+	therapist.ID = "therapist-123"
+	userRepo.users[therapist.ID] = therapist
+
+	admin, _ := userDomain.NewUserWithRole("admin@example.com", "password123", userDomain.RoleAdmin)
+	admin.ID = "admin-123"
+	userRepo.users[admin.ID] = admin
+
+	profile, _ := therapistDomain.NewTherapistProfile(therapist.ID, "Jane", "Smith", "LIC-12345")
+	therapistRepo.profiles[profile.UserID] = profile
+
+	service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, nil)
+	ctx := context.Background()
+
+	submitReq := therapistDomain.SubmitVerificationRequest{
+		Jurisdiction:     "California",
+		LicenseType:      "LMFT",
+		LicenseExpiresAt: time.Now().AddDate(1, 0, 0),
+	}
+
+	t.Run("submit without evidence", func(t *testing.T) {
+		_, err := service.SubmitLicenseVerification(ctx, therapist.ID, submitReq)
+		if err != therapistDomain.ErrVerificationEvidenceMissing {
+			t.Errorf("SubmitLicenseVerification() error = %v, want %v", err, therapistDomain.ErrVerificationEvidenceMissing)
+		}
+	})
+
+	t.Run("upload unsupported document type", func(t *testing.T) {
+		_, err := service.UploadVerificationDocument(ctx, therapist.ID, therapistDomain.UploadVerificationDocumentRequest{
+			FileName:    "license.txt",
+			ContentType: "text/plain; charset=utf-8",
+			Content:     []byte("license"),
+		})
+		if !errors.Is(err, therapistDomain.ErrUnsupportedDocumentType) {
+			t.Errorf("UploadVerificationDocument() error = %v, want %v", err, therapistDomain.ErrUnsupportedDocumentType)
+		}
+	})
+
+	t.Run("submit and approve", func(t *testing.T) {
+		_, err := service.UploadVerificationDocument(ctx, therapist.ID, therapistDomain.UploadVerificationDocumentRequest{
+			FileName:    "license.pdf",
+			ContentType: "application/pdf",
+			Content:     []byte("%PDF-1.4"),
+		})
+		if err != nil {
+			t.Fatalf("UploadVerificationDocument() unexpected error = %v", err)
+		}
+
+		submitted, err := service.SubmitLicenseVerification(ctx, therapist.ID, submitReq)
+		if err != nil {
+			t.Fatalf("SubmitLicenseVerification() unexpected error = %v", err)
+		}
+		if submitted.Verification.Status != therapistDomain.VerificationSubmitted {
+			t.Errorf("SubmitLicenseVerification() Status = %v, want %v", submitted.Verification.Status, therapistDomain.VerificationSubmitted)
+		}
+
+		if _, err := service.ApproveVerification(ctx, therapist.ID, therapist.ID); err != therapistDomain.ErrUnauthorizedAccess {
+			t.Errorf("ApproveVerification() by therapist error = %v, want %v", err, therapistDomain.ErrUnauthorizedAccess)
+		}
+
+		approved, err := service.ApproveVerification(ctx, admin.ID, therapist.ID)
+		if err != nil {
+			t.Fatalf("ApproveVerification() unexpected error = %v", err)
+		}
+		if !approved.IsVerified(time.Now()) {
+			t.Error("ApproveVerification() profile should be verified")
+		}
+	})
+}
+
+func TestTherapistService_PracticeLocations(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	therapistRepo := NewMockTherapistRepository()
+
+	for _, id := range []string{"therapist-123", "therapist-456"} {
+		user, _ := userDomain.NewUserWithRole(id+"@example.com", "password123", userDomain.RoleTherapist)
+		user.ID = id
+		userRepo.users[user.ID] = user
+
+		profile, _ := therapistDomain.NewTherapistProfile(id, "Jane", "Smith", "LIC-"+id)
+		therapistRepo.profiles[profile.UserID] = profile
+	}
+
+	service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, nil)
+	ctx := context.Background()
+
+	req := therapistDomain.PracticeLocationRequest{
+		Address: therapistDomain.PracticeAddress{
+			AddressLine1: "1 Market St",
+			City:         "San Francisco",
+			Country:      "us",
+		},
+		Coordinates: therapistDomain.GeoPoint{Latitude: 37.7936, Longitude: -122.3958},
+	}
+
+	location, err := service.AddPracticeLocation(ctx, "therapist-123", req)
+	if err != nil {
+		t.Fatalf("AddPracticeLocation() unexpected error = %v", err)
+	}
+
+	if location.Country != "US" {
+		t.Errorf("AddPracticeLocation() Country = %v, want %v", location.Country, "US")
+	}
+
+	t.Run("invalid coordinates", func(t *testing.T) {
+		invalid := req
+		invalid.Coordinates = therapistDomain.GeoPoint{Latitude: 91, Longitude: 0}
+
+		_, err := service.AddPracticeLocation(ctx, "therapist-123", invalid)
+		if !errors.Is(err, therapistDomain.ErrInvalidLocationData) {
+			t.Errorf("AddPracticeLocation() error = %v, want %v", err, therapistDomain.ErrInvalidLocationData)
+		}
+	})
+
+	t.Run("other therapist cannot delete", func(t *testing.T) {
+		err := service.DeletePracticeLocation(ctx, "therapist-456", location.ID)
+		if err != therapistDomain.ErrPracticeLocationNotFound {
+			t.Errorf("DeletePracticeLocation() error = %v, want %v", err, therapistDomain.ErrPracticeLocationNotFound)
+		}
+	})
+
+	t.Run("location limit", func(t *testing.T) {
+		for i := 1; i < therapistDomain.MaxPracticeLocations; i++ {
+			if _, err := service.AddPracticeLocation(ctx, "therapist-123", req); err != nil {
+				t.Fatalf("AddPracticeLocation() unexpected error = %v", err)
+			}
+		}
+
+		_, err := service.AddPracticeLocation(ctx, "therapist-123", req)
+		if err != therapistDomain.ErrTooManyPracticeLocations {
+			t.Errorf("AddPracticeLocation() error = %v, want %v", err, therapistDomain.ErrTooManyPracticeLocations)
+		}
+	})
+
+	t.Run("search rejects invalid anchor", func(t *testing.T) {
+		_, err := service.SearchTherapists(ctx, therapistDomain.TherapistSearchFilters{
+			Near:     &therapistDomain.GeoPoint{Latitude: 37.7, Longitude: -122.4},
+			RadiusKm: 0,
+		})
+		if !errors.Is(err, therapistDomain.ErrInvalidLocationData) {
+			t.Errorf("SearchTherapists() error = %v, want %v", err, therapistDomain.ErrInvalidLocationData)
+		}
+	})
+}
+
+func TestTherapistService_UpdateSpecializations(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	therapistRepo := NewMockTherapistRepository()
+	therapyRepo := NewMockTherapyRepository()
+
+	user, _ := userDomain.NewUserWithRole("therapist@example.com", "password123", userDomain.RoleTherapist)
+	user.ID = "therapist-123"
+	userRepo.users[user.ID] = user
+
+	profile, _ := therapistDomain.NewTherapistProfile(user.ID, "Jane", "Smith", "LIC-12345")
+	therapistRepo.profiles[profile.UserID] = profile
+
+	for _, id := range []string{"family-therapy", "emdr-therapy"} {
+		therapy, _ := therapyDomain.NewTherapy(id, "Therapy "+id, "A short description", "ICON", "Detailed information about the therapy", "When the therapy is needed by clients")
+		therapyRepo.therapies[therapy.ID] = therapy
+	}
+	therapyRepo.therapies["emdr-therapy"].SetActive(false)
+
+	service := NewTherapistService(therapistRepo, userRepo, therapyRepo, nil, nil)
+	ctx := context.Background()
+
+	updated, err := service.UpdateSpecializations(ctx, user.ID, []string{" Family-Therapy ", "family-therapy", ""})
+	if err != nil {
+		t.Fatalf("UpdateSpecializations() unexpected error = %v", err)
+	}
+
+	if len(updated.Specializations) != 1 || updated.Specializations[0] != "family-therapy" {
+		t.Errorf("UpdateSpecializations() Specializations = %v, want [family-therapy]", updated.Specializations)
+	}
+
+	for _, id := range []string{"Anxiety Disorders", "emdr-therapy"} {
+		_, err := service.UpdateSpecializations(ctx, user.ID, []string{id})
+		if !errors.Is(err, therapistDomain.ErrTherapyNotInCatalog) {
+			t.Errorf("UpdateSpecializations(%q) error = %v, want %v", id, err, therapistDomain.ErrTherapyNotInCatalog)
+		}
+	}
+
+	_, err = service.AddSpecialization(ctx, user.ID, "unknown-therapy")
+	if !errors.Is(err, therapistDomain.ErrTherapyNotInCatalog) {
+		t.Errorf("AddSpecialization() error = %v, want %v", err, therapistDomain.ErrTherapyNotInCatalog)
+	}
+
+	_, err = service.SearchTherapists(ctx, therapistDomain.TherapistSearchFilters{TherapyID: "unknown-therapy"})
+	if !errors.Is(err, therapyDomain.ErrTherapyNotFound) {
+		t.Errorf("SearchTherapists() error = %v, want %v", err, therapyDomain.ErrTherapyNotFound)
+	}
+}
+
+func TestTherapistService_SearchTherapistsSort(t *testing.T) {
+	service := NewTherapistService(NewMockTherapistRepository(), NewMockUserRepository(), NewMockTherapyRepository(), nil, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		filters therapistDomain.TherapistSearchFilters
+		wantErr error
+	}{
+		{
+			name:    "default sort",
+			filters: therapistDomain.TherapistSearchFilters{},
+		},
+		{
+			name:    "newest",
+			filters: therapistDomain.TherapistSearchFilters{Sort: therapistDomain.SortByNewest},
+		},
+		{
+			name:    "rating",
+			filters: therapistDomain.TherapistSearchFilters{Sort: therapistDomain.SortByRating},
+		},
+		{
+			name:    "relevance with search text",
+			filters: therapistDomain.TherapistSearchFilters{Sort: therapistDomain.SortByRelevance, SearchText: "anxiety"},
+		},
+		{
+			name:    "relevance without search text",
+			filters: therapistDomain.TherapistSearchFilters{Sort: therapistDomain.SortByRelevance, SearchText: " !? "},
+			wantErr: therapistDomain.ErrInvalidSearchSort,
+		},
+		{
+			name:    "unknown sort",
+			filters: therapistDomain.TherapistSearchFilters{Sort: "popularity"},
+			wantErr: therapistDomain.ErrInvalidSearchSort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := service.SearchTherapists(ctx, tt.filters)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SearchTherapists() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && page == nil {
+				t.Error("SearchTherapists() returned nil page")
+			}
+		})
+	}
+}
+
+func TestTherapistService_UploadPhoto(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	therapistRepo := NewMockTherapistRepository()
+	storage := NewMockMediaStorage()
+
+	user, _ := userDomain.NewUserWithRole("therapist@example.com", "password123", userDomain.RoleTherapist)
+	user.ID = "therapist-123"
+	userRepo.users[user.ID] = user
+
+	profile, _ := therapistDomain.NewTherapistProfile(user.ID, "Jane", "Smith", "LIC-12345")
+	therapistRepo.profiles[profile.UserID] = profile
+
+	service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, storage)
+	ctx := context.Background()
+
+	var photo bytes.Buffer
+	if err := png.Encode(&photo, image.NewRGBA(image.Rect(0, 0, 1000, 600))); err != nil {
+		t.Fatalf("Failed to encode photo: %v", err)
+	}
+
+	if _, err := service.UploadPhoto(ctx, user.ID, []byte("<svg onload=alert(1)>")); !errors.Is(err, media.ErrUnsupportedImageType) {
+		t.Errorf("UploadPhoto() error = %v, want %v", err, media.ErrUnsupportedImageType)
+	}
+
+	updated, err := service.UploadPhoto(ctx, user.ID, photo.Bytes())
+	if err != nil {
+		t.Fatalf("UploadPhoto() unexpected error = %v", err)
+	}
+
+	if updated.Photo == nil || !strings.HasPrefix(updated.Photo.Key, therapistDomain.PhotoKeyPrefix+"/"+user.ID+"/") {
+		t.Fatalf("UploadPhoto() Photo = %v, want a key under the therapist's prefix", updated.Photo)
+	}
+	firstKey := updated.Photo.Key
+
+	for _, variant := range media.ProfilePhotoVariants {
+		if _, err := storage.Get(ctx, media.VariantKey(firstKey, variant.Name)); err != nil {
+			t.Errorf("UploadPhoto() did not store the %s variant: %v", variant.Name, err)
+		}
+	}
+
+	// A new upload replaces the files of the previous photo
+	if _, err := service.UploadPhoto(ctx, user.ID, photo.Bytes()); err != nil {
+		t.Fatalf("UploadPhoto() second upload unexpected error = %v", err)
+	}
+	if len(storage.objects) != len(media.ProfilePhotoVariants) {
+		t.Errorf("storage holds %d objects after replacing the photo, want %d", len(storage.objects), len(media.ProfilePhotoVariants))
+	}
+
+	removed, err := service.DeletePhoto(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("DeletePhoto() unexpected error = %v", err)
+	}
+	if removed.Photo != nil || len(storage.objects) != 0 {
+		t.Errorf("DeletePhoto() Photo = %v, %d stored objects left", removed.Photo, len(storage.objects))
+	}
+}
+
+func TestTherapistService_Slugs(t *testing.T) {
+	userRepo := NewMockUserRepository()
+	therapistRepo := NewMockTherapistRepository()
+
+	for _, id := range []string{"therapist-1", "therapist-2"} {
+		user, _ := userDomain.NewUserWithRole(id+"@example.com", "password123", userDomain.RoleTherapist)
+		user.ID = id
+		userRepo.users[user.ID] = user
+	}
+
+	service := NewTherapistService(therapistRepo, userRepo, NewMockTherapyRepository(), nil, nil)
+	ctx := context.Background()
+
+	first, err := service.CreateProfile(ctx, "therapist-1", therapistDomain.CreateProfileRequest{
+		FirstName: "Ana", LastName: "Marić", LicenseNumber: "LIC-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateProfile() unexpected error = %v", err)
+	}
+	if first.Slug != "ana-maric" {
+		t.Errorf("CreateProfile() Slug = %q, want %q", first.Slug, "ana-maric")
+	}
+
+	// A second therapist with the same name gets a numbered slug
+	second, err := service.CreateProfile(ctx, "therapist-2", therapistDomain.CreateProfileRequest{
+		FirstName: "Ana", LastName: "Maric", LicenseNumber: "LIC-2",
+	})
+	if err != nil {
+		t.Fatalf("CreateProfile() unexpected error = %v", err)
+	}
+	if second.Slug != "ana-maric-2" {
+		t.Errorf("CreateProfile() Slug = %q, want %q", second.Slug, "ana-maric-2")
+	}
+
+	if _, err := service.UpdateSlug(ctx, "therapist-2", "ana-maric"); !errors.Is(err, therapistDomain.ErrSlugAlreadyExists) {
+		t.Errorf("UpdateSlug() error = %v, want %v", err, therapistDomain.ErrSlugAlreadyExists)
+	}
+
+	if _, err := service.UpdateSlug(ctx, "therapist-2", "Ana_Maric"); !errors.Is(err, therapistDomain.ErrInvalidSlug) {
+		t.Errorf("UpdateSlug() error = %v, want %v", err, therapistDomain.ErrInvalidSlug)
+	}
+
+	updated, err := service.UpdateSlug(ctx, "therapist-2", " Ana-Maric-Zagreb ")
+	if err != nil {
+		t.Fatalf("UpdateSlug() unexpected error = %v", err)
+	}
+	if updated.Slug != "ana-maric-zagreb" {
+		t.Errorf("UpdateSlug() Slug = %q, want %q", updated.Slug, "ana-maric-zagreb")
+	}
+
+	found, err := service.GetBySlug(ctx, "ANA-MARIC-ZAGREB")
+	if err != nil || found.UserID != "therapist-2" {
+		t.Errorf("GetBySlug() = %v, %v, want therapist-2", found, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/goran/thappy/internal/domain/pagination"
 	"github.com/goran/thappy/internal/domain/therapy"
 )
 
@@ -49,12 +50,12 @@ func (s *TherapyService) GetTherapy(ctx context.Context, id string) (*therapy.Th
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *TherapyService) ListTherapies(ctx context.Context) ([]*therapy.Therapy, error) {
-	return s.repo.GetAll(ctx)
+func (s *TherapyService) ListTherapies(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapy.Therapy], error) {
+	return s.repo.GetAll(ctx, page)
 }
 
-func (s *TherapyService) ListActiveTherapies(ctx context.Context) ([]*therapy.Therapy, error) {
-	return s.repo.GetAllActive(ctx)
+func (s *TherapyService) ListActiveTherapies(ctx context.Context, page pagination.PageRequest) (*pagination.Page[*therapy.Therapy], error) {
+	return s.repo.GetAllActive(ctx, page)
 }
 
 func (s *TherapyService) UpdateTherapy(ctx context.Context, therapyEntity *therapy.Therapy) error {
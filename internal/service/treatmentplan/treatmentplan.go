@@ -0,0 +1,247 @@
+package treatmentplan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	treatmentPlanDomain "github.com/goran/thappy/internal/domain/treatmentplan"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+)
+
+type TreatmentPlanService struct {
+	planRepo   treatmentPlanDomain.Repository
+	clientRepo clientDomain.ClientRepository
+	userRepo   userDomain.UserRepository
+}
+
+func NewTreatmentPlanService(
+	planRepo treatmentPlanDomain.Repository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+) *TreatmentPlanService {
+	return &TreatmentPlanService{
+		planRepo:   planRepo,
+		clientRepo: clientRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreatePlan starts a treatment plan for a client currently assigned to the therapist
+func (s *TreatmentPlanService) CreatePlan(ctx context.Context, therapistUserID string, req treatmentPlanDomain.CreatePlanRequest) (*treatmentPlanDomain.Plan, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, req.ClientID); err != nil {
+		return nil, err
+	}
+
+	plan, err := treatmentPlanDomain.NewPlan(therapistUserID, req.ClientID, req.Title, req.Problems, req.Goals, req.ReviewIntervalDays, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", treatmentPlanDomain.ErrInvalidPlanData, err)
+	}
+
+	err = s.planRepo.Create(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (s *TreatmentPlanService) UpdatePlan(ctx context.Context, therapistUserID, planID string, req treatmentPlanDomain.PlanContent) (*treatmentPlanDomain.Plan, error) {
+	plan, err := s.getOwnedPlan(ctx, therapistUserID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = plan.Revise(req.Title, req.Problems, req.Goals, req.ReviewIntervalDays, time.Now())
+	if err != nil {
+		if err == treatmentPlanDomain.ErrPlanClosed {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", treatmentPlanDomain.ErrInvalidPlanData, err)
+	}
+
+	return s.save(ctx, plan)
+}
+
+func (s *TreatmentPlanService) RecordProgress(ctx context.Context, therapistUserID, planID string, req treatmentPlanDomain.ProgressRequest) (*treatmentPlanDomain.Plan, error) {
+	plan, err := s.getOwnedPlan(ctx, therapistUserID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := plan.RecordProgress(therapistUserID, req.GoalID, req.Note, req.Status, time.Now())
+	if err != nil {
+		if err == treatmentPlanDomain.ErrPlanClosed {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", treatmentPlanDomain.ErrInvalidPlanData, err)
+	}
+
+	err = s.planRepo.AddProgress(ctx, plan, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// MarkReviewed records the periodic plan review and schedules the next one
+func (s *TreatmentPlanService) MarkReviewed(ctx context.Context, therapistUserID, planID string) (*treatmentPlanDomain.Plan, error) {
+	plan, err := s.getOwnedPlan(ctx, therapistUserID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := plan.MarkReviewed(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, plan)
+}
+
+func (s *TreatmentPlanService) ClosePlan(ctx context.Context, therapistUserID, planID string, status treatmentPlanDomain.Status) (*treatmentPlanDomain.Plan, error) {
+	plan, err := s.getOwnedPlan(ctx, therapistUserID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = plan.Close(status, time.Now())
+	if err != nil {
+		if err == treatmentPlanDomain.ErrPlanClosed {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", treatmentPlanDomain.ErrInvalidPlanData, err)
+	}
+
+	return s.save(ctx, plan)
+}
+
+// SetPlanShared gives the client read-only access to the plan, or takes it away
+func (s *TreatmentPlanService) SetPlanShared(ctx context.Context, therapistUserID, planID string, shared bool) (*treatmentPlanDomain.Plan, error) {
+	plan, err := s.getOwnedPlan(ctx, therapistUserID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.SetShared(shared, time.Now())
+
+	return s.save(ctx, plan)
+}
+
+// GetClientPlans lists the therapist's plans for a client currently assigned to them
+func (s *TreatmentPlanService) GetClientPlans(ctx context.Context, therapistUserID, clientUserID string) ([]*treatmentPlanDomain.Plan, error) {
+	if err := s.verifyAssignedClient(ctx, therapistUserID, clientUserID); err != nil {
+		return nil, err
+	}
+
+	plans, err := s.planRepo.GetByClientID(ctx, clientUserID, therapistUserID, false)
+	if err != nil {
+		return nil, treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	return plans, nil
+}
+
+// GetPlansDueForReview lists the therapist's active plans whose periodic review is due
+func (s *TreatmentPlanService) GetPlansDueForReview(ctx context.Context, therapistUserID string) ([]*treatmentPlanDomain.Plan, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	plans, err := s.planRepo.GetDueForReview(ctx, therapistUserID, time.Now())
+	if err != nil {
+		return nil, treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	return plans, nil
+}
+
+// GetSharedPlans lists the plans a client's therapists have shared with them
+func (s *TreatmentPlanService) GetSharedPlans(ctx context.Context, clientUserID string) ([]*treatmentPlanDomain.Plan, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	plans, err := s.planRepo.GetByClientID(ctx, clientUserID, "", true)
+	if err != nil {
+		return nil, treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	return plans, nil
+}
+
+func (s *TreatmentPlanService) save(ctx context.Context, plan *treatmentPlanDomain.Plan) (*treatmentPlanDomain.Plan, error) {
+	err := s.planRepo.Update(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// getOwnedPlan loads a plan for changes. Only the therapist who wrote it may
+// change it, and only while the client is still assigned to them.
+func (s *TreatmentPlanService) getOwnedPlan(ctx context.Context, therapistUserID, planID string) (*treatmentPlanDomain.Plan, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		if err == treatmentPlanDomain.ErrPlanNotFound {
+			return nil, err
+		}
+		return nil, treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	if !plan.OwnedBy(therapistUserID) {
+		return nil, treatmentPlanDomain.ErrUnauthorizedAccess
+	}
+
+	if err := s.verifyAssignment(ctx, therapistUserID, plan.ClientID); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (s *TreatmentPlanService) verifyAssignedClient(ctx context.Context, therapistUserID, clientUserID string) error {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return err
+	}
+
+	return s.verifyAssignment(ctx, therapistUserID, clientUserID)
+}
+
+func (s *TreatmentPlanService) verifyAssignment(ctx context.Context, therapistUserID, clientUserID string) error {
+	client, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		if err == clientDomain.ErrClientProfileNotFound {
+			return err
+		}
+		return treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	if client.TherapistID == nil || *client.TherapistID != therapistUserID {
+		return treatmentPlanDomain.ErrClientNotAssigned
+	}
+
+	return nil
+}
+
+func (s *TreatmentPlanService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return treatmentPlanDomain.ErrUnauthorizedAccess
+		}
+		return treatmentPlanDomain.ErrPlanServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return treatmentPlanDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
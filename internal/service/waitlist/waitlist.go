@@ -0,0 +1,369 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	clientDomain "github.com/goran/thappy/internal/domain/client"
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	therapistDomain "github.com/goran/thappy/internal/domain/therapist"
+	userDomain "github.com/goran/thappy/internal/domain/user"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
+)
+
+type WaitlistService struct {
+	waitlistRepo  waitlistDomain.Repository
+	therapistRepo therapistDomain.TherapistRepository
+	clientRepo    clientDomain.ClientRepository
+	userRepo      userDomain.UserRepository
+	notifier      waitlistDomain.Notifier
+	consents      clientDomain.ConsentChecker
+	events        realtimeDomain.Publisher
+}
+
+func NewWaitlistService(
+	waitlistRepo waitlistDomain.Repository,
+	therapistRepo therapistDomain.TherapistRepository,
+	clientRepo clientDomain.ClientRepository,
+	userRepo userDomain.UserRepository,
+	notifier waitlistDomain.Notifier,
+	consents clientDomain.ConsentChecker,
+	events realtimeDomain.Publisher,
+) *WaitlistService {
+	return &WaitlistService{
+		waitlistRepo:  waitlistRepo,
+		therapistRepo: therapistRepo,
+		clientRepo:    clientRepo,
+		userRepo:      userRepo,
+		notifier:      notifier,
+		consents:      consents,
+		events:        events,
+	}
+}
+
+func (s *WaitlistService) JoinWaitlist(ctx context.Context, clientUserID string, req waitlistDomain.JoinWaitlistRequest) (*waitlistDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	// A client profile is needed so the therapist can be assigned once a spot is accepted
+	exists, err := s.clientRepo.ExistsByUserID(ctx, clientUserID)
+	if err != nil {
+		return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+	if !exists {
+		return nil, clientDomain.ErrClientProfileNotFound
+	}
+
+	therapist, err := s.therapistRepo.GetByUserID(ctx, req.TherapistID)
+	if err != nil {
+		if err == therapistDomain.ErrTherapistProfileNotFound {
+			return nil, err
+		}
+		return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	// Waitlists only exist for full practices; otherwise the client can connect directly
+	if therapist.IsAcceptingClients {
+		return nil, waitlistDomain.ErrTherapistAcceptingClients
+	}
+
+	entry, err := waitlistDomain.NewEntry(therapist.UserID, clientUserID, req.Preferences)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", waitlistDomain.ErrInvalidWaitlistData, err)
+	}
+
+	err = s.waitlistRepo.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload to pick up the computed queue position
+	return s.waitlistRepo.GetByID(ctx, entry.ID)
+}
+
+func (s *WaitlistService) UpdatePreferences(ctx context.Context, clientUserID, entryID string, preferences waitlistDomain.Preferences) (*waitlistDomain.Entry, error) {
+	entry, err := s.getClientEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = entry.UpdatePreferences(preferences)
+	if err != nil {
+		if err == waitlistDomain.ErrInvalidStatusTransition {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", waitlistDomain.ErrInvalidWaitlistData, err)
+	}
+
+	err = s.waitlistRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *WaitlistService) LeaveWaitlist(ctx context.Context, clientUserID, entryID string) error {
+	entry, err := s.getClientEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return err
+	}
+
+	err = entry.Remove()
+	if err != nil {
+		return err
+	}
+
+	return s.waitlistRepo.Update(ctx, entry)
+}
+
+func (s *WaitlistService) GetClientWaitlists(ctx context.Context, clientUserID string) ([]*waitlistDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.waitlistRepo.GetActiveByClientID(ctx, clientUserID)
+	if err != nil {
+		return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	return entries, nil
+}
+
+func (s *WaitlistService) GetTherapistWaitlist(ctx context.Context, therapistUserID string) ([]*waitlistDomain.Entry, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.waitlistRepo.GetActiveByTherapistID(ctx, therapistUserID)
+	if err != nil {
+		return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	return entries, nil
+}
+
+func (s *WaitlistService) OfferNextSpot(ctx context.Context, therapistUserID string) (*waitlistDomain.Entry, error) {
+	if err := s.verifyRole(ctx, therapistUserID, userDomain.RoleTherapist); err != nil {
+		return nil, err
+	}
+
+	// Drop lapsed entries and unanswered offers so they don't block the queue
+	if _, err := s.ExpireStaleEntries(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.waitlistRepo.GetNextWaiting(ctx, therapistUserID)
+	if err != nil {
+		if err == waitlistDomain.ErrEntryNotFound {
+			return nil, waitlistDomain.ErrNoWaitingClients
+		}
+		return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	err = entry.Offer(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.waitlistRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.notifier != nil {
+		if err := s.notifier.NotifySpotOffered(ctx, entry); err != nil {
+			log.Printf("Failed to notify client %s about offered spot: %v", entry.ClientID, err)
+		}
+	}
+
+	s.pushEvent(ctx, entry.ClientID, realtimeDomain.EventSpotOffered, realtimeDomain.SpotOfferedData{
+		WaitlistEntryID: entry.ID,
+		TherapistID:     entry.TherapistID,
+		OfferExpiresAt:  entry.OfferExpiresAt,
+	})
+
+	return entry, nil
+}
+
+func (s *WaitlistService) RespondToOffer(ctx context.Context, clientUserID, entryID string, accept bool) (*waitlistDomain.Entry, error) {
+	entry, err := s.getClientEntry(ctx, clientUserID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !accept {
+		err = entry.Decline(now)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.waitlistRepo.Update(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		return entry, nil
+	}
+
+	err = entry.Accept(now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepting assigns the therapist, which needs the client's consent first.
+	// Nothing is saved yet, so the offer stays open while the client signs.
+	if s.consents != nil {
+		signed, err := s.consents.HasSignedRequiredConsents(ctx, clientUserID)
+		if err != nil {
+			return nil, waitlistDomain.ErrWaitlistServiceUnavailable
+		}
+		if !signed {
+			return nil, clientDomain.ErrConsentRequired
+		}
+	}
+
+	profile, err := s.clientRepo.GetByUserID(ctx, clientUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.AssignTherapist(&entry.TherapistID)
+
+	err = s.clientRepo.Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.waitlistRepo.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	s.pushEvent(ctx, entry.TherapistID, realtimeDomain.EventConnectionAccepted, realtimeDomain.ConnectionAcceptedData{
+		ClientID:        entry.ClientID,
+		WaitlistEntryID: entry.ID,
+	})
+
+	return entry, nil
+}
+
+// pushEvent tells the user about a waitlist change through their open streams
+// and notifications. Failing to is logged: the change stands either way.
+func (s *WaitlistService) pushEvent(ctx context.Context, userID, eventType string, data interface{}) {
+	if s.events == nil {
+		return
+	}
+
+	if err := s.publishEvent(ctx, userID, eventType, data); err != nil {
+		log.Printf("Failed to push %s event to user %s: %v", eventType, userID, err)
+	}
+}
+
+// publishEvent hands the event to the user's streams and notifications
+func (s *WaitlistService) publishEvent(ctx context.Context, userID, eventType string, data interface{}) error {
+	if s.events == nil {
+		return errors.New("no event publisher configured")
+	}
+
+	event, err := realtimeDomain.NewEvent(userID, eventType, data, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return s.events.Publish(ctx, event)
+}
+
+// NotifyTherapistAvailable tells everyone still waiting that the therapist reopened their practice.
+// Only clients the event reached are recorded as notified. It returns the number of clients that
+// were notified, and an error if some could not be.
+func (s *WaitlistService) NotifyTherapistAvailable(ctx context.Context, therapistUserID string) (int, error) {
+	entries, err := s.waitlistRepo.GetActiveByTherapistID(ctx, therapistUserID)
+	if err != nil {
+		return 0, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	notified, failed := 0, 0
+	now := time.Now()
+	for _, entry := range entries {
+		if entry.Status != waitlistDomain.StatusWaiting {
+			continue
+		}
+
+		err := s.publishEvent(ctx, entry.ClientID, realtimeDomain.EventTherapistAvailable, realtimeDomain.TherapistAvailableData{
+			WaitlistEntryID: entry.ID,
+			TherapistID:     entry.TherapistID,
+		})
+		if err != nil {
+			log.Printf("Failed to notify client %s about therapist availability: %v", entry.ClientID, err)
+			failed++
+			continue
+		}
+
+		if s.notifier != nil {
+			if err := s.notifier.NotifyTherapistAvailable(ctx, entry); err != nil {
+				log.Printf("Failed to publish therapist availability for entry %s: %v", entry.ID, err)
+			}
+		}
+
+		entry.MarkNotified(now)
+		if err := s.waitlistRepo.Update(ctx, entry); err != nil {
+			log.Printf("Failed to record waitlist notification for entry %s: %v", entry.ID, err)
+			continue
+		}
+		notified++
+	}
+
+	if failed > 0 {
+		return notified, fmt.Errorf("%w: %d waiting clients could not be notified", waitlistDomain.ErrWaitlistServiceUnavailable, failed)
+	}
+
+	return notified, nil
+}
+
+func (s *WaitlistService) ExpireStaleEntries(ctx context.Context) (int64, error) {
+	expired, err := s.waitlistRepo.ExpireStale(ctx, time.Now())
+	if err != nil {
+		return 0, waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	return expired, nil
+}
+
+func (s *WaitlistService) getClientEntry(ctx context.Context, clientUserID, entryID string) (*waitlistDomain.Entry, error) {
+	if err := s.verifyRole(ctx, clientUserID, userDomain.RoleClient); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.waitlistRepo.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entry.BelongsToClient(clientUserID) {
+		return nil, waitlistDomain.ErrEntryNotFound
+	}
+
+	return entry, nil
+}
+
+func (s *WaitlistService) verifyRole(ctx context.Context, userID string, role userDomain.UserRole) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == userDomain.ErrUserNotFound {
+			return waitlistDomain.ErrUnauthorizedAccess
+		}
+		return waitlistDomain.ErrWaitlistServiceUnavailable
+	}
+
+	if !user.HasRole(role) || !user.IsActive {
+		return waitlistDomain.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	realtimeDomain "github.com/goran/thappy/internal/domain/realtime"
+	waitlistDomain "github.com/goran/thappy/internal/domain/waitlist"
+)
+
+// MockWaitlistRepository is a mock implementation of waitlistDomain.Repository
+type MockWaitlistRepository struct {
+	entries map[string]*waitlistDomain.Entry
+	updated []string
+}
+
+func NewMockWaitlistRepository() *MockWaitlistRepository {
+	return &MockWaitlistRepository{
+		entries: make(map[string]*waitlistDomain.Entry),
+	}
+}
+
+func (m *MockWaitlistRepository) Create(ctx context.Context, entry *waitlistDomain.Entry) error {
+	m.entries[entry.ID] = entry
+	return nil
+}
+
+func (m *MockWaitlistRepository) GetByID(ctx context.Context, id string) (*waitlistDomain.Entry, error) {
+	entry, exists := m.entries[id]
+	if !exists {
+		return nil, waitlistDomain.ErrEntryNotFound
+	}
+	return entry, nil
+}
+
+func (m *MockWaitlistRepository) Update(ctx context.Context, entry *waitlistDomain.Entry) error {
+	m.entries[entry.ID] = entry
+	m.updated = append(m.updated, entry.ID)
+	return nil
+}
+
+func (m *MockWaitlistRepository) GetActiveByTherapistID(ctx context.Context, therapistID string) ([]*waitlistDomain.Entry, error) {
+	var entries []*waitlistDomain.Entry
+	for _, entry := range m.entries {
+		if entry.TherapistID == therapistID && entry.IsActive() {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// Add other required methods with empty implementations for now
+func (m *MockWaitlistRepository) GetActiveByClientID(ctx context.Context, clientID string) ([]*waitlistDomain.Entry, error) {
+	return nil, nil
+}
+func (m *MockWaitlistRepository) GetNextWaiting(ctx context.Context, therapistID string) (*waitlistDomain.Entry, error) {
+	return nil, waitlistDomain.ErrEntryNotFound
+}
+func (m *MockWaitlistRepository) ExpireStale(ctx context.Context, now time.Time) (int64, error) {
+	return 0, nil
+}
+
+// MockPublisher records events and fails for the users in failFor
+type MockPublisher struct {
+	events  []*realtimeDomain.Event
+	failFor map[string]bool
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, event *realtimeDomain.Event) error {
+	if m.failFor[event.UserID] {
+		return errors.New("notification store unavailable")
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+// MockNotifier stands in for a broker that is down
+type MockNotifier struct{}
+
+func (m *MockNotifier) NotifyTherapistAvailable(ctx context.Context, entry *waitlistDomain.Entry) error {
+	return errors.New("broker unavailable")
+}
+
+func (m *MockNotifier) NotifySpotOffered(ctx context.Context, entry *waitlistDomain.Entry) error {
+	return errors.New("broker unavailable")
+}
+
+func TestWaitlistService_NotifyTherapistAvailable(t *testing.T) {
+	waitlistRepo := NewMockWaitlistRepository()
+	reached, _ := waitlistDomain.NewEntry("therapist-123", "client-123", waitlistDomain.Preferences{})
+	unreached, _ := waitlistDomain.NewEntry("therapist-123", "client-456", waitlistDomain.Preferences{})
+	waitlistRepo.entries[reached.ID] = reached
+	waitlistRepo.entries[unreached.ID] = unreached
+
+	events := &MockPublisher{failFor: map[string]bool{"client-456": true}}
+	service := NewWaitlistService(waitlistRepo, nil, nil, nil, &MockNotifier{}, nil, events)
+
+	notified, err := service.NotifyTherapistAvailable(context.Background(), "therapist-123")
+	if !errors.Is(err, waitlistDomain.ErrWaitlistServiceUnavailable) {
+		t.Errorf("NotifyTherapistAvailable() error = %v, want %v", err, waitlistDomain.ErrWaitlistServiceUnavailable)
+	}
+	if notified != 1 {
+		t.Errorf("NotifyTherapistAvailable() notified %d clients, want 1", notified)
+	}
+
+	if len(events.events) != 1 || events.events[0].Type != realtimeDomain.EventTherapistAvailable || events.events[0].UserID != "client-123" {
+		t.Fatalf("Expected one %s event for client-123, got %+v", realtimeDomain.EventTherapistAvailable, events.events)
+	}
+
+	// A broker that is down does not keep the client from being told
+	if reached.NotifiedAt == nil {
+		t.Error("Expected the client the event reached to be recorded as notified")
+	}
+	if unreached.NotifiedAt != nil {
+		t.Error("A client the event did not reach must not be recorded as notified")
+	}
+	if len(waitlistRepo.updated) != 1 || waitlistRepo.updated[0] != reached.ID {
+		t.Errorf("Expected only the notified entry to be saved, got %v", waitlistRepo.updated)
+	}
+}
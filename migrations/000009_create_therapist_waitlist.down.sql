@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_therapist_waitlist_entries_updated_at ON therapist_waitlist_entries;
+DROP INDEX IF EXISTS idx_waitlist_expires_at;
+DROP INDEX IF EXISTS idx_waitlist_client_id;
+DROP INDEX IF EXISTS idx_waitlist_therapist_status;
+DROP INDEX IF EXISTS idx_waitlist_active_client_therapist;
+DROP TABLE IF EXISTS therapist_waitlist_entries;
@@ -0,0 +1,30 @@
+-- Create therapist_waitlist_entries table
+CREATE TABLE IF NOT EXISTS therapist_waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    preferences JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    notified_at TIMESTAMP WITH TIME ZONE,
+    offered_at TIMESTAMP WITH TIME ZONE,
+    offer_expires_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_waitlist_status CHECK (status IN ('waiting', 'offered', 'accepted', 'declined', 'expired', 'removed'))
+);
+
+-- A client can only hold one active spot per therapist
+CREATE UNIQUE INDEX idx_waitlist_active_client_therapist
+    ON therapist_waitlist_entries(therapist_id, client_id)
+    WHERE status IN ('waiting', 'offered');
+
+CREATE INDEX idx_waitlist_therapist_status ON therapist_waitlist_entries(therapist_id, status, created_at);
+CREATE INDEX idx_waitlist_client_id ON therapist_waitlist_entries(client_id);
+CREATE INDEX idx_waitlist_expires_at ON therapist_waitlist_entries(expires_at);
+
+-- Create updated_at trigger for waitlist table
+CREATE TRIGGER update_therapist_waitlist_entries_updated_at
+    BEFORE UPDATE ON therapist_waitlist_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
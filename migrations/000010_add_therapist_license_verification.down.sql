@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_license_documents_therapist_id;
+DROP TABLE IF EXISTS license_verification_documents;
+
+DROP INDEX IF EXISTS idx_therapist_profiles_verification;
+ALTER TABLE therapist_profiles DROP CONSTRAINT IF EXISTS chk_therapist_verification_status;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS verification_rejection_reason;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS verification_reviewed_by;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS verification_reviewed_at;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS verification_submitted_at;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS license_expires_at;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS license_type;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS license_jurisdiction;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS verification_status;
@@ -0,0 +1,30 @@
+-- Add license verification state to therapist_profiles
+ALTER TABLE therapist_profiles ADD COLUMN verification_status VARCHAR(20) NOT NULL DEFAULT 'unverified';
+ALTER TABLE therapist_profiles ADD COLUMN license_jurisdiction VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE therapist_profiles ADD COLUMN license_type VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE therapist_profiles ADD COLUMN license_expires_at DATE;
+ALTER TABLE therapist_profiles ADD COLUMN verification_submitted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE therapist_profiles ADD COLUMN verification_reviewed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE therapist_profiles ADD COLUMN verification_reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE therapist_profiles ADD COLUMN verification_rejection_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE therapist_profiles ADD CONSTRAINT chk_therapist_verification_status
+    CHECK (verification_status IN ('unverified', 'submitted', 'verified', 'rejected', 'expired'));
+
+-- Profiles created before verification existed were never reviewed, so they are
+-- not backfilled: they start 'unverified' like new profiles and drop out of public
+-- listings until their license evidence has been submitted and approved
+
+CREATE INDEX idx_therapist_profiles_verification ON therapist_profiles(verification_status, license_expires_at);
+
+-- Create license_verification_documents table for uploaded evidence
+CREATE TABLE IF NOT EXISTS license_verification_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    content BYTEA NOT NULL,
+    uploaded_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_license_documents_therapist_id ON license_verification_documents(therapist_id, uploaded_at);
@@ -0,0 +1,11 @@
+DROP FUNCTION IF EXISTS haversine_km(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+
+DROP TRIGGER IF EXISTS update_therapist_practice_locations_updated_at ON therapist_practice_locations;
+DROP INDEX IF EXISTS idx_practice_locations_coordinates;
+DROP INDEX IF EXISTS idx_practice_locations_therapist_id;
+DROP TABLE IF EXISTS therapist_practice_locations;
+
+DROP INDEX IF EXISTS idx_therapist_profiles_modality;
+ALTER TABLE therapist_profiles DROP CONSTRAINT IF EXISTS chk_therapist_offers_modality;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS offers_remote;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS offers_in_person;
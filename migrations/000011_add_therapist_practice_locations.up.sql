@@ -0,0 +1,44 @@
+-- Session modalities offered by each therapist
+ALTER TABLE therapist_profiles ADD COLUMN offers_in_person BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE therapist_profiles ADD COLUMN offers_remote BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE therapist_profiles ADD CONSTRAINT chk_therapist_offers_modality
+    CHECK (offers_in_person OR offers_remote);
+
+CREATE INDEX idx_therapist_profiles_modality ON therapist_profiles(offers_in_person, offers_remote);
+
+-- Create therapist_practice_locations table
+CREATE TABLE IF NOT EXISTS therapist_practice_locations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    address_line1 VARCHAR(255) NOT NULL,
+    address_line2 VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    country VARCHAR(2) NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_practice_location_latitude CHECK (latitude BETWEEN -90 AND 90),
+    CONSTRAINT chk_practice_location_longitude CHECK (longitude BETWEEN -180 AND 180)
+);
+
+CREATE INDEX idx_practice_locations_therapist_id ON therapist_practice_locations(therapist_id);
+CREATE INDEX idx_practice_locations_coordinates ON therapist_practice_locations(latitude, longitude);
+
+CREATE TRIGGER update_therapist_practice_locations_updated_at
+    BEFORE UPDATE ON therapist_practice_locations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Great-circle distance in kilometres between two points given in degrees.
+-- LEAST guards ASIN against rounding pushing the argument just above 1 for antipodal points.
+CREATE OR REPLACE FUNCTION haversine_km(lat1 DOUBLE PRECISION, lon1 DOUBLE PRECISION, lat2 DOUBLE PRECISION, lon2 DOUBLE PRECISION)
+RETURNS DOUBLE PRECISION AS $$
+    SELECT 2 * 6371.0 * ASIN(LEAST(1.0, SQRT(
+        POWER(SIN(RADIANS(lat2 - lat1) / 2), 2) +
+        COS(RADIANS(lat1)) * COS(RADIANS(lat2)) * POWER(SIN(RADIANS(lon2 - lon1) / 2), 2)
+    )));
+$$ LANGUAGE SQL IMMUTABLE STRICT;
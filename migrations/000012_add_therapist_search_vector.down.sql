@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_therapist_profiles_search_vector;
+
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,9 @@
+-- Full-text search over therapist profiles. Names rank above specializations, which rank above bio text.
+ALTER TABLE therapist_profiles
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(first_name, '') || ' ' || COALESCE(last_name, '')), 'A') ||
+        setweight(jsonb_to_tsvector('english', COALESCE(specializations, '[]'::jsonb), '["string"]'), 'B') ||
+        setweight(to_tsvector('english', COALESCE(bio, '')), 'C')
+    ) STORED;
+
+CREATE INDEX idx_therapist_profiles_search_vector ON therapist_profiles USING gin(search_vector);
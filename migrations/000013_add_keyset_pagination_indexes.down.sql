@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_therapist_profiles_name_id;
+CREATE INDEX idx_therapist_profiles_name ON therapist_profiles(first_name, last_name);
+
+DROP INDEX IF EXISTS idx_client_profiles_therapist_name_id;
+DROP INDEX IF EXISTS idx_client_profiles_name_id;
+CREATE INDEX idx_client_profiles_name ON client_profiles(first_name, last_name);
+
+DROP INDEX IF EXISTS idx_therapies_created_at_id;
+CREATE INDEX idx_therapies_created_at ON therapies(created_at);
+
+DROP INDEX IF EXISTS idx_articles_published_date_id;
+CREATE INDEX idx_articles_published_date ON articles(published_date DESC);
@@ -0,0 +1,14 @@
+-- Cursor pagination orders every list by its sort key plus a unique ID.
+-- These replace the sort-key-only indexes so the tiebreaker is covered too.
+DROP INDEX IF EXISTS idx_articles_published_date;
+CREATE INDEX idx_articles_published_date_id ON articles(published_date DESC, id DESC);
+
+DROP INDEX IF EXISTS idx_therapies_created_at;
+CREATE INDEX idx_therapies_created_at_id ON therapies(created_at, id);
+
+DROP INDEX IF EXISTS idx_client_profiles_name;
+CREATE INDEX idx_client_profiles_name_id ON client_profiles(first_name, last_name, user_id);
+CREATE INDEX idx_client_profiles_therapist_name_id ON client_profiles(therapist_id, first_name, last_name, user_id);
+
+DROP INDEX IF EXISTS idx_therapist_profiles_name;
+CREATE INDEX idx_therapist_profiles_name_id ON therapist_profiles(first_name, last_name, user_id);
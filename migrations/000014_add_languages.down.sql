@@ -0,0 +1,4 @@
+ALTER TABLE client_profiles DROP COLUMN IF EXISTS preferred_language;
+
+DROP INDEX IF EXISTS idx_therapist_profiles_languages;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS languages;
@@ -0,0 +1,7 @@
+-- Languages a therapist holds sessions in, as [{"code": "hr", "proficiency": "native"}, ...]
+ALTER TABLE therapist_profiles ADD COLUMN languages JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX idx_therapist_profiles_languages ON therapist_profiles USING GIN (languages jsonb_path_ops);
+
+-- ISO 639-1 code of the language a client would like their sessions in
+ALTER TABLE client_profiles ADD COLUMN preferred_language VARCHAR(2);
@@ -0,0 +1,6 @@
+-- Profiles created after the upgrade have no legacy strings and keep their therapy IDs
+UPDATE therapist_profiles SET specializations = legacy_specializations WHERE legacy_specializations <> '[]'::JSONB;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS legacy_specializations;
+
+DROP INDEX IF EXISTS idx_therapist_therapies_therapy_id;
+DROP TABLE IF EXISTS therapist_therapies;
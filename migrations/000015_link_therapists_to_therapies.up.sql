@@ -0,0 +1,28 @@
+-- Therapists practise therapies from the curated catalog
+CREATE TABLE IF NOT EXISTS therapist_therapies (
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    therapy_id VARCHAR(100) NOT NULL REFERENCES therapies(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (therapist_id, therapy_id)
+);
+
+CREATE INDEX idx_therapist_therapies_therapy_id ON therapist_therapies(therapy_id);
+
+-- Keep the free-form strings so unmatched ones can be reviewed by hand
+ALTER TABLE therapist_profiles ADD COLUMN legacy_specializations JSONB NOT NULL DEFAULT '[]';
+UPDATE therapist_profiles SET legacy_specializations = specializations;
+
+-- Link strings that match a therapy ID once slugified ("Family Therapy" -> family-therapy)
+INSERT INTO therapist_therapies (therapist_id, therapy_id)
+SELECT DISTINCT tp.user_id, t.id
+FROM therapist_profiles tp
+CROSS JOIN LATERAL jsonb_array_elements_text(tp.specializations) AS spec
+INNER JOIN therapies t ON t.id = TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(spec, '[^a-zA-Z0-9]+', '-', 'g')))
+ON CONFLICT DO NOTHING;
+
+-- specializations now holds a copy of the linked therapy IDs for full-text search and facets
+UPDATE therapist_profiles tp
+SET specializations = COALESCE(
+    (SELECT jsonb_agg(tt.therapy_id ORDER BY tt.therapy_id) FROM therapist_therapies tt WHERE tt.therapist_id = tp.user_id),
+    '[]'::JSONB
+);
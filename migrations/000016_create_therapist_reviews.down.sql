@@ -0,0 +1,17 @@
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS rating_average;
+
+DROP INDEX IF EXISTS idx_review_reports_open;
+DROP TABLE IF EXISTS therapist_review_reports;
+
+DROP TRIGGER IF EXISTS update_therapist_reviews_updated_at ON therapist_reviews;
+DROP INDEX IF EXISTS idx_therapist_reviews_flagged;
+DROP INDEX IF EXISTS idx_therapist_reviews_client;
+DROP INDEX IF EXISTS idx_therapist_reviews_therapist;
+DROP TABLE IF EXISTS therapist_reviews;
+
+DROP TRIGGER IF EXISTS track_client_profiles_therapist ON client_profiles;
+DROP FUNCTION IF EXISTS track_client_therapist_relationship();
+DROP INDEX IF EXISTS idx_client_therapist_relationships_therapist;
+DROP INDEX IF EXISTS idx_client_therapist_relationships_pair;
+DROP TABLE IF EXISTS client_therapist_relationships;
@@ -0,0 +1,91 @@
+-- Every client-therapist assignment, past and present, so former clients can still leave a review
+CREATE TABLE IF NOT EXISTS client_therapist_relationships (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    therapist_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_client_therapist_relationships_pair ON client_therapist_relationships(client_id, therapist_id);
+CREATE INDEX idx_client_therapist_relationships_therapist ON client_therapist_relationships(therapist_id);
+
+-- Record assignment changes made through client_profiles.therapist_id
+CREATE OR REPLACE FUNCTION track_client_therapist_relationship()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.therapist_id IS NOT DISTINCT FROM OLD.therapist_id THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND OLD.therapist_id IS NOT NULL THEN
+        UPDATE client_therapist_relationships
+        SET ended_at = NOW()
+        WHERE client_id = NEW.user_id AND therapist_id = OLD.therapist_id AND ended_at IS NULL;
+    END IF;
+
+    IF NEW.therapist_id IS NOT NULL THEN
+        INSERT INTO client_therapist_relationships (client_id, therapist_id)
+        VALUES (NEW.user_id, NEW.therapist_id);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_client_profiles_therapist
+    AFTER INSERT OR UPDATE OF therapist_id ON client_profiles
+    FOR EACH ROW
+    EXECUTE FUNCTION track_client_therapist_relationship();
+
+-- Current assignments are the only history available for existing data
+INSERT INTO client_therapist_relationships (client_id, therapist_id, started_at)
+SELECT user_id, therapist_id, created_at
+FROM client_profiles
+WHERE therapist_id IS NOT NULL;
+
+-- Create therapist_reviews table
+CREATE TABLE IF NOT EXISTS therapist_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'published',
+    reply TEXT NOT NULL DEFAULT '',
+    replied_at TIMESTAMP WITH TIME ZONE,
+    moderated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    moderation_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_review_rating CHECK (rating BETWEEN 1 AND 5),
+    CONSTRAINT chk_review_status CHECK (status IN ('published', 'flagged', 'hidden')),
+    CONSTRAINT uq_review_therapist_client UNIQUE (therapist_id, client_id)
+);
+
+CREATE INDEX idx_therapist_reviews_therapist ON therapist_reviews(therapist_id, created_at DESC, id DESC);
+CREATE INDEX idx_therapist_reviews_client ON therapist_reviews(client_id);
+CREATE INDEX idx_therapist_reviews_flagged ON therapist_reviews(created_at, id) WHERE status = 'flagged';
+
+CREATE TRIGGER update_therapist_reviews_updated_at
+    BEFORE UPDATE ON therapist_reviews
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create therapist_review_reports table
+CREATE TABLE IF NOT EXISTS therapist_review_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    review_id UUID NOT NULL REFERENCES therapist_reviews(id) ON DELETE CASCADE,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT uq_review_report_reporter UNIQUE (review_id, reporter_id)
+);
+
+CREATE INDEX idx_review_reports_open ON therapist_review_reports(review_id) WHERE resolved_at IS NULL;
+
+-- Rating summary over visible reviews, maintained by the review repository
+ALTER TABLE therapist_profiles ADD COLUMN rating_average NUMERIC(3, 2);
+ALTER TABLE therapist_profiles ADD COLUMN rating_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS photo_uploaded_at;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS photo_key;
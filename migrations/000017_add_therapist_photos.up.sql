@@ -0,0 +1,3 @@
+-- Profile photos: the key points at the stored renditions in media storage
+ALTER TABLE therapist_profiles ADD COLUMN photo_key TEXT;
+ALTER TABLE therapist_profiles ADD COLUMN photo_uploaded_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS record_therapist_profiles_slug ON therapist_profiles;
+DROP FUNCTION IF EXISTS record_therapist_slug_change();
+DROP INDEX IF EXISTS idx_therapist_slug_history_therapist;
+DROP TABLE IF EXISTS therapist_slug_history;
+
+DROP INDEX IF EXISTS idx_therapist_profiles_slug;
+ALTER TABLE therapist_profiles DROP COLUMN IF EXISTS slug;
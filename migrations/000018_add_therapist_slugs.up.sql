@@ -0,0 +1,59 @@
+-- Human-readable public profile addresses
+ALTER TABLE therapist_profiles ADD COLUMN slug VARCHAR(100);
+
+-- Backfill from names: fold common accented letters, turn everything else into
+-- single hyphens and number repeated names in signup order
+WITH generated AS (
+    SELECT user_id, created_at,
+           COALESCE(NULLIF(TRIM(BOTH '-' FROM LEFT(REGEXP_REPLACE(
+               TRANSLATE(LOWER(first_name || ' ' || last_name),
+                         'áàâäãåčćçďđéèêëěíìîïľĺłňñóòôöõøřŕšśťúùûüůýÿžźż',
+                         'aaaaaacccddeeeeeiiiilllnnoooooorrsstuuuuuyyzzz'),
+               '[^a-z0-9]+', '-', 'g'), 90)), ''), 'therapist') AS base
+    FROM therapist_profiles
+),
+numbered AS (
+    SELECT user_id, base,
+           ROW_NUMBER() OVER (PARTITION BY base ORDER BY created_at, user_id) AS n
+    FROM generated
+)
+UPDATE therapist_profiles tp
+SET slug = CASE WHEN numbered.n = 1 THEN numbered.base ELSE numbered.base || '-' || numbered.n END
+FROM numbered
+WHERE numbered.user_id = tp.user_id;
+
+ALTER TABLE therapist_profiles ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX idx_therapist_profiles_slug ON therapist_profiles(slug);
+
+-- Slugs a profile used before, kept so old links redirect to the current one
+CREATE TABLE IF NOT EXISTS therapist_slug_history (
+    slug VARCHAR(100) PRIMARY KEY,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    retired_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_therapist_slug_history_therapist ON therapist_slug_history(therapist_id);
+
+-- Retire the old slug on every change; taking back an own earlier slug removes it from history
+CREATE OR REPLACE FUNCTION record_therapist_slug_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.slug IS NOT DISTINCT FROM OLD.slug THEN
+        RETURN NEW;
+    END IF;
+
+    DELETE FROM therapist_slug_history
+    WHERE slug = NEW.slug AND therapist_id = NEW.user_id;
+
+    INSERT INTO therapist_slug_history (slug, therapist_id)
+    VALUES (OLD.slug, NEW.user_id)
+    ON CONFLICT (slug) DO UPDATE SET therapist_id = EXCLUDED.therapist_id, retired_at = NOW();
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_therapist_profiles_slug
+    AFTER UPDATE OF slug ON therapist_profiles
+    FOR EACH ROW
+    EXECUTE FUNCTION record_therapist_slug_change();
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_questionnaire_responses_questionnaire;
+DROP INDEX IF EXISTS idx_questionnaire_responses_client;
+DROP TABLE IF EXISTS questionnaire_responses;
+
+DROP TABLE IF EXISTS questionnaire_versions;
+
+DROP TRIGGER IF EXISTS update_questionnaires_updated_at ON questionnaires;
+DROP INDEX IF EXISTS idx_questionnaires_therapist;
+DROP TABLE IF EXISTS questionnaires;
@@ -0,0 +1,42 @@
+-- Intake questionnaires built by therapists
+CREATE TABLE IF NOT EXISTS questionnaires (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    current_version INTEGER NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_questionnaires_therapist ON questionnaires(therapist_id, created_at DESC);
+
+CREATE TRIGGER update_questionnaires_updated_at
+    BEFORE UPDATE ON questionnaires
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Every published version of a questionnaire; rows are never changed once written
+CREATE TABLE IF NOT EXISTS questionnaire_versions (
+    questionnaire_id UUID NOT NULL REFERENCES questionnaires(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    questions JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (questionnaire_id, version),
+    CONSTRAINT chk_questionnaire_version CHECK (version > 0)
+);
+
+-- Client submissions, pinned to the version they answered
+CREATE TABLE IF NOT EXISTS questionnaire_responses (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    questionnaire_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    answers JSONB NOT NULL DEFAULT '[]',
+    submitted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (questionnaire_id, version) REFERENCES questionnaire_versions(questionnaire_id, version) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_questionnaire_responses_client ON questionnaire_responses(client_id, submitted_at DESC);
+CREATE INDEX idx_questionnaire_responses_questionnaire ON questionnaire_responses(questionnaire_id);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_client_assessments_updated_at ON client_assessments;
+DROP INDEX IF EXISTS idx_client_assessments_history;
+DROP INDEX IF EXISTS idx_client_assessments_client;
+DROP INDEX IF EXISTS idx_client_assessments_open;
+DROP TABLE IF EXISTS client_assessments;
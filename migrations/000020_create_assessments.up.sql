@@ -0,0 +1,32 @@
+-- Standardized assessments (PHQ-9, GAD-7, PCL-5) assigned by therapists.
+-- Instruments are defined in code; only administrations and their scores are stored.
+CREATE TABLE IF NOT EXISTS client_assessments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    instrument_code VARCHAR(20) NOT NULL,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'assigned',
+    responses JSONB,
+    score JSONB,
+    due_at TIMESTAMP WITH TIME ZONE,
+    assigned_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_assessment_status CHECK (status IN ('assigned', 'completed', 'cancelled')),
+    CONSTRAINT chk_assessment_completion CHECK ((status = 'completed') = (score IS NOT NULL AND completed_at IS NOT NULL))
+);
+
+-- A client has at most one open administration of each instrument
+CREATE UNIQUE INDEX idx_client_assessments_open
+    ON client_assessments(client_id, instrument_code)
+    WHERE status = 'assigned';
+
+CREATE INDEX idx_client_assessments_client ON client_assessments(client_id, assigned_at DESC);
+CREATE INDEX idx_client_assessments_history
+    ON client_assessments(client_id, therapist_id, instrument_code, completed_at)
+    WHERE status = 'completed';
+
+CREATE TRIGGER update_client_assessments_updated_at
+    BEFORE UPDATE ON client_assessments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
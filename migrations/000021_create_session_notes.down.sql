@@ -0,0 +1,13 @@
+COMMENT ON COLUMN client_profiles.legacy_notes IS NULL;
+ALTER TABLE client_profiles RENAME COLUMN legacy_notes TO notes;
+
+DROP TRIGGER IF EXISTS prevent_session_note_amendment_changes ON session_note_amendments;
+DROP FUNCTION IF EXISTS prevent_session_note_amendment_changes();
+DROP INDEX IF EXISTS idx_session_note_amendments_note;
+DROP TABLE IF EXISTS session_note_amendments;
+
+DROP TRIGGER IF EXISTS prevent_session_note_changes ON session_notes;
+DROP FUNCTION IF EXISTS prevent_session_note_changes();
+DROP INDEX IF EXISTS idx_session_notes_therapist;
+DROP INDEX IF EXISTS idx_session_notes_client;
+DROP TABLE IF EXISTS session_notes;
@@ -0,0 +1,85 @@
+-- Per-session progress notes. Content is encrypted by the application with
+-- envelope encryption: each row stores its own data key, wrapped by the
+-- key-encryption key named in key_id, next to the AES-GCM ciphertext.
+CREATE TABLE IF NOT EXISTS session_notes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    template VARCHAR(20) NOT NULL,
+    session_date DATE NOT NULL,
+    key_id VARCHAR(100) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    nonce BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    shared_with_client BOOLEAN NOT NULL DEFAULT FALSE,
+    shared_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_session_note_template CHECK (template IN ('soap', 'dap')),
+    CONSTRAINT chk_session_note_shared_at CHECK (shared_with_client = (shared_at IS NOT NULL))
+);
+
+CREATE INDEX idx_session_notes_client ON session_notes(client_id, session_date DESC, created_at DESC);
+CREATE INDEX idx_session_notes_therapist ON session_notes(therapist_id);
+
+-- Corrections to a note, appended after it was written
+CREATE TABLE IF NOT EXISTS session_note_amendments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    note_id UUID NOT NULL REFERENCES session_notes(id) ON DELETE CASCADE,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    key_id VARCHAR(100) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    nonce BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_session_note_amendments_note ON session_note_amendments(note_id, created_at);
+
+-- Notes are append-only. Only sharing may change, and the wrapped data key may be
+-- re-wrapped (key_id and wrapped_key) when a key-encryption key is rotated.
+CREATE OR REPLACE FUNCTION prevent_session_note_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.id IS DISTINCT FROM OLD.id
+        OR NEW.client_id IS DISTINCT FROM OLD.client_id
+        OR NEW.therapist_id IS DISTINCT FROM OLD.therapist_id
+        OR NEW.template IS DISTINCT FROM OLD.template
+        OR NEW.session_date IS DISTINCT FROM OLD.session_date
+        OR NEW.nonce IS DISTINCT FROM OLD.nonce
+        OR NEW.ciphertext IS DISTINCT FROM OLD.ciphertext
+        OR NEW.created_at IS DISTINCT FROM OLD.created_at THEN
+        RAISE EXCEPTION 'session notes are append-only; add an amendment instead';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_session_note_changes
+    BEFORE UPDATE ON session_notes
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_session_note_changes();
+
+CREATE OR REPLACE FUNCTION prevent_session_note_amendment_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.id IS DISTINCT FROM OLD.id
+        OR NEW.note_id IS DISTINCT FROM OLD.note_id
+        OR NEW.therapist_id IS DISTINCT FROM OLD.therapist_id
+        OR NEW.nonce IS DISTINCT FROM OLD.nonce
+        OR NEW.ciphertext IS DISTINCT FROM OLD.ciphertext
+        OR NEW.created_at IS DISTINCT FROM OLD.created_at THEN
+        RAISE EXCEPTION 'session note amendments cannot be changed';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_session_note_amendment_changes
+    BEFORE UPDATE ON session_note_amendments
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_session_note_amendment_changes();
+
+-- The old plaintext profile notes are no longer read or returned by the API.
+-- They are kept under a new name until they have been moved into session notes.
+ALTER TABLE client_profiles RENAME COLUMN notes TO legacy_notes;
+COMMENT ON COLUMN client_profiles.legacy_notes IS 'Plaintext notes from before encrypted session notes; not used by the application';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_treatment_plan_progress_plan;
+DROP TABLE IF EXISTS treatment_plan_progress;
+DROP TRIGGER IF EXISTS update_treatment_plans_updated_at ON treatment_plans;
+DROP INDEX IF EXISTS idx_treatment_plans_review_due;
+DROP INDEX IF EXISTS idx_treatment_plans_client;
+DROP INDEX IF EXISTS idx_treatment_plans_active;
+DROP TABLE IF EXISTS treatment_plans;
@@ -0,0 +1,50 @@
+-- Treatment plans written by a client's assigned therapist. Problems and goals
+-- are edited as a whole, so they are stored as JSONB on the plan; progress
+-- updates are an append-only log per goal.
+CREATE TABLE IF NOT EXISTS treatment_plans (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    problems JSONB NOT NULL DEFAULT '[]',
+    goals JSONB NOT NULL DEFAULT '[]',
+    review_interval_days INTEGER NOT NULL DEFAULT 90,
+    last_reviewed_at TIMESTAMP WITH TIME ZONE,
+    next_review_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    shared_with_client BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT chk_treatment_plan_status CHECK (status IN ('active', 'completed', 'discontinued')),
+    CONSTRAINT chk_treatment_plan_closed CHECK ((status = 'active') = (closed_at IS NULL)),
+    CONSTRAINT chk_treatment_plan_review_interval CHECK (review_interval_days BETWEEN 1 AND 365)
+);
+
+-- A client has at most one active plan with each therapist
+CREATE UNIQUE INDEX idx_treatment_plans_active
+    ON treatment_plans(client_id, therapist_id)
+    WHERE status = 'active';
+
+CREATE INDEX idx_treatment_plans_client ON treatment_plans(client_id, created_at DESC);
+CREATE INDEX idx_treatment_plans_review_due
+    ON treatment_plans(therapist_id, next_review_at)
+    WHERE status = 'active';
+
+CREATE TRIGGER update_treatment_plans_updated_at
+    BEFORE UPDATE ON treatment_plans
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS treatment_plan_progress (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    plan_id UUID NOT NULL REFERENCES treatment_plans(id) ON DELETE CASCADE,
+    goal_id VARCHAR(50) NOT NULL,
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    note TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_treatment_plan_progress_status CHECK (status IN ('not_started', 'in_progress', 'achieved', 'discontinued'))
+);
+
+CREATE INDEX idx_treatment_plan_progress_plan ON treatment_plan_progress(plan_id, created_at);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_journal_entries_updated_at ON journal_entries;
+DROP INDEX IF EXISTS idx_journal_entries_shared;
+DROP TABLE IF EXISTS journal_entries;
@@ -0,0 +1,41 @@
+-- Client mood journal, one entry per day. The free text is encrypted by the
+-- application with envelope encryption; the envelope columns are NULL for
+-- entries without text. Scores and emotion tags stay in plain columns for trends.
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    entry_date DATE NOT NULL,
+    mood_score SMALLINT NOT NULL,
+    emotions TEXT[] NOT NULL DEFAULT '{}',
+    sleep_hours NUMERIC(4, 1),
+    sleep_quality SMALLINT,
+    activity_minutes INTEGER,
+    key_id VARCHAR(100),
+    wrapped_key BYTEA,
+    nonce BYTEA,
+    ciphertext BYTEA,
+    shared_with_therapist BOOLEAN NOT NULL DEFAULT FALSE,
+    shared_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT uq_journal_entries_client_date UNIQUE (client_id, entry_date),
+    CONSTRAINT chk_journal_mood_score CHECK (mood_score BETWEEN 1 AND 10),
+    CONSTRAINT chk_journal_sleep_hours CHECK (sleep_hours BETWEEN 0 AND 24),
+    CONSTRAINT chk_journal_sleep_quality CHECK (sleep_quality BETWEEN 1 AND 5),
+    CONSTRAINT chk_journal_activity_minutes CHECK (activity_minutes BETWEEN 0 AND 1440),
+    CONSTRAINT chk_journal_envelope CHECK (
+        (key_id IS NULL) = (wrapped_key IS NULL)
+        AND (key_id IS NULL) = (nonce IS NULL)
+        AND (key_id IS NULL) = (ciphertext IS NULL)
+    ),
+    CONSTRAINT chk_journal_shared_at CHECK (shared_with_therapist = (shared_at IS NOT NULL))
+);
+
+CREATE INDEX idx_journal_entries_shared
+    ON journal_entries(client_id, entry_date)
+    WHERE shared_with_therapist;
+
+CREATE TRIGGER update_journal_entries_updated_at
+    BEFORE UPDATE ON journal_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
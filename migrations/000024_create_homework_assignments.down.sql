@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_homework_assignments_updated_at ON homework_assignments;
+DROP INDEX IF EXISTS idx_homework_assignments_overdue;
+DROP INDEX IF EXISTS idx_homework_assignments_client;
+DROP TABLE IF EXISTS homework_assignments;
@@ -0,0 +1,34 @@
+-- Between-session homework given by a therapist to an assigned client. An
+-- assignment may point to one article or therapy from the library; the
+-- reference is cleared if that content is deleted.
+CREATE TABLE IF NOT EXISTS homework_assignments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    therapist_id UUID NOT NULL REFERENCES therapist_profiles(user_id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    instructions TEXT NOT NULL DEFAULT '',
+    article_id VARCHAR(100) REFERENCES articles(id) ON DELETE SET NULL,
+    therapy_id VARCHAR(100) REFERENCES therapies(id) ON DELETE SET NULL,
+    due_at TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(20) NOT NULL DEFAULT 'assigned',
+    response TEXT,
+    reflection TEXT,
+    helpfulness SMALLINT,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    assigned_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_homework_status CHECK (status IN ('assigned', 'completed', 'cancelled')),
+    CONSTRAINT chk_homework_completion CHECK ((status = 'completed') = (completed_at IS NOT NULL)),
+    CONSTRAINT chk_homework_single_resource CHECK (article_id IS NULL OR therapy_id IS NULL),
+    CONSTRAINT chk_homework_helpfulness CHECK (helpfulness BETWEEN 1 AND 5)
+);
+
+CREATE INDEX idx_homework_assignments_client ON homework_assignments(client_id, assigned_at DESC);
+CREATE INDEX idx_homework_assignments_overdue
+    ON homework_assignments(therapist_id, due_at)
+    WHERE status = 'assigned' AND due_at IS NOT NULL;
+
+CREATE TRIGGER update_homework_assignments_updated_at
+    BEFORE UPDATE ON homework_assignments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS prevent_consent_signature_changes ON consent_signatures;
+DROP FUNCTION IF EXISTS prevent_consent_signature_changes();
+DROP INDEX IF EXISTS idx_consent_signatures_client;
+DROP TABLE IF EXISTS consent_signatures;
+
+DROP TRIGGER IF EXISTS prevent_consent_version_changes ON consent_document_versions;
+DROP FUNCTION IF EXISTS prevent_consent_version_changes();
+DROP TABLE IF EXISTS consent_document_versions;
+
+DROP TRIGGER IF EXISTS update_consent_documents_updated_at ON consent_documents;
+DROP TABLE IF EXISTS consent_documents;
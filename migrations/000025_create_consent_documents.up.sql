@@ -0,0 +1,91 @@
+-- Consent forms clients sign before treatment, e.g. informed consent and the
+-- privacy notice. The text of a document lives in numbered versions that are
+-- never changed once published; a new version asks every client to sign again.
+CREATE TABLE IF NOT EXISTS consent_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(200) NOT NULL,
+    kind VARCHAR(30) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_consent_document_kind CHECK (kind IN ('informed_consent', 'privacy', 'other'))
+);
+
+CREATE TRIGGER update_consent_documents_updated_at
+    BEFORE UPDATE ON consent_documents
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS consent_document_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    document_id UUID NOT NULL REFERENCES consent_documents(id) ON DELETE RESTRICT,
+    version_number INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    change_summary TEXT NOT NULL DEFAULT '',
+    content_hash CHAR(64) NOT NULL,
+    published_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    published_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT uq_consent_document_version UNIQUE (document_id, version_number),
+    CONSTRAINT chk_consent_version_number CHECK (version_number > 0)
+);
+
+-- Signatures keep the version and the hash of the text that was shown, with the
+-- typed name, IP address and user agent as evidence of who signed
+CREATE TABLE IF NOT EXISTS consent_signatures (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    document_id UUID NOT NULL REFERENCES consent_documents(id) ON DELETE RESTRICT,
+    version_id UUID NOT NULL REFERENCES consent_document_versions(id) ON DELETE RESTRICT,
+    version_number INTEGER NOT NULL,
+    typed_name VARCHAR(200) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent VARCHAR(500) NOT NULL DEFAULT '',
+    document_hash CHAR(64) NOT NULL,
+    signed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT uq_consent_signature_version UNIQUE (client_id, version_id)
+);
+
+CREATE INDEX idx_consent_signatures_client ON consent_signatures(client_id, signed_at DESC);
+
+-- Published versions are immutable. Only published_by may be cleared when the
+-- publishing admin's account is deleted.
+CREATE OR REPLACE FUNCTION prevent_consent_version_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        RAISE EXCEPTION 'published consent document versions cannot be deleted';
+    END IF;
+
+    IF NEW.id IS DISTINCT FROM OLD.id
+        OR NEW.document_id IS DISTINCT FROM OLD.document_id
+        OR NEW.version_number IS DISTINCT FROM OLD.version_number
+        OR NEW.title IS DISTINCT FROM OLD.title
+        OR NEW.body IS DISTINCT FROM OLD.body
+        OR NEW.change_summary IS DISTINCT FROM OLD.change_summary
+        OR NEW.content_hash IS DISTINCT FROM OLD.content_hash
+        OR NEW.published_at IS DISTINCT FROM OLD.published_at THEN
+        RAISE EXCEPTION 'published consent document versions cannot be changed; publish a new version instead';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_consent_version_changes
+    BEFORE UPDATE OR DELETE ON consent_document_versions
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_consent_version_changes();
+
+-- Signatures are a record of what happened and are never edited. They are only
+-- removed together with the client's profile.
+CREATE OR REPLACE FUNCTION prevent_consent_signature_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'consent signatures cannot be changed';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_consent_signature_changes
+    BEFORE UPDATE ON consent_signatures
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_consent_signature_changes();
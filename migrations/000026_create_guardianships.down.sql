@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS uq_consent_signature_version_guardian;
+DROP INDEX IF EXISTS uq_consent_signature_version_own;
+DELETE FROM consent_signatures WHERE signed_by_guardian_id IS NOT NULL;
+ALTER TABLE consent_signatures ADD CONSTRAINT uq_consent_signature_version UNIQUE (client_id, version_id);
+ALTER TABLE consent_signatures DROP COLUMN IF EXISTS signed_by_guardian_id;
+
+DROP TRIGGER IF EXISTS update_guardianships_updated_at ON guardianships;
+DROP INDEX IF EXISTS idx_guardianships_client;
+DROP INDEX IF EXISTS uq_guardianships_active;
+DROP TABLE IF EXISTS guardianships;
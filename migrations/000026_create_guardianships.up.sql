@@ -0,0 +1,44 @@
+-- Parents and legal guardians who manage the accounts of minor clients. A
+-- guardianship gives access to the parts of the account named in scopes and
+-- ends when the client comes of age; ended rows are kept as a record.
+CREATE TABLE IF NOT EXISTS guardianships (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    guardian_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    relationship VARCHAR(20) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    end_reason VARCHAR(20),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    ended_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT chk_guardianship_relationship CHECK (relationship IN ('parent', 'legal_guardian')),
+    CONSTRAINT chk_guardianship_status CHECK (status IN ('active', 'ended')),
+    CONSTRAINT chk_guardianship_end_reason CHECK (end_reason IN ('revoked', 'majority')),
+    CONSTRAINT chk_guardianship_ended CHECK ((status = 'ended') = (ended_at IS NOT NULL AND end_reason IS NOT NULL)),
+    CONSTRAINT chk_guardianship_not_self CHECK (guardian_id <> client_id)
+);
+
+CREATE UNIQUE INDEX uq_guardianships_active
+    ON guardianships(guardian_id, client_id)
+    WHERE status = 'active';
+CREATE INDEX idx_guardianships_client ON guardianships(client_id, created_at DESC);
+
+CREATE TRIGGER update_guardianships_updated_at
+    BEFORE UPDATE ON guardianships
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Guardians sign consent forms on behalf of minors. The guardian is recorded
+-- without a foreign key so the signature stays intact if their account goes.
+ALTER TABLE consent_signatures ADD COLUMN signed_by_guardian_id UUID;
+
+-- A version can be signed once by the client and once on their behalf, so a
+-- client who comes of age can sign the version their guardian already signed
+ALTER TABLE consent_signatures DROP CONSTRAINT uq_consent_signature_version;
+CREATE UNIQUE INDEX uq_consent_signature_version_own
+    ON consent_signatures(client_id, version_id)
+    WHERE signed_by_guardian_id IS NULL;
+CREATE UNIQUE INDEX uq_consent_signature_version_guardian
+    ON consent_signatures(client_id, version_id)
+    WHERE signed_by_guardian_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_safety_alerts_updated_at ON safety_alerts;
+DROP INDEX IF EXISTS idx_safety_alerts_escalated;
+DROP INDEX IF EXISTS idx_safety_alerts_escalation;
+DROP INDEX IF EXISTS idx_safety_alerts_source;
+DROP INDEX IF EXISTS idx_safety_alerts_therapist;
+DROP TABLE IF EXISTS safety_alerts;
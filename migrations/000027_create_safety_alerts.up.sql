@@ -0,0 +1,36 @@
+-- Alerts raised when a client's journal entry or assessment suggests acute
+-- risk. The source is recorded without a foreign key so the alert outlives a
+-- deleted entry. Alerts nobody acknowledges by escalation_due_at are escalated
+-- to the admins; alerts for clients without a therapist are escalated at once.
+CREATE TABLE IF NOT EXISTS safety_alerts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES client_profiles(user_id) ON DELETE CASCADE,
+    therapist_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    source VARCHAR(20) NOT NULL,
+    source_id UUID NOT NULL,
+    severity VARCHAR(20) NOT NULL,
+    signals JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    escalation_due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    escalated_at TIMESTAMP WITH TIME ZONE,
+    acknowledged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    acknowledged_at TIMESTAMP WITH TIME ZONE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_safety_alert_source CHECK (source IN ('journal_entry', 'assessment')),
+    CONSTRAINT chk_safety_alert_severity CHECK (severity IN ('high', 'critical')),
+    CONSTRAINT chk_safety_alert_status CHECK (status IN ('open', 'acknowledged')),
+    CONSTRAINT chk_safety_alert_acknowledged CHECK ((status = 'acknowledged') = (acknowledged_at IS NOT NULL))
+);
+
+CREATE INDEX idx_safety_alerts_therapist ON safety_alerts(therapist_id, created_at DESC);
+CREATE INDEX idx_safety_alerts_source ON safety_alerts(source, source_id) WHERE status = 'open';
+CREATE INDEX idx_safety_alerts_escalation ON safety_alerts(escalation_due_at)
+    WHERE status = 'open' AND escalated_at IS NULL;
+CREATE INDEX idx_safety_alerts_escalated ON safety_alerts(escalated_at DESC) WHERE escalated_at IS NOT NULL;
+
+CREATE TRIGGER update_safety_alerts_updated_at
+    BEFORE UPDATE ON safety_alerts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,8 @@
+ALTER TABLE client_profiles ADD COLUMN emergency_contact VARCHAR(255);
+
+-- Only the first contact fits the old column
+UPDATE client_profiles
+SET emergency_contact = left(concat_ws(' ', emergency_contacts -> 0 ->> 'name', emergency_contacts -> 0 ->> 'phone', emergency_contacts -> 0 ->> 'email'), 255)
+WHERE jsonb_array_length(emergency_contacts) > 0;
+
+ALTER TABLE client_profiles DROP COLUMN IF EXISTS emergency_contacts;
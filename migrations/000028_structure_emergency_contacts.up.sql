@@ -0,0 +1,45 @@
+-- Emergency contacts become a list of structured contacts, in priority order,
+-- with phone numbers in E.164.
+ALTER TABLE client_profiles ADD COLUMN emergency_contacts JSONB NOT NULL DEFAULT '[]';
+
+-- The old free-text value becomes one contact. A number in international
+-- format, e.g. "Jane Doe - sister - +1 555 0101", is taken out as the phone
+-- and the rest kept as the name. Anything else is kept as the name, with no
+-- phone or email; the API flags such contacts as needing completion. Names are
+-- cut to 100 characters, the profile's limit. Nobody agreed to be contacted,
+-- so consent starts off.
+WITH legacy AS (
+    SELECT user_id,
+           left(trim(emergency_contact), 100) AS text,
+           substring(emergency_contact FROM '(?:\+|00)[0-9][0-9 ()./-]*[0-9]') AS phone_text
+    FROM client_profiles
+    WHERE emergency_contact IS NOT NULL AND trim(emergency_contact) <> ''
+), parsed AS (
+    SELECT user_id,
+           text,
+           phone_text,
+           regexp_replace(regexp_replace(phone_text, '^00', ''), '[^0-9]', '', 'g') AS digits
+    FROM legacy
+), contacts AS (
+    SELECT user_id,
+           CASE WHEN length(digits) BETWEEN 7 AND 15 AND digits NOT LIKE '0%' THEN '+' || digits END AS phone,
+           text,
+           trim(BOTH ' -,;:/' FROM replace(text, coalesce(phone_text, ''), '')) AS rest
+    FROM parsed
+)
+UPDATE client_profiles cp
+SET emergency_contacts = jsonb_build_array(jsonb_strip_nulls(jsonb_build_object(
+        'name', CASE
+                    WHEN c.phone IS NULL THEN c.text
+                    WHEN c.rest <> '' THEN c.rest
+                    ELSE 'Emergency contact'
+                END,
+        'relationship', 'other',
+        'phone', c.phone,
+        'priority', 1,
+        'consent_to_contact', false
+    )))
+FROM contacts c
+WHERE c.user_id = cp.user_id;
+
+ALTER TABLE client_profiles DROP COLUMN emergency_contact;
@@ -0,0 +1,18 @@
+DELETE FROM safety_alerts WHERE source = 'message';
+ALTER TABLE safety_alerts DROP CONSTRAINT IF EXISTS chk_safety_alert_source;
+ALTER TABLE safety_alerts ADD CONSTRAINT chk_safety_alert_source
+    CHECK (source IN ('journal_entry', 'assessment'));
+
+DROP INDEX IF EXISTS idx_message_attachments_message;
+DROP TABLE IF EXISTS message_attachments;
+
+DROP INDEX IF EXISTS idx_messages_created;
+DROP INDEX IF EXISTS idx_messages_unread;
+DROP INDEX IF EXISTS idx_messages_thread;
+DROP INDEX IF EXISTS idx_messages_conversation;
+DROP TABLE IF EXISTS messages;
+
+DROP TRIGGER IF EXISTS update_conversations_updated_at ON conversations;
+DROP INDEX IF EXISTS idx_conversations_therapist;
+DROP INDEX IF EXISTS idx_conversations_client;
+DROP TABLE IF EXISTS conversations;
@@ -0,0 +1,64 @@
+-- Private conversations between a client and a therapist, one per pair. The
+-- application only lets an active relationship (the client's current
+-- therapist) send messages; past conversations stay readable.
+CREATE TABLE IF NOT EXISTS conversations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    therapist_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    retention_days INTEGER NOT NULL DEFAULT 365,
+    last_message_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT uq_conversations_participants UNIQUE (client_id, therapist_id),
+    CONSTRAINT chk_conversation_retention CHECK (retention_days BETWEEN 30 AND 3650)
+);
+
+CREATE INDEX idx_conversations_client ON conversations(client_id, (COALESCE(last_message_at, created_at)) DESC);
+CREATE INDEX idx_conversations_therapist ON conversations(therapist_id, (COALESCE(last_message_at, created_at)) DESC);
+
+CREATE TRIGGER update_conversations_updated_at
+    BEFORE UPDATE ON conversations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Message text is encrypted by the application with envelope encryption, like
+-- journal entries. Attachment file names are sealed with the text. Replies
+-- point at the first message of their thread.
+CREATE TABLE IF NOT EXISTS messages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    thread_id UUID REFERENCES messages(id) ON DELETE CASCADE,
+    key_id VARCHAR(100) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    nonce BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_messages_conversation ON messages(conversation_id, created_at DESC, id DESC) WHERE thread_id IS NULL;
+CREATE INDEX idx_messages_thread ON messages(thread_id, created_at DESC, id DESC) WHERE thread_id IS NOT NULL;
+CREATE INDEX idx_messages_unread ON messages(conversation_id, sender_id) WHERE read_at IS NULL;
+CREATE INDEX idx_messages_created ON messages(created_at);
+
+-- Attachment content is encrypted separately so listings never load it
+CREATE TABLE IF NOT EXISTS message_attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id UUID NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    key_id VARCHAR(100) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    nonce BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_message_attachment_size CHECK (size_bytes > 0)
+);
+
+CREATE INDEX idx_message_attachments_message ON message_attachments(message_id);
+
+-- Client messages are screened for acute risk like journal entries
+ALTER TABLE safety_alerts DROP CONSTRAINT IF EXISTS chk_safety_alert_source;
+ALTER TABLE safety_alerts ADD CONSTRAINT chk_safety_alert_source
+    CHECK (source IN ('journal_entry', 'assessment', 'message'));
@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS update_notification_emails_updated_at ON notification_emails;
+DROP TRIGGER IF EXISTS update_notification_preferences_updated_at ON notification_preferences;
+
+DROP INDEX IF EXISTS idx_notification_emails_claimed;
+DROP INDEX IF EXISTS idx_notification_emails_due;
+DROP TABLE IF EXISTS notification_emails;
+
+DROP TABLE IF EXISTS notification_preferences;
+
+DROP INDEX IF EXISTS idx_notifications_unread;
+DROP INDEX IF EXISTS idx_notifications_user;
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,66 @@
+-- In-app inbox. Notifications hold rendered, generic text only; the details
+-- stay behind the link to the app.
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user ON notifications(user_id, created_at DESC, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
+
+-- Users without a row get the defaults: every channel on, no quiet hours.
+-- Quiet hours are minutes after midnight in the user's time zone.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    in_app_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    language VARCHAR(10),
+    quiet_hours_start SMALLINT,
+    quiet_hours_end SMALLINT,
+    quiet_hours_time_zone VARCHAR(64),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_notification_quiet_hours CHECK (
+        (quiet_hours_start IS NULL AND quiet_hours_end IS NULL AND quiet_hours_time_zone IS NULL) OR
+        (quiet_hours_start BETWEEN 0 AND 1439 AND quiet_hours_end BETWEEN 0 AND 1439
+            AND quiet_hours_start <> quiet_hours_end AND quiet_hours_time_zone IS NOT NULL)
+    )
+);
+
+-- Email outbox. Any API instance may claim due emails; claimed_until lets
+-- another instance take over emails whose sender stopped mid-send.
+CREATE TABLE IF NOT EXISTS notification_emails (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(100) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    send_after TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    claimed_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_notification_email_status CHECK (status IN ('pending', 'sending', 'sent', 'failed'))
+);
+
+CREATE INDEX idx_notification_emails_due ON notification_emails(send_after) WHERE status = 'pending';
+CREATE INDEX idx_notification_emails_claimed ON notification_emails(claimed_until) WHERE status = 'sending';
+
+CREATE TRIGGER update_notification_preferences_updated_at
+    BEFORE UPDATE ON notification_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_notification_emails_updated_at
+    BEFORE UPDATE ON notification_emails
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
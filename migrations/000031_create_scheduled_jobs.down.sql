@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_scheduled_jobs_updated_at ON scheduled_jobs;
+DROP INDEX IF EXISTS idx_scheduled_jobs_key;
+DROP INDEX IF EXISTS idx_scheduled_jobs_claimed;
+DROP INDEX IF EXISTS idx_scheduled_jobs_due;
+DROP TABLE IF EXISTS scheduled_jobs;
@@ -0,0 +1,27 @@
+-- Durable jobs run by whichever API instance claims them first. key groups
+-- the jobs scheduled for one thing, such as the reminders of an appointment,
+-- so they can be replaced or cancelled together. claimed_until lets another
+-- instance take over jobs whose runner stopped mid-run.
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(100) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    claimed_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_scheduled_job_status CHECK (status IN ('pending', 'running', 'done', 'failed', 'cancelled'))
+);
+
+CREATE INDEX idx_scheduled_jobs_due ON scheduled_jobs(run_at) WHERE status = 'pending';
+CREATE INDEX idx_scheduled_jobs_claimed ON scheduled_jobs(claimed_until) WHERE status = 'running';
+CREATE INDEX idx_scheduled_jobs_key ON scheduled_jobs(key) WHERE status IN ('pending', 'running');
+
+CREATE TRIGGER update_scheduled_jobs_updated_at
+    BEFORE UPDATE ON scheduled_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- Recurring jobs, such as expiring licenses or purging old messages, are one
+-- job under a 'recurring:' key that reschedules itself after each run. The
+-- index keeps instances that start at the same time from adding it twice.
+CREATE UNIQUE INDEX idx_scheduled_jobs_recurring ON scheduled_jobs(key)
+    WHERE key LIKE 'recurring:%' AND status IN ('pending', 'running');